@@ -21,6 +21,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strings"
 
 	"gopkg.in/yaml.v2"
@@ -35,47 +36,213 @@ func errExit(format string, args ...interface{}) {
 }
 
 var (
-	flagPackageName        string
-	flagGenerate           string
-	flagOutputFile         string
-	flagIncludeTags        string
-	flagExcludeTags        string
-	flagTemplatesDir       string
-	flagImportMapping      string
-	flagExcludeSchemas     string
-	flagConfigFile         string
-	flagResponseTypeSuffix string
-	flagAliasTypes         bool
-	flagPrintVersion       bool
-	flagOlfAllOfOutput     bool
+	flagPackageName         string
+	flagGenerate            string
+	flagOutputFile          string
+	flagIncludeTags         string
+	flagExcludeTags         string
+	flagIncludeOperationIDs string
+	flagExcludeOperationIDs string
+	flagTemplatesDir        string
+	flagImportMapping       string
+	flagExcludeSchemas      string
+	flagConfigFile          string
+	flagResponseTypeSuffix  string
+	flagNameNormalizer      string
+	flagNameCollisions      string
+	flagReservedWords       string
+	flagEnumNaming          string
+	flagSkipEmailValidation bool
+	flagSkipInternal        bool
+	flagLogValuers          bool
+	flagBodyBuilders        int
+	flagOptionConstructors  int
+	flagStrictParamContent  bool
+	flagDateLayout          string
+	flagDurationFormat      string
+	flagYAMLPackage         string
+	flagMsgpackPackage      string
+	flagAWSSigV4Service     string
+	flagAWSSigV4Region      string
+	flagCorrelationIDHeader string
+	flagTagPackages         string
+	flagDocumentPackages    string
+	flagPlugins             string
+	flagSpecPlugins         string
+	flagRedactInternal      bool
+	flagRedactTags          string
+	flagPostProcess         string
+	flagBuildTags           string
+	flagGeneratedBanner     string
+	flagSpecHeaders         string
+	flagSpecBearerToken     string
+	flagSpecBasicAuth       string
+	flagSpecCACert          string
+	flagSpecClientCert      string
+	flagSpecClientKey       string
+	flagSpecInsecure        bool
+	flagVerify              int
+	flagReport              bool
+	flagAliasTypes          bool
+	flagBulkHelpers         bool
+	flagSentinelErrors      bool
+	flagLoggingMiddleware   bool
+	flagFactories           bool
+	flagBundle              bool
+	flagTransliterate       bool
+	flagPrintVersion        bool
+	flagOlfAllOfOutput      bool
 )
 
 type configuration struct {
-	PackageName        string            `yaml:"package"`
-	GenerateTargets    []string          `yaml:"generate"`
-	OutputFile         string            `yaml:"output"`
-	IncludeTags        []string          `yaml:"include-tags"`
-	ExcludeTags        []string          `yaml:"exclude-tags"`
-	TemplatesDir       string            `yaml:"templates"`
-	ImportMapping      map[string]string `yaml:"import-mapping"`
-	ExcludeSchemas     []string          `yaml:"exclude-schemas"`
-	OldAllOfOutput     bool              `yaml:"old-all-of-output"`
-	ResponseTypeSuffix string            `yaml:"response-type-suffix"`
+	PackageName         string                 `yaml:"package"`
+	GenerateTargets     []string               `yaml:"generate"`
+	OutputFile          string                 `yaml:"output"`
+	IncludeTags         []string               `yaml:"include-tags"`
+	ExcludeTags         []string               `yaml:"exclude-tags"`
+	IncludeOperationIDs []string               `yaml:"include-operation-ids"`
+	ExcludeOperationIDs []string               `yaml:"exclude-operation-ids"`
+	TemplatesDir        string                 `yaml:"templates"`
+	TemplateData        map[string]interface{} `yaml:"template-data"`
+	ImportMapping       map[string]string      `yaml:"import-mapping"`
+	Bundle              bool                   `yaml:"bundle"`
+	ExcludeSchemas      []string               `yaml:"exclude-schemas"`
+	OldAllOfOutput      bool                   `yaml:"old-all-of-output"`
+	ResponseTypeSuffix  string                 `yaml:"response-type-suffix"`
+	NameNormalizer      string                 `yaml:"name-normalizer"`
+	NameCollisions      string                 `yaml:"name-collisions"`
+	ReservedWords       string                 `yaml:"reserved-words"`
+	ReservedWordsMap    map[string]string      `yaml:"reserved-words-map"`
+	Transliterate       bool                   `yaml:"transliterate"`
+	Transliterations    map[string]string      `yaml:"transliterations"`
+	EnumNaming          string                 `yaml:"enum-naming"`
+	SkipEmailValidation bool                   `yaml:"skip-email-validation"`
+	SkipInternal        bool                   `yaml:"skip-internal"`
+	LogValuers          bool                   `yaml:"log-valuers"`
+	StrictParamContent  bool                   `yaml:"strict-param-content"`
+	DateLayout          string                 `yaml:"date-layout"`
+	DurationFormat      string                 `yaml:"duration-format"`
+	YAMLPackage         string                 `yaml:"yaml-package"`
+	MsgpackPackage      string                 `yaml:"msgpack-package"`
+	AWSSigV4Service     string                 `yaml:"aws-sigv4-service"`
+	AWSSigV4Region      string                 `yaml:"aws-sigv4-region"`
+	CorrelationIDHeader string                 `yaml:"correlation-id-header"`
+	BulkHelpers         bool                   `yaml:"bulk-helpers"`
+	SentinelErrors      bool                   `yaml:"sentinel-errors"`
+	LoggingMiddleware   bool                   `yaml:"logging-middleware"`
+	Factories           bool                   `yaml:"factories"`
+	BodyBuilders        int                    `yaml:"body-builders"`
+	OptionConstructors  int                    `yaml:"option-constructors"`
+	TagPackages         string                 `yaml:"tag-packages"`
+	DocumentPackages    string                 `yaml:"document-packages"`
+	Plugins             []string               `yaml:"plugins"`
+	SpecPlugins         []string               `yaml:"spec-plugins"`
+	PostProcess         string                 `yaml:"post-process"`
+	FileHeader          string                 `yaml:"file-header"`
+	BuildTags           string                 `yaml:"build-tags"`
+	GeneratedBanner     string                 `yaml:"generated-banner"`
+	Verify              int                    `yaml:"verify"`
+	Report              bool                   `yaml:"report"`
+	SpecURL             specURLConfiguration   `yaml:"spec-url"`
+	RedactSpec          redactionConfiguration `yaml:"redact-spec"`
+}
+
+// redactionConfiguration configures what's stripped from the embedded spec.
+type redactionConfiguration struct {
+	Internal    bool     `yaml:"internal"`
+	IncludeTags []string `yaml:"include-tags"`
+	ExcludeTags []string `yaml:"exclude-tags"`
+}
+
+// specURLConfiguration configures how specs at URLs are fetched. Its values
+// can refer to environment variables, such as $TOKEN, to keep secrets out of
+// configuration files.
+type specURLConfiguration struct {
+	Headers            map[string]string `yaml:"headers"`
+	BearerToken        string            `yaml:"bearer-token"`
+	BasicAuth          string            `yaml:"basic-auth"`
+	CACert             string            `yaml:"ca-cert"`
+	ClientCert         string            `yaml:"client-cert"`
+	ClientKey          string            `yaml:"client-key"`
+	InsecureSkipVerify bool              `yaml:"insecure-skip-verify"`
+}
+
+// loadOptions returns the options loading specs at URLs, with the
+// environment variables of the configuration expanded.
+func (c specURLConfiguration) loadOptions() util.LoadOptions {
+	opts := util.LoadOptions{
+		BearerToken:        os.ExpandEnv(c.BearerToken),
+		BasicAuth:          os.ExpandEnv(c.BasicAuth),
+		CACertFile:         os.ExpandEnv(c.CACert),
+		ClientCertFile:     os.ExpandEnv(c.ClientCert),
+		ClientKeyFile:      os.ExpandEnv(c.ClientKey),
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if len(c.Headers) > 0 {
+		opts.Headers = make(map[string]string, len(c.Headers))
+		for name, value := range c.Headers {
+			opts.Headers[name] = os.ExpandEnv(value)
+		}
+	}
+	return opts
 }
 
 func main() {
 
 	flag.StringVar(&flagPackageName, "package", "", "The package name for generated code")
 	flag.StringVar(&flagGenerate, "generate", "types,client,server,spec",
-		`Comma-separated list of code to generate; valid options: "types", "client", "chi-server", "server", "gin", "spec", "skip-fmt", "skip-prune"`)
+		`Comma-separated list of code to generate; valid options: "types", "client", "chi-server", "server", "gin", "spec", "contract-tests", "example-tests", "fuzz-tests", "property-generators", "schema-assertions", "test-client", "cli", "paths", "routes", "skip-fmt", "skip-prune", "prune-unreachable"`)
 	flag.StringVar(&flagOutputFile, "o", "", "Where to output generated code, stdout is default")
 	flag.StringVar(&flagIncludeTags, "include-tags", "", "Only include operations with the given tags. Comma-separated list of tags.")
 	flag.StringVar(&flagExcludeTags, "exclude-tags", "", "Exclude operations that are tagged with the given tags. Comma-separated list of tags.")
+	flag.StringVar(&flagIncludeOperationIDs, "include-operation-ids", "", "Only include operations whose ID matches one of the given glob patterns. Comma-separated list of patterns.")
+	flag.StringVar(&flagExcludeOperationIDs, "exclude-operation-ids", "", "Exclude operations whose ID matches one of the given glob patterns. Comma-separated list of patterns.")
 	flag.StringVar(&flagTemplatesDir, "templates", "", "Path to directory containing user templates")
-	flag.StringVar(&flagImportMapping, "import-mapping", "", "A dict from the external reference to golang package path")
-	flag.StringVar(&flagExcludeSchemas, "exclude-schemas", "", "A comma separated list of schemas which must be excluded from generation")
+	flag.StringVar(&flagImportMapping, "import-mapping", "", "A dict from the external reference to golang package path, which can be followed by #alias to name the package")
+	flag.StringVar(&flagExcludeSchemas, "exclude-schemas", "", "A comma separated list of schema names or glob patterns which must be excluded from generation")
 	flag.StringVar(&flagConfigFile, "config", "", "a YAML config file that controls oapi-codegen behavior")
 	flag.StringVar(&flagResponseTypeSuffix, "response-type-suffix", "", "the suffix used for responses types")
+	flag.StringVar(&flagNameCollisions, "name-collisions", "", `how collisions of the Go names of schemas, or of operations, are resolved; valid options: "error", "numeric-suffix" and "tag-prefix"`)
+	flag.StringVar(&flagReservedWords, "reserved-words", "", `how Go keywords and predeclared identifiers are escaped in sanitized names; valid options: "underscore-prefix", the default, "value-suffix" and "pascal-case"`)
+	flag.StringVar(&flagEnumNaming, "enum-naming", "", `how the constants of enums are named; valid options: "type-prefix", the default, "short" and "screaming-snake"`)
+	flag.BoolVar(&flagSkipEmailValidation, "skip-email-validation", false, "when true, strings of the email format are plain strings, rather than openapi_types.Email, which is validated when unmarshaled and bound")
+	flag.BoolVar(&flagSkipInternal, "skip-internal", false, "when true, no code is generated for the operations, parameters, properties and schemas marked x-internal: true, which are left out of the embedded spec too")
+	flag.BoolVar(&flagLogValuers, "log-valuers", false, "when true, types with x-sensitive properties get log/slog LogValue methods masking them, which need Go 1.21")
+	flag.BoolVar(&flagStrictParamContent, "strict-param-content", false, "when true, parameters whose content is neither JSON, XML nor plain text of a primitive schema are an error, rather than plain strings")
+	flag.StringVar(&flagDateLayout, "date-layout", "", `the Go layout of dates, such as "20060102", rather than "2006-01-02"`)
+	flag.StringVar(&flagDurationFormat, "duration-format", "", `the syntax of durations; valid options: "go", the default, writing "1h30m0s", and "iso8601", writing "PT1H30M"`)
+	flag.StringVar(&flagNameNormalizer, "name-normalizer", "", `how names of the spec become Go identifiers; valid options: "pascal-case", the default, and "title-case", which also turns the rest of every word to lower case`)
+	flag.StringVar(&flagYAMLPackage, "yaml-package", "", "the import path of the YAML library used for YAML bodies, gopkg.in/yaml.v2 by default")
+	flag.StringVar(&flagMsgpackPackage, "msgpack-package", "", "the import path of the MessagePack library used for MessagePack bodies, github.com/vmihailenco/msgpack/v5 by default")
+	flag.StringVar(&flagAWSSigV4Service, "aws-sigv4-service", "", "when set, the client can sign requests with AWS Signature Version 4 for this service, such as execute-api")
+	flag.StringVar(&flagAWSSigV4Region, "aws-sigv4-region", "", "the AWS region requests are signed for, required with aws-sigv4-service")
+	flag.StringVar(&flagCorrelationIDHeader, "correlation-id-header", "", "the header carrying correlation IDs between clients and servers, X-Request-ID by default")
+	flag.StringVar(&flagTagPackages, "tag-packages", "", "when set, the import path of the output directory, in which a package is generated for each tag, and a common one for the components")
+	flag.StringVar(&flagBuildTags, "build-tags", "", "The build constraint of the generated code, such as \"linux && !race\"")
+	flag.StringVar(&flagGeneratedBanner, "generated-banner", "", "Replaces the \"Code generated by oapi-codegen ... DO NOT EDIT.\" comment of the generated code, keeping its form")
+	flag.StringVar(&flagSpecHeaders, "spec-headers", "", "Headers sent with the requests for a spec at a URL, in the form name1:value1,name2:value2")
+	flag.StringVar(&flagSpecBearerToken, "spec-bearer-token", "", "A token sent as the Authorization header of the requests for a spec at a URL")
+	flag.StringVar(&flagSpecBasicAuth, "spec-basic-auth", "", "A user and password sent as the Authorization header of the requests for a spec at a URL, in the form user:password")
+	flag.StringVar(&flagSpecCACert, "spec-ca-cert", "", "A PEM file of certificates trusted along with those of the system when fetching a spec at a URL")
+	flag.StringVar(&flagSpecClientCert, "spec-client-cert", "", "A PEM certificate presented to servers asking for one when fetching a spec at a URL")
+	flag.StringVar(&flagSpecClientKey, "spec-client-key", "", "The PEM key of spec-client-cert")
+	flag.BoolVar(&flagSpecInsecure, "spec-insecure-skip-verify", false, "when true, the certificate of the server of a spec at a URL isn't verified")
+	flag.StringVar(&flagPostProcess, "post-process", "", "A command through which the generated code is piped before it's written, such as gofumpt or \"goimports -local github.com/acme\"")
+	flag.StringVar(&flagPlugins, "plugins", "", "A comma separated list of generator plugin commands, which write their files next to the generated code")
+	flag.StringVar(&flagSpecPlugins, "spec-plugins", "", "A comma separated list of spec plugin commands, which alter the spec, read and written as JSON, before code is generated for it")
+	flag.BoolVar(&flagRedactInternal, "redact-internal", false, "when true, the operations and component schemas marked x-internal: true are stripped from the embedded spec, while code is still generated for them")
+	flag.StringVar(&flagRedactTags, "redact-tags", "", "Strip the operations tagged with the given tags from the embedded spec, while code is still generated for them. Comma-separated list of tags.")
+	flag.IntVar(&flagVerify, "verify", 0, "when greater than one, the code is generated this many times, failing unless it's identical every time")
+	flag.BoolVar(&flagReport, "report", false, "when true, the size of every generated package, how long it took and the peak size of the heap while generating it are printed on the standard error")
+	flag.StringVar(&flagDocumentPackages, "document-packages", "", "when set, the import path of the output directory, in which a package is generated for the spec and for each of the specs its references lead to")
+	flag.BoolVar(&flagBundle, "bundle", false, "when true, the components of other specs which the spec refers to are generated along with its own, rather than imported with import-mapping")
+	flag.BoolVar(&flagTransliterate, "transliterate", false, "when true, letters of names, such as país, are spelled in ASCII in Go identifiers")
+	flag.BoolVar(&flagBulkHelpers, "bulk-helpers", false, "when true, the client gets helpers calling list operations with many parameter sets concurrently")
+	flag.BoolVar(&flagLoggingMiddleware, "logging-middleware", false, "when true, the servers get a LoggingMiddleware logging requests with their operation ID and path template, rather than their path")
+	flag.BoolVar(&flagSentinelErrors, "sentinel-errors", false, "when true, the client gets sentinel errors of the declared client error statuses, such as ErrNotFound, which the Err methods of its responses wrap")
+	flag.BoolVar(&flagFactories, "factories", false, "when true, the types get factories of valid values, built from the examples of the spec, such as PetFactory")
+	flag.IntVar(&flagBodyBuilders, "body-builders", 0, "when greater than zero, request bodies with at least this many properties get builders, such as BuildAddPetJSONRequestBody().WithName(name).Build(), which fail when required properties weren't set")
+	flag.IntVar(&flagOptionConstructors, "option-constructors", 0, "when greater than zero, models with at least this many optional properties get constructors taking the required ones, and options setting the others, such as NewPet(name, WithPetTag(tag))")
 	flag.BoolVar(&flagAliasTypes, "alias-types", false, "Alias type declarations of possible")
 	flag.BoolVar(&flagPrintVersion, "version", false, "when specified, print version and exit")
 	flag.BoolVar(&flagOlfAllOfOutput, "old-all-of-output", false, "when true, old and incorrect AllOf implementation is used")
@@ -93,7 +260,7 @@ func main() {
 	}
 
 	if flag.NArg() < 1 {
-		fmt.Println("Please specify a path to a OpenAPI 3.0 spec file")
+		fmt.Println("Please specify a path to a OpenAPI 3.0 spec file, or - to read it from the standard input")
 		os.Exit(1)
 	}
 
@@ -102,6 +269,9 @@ func main() {
 	// If the package name has not been specified, we will use the name of the
 	// swagger file.
 	if cfg.PackageName == "" {
+		if flag.Arg(0) == "-" {
+			errExit("a package name is required to read the spec from the standard input\n")
+		}
 		path := flag.Arg(0)
 		baseName := filepath.Base(path)
 		// Split the base name on '.' to get the first part of the file.
@@ -127,10 +297,30 @@ func main() {
 			opts.GenerateTypes = true
 		case "spec":
 			opts.EmbedSpec = true
+		case "contract-tests":
+			opts.ContractTests = true
+		case "example-tests":
+			opts.ExampleTests = true
+		case "fuzz-tests":
+			opts.FuzzTests = true
+		case "property-generators":
+			opts.PropertyGenerators = true
+		case "schema-assertions":
+			opts.SchemaAssertions = true
+		case "test-client":
+			opts.TestClient = true
+		case "cli":
+			opts.GenerateCLI = true
+		case "paths":
+			opts.GeneratePaths = true
+		case "routes":
+			opts.GenerateRoutes = true
 		case "skip-fmt":
 			opts.SkipFmt = true
 		case "skip-prune":
 			opts.SkipPrune = true
+		case "prune-unreachable":
+			opts.PruneUnreachable = true
 		default:
 			fmt.Printf("unknown generate option %s\n", g)
 			flag.PrintDefaults()
@@ -140,39 +330,227 @@ func main() {
 
 	opts.IncludeTags = cfg.IncludeTags
 	opts.ExcludeTags = cfg.ExcludeTags
+	opts.IncludeOperationIDs = cfg.IncludeOperationIDs
+	opts.ExcludeOperationIDs = cfg.ExcludeOperationIDs
 	opts.ExcludeSchemas = cfg.ExcludeSchemas
 
 	if opts.GenerateEchoServer && opts.GenerateChiServer {
 		errExit("can not specify both server and chi-server targets simultaneously")
 	}
 
-	swagger, err := util.LoadSwagger(flag.Arg(0))
-	if err != nil {
-		errExit("error loading swagger spec in %s\n: %s", flag.Arg(0), err)
-	}
+	opts.LoadOptions = cfg.SpecURL.loadOptions()
 
 	templates, err := loadTemplateOverrides(cfg.TemplatesDir)
 	if err != nil {
 		errExit("error loading template overrides: %s\n", err)
 	}
 	opts.UserTemplates = templates
+	opts.TemplateData = cfg.TemplateData
 
 	opts.ImportMapping = cfg.ImportMapping
+	opts.BundleExternalRefs = cfg.Bundle
 	opts.OldMergeSchemas = cfg.OldAllOfOutput
+	opts.YAMLPackage = cfg.YAMLPackage
+	opts.MsgpackPackage = cfg.MsgpackPackage
+	opts.AWSSigV4Service = cfg.AWSSigV4Service
+	opts.AWSSigV4Region = cfg.AWSSigV4Region
+	opts.CorrelationIDHeader = cfg.CorrelationIDHeader
+	if cfg.NameNormalizer != "" {
+		normalizer, found := codegen.NameNormalizers[cfg.NameNormalizer]
+		if !found {
+			errExit("unknown name normalizer %q\n", cfg.NameNormalizer)
+		}
+		opts.NameNormalizer = normalizer
+	}
+	opts.NameCollisions = cfg.NameCollisions
+	opts.ReservedWords = cfg.ReservedWords
+	opts.ReservedWordsMap = cfg.ReservedWordsMap
+	opts.Transliterate = cfg.Transliterate
+	opts.EnumNaming = cfg.EnumNaming
+	opts.SkipEmailValidation = cfg.SkipEmailValidation
+	opts.StrictParamContent = cfg.StrictParamContent
+	opts.SkipInternal = cfg.SkipInternal
+	opts.LogValuers = cfg.LogValuers
+	opts.DateLayout = cfg.DateLayout
+	opts.DurationFormat = cfg.DurationFormat
+	if len(cfg.Transliterations) > 0 {
+		opts.Transliterations = map[rune]string{}
+		for letter, spelling := range cfg.Transliterations {
+			runes := []rune(letter)
+			if len(runes) != 1 {
+				errExit("transliterations are given for single letters, not %q\n", letter)
+			}
+			opts.Transliterations[runes[0]] = spelling
+		}
+	}
+	reported := map[codegen.Rename]bool{}
+	opts.ReportRename = func(rename codegen.Rename) {
+		// Renames are the same for every package and verification run.
+		if !reported[rename] {
+			reported[rename] = true
+			fmt.Fprintln(os.Stderr, rename)
+		}
+	}
+	warned := map[string]bool{}
+	opts.ReportWarning = func(warning string) {
+		if !warned[warning] {
+			warned[warning] = true
+			fmt.Fprintln(os.Stderr, "warning:", warning)
+		}
+	}
+	opts.LoadOptions.ReportWarning = opts.ReportWarning
+	if cfg.Report {
+		opts.ReportGeneration = func(stats codegen.GenerationStats) {
+			fmt.Fprintln(os.Stderr, stats)
+		}
+	}
+	opts.BulkHelpers = cfg.BulkHelpers
+	opts.SentinelErrors = cfg.SentinelErrors
+	opts.LoggingMiddleware = cfg.LoggingMiddleware
+	opts.Factories = cfg.Factories
+	opts.BodyBuilders = cfg.BodyBuilders
+	opts.OptionConstructors = cfg.OptionConstructors
+	opts.Plugins = cfg.Plugins
+	opts.SpecPlugins = cfg.SpecPlugins
+	opts.RedactSpec = codegen.SpecRedaction{
+		Internal:    cfg.RedactSpec.Internal,
+		IncludeTags: cfg.RedactSpec.IncludeTags,
+		ExcludeTags: cfg.RedactSpec.ExcludeTags,
+	}
+	opts.PostProcess = cfg.PostProcess
+	opts.FileHeader = cfg.FileHeader
+	opts.BuildTags = cfg.BuildTags
+	opts.GeneratedBanner = cfg.GeneratedBanner
+	opts.TagPackages = cfg.TagPackages
+	opts.DocumentPackages = cfg.DocumentPackages
 
-	code, err := codegen.Generate(swagger, cfg.PackageName, opts)
-	if err != nil {
+	if flag.Arg(0) == "diff" {
+		diffSpecs(opts)
+		return
+	}
+
+	if flag.Arg(0) == "-" {
+		data, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			errExit("error reading the standard input: %s\n", err)
+		}
+		opts.LoadOptions.Stdin = data
+	}
+
+	if cfg.Verify > 1 {
+		verifyGeneration(cfg, opts)
+	}
+
+	if (opts.TagPackages != "" || opts.DocumentPackages != "") && cfg.OutputFile == "" {
+		errExit("an output directory is required to generate tag or document packages\n")
+	}
+	if err := codegen.WriteFiles(flag.Arg(0), cfg.PackageName, opts, outputWriter(cfg, opts)); err != nil {
 		errExit("error generating code: %s\n", err)
 	}
+}
+
+// diffSpecs prints the changes of the code generated for the new revision of a
+// spec, as in "oapi-codegen diff old.yaml new.yaml", which break code using the
+// one generated for the old revision, and fails when there are some.
+func diffSpecs(opts codegen.Options) {
+	if flag.NArg() != 3 {
+		errExit("diff takes the paths of the old and the new revisions of a spec\n")
+	}
+	oldSwagger, err := util.LoadSwaggerWithOptions(flag.Arg(1), opts.LoadOptions)
+	if err != nil {
+		errExit("error loading swagger spec in %s\n: %s", flag.Arg(1), err)
+	}
+	newSwagger, err := util.LoadSwaggerWithOptions(flag.Arg(2), opts.LoadOptions)
+	if err != nil {
+		errExit("error loading swagger spec in %s\n: %s", flag.Arg(2), err)
+	}
 
-	if cfg.OutputFile != "" {
-		err = ioutil.WriteFile(cfg.OutputFile, []byte(code), 0644)
+	changes, err := codegen.DiffAPIs(oldSwagger, newSwagger, opts)
+	if err != nil {
+		errExit("error comparing specs: %s\n", err)
+	}
+	for _, change := range changes {
+		fmt.Println(change)
+	}
+	if len(changes) > 0 {
+		errExit("%d breaking changes\n", len(changes))
+	}
+}
+
+// outputWriter returns the function writing the files of codegen.WriteFiles.
+// The code of a single package goes to the output file, or to the standard
+// output when there is none, and the files of plugins go next to it. The
+// packages of tags and documents go in the output directory, each in a
+// directory named after it, along with the files of plugins.
+func outputWriter(cfg *configuration, opts codegen.Options) func(name string, content string) error {
+	packages := opts.TagPackages != "" || opts.DocumentPackages != ""
+	dir := filepath.Dir(cfg.OutputFile)
+	if packages {
+		dir = cfg.OutputFile
+	}
+	return func(name string, content string) error {
+		if !packages && name == codegen.GeneratedFileName(cfg.PackageName) {
+			if cfg.OutputFile == "" {
+				fmt.Print(content)
+				return nil
+			}
+			return ioutil.WriteFile(cfg.OutputFile, []byte(content), 0644)
+		}
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(file, []byte(content), 0644)
+	}
+}
+
+// verifyGeneration generates the code as many times as the configuration
+// says, and exits with the first difference between two runs if there is one.
+// The plugins only run for the files which are written.
+func verifyGeneration(cfg *configuration, opts codegen.Options) {
+	opts.Plugins = nil
+	var first string
+	for run := 1; run <= cfg.Verify; run++ {
+		files, err := codegen.GenerateFiles(flag.Arg(0), cfg.PackageName, opts)
 		if err != nil {
-			errExit("error writing generated code to file: %s", err)
+			errExit("error generating code: %s\n", err)
+		}
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var code string
+		for _, name := range names {
+			code += "// file " + name + "\n" + files[name]
+		}
+		if run == 1 {
+			first = code
+		} else if code != first {
+			line, want, got := firstDifference(first, code)
+			errExit("generated code differs between run 1 and run %d at line %d:\n- %s\n+ %s\n", run, line, want, got)
+		}
+	}
+}
+
+// firstDifference returns the number of the first line which differs between
+// a and b, along with its two versions.
+func firstDifference(a, b string) (line int, lineA, lineB string) {
+	linesA := strings.Split(a, "\n")
+	linesB := strings.Split(b, "\n")
+	for i := 0; i < len(linesA) || i < len(linesB); i++ {
+		lineA, lineB = "", ""
+		if i < len(linesA) {
+			lineA = linesA[i]
+		}
+		if i < len(linesB) {
+			lineB = linesB[i]
+		}
+		if lineA != lineB {
+			return i + 1, lineA, lineB
 		}
-	} else {
-		fmt.Print(code)
 	}
+	return 0, "", ""
 }
 
 func loadTemplateOverrides(templatesDir string) (map[string]string, error) {
@@ -242,6 +620,12 @@ func configFromFlags() *configuration {
 	if cfg.ExcludeTags == nil {
 		cfg.ExcludeTags = util.ParseCommandLineList(flagExcludeTags)
 	}
+	if cfg.IncludeOperationIDs == nil {
+		cfg.IncludeOperationIDs = util.ParseCommandLineList(flagIncludeOperationIDs)
+	}
+	if cfg.ExcludeOperationIDs == nil {
+		cfg.ExcludeOperationIDs = util.ParseCommandLineList(flagExcludeOperationIDs)
+	}
 	if cfg.TemplatesDir == "" {
 		cfg.TemplatesDir = flagTemplatesDir
 	}
@@ -258,6 +642,134 @@ func configFromFlags() *configuration {
 	if cfg.OutputFile == "" {
 		cfg.OutputFile = flagOutputFile
 	}
+	if cfg.YAMLPackage == "" {
+		cfg.YAMLPackage = flagYAMLPackage
+	}
+	if cfg.MsgpackPackage == "" {
+		cfg.MsgpackPackage = flagMsgpackPackage
+	}
+	if cfg.AWSSigV4Service == "" {
+		cfg.AWSSigV4Service = flagAWSSigV4Service
+	}
+	if cfg.AWSSigV4Region == "" {
+		cfg.AWSSigV4Region = flagAWSSigV4Region
+	}
+	if cfg.CorrelationIDHeader == "" {
+		cfg.CorrelationIDHeader = flagCorrelationIDHeader
+	}
+	if cfg.NameNormalizer == "" {
+		cfg.NameNormalizer = flagNameNormalizer
+	}
+	if cfg.NameCollisions == "" {
+		cfg.NameCollisions = flagNameCollisions
+	}
+	if cfg.ReservedWords == "" {
+		cfg.ReservedWords = flagReservedWords
+	}
+	if cfg.EnumNaming == "" {
+		cfg.EnumNaming = flagEnumNaming
+	}
+	if cfg.DateLayout == "" {
+		cfg.DateLayout = flagDateLayout
+	}
+	if cfg.DurationFormat == "" {
+		cfg.DurationFormat = flagDurationFormat
+	}
+
+	if cfg.TagPackages == "" {
+		cfg.TagPackages = flagTagPackages
+	}
+	if cfg.DocumentPackages == "" {
+		cfg.DocumentPackages = flagDocumentPackages
+	}
+	if cfg.SpecURL.Headers == nil && flagSpecHeaders != "" {
+		var err error
+		cfg.SpecURL.Headers, err = util.ParseCommandlineMap(flagSpecHeaders)
+		if err != nil {
+			errExit("error parsing spec-headers: %s\n", err)
+		}
+	}
+	if cfg.SpecURL.BearerToken == "" {
+		cfg.SpecURL.BearerToken = flagSpecBearerToken
+	}
+	if cfg.SpecURL.BasicAuth == "" {
+		cfg.SpecURL.BasicAuth = flagSpecBasicAuth
+	}
+	if cfg.SpecURL.CACert == "" {
+		cfg.SpecURL.CACert = flagSpecCACert
+	}
+	if cfg.SpecURL.ClientCert == "" {
+		cfg.SpecURL.ClientCert = flagSpecClientCert
+	}
+	if cfg.SpecURL.ClientKey == "" {
+		cfg.SpecURL.ClientKey = flagSpecClientKey
+	}
+	if !cfg.SpecURL.InsecureSkipVerify {
+		cfg.SpecURL.InsecureSkipVerify = flagSpecInsecure
+	}
+	if cfg.BuildTags == "" {
+		cfg.BuildTags = flagBuildTags
+	}
+	if cfg.GeneratedBanner == "" {
+		cfg.GeneratedBanner = flagGeneratedBanner
+	}
+	if cfg.PostProcess == "" {
+		cfg.PostProcess = flagPostProcess
+	}
+	if cfg.Plugins == nil {
+		cfg.Plugins = util.ParseCommandLineList(flagPlugins)
+	}
+	if cfg.SpecPlugins == nil {
+		cfg.SpecPlugins = util.ParseCommandLineList(flagSpecPlugins)
+	}
+	if cfg.Verify == 0 {
+		cfg.Verify = flagVerify
+	}
+	if !cfg.Report {
+		cfg.Report = flagReport
+	}
+	if !cfg.RedactSpec.Internal {
+		cfg.RedactSpec.Internal = flagRedactInternal
+	}
+	if cfg.RedactSpec.ExcludeTags == nil {
+		cfg.RedactSpec.ExcludeTags = util.ParseCommandLineList(flagRedactTags)
+	}
+	if !cfg.Bundle {
+		cfg.Bundle = flagBundle
+	}
+	if !cfg.Transliterate {
+		cfg.Transliterate = flagTransliterate
+	}
+	if !cfg.SkipEmailValidation {
+		cfg.SkipEmailValidation = flagSkipEmailValidation
+	}
+	if !cfg.SkipInternal {
+		cfg.SkipInternal = flagSkipInternal
+	}
+	if !cfg.LogValuers {
+		cfg.LogValuers = flagLogValuers
+	}
+	if !cfg.StrictParamContent {
+		cfg.StrictParamContent = flagStrictParamContent
+	}
+	if !cfg.BulkHelpers {
+		cfg.BulkHelpers = flagBulkHelpers
+	}
+	if !cfg.SentinelErrors {
+		cfg.SentinelErrors = flagSentinelErrors
+	}
+	if !cfg.LoggingMiddleware {
+		cfg.LoggingMiddleware = flagLoggingMiddleware
+	}
+	if !cfg.Factories {
+		cfg.Factories = flagFactories
+	}
+	if cfg.BodyBuilders == 0 {
+		cfg.BodyBuilders = flagBodyBuilders
+	}
+	if cfg.OptionConstructors == 0 {
+		cfg.OptionConstructors = flagOptionConstructors
+	}
 
 	if cfg.OldAllOfOutput == false {
 		cfg.OldAllOfOutput = flagOlfAllOfOutput
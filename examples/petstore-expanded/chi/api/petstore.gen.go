@@ -276,6 +276,8 @@ type ChiServerOptions struct {
 	BaseRouter       chi.Router
 	Middlewares      []MiddlewareFunc
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
 }
 
 // HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
@@ -311,21 +313,32 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	}
 
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/pets", wrapper.FindPets)
+		r.Get(options.BaseURL+"/pets", runtime.LogHandlerFunc(options.Logger, "FindPets", wrapper.FindPets))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pets", wrapper.AddPet)
+		r.Post(options.BaseURL+"/pets", runtime.LogHandlerFunc(options.Logger, "AddPet", wrapper.AddPet))
 	})
 	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/pets/{id}", wrapper.DeletePet)
+		r.Delete(options.BaseURL+"/pets/{id}", runtime.LogHandlerFunc(options.Logger, "DeletePet", wrapper.DeletePet))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/pets/{id}", wrapper.FindPetByID)
+		r.Get(options.BaseURL+"/pets/{id}", runtime.LogHandlerFunc(options.Logger, "FindPetByID", wrapper.FindPetByID))
 	})
 
 	return r
 }
 
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := runtime.RequestCorrelationID(r.Header, "X-Request-ID")
+		w.Header().Set("X-Request-ID", id)
+		next(w, r.WithContext(runtime.ContextWithCorrelationID(r.Context(), id)))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
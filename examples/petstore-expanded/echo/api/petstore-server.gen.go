@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -37,6 +38,7 @@ type ServerInterface interface {
 // ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
+	Logger  runtime.OperationLogger
 }
 
 // FindPets converts echo context to params.
@@ -49,14 +51,14 @@ func (w *ServerInterfaceWrapper) FindPets(ctx echo.Context) error {
 
 	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err)).SetInternal(err)
 	}
 
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -81,7 +83,7 @@ func (w *ServerInterfaceWrapper) DeletePet(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -97,7 +99,7 @@ func (w *ServerInterfaceWrapper) FindPetByID(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -128,16 +130,65 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	RegisterHandlersWithOptions(router, si, EchoServerOptions{BaseURL: baseURL})
+}
+
+// EchoServerOptions provides options for registering the handlers.
+type EchoServerOptions struct {
+	BaseURL string
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// additional options.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options EchoServerOptions) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
+		Logger:  options.Logger,
 	}
 
-	router.GET(baseURL+"/pets", wrapper.FindPets)
-	router.POST(baseURL+"/pets", wrapper.AddPet)
-	router.DELETE(baseURL+"/pets/:id", wrapper.DeletePet)
-	router.GET(baseURL+"/pets/:id", wrapper.FindPetByID)
+	router.GET(options.BaseURL+"/pets", wrapper.logged("FindPets", wrapper.FindPets))
+	router.POST(options.BaseURL+"/pets", wrapper.logged("AddPet", wrapper.AddPet))
+	router.DELETE(options.BaseURL+"/pets/:id", wrapper.logged("DeletePet", wrapper.DeletePet))
+	router.GET(options.BaseURL+"/pets/:id", wrapper.logged("FindPetByID", wrapper.FindPetByID))
+
+}
+
+// logged returns the handler of an operation, logging its calls when the
+// wrapper has a logger.
+func (w *ServerInterfaceWrapper) logged(operationID string, handler echo.HandlerFunc) echo.HandlerFunc {
+	if w.Logger == nil {
+		return handler
+	}
+	return func(ctx echo.Context) error {
+		start := time.Now()
+		err := handler(ctx)
+		statusCode := ctx.Response().Status
+		if err != nil && !ctx.Response().Committed {
+			// The response is written by the error handler, after this returns.
+			statusCode = http.StatusInternalServerError
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				statusCode = httpErr.Code
+			}
+		}
+		w.Logger.LogOperation(ctx.Request().Context(), runtime.NewOperationRecord(operationID, ctx.Request(), statusCode, ctx.Response().Header(), time.Since(start), err))
+		return err
+	}
+}
 
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		id := runtime.RequestCorrelationID(req.Header, "X-Request-ID")
+		ctx.Response().Header().Set("X-Request-ID", id)
+		ctx.SetRequest(req.WithContext(runtime.ContextWithCorrelationID(req.Context(), id)))
+		return next(ctx)
+	}
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
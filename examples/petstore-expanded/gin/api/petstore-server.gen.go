@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -38,6 +39,7 @@ type ServerInterface interface {
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
 	HandlerMiddlewares []MiddlewareFunc
+	Logger             runtime.OperationLogger
 }
 
 type MiddlewareFunc func(c *gin.Context)
@@ -57,6 +59,7 @@ func (siw *ServerInterfaceWrapper) FindPets(c *gin.Context) {
 
 	err = runtime.BindQueryParameter("form", true, false, "tags", c.Request.URL.Query(), &params.Tags)
 	if err != nil {
+		_ = c.Error(err)
 		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter tags: %s", err)})
 		return
 	}
@@ -68,6 +71,7 @@ func (siw *ServerInterfaceWrapper) FindPets(c *gin.Context) {
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
+		_ = c.Error(err)
 		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter limit: %s", err)})
 		return
 	}
@@ -99,6 +103,7 @@ func (siw *ServerInterfaceWrapper) DeletePet(c *gin.Context) {
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
+		_ = c.Error(err)
 		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter id: %s", err)})
 		return
 	}
@@ -120,6 +125,7 @@ func (siw *ServerInterfaceWrapper) FindPetByID(c *gin.Context) {
 
 	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
 	if err != nil {
+		_ = c.Error(err)
 		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter id: %s", err)})
 		return
 	}
@@ -135,6 +141,8 @@ func (siw *ServerInterfaceWrapper) FindPetByID(c *gin.Context) {
 type GinServerOptions struct {
 	BaseURL     string
 	Middlewares []MiddlewareFunc
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
 }
 
 // RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
@@ -147,18 +155,47 @@ func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options
 	wrapper := ServerInterfaceWrapper{
 		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
+		Logger:             options.Logger,
 	}
 
-	router.GET(options.BaseURL+"/pets", wrapper.FindPets)
+	router.GET(options.BaseURL+"/pets", wrapper.logged("FindPets", wrapper.FindPets))
 
-	router.POST(options.BaseURL+"/pets", wrapper.AddPet)
+	router.POST(options.BaseURL+"/pets", wrapper.logged("AddPet", wrapper.AddPet))
 
-	router.DELETE(options.BaseURL+"/pets/:id", wrapper.DeletePet)
+	router.DELETE(options.BaseURL+"/pets/:id", wrapper.logged("DeletePet", wrapper.DeletePet))
 
-	router.GET(options.BaseURL+"/pets/:id", wrapper.FindPetByID)
+	router.GET(options.BaseURL+"/pets/:id", wrapper.logged("FindPetByID", wrapper.FindPetByID))
 
 	return router
-} // Base64 encoded, gzipped, json marshaled Swagger object
+}
+
+// logged returns the handler of an operation, logging its calls when the
+// wrapper has a logger.
+func (siw *ServerInterfaceWrapper) logged(operationID string, handler gin.HandlerFunc) gin.HandlerFunc {
+	if siw.Logger == nil {
+		return handler
+	}
+	return func(c *gin.Context) {
+		start := time.Now()
+		handler(c)
+		var err error
+		if last := c.Errors.Last(); last != nil {
+			err = last
+		}
+		siw.Logger.LogOperation(c.Request.Context(), runtime.NewOperationRecord(operationID, c.Request, c.Writer.Status(), c.Writer.Header(), time.Since(start), err))
+	}
+}
+
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(c *gin.Context) {
+	id := runtime.RequestCorrelationID(c.Request.Header, "X-Request-ID")
+	c.Header("X-Request-ID", id)
+	c.Request = c.Request.WithContext(runtime.ContextWithCorrelationID(c.Request.Context(), id))
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
 	"H4sIAAAAAAAC/+RXW48budH9KwV+32OnNbEXedBTvB4vICBrT+LdvKznoYZdkmrBSw9Z1FgY6L8HRbZu",
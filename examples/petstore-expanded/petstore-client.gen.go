@@ -6,6 +6,7 @@ package petstore
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
@@ -65,6 +67,19 @@ type AddPetJSONRequestBody AddPetJSONBody
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseValidatorFn is the function signature for the ResponseValidator callback function
+type ResponseValidatorFn func(ctx context.Context, req *http.Request, rsp *http.Response) error
+
+// BeforeCallFn is called before every call of an operation. When it returns
+// an error, the request isn't sent and the error is returned to the caller,
+// which allows short-circuiting failing operations. The returned context is
+// passed to the AfterCallFn of the call.
+type BeforeCallFn func(ctx context.Context, operationID string) (context.Context, error)
+
+// AfterCallFn is called after every call of an operation which was let
+// through by the BeforeCallFn, with the response unless the call failed.
+type AfterCallFn func(ctx context.Context, operationID string, rsp *http.Response, err error)
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -87,6 +102,38 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A callback for checking responses received over the network before
+	// they are returned to the caller.
+	ResponseValidator ResponseValidatorFn
+
+	// When greater than zero, request bodies of at least this many bytes are
+	// gzip compressed, and gzip encoded responses are accepted and
+	// transparently decompressed.
+	CompressionThreshold int64
+
+	// A callback for signing requests, called after the request editors and
+	// compression, once nothing else will change the request.
+	RequestSigner RequestEditorFn
+
+	// When set, requests are sent to the servers of the pool, of which Server
+	// is the first, following the pool's policy.
+	ServerPool *runtime.ServerPool
+
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+
+	// Callbacks called around every call of an operation, for circuit
+	// breakers and the like.
+	BeforeCall BeforeCallFn
+	AfterCall  AfterCallFn
+
+	// The User-Agent header sent with requests, unless they have one as a
+	// parameter or a request editor sets another one.
+	UserAgent string
+
+	// The transport cloned by the transport options, which they share.
+	clonedTransport *http.Transport
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -96,7 +143,8 @@ type ClientOption func(*Client) error
 func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// create a client with sane default values
 	client := Client{
-		Server: server,
+		Server:    server,
+		UserAgent: "Swagger-Petstore/1.0.0",
 	}
 	// mutate client and add all optional params
 	for _, o := range opts {
@@ -124,6 +172,85 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration of the client's transport, such
+// as client certificates or root CAs.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.TLSClientConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function returning the proxy of each request, such as
+// http.ProxyURL(proxyURL). By default, the proxy is read from the
+// environment, as with http.ProxyFromEnvironment.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.Proxy = proxy
+		return nil
+	}
+}
+
+// WithTransport allows tuning the client's transport, such as its timeouts
+// and connection pool, with a function called on it.
+func WithTransport(configure func(*http.Transport)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		configure(transport)
+		return nil
+	}
+}
+
+// transport returns the *http.Transport of the client's doer, which transport
+// options configure. The doer and its transport are cloned the first time, from
+// http.DefaultTransport when there's no transport, so that neither a doer set
+// with WithHTTPClient nor the defaults of net/http are changed.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+	httpClient, ok := c.Client.(*http.Client)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the doer to be an *http.Client, not %T", c.Client)
+	}
+	if c.clonedTransport != nil && httpClient.Transport == c.clonedTransport {
+		return c.clonedTransport, nil
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the transport to be an *http.Transport, not %T", transport)
+	}
+	cloned := *httpClient
+	cloned.Transport = httpTransport.Clone()
+	c.Client = &cloned
+	c.clonedTransport = cloned.Transport.(*http.Transport)
+	return c.clonedTransport, nil
+}
+
+// WithUserAgent sets the User-Agent header sent with requests, which is
+// Swagger-Petstore/1.0.0 by default.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -133,6 +260,125 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseValidator allows setting up a callback function, which will be
+// called on every response before it is returned. This can be used to check
+// that responses conform to the specification.
+func WithResponseValidator(fn ResponseValidatorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseValidator = fn
+		return nil
+	}
+}
+
+// WithIfNoneMatch returns a RequestEditorFn for making a request conditional
+// on the resource no longer matching the given ETag, in which case the
+// server may respond with 304 Not Modified.
+func WithIfNoneMatch(etag string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	}
+}
+
+// WithIfModifiedSince returns a RequestEditorFn for making a request
+// conditional on the resource having been modified after the given time, in
+// which case the server may respond with 304 Not Modified.
+func WithIfModifiedSince(t time.Time) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+		return nil
+	}
+}
+
+// PropagateCorrelationID is a RequestEditorFn sending the correlation ID of the
+// request context in the X-Request-ID header, generating one when the context has
+// none. Generated servers add the correlation ID of the requests they handle to
+// their context, so that it's passed on to the services they call.
+func PropagateCorrelationID(ctx context.Context, req *http.Request) error {
+	if req.Header.Get("X-Request-ID") != "" {
+		return nil
+	}
+	id := runtime.CorrelationIDFromContext(ctx)
+	if id == "" {
+		id = runtime.NewCorrelationID()
+	}
+	req.Header.Set("X-Request-ID", id)
+	return nil
+}
+
+// WithRequestCompression enables gzip compression of request bodies which are
+// at least minSize bytes long. The client also advertises that it accepts
+// gzip encoded responses, which are decompressed before they're parsed.
+func WithRequestCompression(minSize int64) ClientOption {
+	return func(c *Client) error {
+		if minSize <= 0 {
+			return fmt.Errorf("compression threshold must be positive, got %d", minSize)
+		}
+		c.CompressionThreshold = minSize
+		return nil
+	}
+}
+
+// WithServers sets several servers serving the API, of which requests are
+// sent to one following the policy. Whatever the policy, requests are sent to
+// the next server when one is unavailable. Servers given with NewClient are
+// replaced by these.
+func WithServers(policy runtime.ServerPolicy, servers ...string) ClientOption {
+	return func(c *Client) error {
+		pool, err := runtime.NewServerPool(policy, servers...)
+		if err != nil {
+			return err
+		}
+		c.Server = pool.Servers()[0]
+		c.ServerPool = pool
+		return nil
+	}
+}
+
+// WithLogger sets a logger, which receives a record of every call of an
+// operation, with its duration and status code. Credentials are redacted from
+// the recorded URL and headers.
+func WithLogger(logger runtime.OperationLogger) ClientOption {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithCallHooks sets callbacks called before and after every call of an
+// operation, either of which may be nil. This allows plugging in a circuit
+// breaker per operation, which lets the before hook fail calls of the
+// operations it considers unavailable, and learns from the outcome of calls
+// in the after hook.
+func WithCallHooks(before BeforeCallFn, after AfterCallFn) ClientOption {
+	return func(c *Client) error {
+		c.BeforeCall = before
+		c.AfterCall = after
+		return nil
+	}
+}
+
+// WithRecorder replays the responses recorded in the directory, in
+// runtime.RecorderReplay mode, or sends requests with the client's doer and
+// records their responses there, in runtime.RecorderRecord mode, for tests
+// which don't reach the server. Options changing the transport must come
+// before it.
+func WithRecorder(mode runtime.RecorderMode, dir string) ClientOption {
+	return func(c *Client) error {
+		c.Client = runtime.NewRecorder(mode, dir, c.Client)
+		return nil
+	}
+}
+
+// WithRequestSigner sets a callback for signing requests, which is called
+// right before sending them, after any request editor and compression.
+func WithRequestSigner(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestSigner = fn
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// FindPets request
@@ -159,7 +405,7 @@ func (c *Client) FindPets(ctx context.Context, params *FindPetsParams, reqEditor
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "FindPets", req)
 }
 
 func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -171,7 +417,7 @@ func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "AddPet", req)
 }
 
 func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -183,7 +429,7 @@ func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEdit
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "AddPet", req)
 }
 
 func (c *Client) DeletePet(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -195,7 +441,7 @@ func (c *Client) DeletePet(ctx context.Context, id int64, reqEditors ...RequestE
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "DeletePet", req)
 }
 
 func (c *Client) FindPetByID(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -207,7 +453,7 @@ func (c *Client) FindPetByID(ctx context.Context, id int64, reqEditors ...Reques
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "FindPetByID", req)
 }
 
 // NewFindPetsRequest generates requests for FindPets
@@ -220,7 +466,7 @@ func NewFindPetsRequest(server string, params *FindPetsParams) (*http.Request, e
 	}
 
 	operationPath := fmt.Sprintf("/pets")
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -294,7 +540,7 @@ func NewAddPetRequestWithBody(server string, contentType string, body io.Reader)
 	}
 
 	operationPath := fmt.Sprintf("/pets")
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -330,7 +576,7 @@ func NewDeletePetRequest(server string, id int64) (*http.Request, error) {
 	}
 
 	operationPath := fmt.Sprintf("/pets/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -364,7 +610,7 @@ func NewFindPetByIDRequest(server string, id int64) (*http.Request, error) {
 	}
 
 	operationPath := fmt.Sprintf("/pets/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -382,6 +628,9 @@ func NewFindPetByIDRequest(server string, id int64) (*http.Request, error) {
 }
 
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
 			return err
@@ -395,6 +644,73 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// do sends the request of an operation, calling the call hooks around it and
+// logging the call when the client has been configured to.
+func (c *Client) do(ctx context.Context, operationID string, req *http.Request) (rsp *http.Response, err error) {
+	// Doers such as runtime.Recorder find the operation in the request context.
+	req = req.WithContext(runtime.ContextWithOperationID(req.Context(), operationID))
+	if c.Logger != nil {
+		start := time.Now()
+		defer func() {
+			c.Logger.LogOperation(ctx, runtime.NewClientOperationRecord(operationID, req, rsp, time.Since(start), err))
+		}()
+	}
+	hookCtx := ctx
+	if c.BeforeCall != nil {
+		if hookCtx, err = c.BeforeCall(ctx, operationID); err != nil {
+			return nil, err
+		}
+	}
+	rsp, err = c.send(ctx, req)
+	if c.AfterCall != nil {
+		c.AfterCall(hookCtx, operationID, rsp, err)
+	}
+	return rsp, err
+}
+
+// send sends the request, compressing it, signing it, failing over to other
+// servers and validating the response when the client has been configured to.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GzipRequestBody(req, c.CompressionThreshold); err != nil {
+			return nil, err
+		}
+		if req.Header.Get("Accept-Encoding") == "" {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+	}
+	transmit := func(req *http.Request) (*http.Response, error) {
+		if c.RequestSigner != nil {
+			if err := c.RequestSigner(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+		return c.Client.Do(req)
+	}
+	var rsp *http.Response
+	var err error
+	if c.ServerPool != nil {
+		rsp, err = c.ServerPool.Do(req, transmit)
+	} else {
+		rsp, err = transmit(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GunzipResponseBody(rsp); err != nil {
+			return nil, err
+		}
+	}
+	if c.ResponseValidator != nil {
+		if err := c.ResponseValidator(ctx, req, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
 // ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
@@ -422,21 +738,59 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// StreamResponse gives unbuffered access to a response, for large downloads
+// and long-poll endpoints. The caller is responsible for closing Body.
+type StreamResponse struct {
+	Body         io.ReadCloser
+	Header       http.Header
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// newStreamResponse wraps an HTTP response without reading its body.
+func newStreamResponse(rsp *http.Response) *StreamResponse {
+	return &StreamResponse{
+		Body:         rsp.Body,
+		Header:       rsp.Header,
+		HTTPResponse: rsp,
+	}
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// FindPets request
 	FindPetsWithResponse(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*FindPetsResponse, error)
+	FindPetsWithBodyStream(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// AddPet request with any body
 	AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+	AddPetWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+	AddPetWithBodyStream(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// DeletePet request
 	DeletePetWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePetResponse, error)
+	DeletePetWithBodyStream(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// FindPetByID request
 	FindPetByIDWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindPetByIDResponse, error)
+	FindPetByIDWithBodyStream(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 }
 
 type FindPetsResponse struct {
@@ -462,6 +816,15 @@ func (r FindPetsResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r FindPetsResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type AddPetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -485,6 +848,15 @@ func (r AddPetResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r AddPetResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type DeletePetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -507,6 +879,15 @@ func (r DeletePetResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r DeletePetResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type FindPetByIDResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -530,6 +911,15 @@ func (r FindPetByIDResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r FindPetByIDResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 // FindPetsWithResponse request returning *FindPetsResponse
 func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*FindPetsResponse, error) {
 	rsp, err := c.FindPets(ctx, params, reqEditors...)
@@ -539,6 +929,15 @@ func (c *ClientWithResponses) FindPetsWithResponse(ctx context.Context, params *
 	return ParseFindPetsResponse(rsp)
 }
 
+// FindPetsWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) FindPetsWithBodyStream(ctx context.Context, params *FindPetsParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.FindPets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
 func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
 	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
@@ -548,6 +947,15 @@ func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, co
 	return ParseAddPetResponse(rsp)
 }
 
+// AddPetWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) AddPetWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
 	rsp, err := c.AddPet(ctx, body, reqEditors...)
 	if err != nil {
@@ -556,6 +964,14 @@ func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPe
 	return ParseAddPetResponse(rsp)
 }
 
+func (c *ClientWithResponses) AddPetWithBodyStream(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.AddPet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // DeletePetWithResponse request returning *DeletePetResponse
 func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*DeletePetResponse, error) {
 	rsp, err := c.DeletePet(ctx, id, reqEditors...)
@@ -565,6 +981,15 @@ func (c *ClientWithResponses) DeletePetWithResponse(ctx context.Context, id int6
 	return ParseDeletePetResponse(rsp)
 }
 
+// DeletePetWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) DeletePetWithBodyStream(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.DeletePet(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // FindPetByIDWithResponse request returning *FindPetByIDResponse
 func (c *ClientWithResponses) FindPetByIDWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*FindPetByIDResponse, error) {
 	rsp, err := c.FindPetByID(ctx, id, reqEditors...)
@@ -574,6 +999,15 @@ func (c *ClientWithResponses) FindPetByIDWithResponse(ctx context.Context, id in
 	return ParseFindPetByIDResponse(rsp)
 }
 
+// FindPetByIDWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) FindPetByIDWithBodyStream(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.FindPetByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // ParseFindPetsResponse parses an HTTP response from a FindPetsWithResponse call
 func ParseFindPetsResponse(rsp *http.Response) (*FindPetsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -698,3 +1132,14 @@ func ParseFindPetByIDResponse(rsp *http.Response) (*FindPetByIDResponse, error)
 
 	return response, nil
 }
+
+// Server1URL is the Server1 URL.
+const Server1URL = "http://petstore.swagger.io/api"
+
+// WithServer1 sets the server of the client to Server1URL.
+func WithServer1() ClientOption {
+	return func(c *Client) error {
+		c.Server = Server1URL
+		return nil
+	}
+}
@@ -0,0 +1,1204 @@
+// Package builders provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package builders
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// NewPet defines model for NewPet.
+type NewPet struct {
+	Birthday *openapi_types.Date `json:"birthday,omitempty"`
+	Name     string              `json:"name"`
+	Tags     *[]string           `json:"tags,omitempty"`
+}
+
+// AddOwnerJSONBody defines parameters for AddOwner.
+type AddOwnerJSONBody struct {
+	Email                string            `json:"email"`
+	Id                   *int              `json:"id,omitempty"`
+	Name                 string            `json:"name"`
+	Phone                *string           `json:"phone,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// AddPetJSONBody defines parameters for AddPet.
+type AddPetJSONBody NewPet
+
+// TagPetJSONBody defines parameters for TagPet.
+type TagPetJSONBody struct {
+	Tag *string `json:"tag,omitempty"`
+}
+
+// AddOwnerJSONRequestBody defines body for AddOwner for application/json ContentType.
+type AddOwnerJSONRequestBody AddOwnerJSONBody
+
+// AddPetJSONRequestBody defines body for AddPet for application/json ContentType.
+type AddPetJSONRequestBody AddPetJSONBody
+
+// TagPetJSONRequestBody defines body for TagPet for application/json ContentType.
+type TagPetJSONRequestBody TagPetJSONBody
+
+// Getter for additional properties for AddOwnerJSONBody. Returns the specified
+// element and whether it was found
+func (a AddOwnerJSONBody) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for AddOwnerJSONBody
+func (a *AddOwnerJSONBody) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for AddOwnerJSONBody to handle AdditionalProperties
+func (a *AddOwnerJSONBody) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["email"]; found {
+		err = json.Unmarshal(raw, &a.Email)
+		if err != nil {
+			return fmt.Errorf("error reading 'email': %w", err)
+		}
+		delete(object, "email")
+	}
+
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
+		if err != nil {
+			return fmt.Errorf("error reading 'id': %w", err)
+		}
+		delete(object, "id")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["phone"]; found {
+		err = json.Unmarshal(raw, &a.Phone)
+		if err != nil {
+			return fmt.Errorf("error reading 'phone': %w", err)
+		}
+		delete(object, "phone")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for AddOwnerJSONBody to handle AdditionalProperties
+func (a AddOwnerJSONBody) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["email"], err = json.Marshal(a.Email)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'email': %w", err)
+	}
+
+	if a.Id != nil {
+		object["id"], err = json.Marshal(a.Id)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'id': %w", err)
+		}
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	if a.Phone != nil {
+		object["phone"], err = json.Marshal(a.Phone)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'phone': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AddOwnerJSONRequestBodyBuilder builds AddOwnerJSONRequestBody values one property
+// at a time, rather than with struct literals.
+type AddOwnerJSONRequestBodyBuilder struct {
+	v   AddOwnerJSONRequestBody
+	set [2]bool // Whether each required property was set
+}
+
+// BuildAddOwnerJSONRequestBody returns a new builder of AddOwnerJSONRequestBody values.
+func BuildAddOwnerJSONRequestBody() *AddOwnerJSONRequestBodyBuilder {
+	return &AddOwnerJSONRequestBodyBuilder{}
+}
+
+// WithEmail sets the email property.
+func (b *AddOwnerJSONRequestBodyBuilder) WithEmail(value string) *AddOwnerJSONRequestBodyBuilder {
+	b.v.Email = value
+	b.set[0] = true
+	return b
+}
+
+// WithName sets the name property.
+func (b *AddOwnerJSONRequestBodyBuilder) WithName(value string) *AddOwnerJSONRequestBodyBuilder {
+	b.v.Name = value
+	b.set[1] = true
+	return b
+}
+
+// WithPhone sets the phone property.
+func (b *AddOwnerJSONRequestBodyBuilder) WithPhone(value string) *AddOwnerJSONRequestBodyBuilder {
+	b.v.Phone = &value
+	return b
+}
+
+// WithAdditionalProperty sets an additional property.
+func (b *AddOwnerJSONRequestBodyBuilder) WithAdditionalProperty(name string, value string) *AddOwnerJSONRequestBodyBuilder {
+	if b.v.AdditionalProperties == nil {
+		b.v.AdditionalProperties = make(map[string]string)
+	}
+	b.v.AdditionalProperties[name] = value
+	return b
+}
+
+// Build returns the AddOwnerJSONRequestBody, or an error naming the required
+// properties which weren't set.
+func (b *AddOwnerJSONRequestBodyBuilder) Build() (AddOwnerJSONRequestBody, error) {
+	var missing []string
+	if !b.set[0] {
+		missing = append(missing, "email")
+	}
+	if !b.set[1] {
+		missing = append(missing, "name")
+	}
+	if len(missing) > 0 {
+		return AddOwnerJSONRequestBody{}, fmt.Errorf("missing required properties of AddOwnerJSONRequestBody: %s", strings.Join(missing, ", "))
+	}
+	return b.v, nil
+}
+
+// AddPetJSONRequestBodyBuilder builds AddPetJSONRequestBody values one property
+// at a time, rather than with struct literals.
+type AddPetJSONRequestBodyBuilder struct {
+	v   AddPetJSONRequestBody
+	set [1]bool // Whether each required property was set
+}
+
+// BuildAddPetJSONRequestBody returns a new builder of AddPetJSONRequestBody values.
+func BuildAddPetJSONRequestBody() *AddPetJSONRequestBodyBuilder {
+	return &AddPetJSONRequestBodyBuilder{}
+}
+
+// WithBirthday sets the birthday property.
+func (b *AddPetJSONRequestBodyBuilder) WithBirthday(value openapi_types.Date) *AddPetJSONRequestBodyBuilder {
+	b.v.Birthday = &value
+	return b
+}
+
+// WithName sets the name property.
+func (b *AddPetJSONRequestBodyBuilder) WithName(value string) *AddPetJSONRequestBodyBuilder {
+	b.v.Name = value
+	b.set[0] = true
+	return b
+}
+
+// WithTags sets the tags property.
+func (b *AddPetJSONRequestBodyBuilder) WithTags(value []string) *AddPetJSONRequestBodyBuilder {
+	b.v.Tags = &value
+	return b
+}
+
+// Build returns the AddPetJSONRequestBody, or an error naming the required
+// properties which weren't set.
+func (b *AddPetJSONRequestBodyBuilder) Build() (AddPetJSONRequestBody, error) {
+	var missing []string
+	if !b.set[0] {
+		missing = append(missing, "name")
+	}
+	if len(missing) > 0 {
+		return AddPetJSONRequestBody{}, fmt.Errorf("missing required properties of AddPetJSONRequestBody: %s", strings.Join(missing, ", "))
+	}
+	return b.v, nil
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseValidatorFn is the function signature for the ResponseValidator callback function
+type ResponseValidatorFn func(ctx context.Context, req *http.Request, rsp *http.Response) error
+
+// BeforeCallFn is called before every call of an operation. When it returns
+// an error, the request isn't sent and the error is returned to the caller,
+// which allows short-circuiting failing operations. The returned context is
+// passed to the AfterCallFn of the call.
+type BeforeCallFn func(ctx context.Context, operationID string) (context.Context, error)
+
+// AfterCallFn is called after every call of an operation which was let
+// through by the BeforeCallFn, with the response unless the call failed.
+type AfterCallFn func(ctx context.Context, operationID string, rsp *http.Response, err error)
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// A callback for checking responses received over the network before
+	// they are returned to the caller.
+	ResponseValidator ResponseValidatorFn
+
+	// When greater than zero, request bodies of at least this many bytes are
+	// gzip compressed, and gzip encoded responses are accepted and
+	// transparently decompressed.
+	CompressionThreshold int64
+
+	// A callback for signing requests, called after the request editors and
+	// compression, once nothing else will change the request.
+	RequestSigner RequestEditorFn
+
+	// When set, requests are sent to the servers of the pool, of which Server
+	// is the first, following the pool's policy.
+	ServerPool *runtime.ServerPool
+
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+
+	// Callbacks called around every call of an operation, for circuit
+	// breakers and the like.
+	BeforeCall BeforeCallFn
+	AfterCall  AfterCallFn
+
+	// The User-Agent header sent with requests, unless they have one as a
+	// parameter or a request editor sets another one.
+	UserAgent string
+
+	// The transport cloned by the transport options, which they share.
+	clonedTransport *http.Transport
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server:    server,
+		UserAgent: "Builders/1.0.0",
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the client's transport, such
+// as client certificates or root CAs.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.TLSClientConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function returning the proxy of each request, such as
+// http.ProxyURL(proxyURL). By default, the proxy is read from the
+// environment, as with http.ProxyFromEnvironment.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.Proxy = proxy
+		return nil
+	}
+}
+
+// WithTransport allows tuning the client's transport, such as its timeouts
+// and connection pool, with a function called on it.
+func WithTransport(configure func(*http.Transport)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		configure(transport)
+		return nil
+	}
+}
+
+// transport returns the *http.Transport of the client's doer, which transport
+// options configure. The doer and its transport are cloned the first time, from
+// http.DefaultTransport when there's no transport, so that neither a doer set
+// with WithHTTPClient nor the defaults of net/http are changed.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+	httpClient, ok := c.Client.(*http.Client)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the doer to be an *http.Client, not %T", c.Client)
+	}
+	if c.clonedTransport != nil && httpClient.Transport == c.clonedTransport {
+		return c.clonedTransport, nil
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the transport to be an *http.Transport, not %T", transport)
+	}
+	cloned := *httpClient
+	cloned.Transport = httpTransport.Clone()
+	c.Client = &cloned
+	c.clonedTransport = cloned.Transport.(*http.Transport)
+	return c.clonedTransport, nil
+}
+
+// WithUserAgent sets the User-Agent header sent with requests, which is
+// Builders/1.0.0 by default.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseValidator allows setting up a callback function, which will be
+// called on every response before it is returned. This can be used to check
+// that responses conform to the specification.
+func WithResponseValidator(fn ResponseValidatorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseValidator = fn
+		return nil
+	}
+}
+
+// WithIfNoneMatch returns a RequestEditorFn for making a request conditional
+// on the resource no longer matching the given ETag, in which case the
+// server may respond with 304 Not Modified.
+func WithIfNoneMatch(etag string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	}
+}
+
+// WithIfModifiedSince returns a RequestEditorFn for making a request
+// conditional on the resource having been modified after the given time, in
+// which case the server may respond with 304 Not Modified.
+func WithIfModifiedSince(t time.Time) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+		return nil
+	}
+}
+
+// PropagateCorrelationID is a RequestEditorFn sending the correlation ID of the
+// request context in the X-Request-ID header, generating one when the context has
+// none. Generated servers add the correlation ID of the requests they handle to
+// their context, so that it's passed on to the services they call.
+func PropagateCorrelationID(ctx context.Context, req *http.Request) error {
+	if req.Header.Get("X-Request-ID") != "" {
+		return nil
+	}
+	id := runtime.CorrelationIDFromContext(ctx)
+	if id == "" {
+		id = runtime.NewCorrelationID()
+	}
+	req.Header.Set("X-Request-ID", id)
+	return nil
+}
+
+// WithRequestCompression enables gzip compression of request bodies which are
+// at least minSize bytes long. The client also advertises that it accepts
+// gzip encoded responses, which are decompressed before they're parsed.
+func WithRequestCompression(minSize int64) ClientOption {
+	return func(c *Client) error {
+		if minSize <= 0 {
+			return fmt.Errorf("compression threshold must be positive, got %d", minSize)
+		}
+		c.CompressionThreshold = minSize
+		return nil
+	}
+}
+
+// WithServers sets several servers serving the API, of which requests are
+// sent to one following the policy. Whatever the policy, requests are sent to
+// the next server when one is unavailable. Servers given with NewClient are
+// replaced by these.
+func WithServers(policy runtime.ServerPolicy, servers ...string) ClientOption {
+	return func(c *Client) error {
+		pool, err := runtime.NewServerPool(policy, servers...)
+		if err != nil {
+			return err
+		}
+		c.Server = pool.Servers()[0]
+		c.ServerPool = pool
+		return nil
+	}
+}
+
+// WithLogger sets a logger, which receives a record of every call of an
+// operation, with its duration and status code. Credentials are redacted from
+// the recorded URL and headers.
+func WithLogger(logger runtime.OperationLogger) ClientOption {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithCallHooks sets callbacks called before and after every call of an
+// operation, either of which may be nil. This allows plugging in a circuit
+// breaker per operation, which lets the before hook fail calls of the
+// operations it considers unavailable, and learns from the outcome of calls
+// in the after hook.
+func WithCallHooks(before BeforeCallFn, after AfterCallFn) ClientOption {
+	return func(c *Client) error {
+		c.BeforeCall = before
+		c.AfterCall = after
+		return nil
+	}
+}
+
+// WithRecorder replays the responses recorded in the directory, in
+// runtime.RecorderReplay mode, or sends requests with the client's doer and
+// records their responses there, in runtime.RecorderRecord mode, for tests
+// which don't reach the server. Options changing the transport must come
+// before it.
+func WithRecorder(mode runtime.RecorderMode, dir string) ClientOption {
+	return func(c *Client) error {
+		c.Client = runtime.NewRecorder(mode, dir, c.Client)
+		return nil
+	}
+}
+
+// WithRequestSigner sets a callback for signing requests, which is called
+// right before sending them, after any request editor and compression.
+func WithRequestSigner(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestSigner = fn
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AddOwner request with any body
+	AddOwnerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddOwner(ctx context.Context, body AddOwnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddPet request with any body
+	AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TagPet request with any body
+	TagPetWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TagPet(ctx context.Context, id int, body TagPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AddOwnerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddOwnerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "AddOwner", req)
+}
+
+func (c *Client) AddOwner(ctx context.Context, body AddOwnerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddOwnerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "AddOwner", req)
+}
+
+func (c *Client) AddPetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "AddPet", req)
+}
+
+func (c *Client) AddPet(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "AddPet", req)
+}
+
+func (c *Client) TagPetWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTagPetRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "TagPet", req)
+}
+
+func (c *Client) TagPet(ctx context.Context, id int, body TagPetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTagPetRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "TagPet", req)
+}
+
+// NewAddOwnerRequest calls the generic AddOwner builder with application/json body
+func NewAddOwnerRequest(server string, body AddOwnerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddOwnerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddOwnerRequestWithBody generates requests for AddOwner with any type of body
+func NewAddOwnerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/owners")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddPetRequest calls the generic AddPet builder with application/json body
+func NewAddPetRequest(server string, body AddPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddPetRequestWithBody generates requests for AddPet with any type of body
+func NewAddPetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTagPetRequest calls the generic TagPet builder with application/json body
+func NewTagPetRequest(server string, id int, body TagPetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTagPetRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewTagPetRequestWithBody generates requests for TagPet with any type of body
+func NewTagPetRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets/%s", pathParam0)
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// do sends the request of an operation, calling the call hooks around it and
+// logging the call when the client has been configured to.
+func (c *Client) do(ctx context.Context, operationID string, req *http.Request) (rsp *http.Response, err error) {
+	// Doers such as runtime.Recorder find the operation in the request context.
+	req = req.WithContext(runtime.ContextWithOperationID(req.Context(), operationID))
+	if c.Logger != nil {
+		start := time.Now()
+		defer func() {
+			c.Logger.LogOperation(ctx, runtime.NewClientOperationRecord(operationID, req, rsp, time.Since(start), err))
+		}()
+	}
+	hookCtx := ctx
+	if c.BeforeCall != nil {
+		if hookCtx, err = c.BeforeCall(ctx, operationID); err != nil {
+			return nil, err
+		}
+	}
+	rsp, err = c.send(ctx, req)
+	if c.AfterCall != nil {
+		c.AfterCall(hookCtx, operationID, rsp, err)
+	}
+	return rsp, err
+}
+
+// send sends the request, compressing it, signing it, failing over to other
+// servers and validating the response when the client has been configured to.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GzipRequestBody(req, c.CompressionThreshold); err != nil {
+			return nil, err
+		}
+		if req.Header.Get("Accept-Encoding") == "" {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+	}
+	transmit := func(req *http.Request) (*http.Response, error) {
+		if c.RequestSigner != nil {
+			if err := c.RequestSigner(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+		return c.Client.Do(req)
+	}
+	var rsp *http.Response
+	var err error
+	if c.ServerPool != nil {
+		rsp, err = c.ServerPool.Do(req, transmit)
+	} else {
+		rsp, err = transmit(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GunzipResponseBody(rsp); err != nil {
+			return nil, err
+		}
+	}
+	if c.ResponseValidator != nil {
+		if err := c.ResponseValidator(ctx, req, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// StreamResponse gives unbuffered access to a response, for large downloads
+// and long-poll endpoints. The caller is responsible for closing Body.
+type StreamResponse struct {
+	Body         io.ReadCloser
+	Header       http.Header
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// newStreamResponse wraps an HTTP response without reading its body.
+func newStreamResponse(rsp *http.Response) *StreamResponse {
+	return &StreamResponse{
+		Body:         rsp.Body,
+		Header:       rsp.Header,
+		HTTPResponse: rsp,
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AddOwner request with any body
+	AddOwnerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddOwnerResponse, error)
+	AddOwnerWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	AddOwnerWithResponse(ctx context.Context, body AddOwnerJSONRequestBody, reqEditors ...RequestEditorFn) (*AddOwnerResponse, error)
+	AddOwnerWithBodyStream(ctx context.Context, body AddOwnerJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// AddPet request with any body
+	AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+	AddPetWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error)
+	AddPetWithBodyStream(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// TagPet request with any body
+	TagPetWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TagPetResponse, error)
+	TagPetWithBodyWithBodyStream(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	TagPetWithResponse(ctx context.Context, id int, body TagPetJSONRequestBody, reqEditors ...RequestEditorFn) (*TagPetResponse, error)
+	TagPetWithBodyStream(ctx context.Context, id int, body TagPetJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+}
+
+type AddOwnerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AddOwnerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddOwnerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r AddOwnerResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type AddPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r AddPetResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type TagPetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TagPetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TagPetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r TagPetResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+// AddOwnerWithBodyWithResponse request with arbitrary body returning *AddOwnerResponse
+func (c *ClientWithResponses) AddOwnerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddOwnerResponse, error) {
+	rsp, err := c.AddOwnerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddOwnerResponse(rsp)
+}
+
+// AddOwnerWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) AddOwnerWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.AddOwnerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) AddOwnerWithResponse(ctx context.Context, body AddOwnerJSONRequestBody, reqEditors ...RequestEditorFn) (*AddOwnerResponse, error) {
+	rsp, err := c.AddOwner(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddOwnerResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddOwnerWithBodyStream(ctx context.Context, body AddOwnerJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.AddOwner(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// AddPetWithBodyWithResponse request with arbitrary body returning *AddPetResponse
+func (c *ClientWithResponses) AddPetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+// AddPetWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) AddPetWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.AddPetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) AddPetWithResponse(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPetResponse, error) {
+	rsp, err := c.AddPet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPetResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddPetWithBodyStream(ctx context.Context, body AddPetJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.AddPet(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// TagPetWithBodyWithResponse request with arbitrary body returning *TagPetResponse
+func (c *ClientWithResponses) TagPetWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TagPetResponse, error) {
+	rsp, err := c.TagPetWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTagPetResponse(rsp)
+}
+
+// TagPetWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) TagPetWithBodyWithBodyStream(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.TagPetWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) TagPetWithResponse(ctx context.Context, id int, body TagPetJSONRequestBody, reqEditors ...RequestEditorFn) (*TagPetResponse, error) {
+	rsp, err := c.TagPet(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTagPetResponse(rsp)
+}
+
+func (c *ClientWithResponses) TagPetWithBodyStream(ctx context.Context, id int, body TagPetJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.TagPet(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ParseAddOwnerResponse parses an HTTP response from a AddOwnerWithResponse call
+func ParseAddOwnerResponse(rsp *http.Response) (*AddOwnerResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddOwnerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAddPetResponse parses an HTTP response from a AddPetWithResponse call
+func ParseAddPetResponse(rsp *http.Response) (*AddPetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTagPetResponse parses an HTTP response from a TagPetWithResponse call
+func ParseTagPetResponse(rsp *http.Response) (*TagPetResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TagPetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
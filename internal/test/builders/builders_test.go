@@ -0,0 +1,45 @@
+package builders
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilders(t *testing.T) {
+	_, err := BuildAddOwnerJSONRequestBody().WithPhone("555-0100").Build()
+	assert.EqualError(t, err, "missing required properties of AddOwnerJSONRequestBody: email, name")
+
+	owner, err := BuildAddOwnerJSONRequestBody().
+		WithName("Alex").
+		WithEmail("alex@example.com").
+		WithAdditionalProperty("team", "blue").
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, "Alex", owner.Name)
+	assert.Nil(t, owner.Phone)
+	assert.Equal(t, map[string]string{"team": "blue"}, owner.AdditionalProperties)
+
+	var sent map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &sent)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+	client, err := NewClient(ts.URL)
+	require.NoError(t, err)
+
+	pet, err := BuildAddPetJSONRequestBody().WithName("Rex").WithTags([]string{"good"}).Build()
+	require.NoError(t, err)
+	rsp, err := client.AddPet(context.Background(), pet)
+	require.NoError(t, err)
+	rsp.Body.Close()
+	assert.Equal(t, map[string]interface{}{"name": "Rex", "tags": []interface{}{"good"}}, sent)
+}
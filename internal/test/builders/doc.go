@@ -0,0 +1,3 @@
+package builders
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=builders --generate types,client --body-builders 3 -o builders.gen.go builders.yaml
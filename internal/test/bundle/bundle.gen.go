@@ -0,0 +1,1015 @@
+// Package bundle provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package bundle
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Defines values for Tag2.
+const (
+	Tag2Cute Tag2 = "cute"
+
+	Tag2Grumpy Tag2 = "grumpy"
+)
+
+// Defines values for Tag3.
+const (
+	Tag3Cute Tag3 = "cute"
+
+	Tag3Grumpy Tag3 = "grumpy"
+)
+
+// Owner defines model for Owner.
+type Owner struct {
+	Name string `json:"name"`
+	Tag  *Tag2  `json:"tag,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Name string `json:"name"`
+	Tag  *Tag3  `json:"tag,omitempty"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	Label string `json:"label"`
+}
+
+// Tag2 defines model for Tag2.
+type Tag2 string
+
+// Tag3 defines model for Tag3.
+type Tag3 string
+
+// Error defines model for error.
+type Error struct {
+	Message string `json:"message"`
+}
+
+// Limit defines model for Limit.
+type Limit int
+
+// ListPetsParams defines parameters for ListPets.
+type ListPetsParams struct {
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseValidatorFn is the function signature for the ResponseValidator callback function
+type ResponseValidatorFn func(ctx context.Context, req *http.Request, rsp *http.Response) error
+
+// BeforeCallFn is called before every call of an operation. When it returns
+// an error, the request isn't sent and the error is returned to the caller,
+// which allows short-circuiting failing operations. The returned context is
+// passed to the AfterCallFn of the call.
+type BeforeCallFn func(ctx context.Context, operationID string) (context.Context, error)
+
+// AfterCallFn is called after every call of an operation which was let
+// through by the BeforeCallFn, with the response unless the call failed.
+type AfterCallFn func(ctx context.Context, operationID string, rsp *http.Response, err error)
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// A callback for checking responses received over the network before
+	// they are returned to the caller.
+	ResponseValidator ResponseValidatorFn
+
+	// When greater than zero, request bodies of at least this many bytes are
+	// gzip compressed, and gzip encoded responses are accepted and
+	// transparently decompressed.
+	CompressionThreshold int64
+
+	// A callback for signing requests, called after the request editors and
+	// compression, once nothing else will change the request.
+	RequestSigner RequestEditorFn
+
+	// When set, requests are sent to the servers of the pool, of which Server
+	// is the first, following the pool's policy.
+	ServerPool *runtime.ServerPool
+
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+
+	// Callbacks called around every call of an operation, for circuit
+	// breakers and the like.
+	BeforeCall BeforeCallFn
+	AfterCall  AfterCallFn
+
+	// The User-Agent header sent with requests, unless they have one as a
+	// parameter or a request editor sets another one.
+	UserAgent string
+
+	// The transport cloned by the transport options, which they share.
+	clonedTransport *http.Transport
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server:    server,
+		UserAgent: "Bundled-references/1.0.0",
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the client's transport, such
+// as client certificates or root CAs.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.TLSClientConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function returning the proxy of each request, such as
+// http.ProxyURL(proxyURL). By default, the proxy is read from the
+// environment, as with http.ProxyFromEnvironment.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.Proxy = proxy
+		return nil
+	}
+}
+
+// WithTransport allows tuning the client's transport, such as its timeouts
+// and connection pool, with a function called on it.
+func WithTransport(configure func(*http.Transport)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		configure(transport)
+		return nil
+	}
+}
+
+// transport returns the *http.Transport of the client's doer, which transport
+// options configure. The doer and its transport are cloned the first time, from
+// http.DefaultTransport when there's no transport, so that neither a doer set
+// with WithHTTPClient nor the defaults of net/http are changed.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+	httpClient, ok := c.Client.(*http.Client)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the doer to be an *http.Client, not %T", c.Client)
+	}
+	if c.clonedTransport != nil && httpClient.Transport == c.clonedTransport {
+		return c.clonedTransport, nil
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the transport to be an *http.Transport, not %T", transport)
+	}
+	cloned := *httpClient
+	cloned.Transport = httpTransport.Clone()
+	c.Client = &cloned
+	c.clonedTransport = cloned.Transport.(*http.Transport)
+	return c.clonedTransport, nil
+}
+
+// WithUserAgent sets the User-Agent header sent with requests, which is
+// Bundled-references/1.0.0 by default.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseValidator allows setting up a callback function, which will be
+// called on every response before it is returned. This can be used to check
+// that responses conform to the specification.
+func WithResponseValidator(fn ResponseValidatorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseValidator = fn
+		return nil
+	}
+}
+
+// WithIfNoneMatch returns a RequestEditorFn for making a request conditional
+// on the resource no longer matching the given ETag, in which case the
+// server may respond with 304 Not Modified.
+func WithIfNoneMatch(etag string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	}
+}
+
+// WithIfModifiedSince returns a RequestEditorFn for making a request
+// conditional on the resource having been modified after the given time, in
+// which case the server may respond with 304 Not Modified.
+func WithIfModifiedSince(t time.Time) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+		return nil
+	}
+}
+
+// PropagateCorrelationID is a RequestEditorFn sending the correlation ID of the
+// request context in the X-Request-ID header, generating one when the context has
+// none. Generated servers add the correlation ID of the requests they handle to
+// their context, so that it's passed on to the services they call.
+func PropagateCorrelationID(ctx context.Context, req *http.Request) error {
+	if req.Header.Get("X-Request-ID") != "" {
+		return nil
+	}
+	id := runtime.CorrelationIDFromContext(ctx)
+	if id == "" {
+		id = runtime.NewCorrelationID()
+	}
+	req.Header.Set("X-Request-ID", id)
+	return nil
+}
+
+// WithRequestCompression enables gzip compression of request bodies which are
+// at least minSize bytes long. The client also advertises that it accepts
+// gzip encoded responses, which are decompressed before they're parsed.
+func WithRequestCompression(minSize int64) ClientOption {
+	return func(c *Client) error {
+		if minSize <= 0 {
+			return fmt.Errorf("compression threshold must be positive, got %d", minSize)
+		}
+		c.CompressionThreshold = minSize
+		return nil
+	}
+}
+
+// WithServers sets several servers serving the API, of which requests are
+// sent to one following the policy. Whatever the policy, requests are sent to
+// the next server when one is unavailable. Servers given with NewClient are
+// replaced by these.
+func WithServers(policy runtime.ServerPolicy, servers ...string) ClientOption {
+	return func(c *Client) error {
+		pool, err := runtime.NewServerPool(policy, servers...)
+		if err != nil {
+			return err
+		}
+		c.Server = pool.Servers()[0]
+		c.ServerPool = pool
+		return nil
+	}
+}
+
+// WithLogger sets a logger, which receives a record of every call of an
+// operation, with its duration and status code. Credentials are redacted from
+// the recorded URL and headers.
+func WithLogger(logger runtime.OperationLogger) ClientOption {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithCallHooks sets callbacks called before and after every call of an
+// operation, either of which may be nil. This allows plugging in a circuit
+// breaker per operation, which lets the before hook fail calls of the
+// operations it considers unavailable, and learns from the outcome of calls
+// in the after hook.
+func WithCallHooks(before BeforeCallFn, after AfterCallFn) ClientOption {
+	return func(c *Client) error {
+		c.BeforeCall = before
+		c.AfterCall = after
+		return nil
+	}
+}
+
+// WithRecorder replays the responses recorded in the directory, in
+// runtime.RecorderReplay mode, or sends requests with the client's doer and
+// records their responses there, in runtime.RecorderRecord mode, for tests
+// which don't reach the server. Options changing the transport must come
+// before it.
+func WithRecorder(mode runtime.RecorderMode, dir string) ClientOption {
+	return func(c *Client) error {
+		c.Client = runtime.NewRecorder(mode, dir, c.Client)
+		return nil
+	}
+}
+
+// WithRequestSigner sets a callback for signing requests, which is called
+// right before sending them, after any request editor and compression.
+func WithRequestSigner(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestSigner = fn
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListOwners request
+	ListOwners(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPets request
+	ListPets(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTags request
+	ListTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListOwners(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOwnersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "ListOwners", req)
+}
+
+func (c *Client) ListPets(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPetsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "ListPets", req)
+}
+
+func (c *Client) ListTags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "ListTags", req)
+}
+
+// NewListOwnersRequest generates requests for ListOwners
+func NewListOwnersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/owners")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPetsRequest generates requests for ListPets
+func NewListPetsRequest(server string, params *ListPetsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pets")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTagsRequest generates requests for ListTags
+func NewListTagsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tags")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// do sends the request of an operation, calling the call hooks around it and
+// logging the call when the client has been configured to.
+func (c *Client) do(ctx context.Context, operationID string, req *http.Request) (rsp *http.Response, err error) {
+	// Doers such as runtime.Recorder find the operation in the request context.
+	req = req.WithContext(runtime.ContextWithOperationID(req.Context(), operationID))
+	if c.Logger != nil {
+		start := time.Now()
+		defer func() {
+			c.Logger.LogOperation(ctx, runtime.NewClientOperationRecord(operationID, req, rsp, time.Since(start), err))
+		}()
+	}
+	hookCtx := ctx
+	if c.BeforeCall != nil {
+		if hookCtx, err = c.BeforeCall(ctx, operationID); err != nil {
+			return nil, err
+		}
+	}
+	rsp, err = c.send(ctx, req)
+	if c.AfterCall != nil {
+		c.AfterCall(hookCtx, operationID, rsp, err)
+	}
+	return rsp, err
+}
+
+// send sends the request, compressing it, signing it, failing over to other
+// servers and validating the response when the client has been configured to.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GzipRequestBody(req, c.CompressionThreshold); err != nil {
+			return nil, err
+		}
+		if req.Header.Get("Accept-Encoding") == "" {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+	}
+	transmit := func(req *http.Request) (*http.Response, error) {
+		if c.RequestSigner != nil {
+			if err := c.RequestSigner(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+		return c.Client.Do(req)
+	}
+	var rsp *http.Response
+	var err error
+	if c.ServerPool != nil {
+		rsp, err = c.ServerPool.Do(req, transmit)
+	} else {
+		rsp, err = transmit(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GunzipResponseBody(rsp); err != nil {
+			return nil, err
+		}
+	}
+	if c.ResponseValidator != nil {
+		if err := c.ResponseValidator(ctx, req, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// StreamResponse gives unbuffered access to a response, for large downloads
+// and long-poll endpoints. The caller is responsible for closing Body.
+type StreamResponse struct {
+	Body         io.ReadCloser
+	Header       http.Header
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// newStreamResponse wraps an HTTP response without reading its body.
+func newStreamResponse(rsp *http.Response) *StreamResponse {
+	return &StreamResponse{
+		Body:         rsp.Body,
+		Header:       rsp.Header,
+		HTTPResponse: rsp,
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListOwners request
+	ListOwnersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOwnersResponse, error)
+	ListOwnersWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// ListPets request
+	ListPetsWithResponse(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*ListPetsResponse, error)
+	ListPetsWithBodyStream(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// ListTags request
+	ListTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+	ListTagsWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+}
+
+type ListOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Owner
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r ListOwnersResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type ListPetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Pet
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r ListPetsResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type ListTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Tag
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r ListTagsResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+// ListOwnersWithResponse request returning *ListOwnersResponse
+func (c *ClientWithResponses) ListOwnersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListOwnersResponse, error) {
+	rsp, err := c.ListOwners(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOwnersResponse(rsp)
+}
+
+// ListOwnersWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) ListOwnersWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.ListOwners(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ListPetsWithResponse request returning *ListPetsResponse
+func (c *ClientWithResponses) ListPetsWithResponse(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*ListPetsResponse, error) {
+	rsp, err := c.ListPets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPetsResponse(rsp)
+}
+
+// ListPetsWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) ListPetsWithBodyStream(ctx context.Context, params *ListPetsParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.ListPets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ListTagsWithResponse request returning *ListTagsResponse
+func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
+	rsp, err := c.ListTags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagsResponse(rsp)
+}
+
+// ListTagsWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) ListTagsWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.ListTags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ParseListOwnersResponse parses an HTTP response from a ListOwnersWithResponse call
+func ParseListOwnersResponse(rsp *http.Response) (*ListOwnersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOwnersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Owner
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPetsResponse parses an HTTP response from a ListPetsWithResponse call
+func ParseListPetsResponse(rsp *http.Response) (*ListPetsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Pet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
+func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/7xUzW7iQAx+FeTdY0QoveW4t5UqtQduVQ9uYsJUGc/U4+wKobz7yjMFuluWUqkqF0L8",
+	"+fvxeNhBG3wMTKwJmh1EFPSkJPnXjfNO7cExNPA8kmyhAkZP0MCQixWkdkMeDaXbaAXHSj0JTNO0r2a2",
+	"299MkkUkRBJ1lF8XukN3UnHcw1SBYm/vvwutoYFv9dFp/cJar7BfgskIPY9OqIPmvhA+VHvC8PhErRrh",
+	"Hemny19fLr/C/q38gI80nND/h7TA/sO6tH7i0RuyHZWggl5GH7evOo7BsusPdZBIOHFynlLCnt53vwe+",
+	"9W9Ix+uQOZwOVvsxcjdQNxNakxC3lKCCXyTJBYYGruaL+cJchUiM0UED1/PF/AoqiKib7KwOtmv5sS+H",
+	"br5RXeCfHTRw45LeFog5TTFwKpmWi4V9tYGVOHdijINrc2/9lMzC7tXOOyWf3tuTLAXTIT2K4LaE7yi1",
+	"4qKWbLqh2Yv1KZfrSHo+xp0Bqr8u7v1pN0dIXS729PAV4e3WXRg9h82VNY6DfsjLOQtlf0+IIs8ONZu2",
+	"Yn9+2isDfMXU7M/iwqll01P+/BkAuI5wVc8FAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	var res = make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	var resolvePath = PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		var pathToFile = url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
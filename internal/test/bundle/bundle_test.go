@@ -0,0 +1,49 @@
+package bundle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundledReferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("limit") == "0" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"invalid limit"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[{"name":"Rex","tag":"grumpy"}]`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	// The components of the other documents are generated in this package,
+	// with those whose names are taken numbered.
+	limit := Limit(10)
+	rsp, err := client.ListPetsWithResponse(context.Background(), &ListPetsParams{Limit: &limit})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSON200)
+	grumpy := Tag3Grumpy
+	assert.Equal(t, []Pet{{Name: "Rex", Tag: &grumpy}}, *rsp.JSON200)
+
+	limit = 0
+	rsp, err = client.ListPetsWithResponse(context.Background(), &ListPetsParams{Limit: &limit})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.JSONDefault)
+	assert.Equal(t, Error{Message: "invalid limit"}, *rsp.JSONDefault)
+
+	// The references of the embedded spec are all local.
+	swagger, err := GetSwagger()
+	require.NoError(t, err)
+	assert.Contains(t, swagger.Components.Schemas, "Pet")
+	assert.Contains(t, swagger.Components.Schemas, "Tag2")
+	assert.Equal(t, "#/components/schemas/Pet", swagger.Paths["/pets"].Get.Responses["200"].Value.Content["application/json"].Schema.Value.Items.Ref)
+}
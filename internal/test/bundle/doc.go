@@ -0,0 +1,3 @@
+package bundle
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=bundle --bundle --generate=types,client,spec -o bundle.gen.go spec.yaml
@@ -0,0 +1,1023 @@
+// Package callbacks provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package callbacks
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/go-chi/chi/v5"
+)
+
+// JobResult defines model for JobResult.
+type JobResult struct {
+	Name      string `json:"name"`
+	Succeeded bool   `json:"succeeded"`
+}
+
+// StartJobJSONBody defines parameters for StartJob.
+type StartJobJSONBody struct {
+	CallbackUrl string `json:"callbackUrl"`
+	Name        string `json:"name"`
+}
+
+// StartJobOnDoneJSONBody defines parameters for StartJobOnDone.
+type StartJobOnDoneJSONBody JobResult
+
+// StartJobJSONRequestBody defines body for StartJob for application/json ContentType.
+type StartJobJSONRequestBody StartJobJSONBody
+
+// StartJobOnDoneJSONRequestBody defines body for StartJobOnDone for application/json ContentType.
+type StartJobOnDoneJSONRequestBody StartJobOnDoneJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// ResponseValidatorFn is the function signature for the ResponseValidator callback function
+type ResponseValidatorFn func(ctx context.Context, req *http.Request, rsp *http.Response) error
+
+// BeforeCallFn is called before every call of an operation. When it returns
+// an error, the request isn't sent and the error is returned to the caller,
+// which allows short-circuiting failing operations. The returned context is
+// passed to the AfterCallFn of the call.
+type BeforeCallFn func(ctx context.Context, operationID string) (context.Context, error)
+
+// AfterCallFn is called after every call of an operation which was let
+// through by the BeforeCallFn, with the response unless the call failed.
+type AfterCallFn func(ctx context.Context, operationID string, rsp *http.Response, err error)
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+
+	// A callback for checking responses received over the network before
+	// they are returned to the caller.
+	ResponseValidator ResponseValidatorFn
+
+	// When greater than zero, request bodies of at least this many bytes are
+	// gzip compressed, and gzip encoded responses are accepted and
+	// transparently decompressed.
+	CompressionThreshold int64
+
+	// A callback for signing requests, called after the request editors and
+	// compression, once nothing else will change the request.
+	RequestSigner RequestEditorFn
+
+	// When set, requests are sent to the servers of the pool, of which Server
+	// is the first, following the pool's policy.
+	ServerPool *runtime.ServerPool
+
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+
+	// Callbacks called around every call of an operation, for circuit
+	// breakers and the like.
+	BeforeCall BeforeCallFn
+	AfterCall  AfterCallFn
+
+	// The User-Agent header sent with requests, unless they have one as a
+	// parameter or a request editor sets another one.
+	UserAgent string
+
+	// The transport cloned by the transport options, which they share.
+	clonedTransport *http.Transport
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server:    server,
+		UserAgent: "Callbacks/1.0.0",
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the client's transport, such
+// as client certificates or root CAs.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.TLSClientConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function returning the proxy of each request, such as
+// http.ProxyURL(proxyURL). By default, the proxy is read from the
+// environment, as with http.ProxyFromEnvironment.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.Proxy = proxy
+		return nil
+	}
+}
+
+// WithTransport allows tuning the client's transport, such as its timeouts
+// and connection pool, with a function called on it.
+func WithTransport(configure func(*http.Transport)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		configure(transport)
+		return nil
+	}
+}
+
+// transport returns the *http.Transport of the client's doer, which transport
+// options configure. The doer and its transport are cloned the first time, from
+// http.DefaultTransport when there's no transport, so that neither a doer set
+// with WithHTTPClient nor the defaults of net/http are changed.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+	httpClient, ok := c.Client.(*http.Client)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the doer to be an *http.Client, not %T", c.Client)
+	}
+	if c.clonedTransport != nil && httpClient.Transport == c.clonedTransport {
+		return c.clonedTransport, nil
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the transport to be an *http.Transport, not %T", transport)
+	}
+	cloned := *httpClient
+	cloned.Transport = httpTransport.Clone()
+	c.Client = &cloned
+	c.clonedTransport = cloned.Transport.(*http.Transport)
+	return c.clonedTransport, nil
+}
+
+// WithUserAgent sets the User-Agent header sent with requests, which is
+// Callbacks/1.0.0 by default.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithResponseValidator allows setting up a callback function, which will be
+// called on every response before it is returned. This can be used to check
+// that responses conform to the specification.
+func WithResponseValidator(fn ResponseValidatorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseValidator = fn
+		return nil
+	}
+}
+
+// WithIfNoneMatch returns a RequestEditorFn for making a request conditional
+// on the resource no longer matching the given ETag, in which case the
+// server may respond with 304 Not Modified.
+func WithIfNoneMatch(etag string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	}
+}
+
+// WithIfModifiedSince returns a RequestEditorFn for making a request
+// conditional on the resource having been modified after the given time, in
+// which case the server may respond with 304 Not Modified.
+func WithIfModifiedSince(t time.Time) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+		return nil
+	}
+}
+
+// PropagateCorrelationID is a RequestEditorFn sending the correlation ID of the
+// request context in the X-Request-ID header, generating one when the context has
+// none. Generated servers add the correlation ID of the requests they handle to
+// their context, so that it's passed on to the services they call.
+func PropagateCorrelationID(ctx context.Context, req *http.Request) error {
+	if req.Header.Get("X-Request-ID") != "" {
+		return nil
+	}
+	id := runtime.CorrelationIDFromContext(ctx)
+	if id == "" {
+		id = runtime.NewCorrelationID()
+	}
+	req.Header.Set("X-Request-ID", id)
+	return nil
+}
+
+// WithRequestCompression enables gzip compression of request bodies which are
+// at least minSize bytes long. The client also advertises that it accepts
+// gzip encoded responses, which are decompressed before they're parsed.
+func WithRequestCompression(minSize int64) ClientOption {
+	return func(c *Client) error {
+		if minSize <= 0 {
+			return fmt.Errorf("compression threshold must be positive, got %d", minSize)
+		}
+		c.CompressionThreshold = minSize
+		return nil
+	}
+}
+
+// WithServers sets several servers serving the API, of which requests are
+// sent to one following the policy. Whatever the policy, requests are sent to
+// the next server when one is unavailable. Servers given with NewClient are
+// replaced by these.
+func WithServers(policy runtime.ServerPolicy, servers ...string) ClientOption {
+	return func(c *Client) error {
+		pool, err := runtime.NewServerPool(policy, servers...)
+		if err != nil {
+			return err
+		}
+		c.Server = pool.Servers()[0]
+		c.ServerPool = pool
+		return nil
+	}
+}
+
+// WithLogger sets a logger, which receives a record of every call of an
+// operation, with its duration and status code. Credentials are redacted from
+// the recorded URL and headers.
+func WithLogger(logger runtime.OperationLogger) ClientOption {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithCallHooks sets callbacks called before and after every call of an
+// operation, either of which may be nil. This allows plugging in a circuit
+// breaker per operation, which lets the before hook fail calls of the
+// operations it considers unavailable, and learns from the outcome of calls
+// in the after hook.
+func WithCallHooks(before BeforeCallFn, after AfterCallFn) ClientOption {
+	return func(c *Client) error {
+		c.BeforeCall = before
+		c.AfterCall = after
+		return nil
+	}
+}
+
+// WithRecorder replays the responses recorded in the directory, in
+// runtime.RecorderReplay mode, or sends requests with the client's doer and
+// records their responses there, in runtime.RecorderRecord mode, for tests
+// which don't reach the server. Options changing the transport must come
+// before it.
+func WithRecorder(mode runtime.RecorderMode, dir string) ClientOption {
+	return func(c *Client) error {
+		c.Client = runtime.NewRecorder(mode, dir, c.Client)
+		return nil
+	}
+}
+
+// WithRequestSigner sets a callback for signing requests, which is called
+// right before sending them, after any request editor and compression.
+func WithRequestSigner(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestSigner = fn
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// StartJob request with any body
+	StartJobWithBody(ctx context.Context, queue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartJob(ctx context.Context, queue string, body StartJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) StartJobWithBody(ctx context.Context, queue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartJobRequestWithBody(c.Server, queue, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "StartJob", req)
+}
+
+func (c *Client) StartJob(ctx context.Context, queue string, body StartJobJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartJobRequest(c.Server, queue, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "StartJob", req)
+}
+
+// NewStartJobRequest calls the generic StartJob builder with application/json body
+func NewStartJobRequest(server string, queue string, body StartJobJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartJobRequestWithBody(server, queue, "application/json", bodyReader)
+}
+
+// NewStartJobRequestWithBody generates requests for StartJob with any type of body
+func NewStartJobRequestWithBody(server string, queue string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "queue", runtime.ParamLocationPath, queue)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// do sends the request of an operation, calling the call hooks around it and
+// logging the call when the client has been configured to.
+func (c *Client) do(ctx context.Context, operationID string, req *http.Request) (rsp *http.Response, err error) {
+	// Doers such as runtime.Recorder find the operation in the request context.
+	req = req.WithContext(runtime.ContextWithOperationID(req.Context(), operationID))
+	if c.Logger != nil {
+		start := time.Now()
+		defer func() {
+			c.Logger.LogOperation(ctx, runtime.NewClientOperationRecord(operationID, req, rsp, time.Since(start), err))
+		}()
+	}
+	hookCtx := ctx
+	if c.BeforeCall != nil {
+		if hookCtx, err = c.BeforeCall(ctx, operationID); err != nil {
+			return nil, err
+		}
+	}
+	rsp, err = c.send(ctx, req)
+	if c.AfterCall != nil {
+		c.AfterCall(hookCtx, operationID, rsp, err)
+	}
+	return rsp, err
+}
+
+// send sends the request, compressing it, signing it, failing over to other
+// servers and validating the response when the client has been configured to.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GzipRequestBody(req, c.CompressionThreshold); err != nil {
+			return nil, err
+		}
+		if req.Header.Get("Accept-Encoding") == "" {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+	}
+	transmit := func(req *http.Request) (*http.Response, error) {
+		if c.RequestSigner != nil {
+			if err := c.RequestSigner(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+		return c.Client.Do(req)
+	}
+	var rsp *http.Response
+	var err error
+	if c.ServerPool != nil {
+		rsp, err = c.ServerPool.Do(req, transmit)
+	} else {
+		rsp, err = transmit(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GunzipResponseBody(rsp); err != nil {
+			return nil, err
+		}
+	}
+	if c.ResponseValidator != nil {
+		if err := c.ResponseValidator(ctx, req, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// StreamResponse gives unbuffered access to a response, for large downloads
+// and long-poll endpoints. The caller is responsible for closing Body.
+type StreamResponse struct {
+	Body         io.ReadCloser
+	Header       http.Header
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// newStreamResponse wraps an HTTP response without reading its body.
+func newStreamResponse(rsp *http.Response) *StreamResponse {
+	return &StreamResponse{
+		Body:         rsp.Body,
+		Header:       rsp.Header,
+		HTTPResponse: rsp,
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// StartJob request with any body
+	StartJobWithBodyWithResponse(ctx context.Context, queue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartJobResponse, error)
+	StartJobWithBodyWithBodyStream(ctx context.Context, queue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	StartJobWithResponse(ctx context.Context, queue string, body StartJobJSONRequestBody, reqEditors ...RequestEditorFn) (*StartJobResponse, error)
+	StartJobWithBodyStream(ctx context.Context, queue string, body StartJobJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+}
+
+type StartJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StartJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r StartJobResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+// StartJobWithBodyWithResponse request with arbitrary body returning *StartJobResponse
+func (c *ClientWithResponses) StartJobWithBodyWithResponse(ctx context.Context, queue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartJobResponse, error) {
+	rsp, err := c.StartJobWithBody(ctx, queue, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartJobResponse(rsp)
+}
+
+// StartJobWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) StartJobWithBodyWithBodyStream(ctx context.Context, queue string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.StartJobWithBody(ctx, queue, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) StartJobWithResponse(ctx context.Context, queue string, body StartJobJSONRequestBody, reqEditors ...RequestEditorFn) (*StartJobResponse, error) {
+	rsp, err := c.StartJob(ctx, queue, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartJobResponse(rsp)
+}
+
+func (c *ClientWithResponses) StartJobWithBodyStream(ctx context.Context, queue string, body StartJobJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.StartJob(ctx, queue, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ParseStartJobResponse parses an HTTP response from a StartJobWithResponse call
+func ParseStartJobResponse(rsp *http.Response) (*StartJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// CallbackSender invokes the callbacks of the operations of the API, at the
+// URLs which their requests gave, with the options of a Client, such as its
+// doer, request editors and signer.
+type CallbackSender struct {
+	client *Client
+}
+
+// NewCallbackSender creates a new CallbackSender, with reasonable defaults
+func NewCallbackSender(opts ...ClientOption) (*CallbackSender, error) {
+	client, err := NewClient("", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &CallbackSender{client: client}, nil
+}
+
+// StartJobOnDoneURL returns the URL of the onDone callback, whose runtime expressions
+// are evaluated against the request which gave it, given its body and path
+// parameters: {$request.body#/callbackUrl}/queues/{$request.path.queue}
+func StartJobOnDoneURL(r *http.Request, body []byte, pathParams map[string]string) (string, error) {
+	return runtime.ExpandCallbackURL("{$request.body#/callbackUrl}/queues/{$request.path.queue}", r, body, pathParams)
+}
+
+// SendStartJobOnDoneWithBody sends the onDone callback to url with any body.
+func (s *CallbackSender) SendStartJobOnDoneWithBody(ctx context.Context, url string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartJobOnDoneRequestWithBody(url, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := s.client.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return s.client.do(ctx, "StartJobOnDone", req)
+}
+
+// SendStartJobOnDone sends the onDone callback to url with body, as application/json.
+func (s *CallbackSender) SendStartJobOnDone(ctx context.Context, url string, body StartJobOnDoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartJobOnDoneRequest(url, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := s.client.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return s.client.do(ctx, "StartJobOnDone", req)
+}
+
+// NewStartJobOnDoneRequest calls the generic StartJobOnDone builder with application/json body
+func NewStartJobOnDoneRequest(server string, body StartJobOnDoneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartJobOnDoneRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewStartJobOnDoneRequestWithBody generates requests for StartJobOnDone with any type of body
+func NewStartJobOnDoneRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /jobs/{queue})
+	StartJob(w http.ResponseWriter, r *http.Request, queue string)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+
+// StartJob operation middleware
+func (siw *ServerInterfaceWrapper) StartJob(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "queue" -------------
+	var queue string
+
+	err = runtime.BindStyledParameter("simple", false, "queue", chi.URLParam(r, "queue"), &queue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "queue", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartJob(w, r, queue)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/jobs/{queue}", runtime.LogHandlerFunc(options.Logger, "StartJob", wrapper.StartJob))
+	})
+
+	return r
+}
+
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := runtime.RequestCorrelationID(r.Header, "X-Request-ID")
+		w.Header().Set("X-Request-ID", id)
+		next(w, r.WithContext(runtime.ContextWithCorrelationID(r.Context(), id)))
+	}
+}
+
+// CallbackInterface is implemented by the receivers of the callbacks of the
+// operations of the API, whose requests it handles with their parameters bound
+// and their body decoded, like ServerInterface handles those of operations.
+type CallbackInterface interface {
+	// The job is done
+	// (POST onDone callback)
+	StartJobOnDone(w http.ResponseWriter, r *http.Request, body StartJobOnDoneJSONRequestBody)
+}
+
+// CallbackInterfaceWrapper binds the parameters and decodes the body of the
+// requests of callbacks, which it gives to its Handler.
+type CallbackInterfaceWrapper struct {
+	Handler CallbackInterface
+	// ErrorHandlerFunc handles the errors binding the parameters and decoding
+	// the body of requests.
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// StartJobOnDone binds the parameters and decodes the body of a StartJobOnDone request.
+func (siw *CallbackInterfaceWrapper) StartJobOnDone(w http.ResponseWriter, r *http.Request) {
+	bodies, err := DecodeStartJobOnDoneBody(r)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, err)
+		return
+	}
+	siw.Handler.StartJobOnDone(w, r, *bodies.JSON)
+}
+
+// CallbackHandlerOptions configures the handlers of callbacks.
+type CallbackHandlerOptions struct {
+	// Verify checks the signature of a request, given its body, before it's
+	// handled. Requests it fails get a 401 Unauthorized response.
+	Verify func(r *http.Request, body []byte) error
+	// ErrorHandlerFunc handles the errors reading the body of requests,
+	// binding their parameters and decoding their body. They get a 400 Bad
+	// Request response by default.
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// When set, every request received is logged with it.
+	Logger runtime.OperationLogger
+}
+
+// NewStartJobOnDoneCallbackHandler returns a handler of the onDone callback,
+// to be served at the URL which is given to its sender.
+func NewStartJobOnDoneCallbackHandler(si CallbackInterface, options CallbackHandlerOptions) http.Handler {
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	siw := &CallbackInterfaceWrapper{Handler: si, ErrorHandlerFunc: options.ErrorHandlerFunc}
+	return runtime.LogHandlerFunc(options.Logger, "StartJobOnDone",
+		runtime.VerifiedHandlerFunc("POST", options.Verify, options.ErrorHandlerFunc, siw.StartJobOnDone))
+}
+
+// StartJobOnDoneBodies holds the body of a StartJobOnDone request, decoded by
+// DecodeStartJobOnDoneBody. Only the field of the request's content type is set.
+type StartJobOnDoneBodies struct {
+	JSON *StartJobOnDoneJSONRequestBody
+}
+
+// DecodeStartJobOnDoneBody decodes the body of a StartJobOnDone request according to its
+// Content-Type, failing with a *runtime.UnsupportedMediaTypeError for content
+// types the operation doesn't accept.
+func DecodeStartJobOnDoneBody(r *http.Request) (*StartJobOnDoneBodies, error) {
+	supported := []string{"application/json"}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: r.Header.Get("Content-Type"), Supported: supported}
+	}
+
+	bodies := &StartJobOnDoneBodies{}
+	switch mediaType {
+	case "application/json":
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		var body StartJobOnDoneJSONRequestBody
+		if err := json.Unmarshal(data, &body); err != nil {
+			return nil, err
+		}
+		bodies.JSON = &body
+	default:
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: mediaType, Supported: supported}
+	}
+	return bodies, nil
+}
@@ -0,0 +1,94 @@
+package callbacks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secret = []byte("secret")
+
+// jobs is a ServerInterface implementation which runs jobs as soon as they're
+// started, and invokes their onDone callback.
+type jobs struct {
+	sender *CallbackSender
+}
+
+var _ ServerInterface = (*jobs)(nil)
+
+func (s *jobs) StartJob(w http.ResponseWriter, r *http.Request, queue string) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var job StartJobJSONRequestBody
+	if err := json.Unmarshal(body, &job); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	url, err := StartJobOnDoneURL(r, body, map[string]string{"queue": queue})
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	rsp, err := s.sender.SendStartJobOnDone(r.Context(), url, StartJobOnDoneJSONRequestBody{Name: job.Name, Succeeded: true})
+	if err != nil || rsp.StatusCode != http.StatusNoContent {
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// receiver is a CallbackInterface implementation recording the results.
+type receiver struct {
+	results []JobResult
+}
+
+var _ CallbackInterface = (*receiver)(nil)
+
+func (c *receiver) StartJobOnDone(w http.ResponseWriter, r *http.Request, body StartJobOnDoneJSONRequestBody) {
+	c.results = append(c.results, JobResult(body))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestCallbacks(t *testing.T) {
+	c := &receiver{}
+	mux := http.NewServeMux()
+	mux.Handle("/hooks/queues/fast", NewStartJobOnDoneCallbackHandler(c, CallbackHandlerOptions{
+		Verify: runtime.VerifyHMACSHA256(secret, "X-Signature"),
+	}))
+	callbackServer := httptest.NewServer(mux)
+	defer callbackServer.Close()
+
+	sender, err := NewCallbackSender(WithRequestSigner(runtime.SignHMACSHA256(secret, "X-Signature")))
+	require.NoError(t, err)
+	server := httptest.NewServer(Handler(&jobs{sender: sender}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+	rsp, err := client.StartJob(context.Background(), "fast", StartJobJSONRequestBody{
+		CallbackUrl: callbackServer.URL + "/hooks",
+		Name:        "backup",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, rsp.StatusCode)
+	assert.Equal(t, []JobResult{{Name: "backup", Succeeded: true}}, c.results)
+
+	// Callbacks to other queues aren't received.
+	rsp, err = client.StartJob(context.Background(), "slow", StartJobJSONRequestBody{
+		CallbackUrl: callbackServer.URL + "/hooks",
+		Name:        "restore",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadGateway, rsp.StatusCode)
+	assert.Len(t, c.results, 1)
+}
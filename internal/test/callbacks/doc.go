@@ -0,0 +1,3 @@
+package callbacks
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=callbacks --generate types,client,chi-server -o callbacks.gen.go callbacks.yaml
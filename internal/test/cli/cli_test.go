@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server is a ServerInterface implementation which answers with what it's
+// given.
+type server struct{}
+
+var _ ServerInterface = server{}
+
+func (server) ListPets(w http.ResponseWriter, r *http.Request, params ListPetsParams) {
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"tags":   params.Tags,
+		"limit":  params.Limit,
+		"filter": params.Filter,
+		"server": params.Server,
+	})
+}
+
+func (server) CreatePet(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	w.Header().Set("Content-Type", r.Header.Get("Content-Type"))
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(body)
+}
+
+func (server) GetPet(w http.ResponseWriter, r *http.Request, id int64) {
+	if id != 1 {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("no such pet\n"))
+		return
+	}
+	_, _ = w.Write([]byte(`{"name":"Rex"}` + "\n"))
+}
+
+func run(url string, stdin string, args ...string) (string, error) {
+	cmd := NewCommand("pets")
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(ioutil.Discard)
+	cmd.SetIn(strings.NewReader(stdin))
+	cmd.SetArgs(append(args, "--server", url))
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestCommand(t *testing.T) {
+	ts := httptest.NewServer(Handler(server{}))
+	defer ts.Close()
+
+	// Operations are under the commands of their tags, and parameters are
+	// flags, which are renamed when they're taken.
+	out, err := run(ts.URL, "", "pet-store", "list-pets", "--tags", "a,b", "--limit", "3", "--filter", `{"name":"Rex"}`, "--header-server", "eu")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tags":["a","b"],"limit":3,"filter":{"name":"Rex"},"server":"eu"}`, out)
+
+	out, err = run(ts.URL, "", "pet-store", "list-pets")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tags":null,"limit":null,"filter":null,"server":null}`, out)
+
+	_, err = run(ts.URL, "", "pet-store", "list-pets", "--limit", "many")
+	assert.Error(t, err)
+
+	// Bodies are read from the standard input for -.
+	out, err = run(ts.URL, `{"name":"Rex"}`, "pet-store", "create-pet", "--body", "-")
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"Rex"}`, out)
+
+	_, err = run(ts.URL, "", "pet-store", "create-pet")
+	assert.EqualError(t, err, `required flag(s) "body" not set`)
+
+	out, err = run(ts.URL, "", "get-pet", "--id", "1")
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"Rex"}`+"\n", out)
+
+	// Bodies of failed responses are written too.
+	out, err = run(ts.URL, "", "get-pet", "--id", "2")
+	assert.EqualError(t, err, "unexpected status 404 Not Found")
+	assert.Equal(t, "no such pet\n", out)
+}
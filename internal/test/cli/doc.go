@@ -0,0 +1,3 @@
+package cli
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=cli --generate types,client,chi-server,cli -o cli.gen.go cli.yaml
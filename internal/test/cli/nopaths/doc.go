@@ -0,0 +1,3 @@
+package nopaths
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=nopaths --generate types,client,cli -o nopaths.gen.go nopaths.yaml
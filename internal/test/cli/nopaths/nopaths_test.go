@@ -0,0 +1,14 @@
+package nopaths
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCommand(t *testing.T) {
+	// A spec without operations gets a command tree without commands.
+	cmd := NewCommand("pets")
+	assert.Empty(t, cmd.Commands())
+	assert.NotNil(t, cmd.PersistentFlags().Lookup("server"))
+}
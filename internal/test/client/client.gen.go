@@ -7,45 +7,173 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
+	"gopkg.in/yaml.v2"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/protobuf/proto"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 const (
 	OpenIdScopes = "OpenId.Scopes"
 )
 
+// Document defines model for Document.
+type Document struct {
+	Content string `json:"content" xml:"content" yaml:"content"`
+	Title   string `json:"title" xml:"title" yaml:"title"`
+}
+
 // SchemaObject defines model for SchemaObject.
 type SchemaObject struct {
-	FirstName string `json:"firstName"`
-	Role      string `json:"role"`
+	FirstName string `json:"firstName" xml:"firstName" yaml:"firstName"`
+	Role      string `json:"role" xml:"role" yaml:"role"`
+}
+
+// User defines model for User.
+type User struct {
+	Id   int    `json:"id" xml:"id" yaml:"id"`
+	Name string `json:"name" xml:"name" yaml:"name"`
+}
+
+// GetAgentParams defines parameters for GetAgent.
+type GetAgentParams struct {
+	UserAgent *string `json:"User-Agent,omitempty"`
+}
+
+// ListFilesParams defines parameters for ListFiles.
+type ListFilesParams struct {
+	Path string    `json:"path"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	Team *string `json:"team,omitempty"`
+	Page *int    `json:"page,omitempty"`
+}
+
+// GetUserParams defines parameters for GetUser.
+type GetUserParams struct {
+	Verbose *bool `json:"verbose,omitempty"`
 }
 
 // PostBothJSONBody defines parameters for PostBoth.
 type PostBothJSONBody SchemaObject
 
+// PostFormFormdataBody defines parameters for PostForm.
+type PostFormFormdataBody struct {
+	Name   string    `json:"name" xml:"name" yaml:"name"`
+	Scores *[]int    `json:"scores,omitempty" xml:"scores,omitempty" yaml:"scores,omitempty"`
+	Tags   *[]string `json:"tags,omitempty" xml:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
 // PostJsonJSONBody defines parameters for PostJson.
 type PostJsonJSONBody SchemaObject
 
+// PostMultipartMultipartBody defines parameters for PostMultipart.
+type PostMultipartMultipartBody struct {
+	File *openapi_types.File `json:"file,omitempty" xml:"file,omitempty" yaml:"file,omitempty"`
+	Name *string             `json:"name,omitempty" xml:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// PostGalleryMultipartBody defines parameters for PostGallery.
+type PostGalleryMultipartBody struct {
+	Images *[]openapi_types.File `json:"images,omitempty" xml:"images,omitempty" yaml:"images,omitempty"`
+	Tags   *[]string             `json:"tags,omitempty" xml:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// PostDocumentMultipartBody defines parameters for PostDocument.
+type PostDocumentMultipartBody struct {
+	Content openapi_types.File `json:"content" xml:"content" yaml:"content"`
+	Title   string             `json:"title" xml:"title" yaml:"title"`
+}
+
+// PostXmlXMLBody defines parameters for PostXml.
+type PostXmlXMLBody SchemaObject
+
+// PostYamlYAMLBody defines parameters for PostYaml.
+type PostYamlYAMLBody SchemaObject
+
+// UploadReportOctetStreamRequestBody defines body for UploadReport for application/octet-stream ContentType.
+type UploadReportOctetStreamRequestBody []byte
+
+// ReverseBytesOctetStreamRequestBody defines body for ReverseBytes for application/octet-stream ContentType.
+type ReverseBytesOctetStreamRequestBody []byte
+
 // PostBothJSONRequestBody defines body for PostBoth for application/json ContentType.
 type PostBothJSONRequestBody PostBothJSONBody
 
+// PostBothOctetStreamRequestBody defines body for PostBoth for application/octet-stream ContentType.
+type PostBothOctetStreamRequestBody []byte
+
+// PostFormFormdataRequestBody defines body for PostForm for application/x-www-form-urlencoded ContentType.
+type PostFormFormdataRequestBody PostFormFormdataBody
+
 // PostJsonJSONRequestBody defines body for PostJson for application/json ContentType.
 type PostJsonJSONRequestBody PostJsonJSONBody
 
+// PostMultipartMultipartRequestBody defines body for PostMultipart for multipart/form-data ContentType.
+type PostMultipartMultipartRequestBody PostMultipartMultipartBody
+
+// PostGalleryMultipartRequestBody defines body for PostGallery for multipart/form-data ContentType.
+type PostGalleryMultipartRequestBody PostGalleryMultipartBody
+
+// PostGalleryMultipartEncodings are the encoding objects of the properties of the
+// multipart/form-data body of PostGallery.
+var PostGalleryMultipartEncodings = map[string]runtime.MultipartEncoding{"images": {ContentType: "image/png, image/jpeg", Headers: map[string]string{"X-Rate-Limit": "10"}}, "tags": {Style: "form", Explode: false}}
+
+// PostDocumentMultipartRequestBody defines body for PostDocument for multipart/form-data ContentType.
+type PostDocumentMultipartRequestBody PostDocumentMultipartBody
+
+// PostOtherOctetStreamRequestBody defines body for PostOther for application/octet-stream ContentType.
+type PostOtherOctetStreamRequestBody []byte
+
+// PostProtobufProtobufRequestBody defines body for PostProtobuf for application/x-protobuf ContentType.
+type PostProtobufProtobufRequestBody = *wrapperspb.StringValue
+
+// PostXmlXMLRequestBody defines body for PostXml for application/xml ContentType.
+type PostXmlXMLRequestBody PostXmlXMLBody
+
+// PostYamlYAMLRequestBody defines body for PostYaml for application/yaml ContentType.
+type PostYamlYAMLRequestBody PostYamlYAMLBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseValidatorFn is the function signature for the ResponseValidator callback function
+type ResponseValidatorFn func(ctx context.Context, req *http.Request, rsp *http.Response) error
+
+// BeforeCallFn is called before every call of an operation. When it returns
+// an error, the request isn't sent and the error is returned to the caller,
+// which allows short-circuiting failing operations. The returned context is
+// passed to the AfterCallFn of the call.
+type BeforeCallFn func(ctx context.Context, operationID string) (context.Context, error)
+
+// AfterCallFn is called after every call of an operation which was let
+// through by the BeforeCallFn, with the response unless the call failed.
+type AfterCallFn func(ctx context.Context, operationID string, rsp *http.Response, err error)
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -68,6 +196,38 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A callback for checking responses received over the network before
+	// they are returned to the caller.
+	ResponseValidator ResponseValidatorFn
+
+	// When greater than zero, request bodies of at least this many bytes are
+	// gzip compressed, and gzip encoded responses are accepted and
+	// transparently decompressed.
+	CompressionThreshold int64
+
+	// A callback for signing requests, called after the request editors and
+	// compression, once nothing else will change the request.
+	RequestSigner RequestEditorFn
+
+	// When set, requests are sent to the servers of the pool, of which Server
+	// is the first, following the pool's policy.
+	ServerPool *runtime.ServerPool
+
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+
+	// Callbacks called around every call of an operation, for circuit
+	// breakers and the like.
+	BeforeCall BeforeCallFn
+	AfterCall  AfterCallFn
+
+	// The User-Agent header sent with requests, unless they have one as a
+	// parameter or a request editor sets another one.
+	UserAgent string
+
+	// The transport cloned by the transport options, which they share.
+	clonedTransport *http.Transport
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -77,7 +237,8 @@ type ClientOption func(*Client) error
 func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// create a client with sane default values
 	client := Client{
-		Server: server,
+		Server:    server,
+		UserAgent: "Test-Server/1.0.0",
 	}
 	// mutate client and add all optional params
 	for _, o := range opts {
@@ -105,6 +266,85 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration of the client's transport, such
+// as client certificates or root CAs.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.TLSClientConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function returning the proxy of each request, such as
+// http.ProxyURL(proxyURL). By default, the proxy is read from the
+// environment, as with http.ProxyFromEnvironment.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.Proxy = proxy
+		return nil
+	}
+}
+
+// WithTransport allows tuning the client's transport, such as its timeouts
+// and connection pool, with a function called on it.
+func WithTransport(configure func(*http.Transport)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		configure(transport)
+		return nil
+	}
+}
+
+// transport returns the *http.Transport of the client's doer, which transport
+// options configure. The doer and its transport are cloned the first time, from
+// http.DefaultTransport when there's no transport, so that neither a doer set
+// with WithHTTPClient nor the defaults of net/http are changed.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+	httpClient, ok := c.Client.(*http.Client)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the doer to be an *http.Client, not %T", c.Client)
+	}
+	if c.clonedTransport != nil && httpClient.Transport == c.clonedTransport {
+		return c.clonedTransport, nil
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the transport to be an *http.Transport, not %T", transport)
+	}
+	cloned := *httpClient
+	cloned.Transport = httpTransport.Clone()
+	c.Client = &cloned
+	c.clonedTransport = cloned.Transport.(*http.Transport)
+	return c.clonedTransport, nil
+}
+
+// WithUserAgent sets the User-Agent header sent with requests, which is
+// Test-Server/1.0.0 by default.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -114,16 +354,202 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseValidator allows setting up a callback function, which will be
+// called on every response before it is returned. This can be used to check
+// that responses conform to the specification.
+func WithResponseValidator(fn ResponseValidatorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseValidator = fn
+		return nil
+	}
+}
+
+// WithIfNoneMatch returns a RequestEditorFn for making a request conditional
+// on the resource no longer matching the given ETag, in which case the
+// server may respond with 304 Not Modified.
+func WithIfNoneMatch(etag string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	}
+}
+
+// WithIfModifiedSince returns a RequestEditorFn for making a request
+// conditional on the resource having been modified after the given time, in
+// which case the server may respond with 304 Not Modified.
+func WithIfModifiedSince(t time.Time) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+		return nil
+	}
+}
+
+// PropagateCorrelationID is a RequestEditorFn sending the correlation ID of the
+// request context in the X-Request-ID header, generating one when the context has
+// none. Generated servers add the correlation ID of the requests they handle to
+// their context, so that it's passed on to the services they call.
+func PropagateCorrelationID(ctx context.Context, req *http.Request) error {
+	if req.Header.Get("X-Request-ID") != "" {
+		return nil
+	}
+	id := runtime.CorrelationIDFromContext(ctx)
+	if id == "" {
+		id = runtime.NewCorrelationID()
+	}
+	req.Header.Set("X-Request-ID", id)
+	return nil
+}
+
+// WithRequestCompression enables gzip compression of request bodies which are
+// at least minSize bytes long. The client also advertises that it accepts
+// gzip encoded responses, which are decompressed before they're parsed.
+func WithRequestCompression(minSize int64) ClientOption {
+	return func(c *Client) error {
+		if minSize <= 0 {
+			return fmt.Errorf("compression threshold must be positive, got %d", minSize)
+		}
+		c.CompressionThreshold = minSize
+		return nil
+	}
+}
+
+// WithIdempotencyKey returns a RequestEditorFn which sends key as the
+// idempotency key of an operation, in place of the generated one. This allows
+// retrying a call safely with the key of the first attempt.
+func WithIdempotencyKey(key string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		if req.Header.Get("Idempotency-Key") != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		if req.Header.Get("X-Upload-Token") != "" {
+			req.Header.Set("X-Upload-Token", key)
+		}
+		return nil
+	}
+}
+
+// WithServers sets several servers serving the API, of which requests are
+// sent to one following the policy. Whatever the policy, requests are sent to
+// the next server when one is unavailable. Servers given with NewClient are
+// replaced by these.
+func WithServers(policy runtime.ServerPolicy, servers ...string) ClientOption {
+	return func(c *Client) error {
+		pool, err := runtime.NewServerPool(policy, servers...)
+		if err != nil {
+			return err
+		}
+		c.Server = pool.Servers()[0]
+		c.ServerPool = pool
+		return nil
+	}
+}
+
+// WithLogger sets a logger, which receives a record of every call of an
+// operation, with its duration and status code. Credentials are redacted from
+// the recorded URL and headers.
+func WithLogger(logger runtime.OperationLogger) ClientOption {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithCallHooks sets callbacks called before and after every call of an
+// operation, either of which may be nil. This allows plugging in a circuit
+// breaker per operation, which lets the before hook fail calls of the
+// operations it considers unavailable, and learns from the outcome of calls
+// in the after hook.
+func WithCallHooks(before BeforeCallFn, after AfterCallFn) ClientOption {
+	return func(c *Client) error {
+		c.BeforeCall = before
+		c.AfterCall = after
+		return nil
+	}
+}
+
+// WithRecorder replays the responses recorded in the directory, in
+// runtime.RecorderReplay mode, or sends requests with the client's doer and
+// records their responses there, in runtime.RecorderRecord mode, for tests
+// which don't reach the server. Options changing the transport must come
+// before it.
+func WithRecorder(mode runtime.RecorderMode, dir string) ClientOption {
+	return func(c *Client) error {
+		c.Client = runtime.NewRecorder(mode, dir, c.Client)
+		return nil
+	}
+}
+
+// WithRequestSigner sets a callback for signing requests, which is called
+// right before sending them, after any request editor and compression.
+func WithRequestSigner(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestSigner = fn
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAgent request
+	GetAgent(ctx context.Context, params *GetAgentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCached request
+	GetCached(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEvents request
+	GetEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFiles request
+	ListFiles(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadImage request with any body
+	UploadImageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UploadImageWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadReport request with any body
+	UploadReportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UploadReportWithOctetStreamBody(ctx context.Context, body UploadReportOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UploadReportWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReport request
+	GetReport(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReverseBytes request with any body
+	ReverseBytesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReverseBytesWithOctetStreamBody(ctx context.Context, body ReverseBytesOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReverseBytesWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUsers request
+	ListUsers(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUser request
+	CreateUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUser request
+	GetUser(ctx context.Context, teamName string, id int, params *GetUserParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostBoth request with any body
 	PostBothWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostBoth(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	PostBothWithOctetStreamBody(ctx context.Context, body PostBothOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBothWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetBoth request
 	GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostForm request with any body
+	PostFormWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostFormWithFormdataBody(ctx context.Context, body PostFormFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostJson request with any body
 	PostJsonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -132,18 +558,52 @@ type ClientInterface interface {
 	// GetJson request
 	GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostMultipart request with any body
+	PostMultipartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostMultipartWithMultipartBody(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostGallery request with any body
+	PostGalleryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostGalleryWithMultipartBody(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDocument request with any body
+	PostDocumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDocumentWithMultipartBody(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostOther request with any body
 	PostOtherWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	PostOtherWithOctetStreamBody(ctx context.Context, body PostOtherOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostOtherWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetOther request
 	GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostProtobuf request with any body
+	PostProtobufWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostProtobufWithProtobufBody(ctx context.Context, body PostProtobufProtobufRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetJsonWithTrailingSlash request
 	GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostXml request with any body
+	PostXmlWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostXmlWithXMLBody(ctx context.Context, body PostXmlXMLRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostYaml request with any body
+	PostYamlWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostYamlWithYAMLBody(ctx context.Context, body PostYamlYAMLRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostBothWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostBothRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAgent(ctx context.Context, params *GetAgentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAgentRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -151,11 +611,11 @@ func (c *Client) PostBothWithBody(ctx context.Context, contentType string, body
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetAgent", req)
 }
 
-func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostBothRequest(c.Server, body)
+func (c *Client) GetCached(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCachedRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -163,11 +623,11 @@ func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody, req
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetCached", req)
 }
 
-func (c *Client) GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetBothRequest(c.Server)
+func (c *Client) GetEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -175,11 +635,11 @@ func (c *Client) GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetEvents", req)
 }
 
-func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostJsonRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListFiles(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFilesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -187,11 +647,11 @@ func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "ListFiles", req)
 }
 
-func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostJsonRequest(c.Server, body)
+func (c *Client) UploadImageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadImageRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -199,11 +659,34 @@ func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody, req
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "UploadImage", req)
 }
 
-func (c *Client) GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetJsonRequest(c.Server)
+// UploadImageWithBinaryBody sends body without buffering it. When contentLength
+// is negative, the length of the body is unknown and it's sent with chunked
+// transfer encoding, unless it can be determined from the reader. The content
+// type defaults to image/png when empty.
+func (c *Client) UploadImageWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	if contentType == "" {
+		contentType = "image/png"
+	}
+	editors := append(reqEditors[:len(reqEditors):len(reqEditors)], func(ctx context.Context, req *http.Request) error {
+		switch {
+		case contentLength == 0:
+			// A zero ContentLength with a body means an unknown length.
+			req.Body = http.NoBody
+			req.GetBody = func() (io.ReadCloser, error) { return http.NoBody, nil }
+			req.ContentLength = 0
+		case contentLength > 0:
+			req.ContentLength = contentLength
+		}
+		return nil
+	})
+	return c.UploadImageWithBody(ctx, contentType, body, editors...)
+}
+
+func (c *Client) UploadReportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadReportRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -211,11 +694,11 @@ func (c *Client) GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "UploadReport", req)
 }
 
-func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostOtherRequestWithBody(c.Server, contentType, body)
+func (c *Client) UploadReportWithOctetStreamBody(ctx context.Context, body UploadReportOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadReportRequestWithOctetStreamBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -223,11 +706,34 @@ func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "UploadReport", req)
 }
 
-func (c *Client) GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOtherRequest(c.Server)
+// UploadReportWithBinaryBody sends body without buffering it. When contentLength
+// is negative, the length of the body is unknown and it's sent with chunked
+// transfer encoding, unless it can be determined from the reader. The content
+// type defaults to application/octet-stream when empty.
+func (c *Client) UploadReportWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	editors := append(reqEditors[:len(reqEditors):len(reqEditors)], func(ctx context.Context, req *http.Request) error {
+		switch {
+		case contentLength == 0:
+			// A zero ContentLength with a body means an unknown length.
+			req.Body = http.NoBody
+			req.GetBody = func() (io.ReadCloser, error) { return http.NoBody, nil }
+			req.ContentLength = 0
+		case contentLength > 0:
+			req.ContentLength = contentLength
+		}
+		return nil
+	})
+	return c.UploadReportWithBody(ctx, contentType, body, editors...)
+}
+
+func (c *Client) GetReport(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReportRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -235,11 +741,11 @@ func (c *Client) GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetReport", req)
 }
 
-func (c *Client) GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetJsonWithTrailingSlashRequest(c.Server)
+func (c *Client) ReverseBytesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReverseBytesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -247,145 +753,431 @@ func (c *Client) GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...Req
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "ReverseBytes", req)
 }
 
-// NewPostBothRequest calls the generic PostBoth builder with application/json body
-func NewPostBothRequest(server string, body PostBothJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ReverseBytesWithOctetStreamBody(ctx context.Context, body ReverseBytesOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReverseBytesRequestWithOctetStreamBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostBothRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewPostBothRequestWithBody generates requests for PostBoth with any type of body
-func NewPostBothRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.do(ctx, "ReverseBytes", req)
+}
 
-	operationPath := fmt.Sprintf("/with_both_bodies")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// ReverseBytesWithBinaryBody sends body without buffering it. When contentLength
+// is negative, the length of the body is unknown and it's sent with chunked
+// transfer encoding, unless it can be determined from the reader. The content
+// type defaults to application/octet-stream when empty.
+func (c *Client) ReverseBytesWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
 	}
+	editors := append(reqEditors[:len(reqEditors):len(reqEditors)], func(ctx context.Context, req *http.Request) error {
+		switch {
+		case contentLength == 0:
+			// A zero ContentLength with a body means an unknown length.
+			req.Body = http.NoBody
+			req.GetBody = func() (io.ReadCloser, error) { return http.NoBody, nil }
+			req.ContentLength = 0
+		case contentLength > 0:
+			req.ContentLength = contentLength
+		}
+		return nil
+	})
+	return c.ReverseBytesWithBody(ctx, contentType, body, editors...)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListUsers(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUsersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.do(ctx, "ListUsers", req)
 }
 
-// NewGetBothRequest generates requests for GetBoth
-func NewGetBothRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/with_both_responses")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.do(ctx, "CreateUser", req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetUser(ctx context.Context, teamName string, id int, params *GetUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server, teamName, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.do(ctx, "GetUser", req)
 }
 
-// NewPostJsonRequest calls the generic PostJson builder with application/json body
-func NewPostJsonRequest(server string, body PostJsonJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostBothWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBothRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostJsonRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostBoth", req)
 }
 
-// NewPostJsonRequestWithBody generates requests for PostJson with any type of body
-func NewPostJsonRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) PostBoth(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBothRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostBoth", req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostBothWithOctetStreamBody(ctx context.Context, body PostBothOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBothRequestWithOctetStreamBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostBoth", req)
+}
 
-	operationPath := fmt.Sprintf("/with_json_body")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// PostBothWithBinaryBody sends body without buffering it. When contentLength
+// is negative, the length of the body is unknown and it's sent with chunked
+// transfer encoding, unless it can be determined from the reader. The content
+// type defaults to application/octet-stream when empty.
+func (c *Client) PostBothWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
 	}
+	editors := append(reqEditors[:len(reqEditors):len(reqEditors)], func(ctx context.Context, req *http.Request) error {
+		switch {
+		case contentLength == 0:
+			// A zero ContentLength with a body means an unknown length.
+			req.Body = http.NoBody
+			req.GetBody = func() (io.ReadCloser, error) { return http.NoBody, nil }
+			req.ContentLength = 0
+		case contentLength > 0:
+			req.ContentLength = contentLength
+		}
+		return nil
+	})
+	return c.PostBothWithBody(ctx, contentType, body, editors...)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetBoth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBothRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "GetBoth", req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostFormWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFormRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostForm", req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) PostFormWithFormdataBody(ctx context.Context, body PostFormFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFormRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostForm", req)
+}
 
-	return req, nil
+func (c *Client) PostJsonWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostJsonRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostJson", req)
 }
 
-// NewGetJsonRequest generates requests for GetJson
-func NewGetJsonRequest(server string) (*http.Request, error) {
-	var err error
+func (c *Client) PostJson(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostJsonRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostJson", req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJsonRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "GetJson", req)
+}
 
-	operationPath := fmt.Sprintf("/with_json_response")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PostMultipartWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostMultipartRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.do(ctx, "PostMultipart", req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// PostMultipartWithMultipartBody sends a multipart/form-data body, streaming its
+// parts rather than buffering them.
+func (c *Client) PostMultipartWithMultipartBody(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	contentType, bodyReader := body.ReaderContext(ctx)
+	rsp, err := c.PostMultipartWithBody(ctx, contentType, bodyReader, reqEditors...)
+	if err != nil {
+		// The body may not have been read, in which case closing it closes
+		// its files.
+		bodyReader.Close()
+	}
+	return rsp, err
+}
+
+func (c *Client) PostGalleryWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostGalleryRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostGallery", req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// PostGalleryWithMultipartBody sends a multipart/form-data body, streaming its
+// parts rather than buffering them.
+func (c *Client) PostGalleryWithMultipartBody(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	contentType, bodyReader := body.ReaderContext(ctx)
+	rsp, err := c.PostGalleryWithBody(ctx, contentType, bodyReader, reqEditors...)
+	if err != nil {
+		// The body may not have been read, in which case closing it closes
+		// its files.
+		bodyReader.Close()
+	}
+	return rsp, err
+}
+
+func (c *Client) PostDocumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDocumentRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostDocument", req)
+}
 
-	return req, nil
+// PostDocumentWithMultipartBody sends a multipart/form-data body, streaming its
+// parts rather than buffering them.
+func (c *Client) PostDocumentWithMultipartBody(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	contentType, bodyReader := body.ReaderContext(ctx)
+	rsp, err := c.PostDocumentWithBody(ctx, contentType, bodyReader, reqEditors...)
+	if err != nil {
+		// The body may not have been read, in which case closing it closes
+		// its files.
+		bodyReader.Close()
+	}
+	return rsp, err
 }
 
-// NewPostOtherRequestWithBody generates requests for PostOther with any type of body
-func NewPostOtherRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+func (c *Client) PostOtherWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOtherRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostOther", req)
+}
+
+func (c *Client) PostOtherWithOctetStreamBody(ctx context.Context, body PostOtherOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostOtherRequestWithOctetStreamBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostOther", req)
+}
+
+// PostOtherWithBinaryBody sends body without buffering it. When contentLength
+// is negative, the length of the body is unknown and it's sent with chunked
+// transfer encoding, unless it can be determined from the reader. The content
+// type defaults to application/octet-stream when empty.
+func (c *Client) PostOtherWithBinaryBody(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	editors := append(reqEditors[:len(reqEditors):len(reqEditors)], func(ctx context.Context, req *http.Request) error {
+		switch {
+		case contentLength == 0:
+			// A zero ContentLength with a body means an unknown length.
+			req.Body = http.NoBody
+			req.GetBody = func() (io.ReadCloser, error) { return http.NoBody, nil }
+			req.ContentLength = 0
+		case contentLength > 0:
+			req.ContentLength = contentLength
+		}
+		return nil
+	})
+	return c.PostOtherWithBody(ctx, contentType, body, editors...)
+}
+
+func (c *Client) GetOther(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOtherRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "GetOther", req)
+}
+
+func (c *Client) PostProtobufWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProtobufRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostProtobuf", req)
+}
+
+func (c *Client) PostProtobufWithProtobufBody(ctx context.Context, body PostProtobufProtobufRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProtobufRequestWithProtobufBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostProtobuf", req)
+}
+
+func (c *Client) GetJsonWithTrailingSlash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJsonWithTrailingSlashRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "GetJsonWithTrailingSlash", req)
+}
+
+func (c *Client) PostXmlWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostXmlRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostXml", req)
+}
+
+func (c *Client) PostXmlWithXMLBody(ctx context.Context, body PostXmlXMLRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostXmlRequestWithXMLBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostXml", req)
+}
+
+func (c *Client) PostYamlWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostYamlRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostYaml", req)
+}
+
+func (c *Client) PostYamlWithYAMLBody(ctx context.Context, body PostYamlYAMLRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostYamlRequestWithYAMLBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "PostYaml", req)
+}
+
+// NewGetAgentRequest generates requests for GetAgent
+func NewGetAgentRequest(server string, params *GetAgentParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -393,8 +1185,8 @@ func NewPostOtherRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/with_other_body")
-	if operationPath[0] == '/' {
+	operationPath := fmt.Sprintf("/agent")
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -403,18 +1195,27 @@ func NewPostOtherRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	if params.UserAgent != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, *params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", headerParam0)
+	}
 
 	return req, nil
 }
 
-// NewGetOtherRequest generates requests for GetOther
-func NewGetOtherRequest(server string) (*http.Request, error) {
+// NewGetCachedRequest generates requests for GetCached
+func NewGetCachedRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -422,8 +1223,8 @@ func NewGetOtherRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/with_other_response")
-	if operationPath[0] == '/' {
+	operationPath := fmt.Sprintf("/cached")
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -440,8 +1241,8 @@ func NewGetOtherRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetJsonWithTrailingSlashRequest generates requests for GetJsonWithTrailingSlash
-func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
+// NewGetEventsRequest generates requests for GetEvents
+func NewGetEventsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -449,8 +1250,8 @@ func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/with_trailing_slash/")
-	if operationPath[0] == '/' {
+	operationPath := fmt.Sprintf("/events")
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -467,441 +1268,3676 @@ func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewListFilesRequest generates requests for ListFiles
+func NewListFilesRequest(server string, params *ListFilesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/files")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
-
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostBoth request with any body
-	PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
-
-	PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
-
-	// GetBoth request
-	GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error)
-
-	// PostJson request with any body
-	PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error)
-
-	PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error)
 
-	// GetJson request
-	GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error)
+	queryValues := queryURL.Query()
+	var rawQueries []string
 
-	// PostOther request with any body
-	PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error)
+	if queryFrag, err := runtime.StyleParamAllowReserved("form", true, "path", params.Path); err != nil {
+		return nil, err
+	} else if queryFrag != "" {
+		rawQueries = append(rawQueries, queryFrag)
+	}
 
-	// GetOther request
-	GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error)
+	if params.Tags != nil {
 
-	// GetJsonWithTrailingSlash request
-	GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error)
-}
+		if queryFrag, err := runtime.StyleParamAllowReserved("form", false, "tags", *params.Tags); err != nil {
+			return nil, err
+		} else if queryFrag != "" {
+			rawQueries = append(rawQueries, queryFrag)
+		}
 
-type PostBothResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostBothResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL.RawQuery = queryValues.Encode()
+	// deepObject parameters, which keep their brackets, and parameters
+	// allowing reserved characters are already escaped.
+	for _, rawQuery := range rawQueries {
+		if queryURL.RawQuery != "" {
+			queryURL.RawQuery += "&"
+		}
+		queryURL.RawQuery += rawQuery
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostBothResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetBothResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetBothResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewUploadImageRequestWithBody generates requests for UploadImage with any type of body
+func NewUploadImageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBothResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostJsonResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/images")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostJsonResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostJsonResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetJsonResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetJsonResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJsonResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+// NewUploadReportRequestWithOctetStreamBody calls the generic UploadReport builder with application/octet-stream body
+func NewUploadReportRequestWithOctetStreamBody(server string, body UploadReportOctetStreamRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = bytes.NewReader(body)
+	return NewUploadReportRequestWithBody(server, "application/octet-stream", bodyReader)
 }
 
-type PostOtherResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// NewUploadReportRequestWithBody generates requests for UploadReport with any type of body
+func NewUploadReportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostOtherResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostOtherResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/reports")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type GetOtherResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
 
-// Status returns HTTPResponse.Status
-func (r GetOtherResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOtherResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetJsonWithTrailingSlashResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetJsonWithTrailingSlashResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	req.Header.Set("X-Upload-Token", uuid.New().String())
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetJsonWithTrailingSlashResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewGetReportRequest generates requests for GetReport
+func NewGetReportRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-// PostBothWithBodyWithResponse request with arbitrary body returning *PostBothResponse
-func (c *ClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
-	rsp, err := c.PostBothWithBody(ctx, contentType, body, reqEditors...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostBothResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
-	rsp, err := c.PostBoth(ctx, body, reqEditors...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostBothResponse(rsp)
-}
 
-// GetBothWithResponse request returning *GetBothResponse
-func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error) {
-	rsp, err := c.GetBoth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	operationPath := fmt.Sprintf("/reports/%s", pathParam0)
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
 	}
-	return ParseGetBothResponse(rsp)
-}
 
-// PostJsonWithBodyWithResponse request with arbitrary body returning *PostJsonResponse
-func (c *ClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
-	rsp, err := c.PostJsonWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostJsonResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
-	rsp, err := c.PostJson(ctx, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostJsonResponse(rsp)
+
+	return req, nil
 }
 
-// GetJsonWithResponse request returning *GetJsonResponse
-func (c *ClientWithResponses) GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error) {
-	rsp, err := c.GetJson(ctx, reqEditors...)
+// NewReverseBytesRequestWithOctetStreamBody calls the generic ReverseBytes builder with application/octet-stream body
+func NewReverseBytesRequestWithOctetStreamBody(server string, body ReverseBytesOctetStreamRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = bytes.NewReader(body)
+	return NewReverseBytesRequestWithBody(server, "application/octet-stream", bodyReader)
+}
+
+// NewReverseBytesRequestWithBody generates requests for ReverseBytes with any type of body
+func NewReverseBytesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJsonResponse(rsp)
-}
 
-// PostOtherWithBodyWithResponse request with arbitrary body returning *PostOtherResponse
-func (c *ClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
-	rsp, err := c.PostOtherWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/reverse")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostOtherResponse(rsp)
-}
 
-// GetOtherWithResponse request returning *GetOtherResponse
-func (c *ClientWithResponses) GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error) {
-	rsp, err := c.GetOther(ctx, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListUsersRequest generates requests for ListUsers
+func NewListUsersRequest(server string, params *ListUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Team != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team", runtime.ParamLocationQuery, *params.Team); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Page != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserRequest generates requests for CreateUser
+func NewCreateUserRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Idempotency-Key", uuid.New().String())
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string, teamName string, id int, params *GetUserParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "teamName", runtime.ParamLocationPath, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/%s", pathParam0, pathParam1)
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Verbose != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "verbose", runtime.ParamLocationQuery, *params.Verbose); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostBothRequest calls the generic PostBoth builder with application/json body
+func NewPostBothRequest(server string, body PostBothJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBothRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostBothRequestWithOctetStreamBody calls the generic PostBoth builder with application/octet-stream body
+func NewPostBothRequestWithOctetStreamBody(server string, body PostBothOctetStreamRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = bytes.NewReader(body)
+	return NewPostBothRequestWithBody(server, "application/octet-stream", bodyReader)
+}
+
+// NewPostBothRequestWithBody generates requests for PostBoth with any type of body
+func NewPostBothRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_both_bodies")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBothRequest generates requests for GetBoth
+func NewGetBothRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_both_responses")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFormRequestWithFormdataBody calls the generic PostForm builder with application/x-www-form-urlencoded body
+func NewPostFormRequestWithFormdataBody(server string, body PostFormFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	form, err := runtime.MarshalForm(body, map[string]runtime.FormEncoding{"scores": {ContentType: "", Style: "pipeDelimited", Explode: false}})
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(form.Encode())
+	return NewPostFormRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostFormRequestWithBody generates requests for PostForm with any type of body
+func NewPostFormRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_form_body")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostJsonRequest calls the generic PostJson builder with application/json body
+func NewPostJsonRequest(server string, body PostJsonJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostJsonRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostJsonRequestWithBody generates requests for PostJson with any type of body
+func NewPostJsonRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_json_body")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetJsonRequest generates requests for GetJson
+func NewGetJsonRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_json_response")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostMultipartMultipartBody builds the multipart/form-data body of PostMultipart
+// for PostMultipartWithMultipartBody, sending its files as file parts.
+func NewPostMultipartMultipartBody(body PostMultipartMultipartRequestBody) (*runtime.MultipartBody, error) {
+	return runtime.MarshalMultipart(body)
+}
+
+// NewPostMultipartRequestWithBody generates requests for PostMultipart with any type of body
+func NewPostMultipartRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_multipart_body")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostGalleryMultipartBody builds the multipart/form-data body of PostGallery
+// for PostGalleryWithMultipartBody, sending its files as file parts.
+func NewPostGalleryMultipartBody(body PostGalleryMultipartRequestBody) (*runtime.MultipartBody, error) {
+	return runtime.MarshalMultipartWithEncoding(body, PostGalleryMultipartEncodings)
+}
+
+// NewPostGalleryRequestWithBody generates requests for PostGallery with any type of body
+func NewPostGalleryRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_multipart_encoding")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostDocumentMultipartBody builds the multipart/form-data body of PostDocument
+// for PostDocumentWithMultipartBody, sending its files as file parts.
+func NewPostDocumentMultipartBody(body PostDocumentMultipartRequestBody) (*runtime.MultipartBody, error) {
+	return runtime.MarshalMultipart(body)
+}
+
+// NewPostDocumentRequestWithBody generates requests for PostDocument with any type of body
+func NewPostDocumentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_multipart_ref")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostOtherRequestWithOctetStreamBody calls the generic PostOther builder with application/octet-stream body
+func NewPostOtherRequestWithOctetStreamBody(server string, body PostOtherOctetStreamRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = bytes.NewReader(body)
+	return NewPostOtherRequestWithBody(server, "application/octet-stream", bodyReader)
+}
+
+// NewPostOtherRequestWithBody generates requests for PostOther with any type of body
+func NewPostOtherRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_other_body")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOtherRequest generates requests for GetOther
+func NewGetOtherRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_other_response")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostProtobufRequestWithProtobufBody calls the generic PostProtobuf builder with application/x-protobuf body
+func NewPostProtobufRequestWithProtobufBody(server string, body PostProtobufProtobufRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := proto.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostProtobufRequestWithBody(server, "application/x-protobuf", bodyReader)
+}
+
+// NewPostProtobufRequestWithBody generates requests for PostProtobuf with any type of body
+func NewPostProtobufRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_protobuf_body")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetJsonWithTrailingSlashRequest generates requests for GetJsonWithTrailingSlash
+func NewGetJsonWithTrailingSlashRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_trailing_slash/")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostXmlRequestWithXMLBody calls the generic PostXml builder with application/xml body
+func NewPostXmlRequestWithXMLBody(server string, body PostXmlXMLRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := runtime.MarshalXML(body, "SchemaObject")
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostXmlRequestWithBody(server, "application/xml", bodyReader)
+}
+
+// NewPostXmlRequestWithBody generates requests for PostXml with any type of body
+func NewPostXmlRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_xml_body")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostYamlRequestWithYAMLBody calls the generic PostYaml builder with application/yaml body
+func NewPostYamlRequestWithYAMLBody(server string, body PostYamlYAMLRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := yaml.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostYamlRequestWithBody(server, "application/yaml", bodyReader)
+}
+
+// NewPostYamlRequestWithBody generates requests for PostYaml with any type of body
+func NewPostYamlRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/with_yaml_body")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// do sends the request of an operation, calling the call hooks around it and
+// logging the call when the client has been configured to.
+func (c *Client) do(ctx context.Context, operationID string, req *http.Request) (rsp *http.Response, err error) {
+	// Doers such as runtime.Recorder find the operation in the request context.
+	req = req.WithContext(runtime.ContextWithOperationID(req.Context(), operationID))
+	if c.Logger != nil {
+		start := time.Now()
+		defer func() {
+			c.Logger.LogOperation(ctx, runtime.NewClientOperationRecord(operationID, req, rsp, time.Since(start), err))
+		}()
+	}
+	hookCtx := ctx
+	if c.BeforeCall != nil {
+		if hookCtx, err = c.BeforeCall(ctx, operationID); err != nil {
+			return nil, err
+		}
+	}
+	rsp, err = c.send(ctx, req)
+	if c.AfterCall != nil {
+		c.AfterCall(hookCtx, operationID, rsp, err)
+	}
+	return rsp, err
+}
+
+// send sends the request, compressing it, signing it, failing over to other
+// servers and validating the response when the client has been configured to.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GzipRequestBody(req, c.CompressionThreshold); err != nil {
+			return nil, err
+		}
+		if req.Header.Get("Accept-Encoding") == "" {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+	}
+	transmit := func(req *http.Request) (*http.Response, error) {
+		if c.RequestSigner != nil {
+			if err := c.RequestSigner(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+		return c.Client.Do(req)
+	}
+	var rsp *http.Response
+	var err error
+	if c.ServerPool != nil {
+		rsp, err = c.ServerPool.Do(req, transmit)
+	} else {
+		rsp, err = transmit(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GunzipResponseBody(rsp); err != nil {
+			return nil, err
+		}
+	}
+	if c.ResponseValidator != nil {
+		if err := c.ResponseValidator(ctx, req, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// StreamResponse gives unbuffered access to a response, for large downloads
+// and long-poll endpoints. The caller is responsible for closing Body.
+type StreamResponse struct {
+	Body         io.ReadCloser
+	Header       http.Header
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// newStreamResponse wraps an HTTP response without reading its body.
+func newStreamResponse(rsp *http.Response) *StreamResponse {
+	return &StreamResponse{
+		Body:         rsp.Body,
+		Header:       rsp.Header,
+		HTTPResponse: rsp,
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAgent request
+	GetAgentWithResponse(ctx context.Context, params *GetAgentParams, reqEditors ...RequestEditorFn) (*GetAgentResponse, error)
+	GetAgentWithBodyStream(ctx context.Context, params *GetAgentParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	GetAgentBulkWithResponse(ctx context.Context, params []GetAgentParams, concurrency int, reqEditors ...RequestEditorFn) ([]*GetAgentResponse, error)
+
+	// GetCached request
+	GetCachedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCachedResponse, error)
+	GetCachedWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// GetEvents request
+	GetEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsResponse, error)
+	GetEventsWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	GetEventsWithEventStream(ctx context.Context, reqEditors ...RequestEditorFn) (*runtime.EventStream, error)
+
+	// ListFiles request
+	ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error)
+	ListFilesWithBodyStream(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	ListFilesBulkWithResponse(ctx context.Context, params []ListFilesParams, concurrency int, reqEditors ...RequestEditorFn) ([]*ListFilesResponse, error)
+
+	// UploadImage request with any body
+	UploadImageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadImageResponse, error)
+	UploadImageWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	UploadImageWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*UploadImageResponse, error)
+
+	// UploadReport request with any body
+	UploadReportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadReportResponse, error)
+	UploadReportWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	UploadReportWithOctetStreamBodyWithResponse(ctx context.Context, body UploadReportOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*UploadReportResponse, error)
+	UploadReportWithOctetStreamBodyWithBodyStream(ctx context.Context, body UploadReportOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	UploadReportWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*UploadReportResponse, error)
+
+	// GetReport request
+	GetReportWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetReportResponse, error)
+	GetReportWithBodyStream(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	DownloadGetReport(ctx context.Context, id string, w io.Writer, reqEditors ...RequestEditorFn) (*DownloadGetReportResponse, error)
+
+	// ReverseBytes request with any body
+	ReverseBytesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReverseBytesResponse, error)
+	ReverseBytesWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	ReverseBytesWithOctetStreamBodyWithResponse(ctx context.Context, body ReverseBytesOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*ReverseBytesResponse, error)
+	ReverseBytesWithOctetStreamBodyWithBodyStream(ctx context.Context, body ReverseBytesOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	ReverseBytesWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*ReverseBytesResponse, error)
+
+	// ListUsers request
+	ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error)
+	ListUsersWithBodyStream(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	ListUsersBulkWithResponse(ctx context.Context, params []ListUsersParams, concurrency int, reqEditors ...RequestEditorFn) ([]*ListUsersResponse, error)
+
+	// CreateUser request
+	CreateUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateUserResponse, error)
+	CreateUserWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// GetUser request
+	GetUserWithResponse(ctx context.Context, teamName string, id int, params *GetUserParams, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+	GetUserWithBodyStream(ctx context.Context, teamName string, id int, params *GetUserParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// PostBoth request with any body
+	PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
+	PostBothWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
+	PostBothWithBodyStream(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostBothWithOctetStreamBodyWithResponse(ctx context.Context, body PostBothOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
+	PostBothWithOctetStreamBodyWithBodyStream(ctx context.Context, body PostBothOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostBothWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*PostBothResponse, error)
+
+	// GetBoth request
+	GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error)
+	GetBothWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// PostForm request with any body
+	PostFormWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFormResponse, error)
+	PostFormWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostFormWithFormdataBodyWithResponse(ctx context.Context, body PostFormFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostFormResponse, error)
+	PostFormWithFormdataBodyWithBodyStream(ctx context.Context, body PostFormFormdataRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// PostJson request with any body
+	PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error)
+	PostJsonWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error)
+	PostJsonWithBodyStream(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// GetJson request
+	GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error)
+	GetJsonWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// PostMultipart request with any body
+	PostMultipartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMultipartResponse, error)
+	PostMultipartWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostMultipartWithMultipartBodyWithResponse(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*PostMultipartResponse, error)
+
+	// PostGallery request with any body
+	PostGalleryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGalleryResponse, error)
+	PostGalleryWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostGalleryWithMultipartBodyWithResponse(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*PostGalleryResponse, error)
+
+	// PostDocument request with any body
+	PostDocumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDocumentResponse, error)
+	PostDocumentWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostDocumentWithMultipartBodyWithResponse(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*PostDocumentResponse, error)
+
+	// PostOther request with any body
+	PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error)
+	PostOtherWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostOtherWithOctetStreamBodyWithResponse(ctx context.Context, body PostOtherOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*PostOtherResponse, error)
+	PostOtherWithOctetStreamBodyWithBodyStream(ctx context.Context, body PostOtherOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostOtherWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*PostOtherResponse, error)
+
+	// GetOther request
+	GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error)
+	GetOtherWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// PostProtobuf request with any body
+	PostProtobufWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProtobufResponse, error)
+	PostProtobufWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostProtobufWithProtobufBodyWithResponse(ctx context.Context, body PostProtobufProtobufRequestBody, reqEditors ...RequestEditorFn) (*PostProtobufResponse, error)
+	PostProtobufWithProtobufBodyWithBodyStream(ctx context.Context, body PostProtobufProtobufRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// GetJsonWithTrailingSlash request
+	GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error)
+	GetJsonWithTrailingSlashWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// PostXml request with any body
+	PostXmlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostXmlResponse, error)
+	PostXmlWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostXmlWithXMLBodyWithResponse(ctx context.Context, body PostXmlXMLRequestBody, reqEditors ...RequestEditorFn) (*PostXmlResponse, error)
+	PostXmlWithXMLBodyWithBodyStream(ctx context.Context, body PostXmlXMLRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// PostYaml request with any body
+	PostYamlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostYamlResponse, error)
+	PostYamlWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	PostYamlWithYAMLBodyWithResponse(ctx context.Context, body PostYamlYAMLRequestBody, reqEditors ...RequestEditorFn) (*PostYamlResponse, error)
+	PostYamlWithYAMLBodyWithBodyStream(ctx context.Context, body PostYamlYAMLRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+}
+
+type GetAgentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAgentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAgentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetAgentResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type GetCachedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SchemaObject
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCachedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCachedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// NotModified returns whether the server responded with 304 Not Modified to
+// a conditional request, in which case the cached copy may be used.
+func (r GetCachedResponse) NotModified() bool {
+	return r.StatusCode() == http.StatusNotModified
+}
+
+// ETag returns the ETag header of the response, for use with WithIfNoneMatch.
+func (r GetCachedResponse) ETag() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Header.Get("ETag")
+	}
+	return ""
+}
+
+// LastModified returns the Last-Modified header of the response, for use with
+// WithIfModifiedSince, and whether it was present and valid.
+func (r GetCachedResponse) LastModified() (time.Time, bool) {
+	if r.HTTPResponse == nil {
+		return time.Time{}, false
+	}
+	t, err := http.ParseTime(r.HTTPResponse.Header.Get("Last-Modified"))
+	return t, err == nil
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetCachedResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type GetEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetEventsResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type ListFilesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFilesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFilesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r ListFilesResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type UploadImageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadImageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadImageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r UploadImageResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type UploadReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r UploadReportResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+// IdempotencyKey returns the idempotency key the request was sent with.
+func (r UploadReportResponse) IdempotencyKey() string {
+	if r.HTTPResponse == nil || r.HTTPResponse.Request == nil {
+		return ""
+	}
+	return r.HTTPResponse.Request.Header.Get("X-Upload-Token")
+}
+
+type GetReportResponse struct {
+	Body           []byte
+	HTTPResponse   *http.Response
+	OctetStream200 *[]byte
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetReportResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type ReverseBytesResponse struct {
+	Body           []byte
+	HTTPResponse   *http.Response
+	OctetStream200 *[]byte
+}
+
+// Status returns HTTPResponse.Status
+func (r ReverseBytesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReverseBytesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r ReverseBytesResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type ListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r ListUsersResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type CreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r CreateUserResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+// IdempotencyKey returns the idempotency key the request was sent with.
+func (r CreateUserResponse) IdempotencyKey() string {
+	if r.HTTPResponse == nil || r.HTTPResponse.Request == nil {
+		return ""
+	}
+	return r.HTTPResponse.Request.Header.Get("Idempotency-Key")
+}
+
+// FollowGetUser follows the GetUser link of the 201 response, calling
+// GetUser with parameters taken from this response.
+func (r CreateUserResponse) FollowGetUser(ctx context.Context, client ClientWithResponsesInterface, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	if r.HTTPResponse == nil {
+		return nil, errors.New("can't follow the GetUser link without a response")
+	}
+	var teamName string
+	if err := runtime.BindLinkParameter("$response.header.X-Team", r.HTTPResponse, r.Body, &teamName); err != nil {
+		return nil, fmt.Errorf("error binding link parameter 'teamName': %w", err)
+	}
+	var id int
+	if err := runtime.BindLinkParameter("$response.body#/id", r.HTTPResponse, r.Body, &id); err != nil {
+		return nil, fmt.Errorf("error binding link parameter 'id': %w", err)
+	}
+	var params GetUserParams
+	if err := runtime.BindLinkParameter("true", r.HTTPResponse, r.Body, &params.Verbose); err != nil {
+		return nil, fmt.Errorf("error binding link parameter 'verbose': %w", err)
+	}
+	return client.GetUserWithResponse(ctx, teamName, id, &params, reqEditors...)
+}
+
+// FollowGetUserByRef follows the GetUserByRef link of the 201 response, calling
+// GetUser with parameters taken from this response.
+func (r CreateUserResponse) FollowGetUserByRef(ctx context.Context, client ClientWithResponsesInterface, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	if r.HTTPResponse == nil {
+		return nil, errors.New("can't follow the GetUserByRef link without a response")
+	}
+	var teamName string
+	if err := runtime.BindLinkParameter("$response.header.X-Team", r.HTTPResponse, r.Body, &teamName); err != nil {
+		return nil, fmt.Errorf("error binding link parameter 'teamName': %w", err)
+	}
+	var id int
+	if err := runtime.BindLinkParameter("$response.body#/id", r.HTTPResponse, r.Body, &id); err != nil {
+		return nil, fmt.Errorf("error binding link parameter 'id': %w", err)
+	}
+	var params GetUserParams
+	return client.GetUserWithResponse(ctx, teamName, id, &params, reqEditors...)
+}
+
+type GetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetUserResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostBothResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBothResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBothResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostBothResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type GetBothResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBothResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBothResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetBothResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostFormResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFormResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFormResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostFormResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostJsonResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type GetJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetJsonResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostMultipartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostMultipartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostMultipartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostMultipartResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostGalleryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostGalleryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostGalleryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostGalleryResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostDocumentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostDocumentResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostOtherResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostOtherResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostOtherResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostOtherResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type GetOtherResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOtherResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOtherResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetOtherResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostProtobufResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	Protobuf200  *wrapperspb.StringValue
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProtobufResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProtobufResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostProtobufResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type GetJsonWithTrailingSlashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJsonWithTrailingSlashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJsonWithTrailingSlashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetJsonWithTrailingSlashResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostXmlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	XML200       *SchemaObject
+}
+
+// Status returns HTTPResponse.Status
+func (r PostXmlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostXmlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostXmlResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type PostYamlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *SchemaObject
+}
+
+// Status returns HTTPResponse.Status
+func (r PostYamlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostYamlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r PostYamlResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+// GetAgentWithResponse request returning *GetAgentResponse
+func (c *ClientWithResponses) GetAgentWithResponse(ctx context.Context, params *GetAgentParams, reqEditors ...RequestEditorFn) (*GetAgentResponse, error) {
+	rsp, err := c.GetAgent(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAgentResponse(rsp)
+}
+
+// GetAgentWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetAgentWithBodyStream(ctx context.Context, params *GetAgentParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetAgent(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// GetAgentBulkWithResponse calls GetAgentWithResponse with each of the parameter
+// sets, at most concurrency at once, and returns the responses in the same order.
+// The first failing call cancels the others, and its error is returned.
+func (c *ClientWithResponses) GetAgentBulkWithResponse(ctx context.Context, params []GetAgentParams, concurrency int, reqEditors ...RequestEditorFn) ([]*GetAgentResponse, error) {
+	responses := make([]*GetAgentResponse, len(params))
+	err := runtime.Bulk(ctx, len(params), concurrency, func(ctx context.Context, i int) error {
+		rsp, err := c.GetAgentWithResponse(ctx, &params[i], reqEditors...)
+		if err != nil {
+			return err
+		}
+		responses[i] = rsp
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// GetCachedWithResponse request returning *GetCachedResponse
+func (c *ClientWithResponses) GetCachedWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCachedResponse, error) {
+	rsp, err := c.GetCached(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCachedResponse(rsp)
+}
+
+// GetCachedWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetCachedWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetCached(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// GetEventsWithResponse request returning *GetEventsResponse
+func (c *ClientWithResponses) GetEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetEventsResponse, error) {
+	rsp, err := c.GetEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsResponse(rsp)
+}
+
+// GetEventsWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetEventsWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// GetEventsWithEventStream request returning a stream of server-sent events,
+// which reconnects with the Last-Event-ID header when the connection is lost
+func (c *ClientWithResponses) GetEventsWithEventStream(ctx context.Context, reqEditors ...RequestEditorFn) (*runtime.EventStream, error) {
+	return runtime.NewEventStream(ctx, func(ctx context.Context, lastEventID string) (*http.Response, error) {
+		editors := append(reqEditors[:len(reqEditors):len(reqEditors)], func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Accept", "text/event-stream")
+			if lastEventID != "" {
+				req.Header.Set("Last-Event-ID", lastEventID)
+			}
+			return nil
+		})
+		return c.GetEvents(ctx, editors...)
+	})
+}
+
+// ListFilesWithResponse request returning *ListFilesResponse
+func (c *ClientWithResponses) ListFilesWithResponse(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*ListFilesResponse, error) {
+	rsp, err := c.ListFiles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFilesResponse(rsp)
+}
+
+// ListFilesWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) ListFilesWithBodyStream(ctx context.Context, params *ListFilesParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.ListFiles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ListFilesBulkWithResponse calls ListFilesWithResponse with each of the parameter
+// sets, at most concurrency at once, and returns the responses in the same order.
+// The first failing call cancels the others, and its error is returned.
+func (c *ClientWithResponses) ListFilesBulkWithResponse(ctx context.Context, params []ListFilesParams, concurrency int, reqEditors ...RequestEditorFn) ([]*ListFilesResponse, error) {
+	responses := make([]*ListFilesResponse, len(params))
+	err := runtime.Bulk(ctx, len(params), concurrency, func(ctx context.Context, i int) error {
+		rsp, err := c.ListFilesWithResponse(ctx, &params[i], reqEditors...)
+		if err != nil {
+			return err
+		}
+		responses[i] = rsp
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// UploadImageWithBodyWithResponse request with arbitrary body returning *UploadImageResponse
+func (c *ClientWithResponses) UploadImageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadImageResponse, error) {
+	rsp, err := c.UploadImageWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadImageResponse(rsp)
+}
+
+// UploadImageWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) UploadImageWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.UploadImageWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// UploadImageWithBinaryBodyWithResponse request with an unbuffered binary body returning *UploadImageResponse
+func (c *ClientWithResponses) UploadImageWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*UploadImageResponse, error) {
+	rsp, err := c.UploadImageWithBinaryBody(ctx, contentType, body, contentLength, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadImageResponse(rsp)
+}
+
+// UploadReportWithBodyWithResponse request with arbitrary body returning *UploadReportResponse
+func (c *ClientWithResponses) UploadReportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadReportResponse, error) {
+	rsp, err := c.UploadReportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadReportResponse(rsp)
+}
+
+// UploadReportWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) UploadReportWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.UploadReportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) UploadReportWithOctetStreamBodyWithResponse(ctx context.Context, body UploadReportOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*UploadReportResponse, error) {
+	rsp, err := c.UploadReportWithOctetStreamBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadReportResponse(rsp)
+}
+
+func (c *ClientWithResponses) UploadReportWithOctetStreamBodyWithBodyStream(ctx context.Context, body UploadReportOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.UploadReportWithOctetStreamBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// UploadReportWithBinaryBodyWithResponse request with an unbuffered binary body returning *UploadReportResponse
+func (c *ClientWithResponses) UploadReportWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*UploadReportResponse, error) {
+	rsp, err := c.UploadReportWithBinaryBody(ctx, contentType, body, contentLength, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadReportResponse(rsp)
+}
+
+// GetReportWithResponse request returning *GetReportResponse
+func (c *ClientWithResponses) GetReportWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetReportResponse, error) {
+	rsp, err := c.GetReport(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReportResponse(rsp)
+}
+
+// GetReportWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetReportWithBodyStream(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetReport(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// DownloadGetReportResponse describes a response whose body was written by
+// DownloadGetReport.
+type DownloadGetReportResponse struct {
+	HTTPResponse       *http.Response
+	BytesWritten       int64 // The number of bytes of the body written
+	ContentDisposition *string
+	XReportRows        int
+}
+
+// DownloadGetReport request, writing a successful response body to w without
+// buffering it. Other responses are returned along with an error, without
+// writing their body.
+func (c *ClientWithResponses) DownloadGetReport(ctx context.Context, id string, w io.Writer, reqEditors ...RequestEditorFn) (*DownloadGetReportResponse, error) {
+	rsp, err := c.GetReport(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rsp.Body.Close() }()
+
+	response := &DownloadGetReportResponse{HTTPResponse: rsp}
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return response, fmt.Errorf("unexpected status code %d", rsp.StatusCode)
+	}
+
+	if value := rsp.Header.Get("Content-Disposition"); value != "" {
+		var header0 string
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "Content-Disposition", runtime.ParamLocationHeader, value, &header0); err != nil {
+			return response, fmt.Errorf("invalid format for header Content-Disposition: %w", err)
+		}
+		response.ContentDisposition = &header0
+	}
+
+	if value := rsp.Header.Get("X-Report-Rows"); value != "" {
+		var header1 int
+		if err := runtime.BindStyledParameterWithLocation("simple", false, "X-Report-Rows", runtime.ParamLocationHeader, value, &header1); err != nil {
+			return response, fmt.Errorf("invalid format for header X-Report-Rows: %w", err)
+		}
+		response.XReportRows = header1
+	}
+
+	response.BytesWritten, err = io.Copy(w, rsp.Body)
+	return response, err
+}
+
+// ReverseBytesWithBodyWithResponse request with arbitrary body returning *ReverseBytesResponse
+func (c *ClientWithResponses) ReverseBytesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReverseBytesResponse, error) {
+	rsp, err := c.ReverseBytesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReverseBytesResponse(rsp)
+}
+
+// ReverseBytesWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) ReverseBytesWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.ReverseBytesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) ReverseBytesWithOctetStreamBodyWithResponse(ctx context.Context, body ReverseBytesOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*ReverseBytesResponse, error) {
+	rsp, err := c.ReverseBytesWithOctetStreamBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReverseBytesResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReverseBytesWithOctetStreamBodyWithBodyStream(ctx context.Context, body ReverseBytesOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.ReverseBytesWithOctetStreamBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ReverseBytesWithBinaryBodyWithResponse request with an unbuffered binary body returning *ReverseBytesResponse
+func (c *ClientWithResponses) ReverseBytesWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*ReverseBytesResponse, error) {
+	rsp, err := c.ReverseBytesWithBinaryBody(ctx, contentType, body, contentLength, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReverseBytesResponse(rsp)
+}
+
+// ListUsersWithResponse request returning *ListUsersResponse
+func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*ListUsersResponse, error) {
+	rsp, err := c.ListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersResponse(rsp)
+}
+
+// ListUsersWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) ListUsersWithBodyStream(ctx context.Context, params *ListUsersParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.ListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ListUsersBulkWithResponse calls ListUsersWithResponse with each of the parameter
+// sets, at most concurrency at once, and returns the responses in the same order.
+// The first failing call cancels the others, and its error is returned.
+func (c *ClientWithResponses) ListUsersBulkWithResponse(ctx context.Context, params []ListUsersParams, concurrency int, reqEditors ...RequestEditorFn) ([]*ListUsersResponse, error) {
+	responses := make([]*ListUsersResponse, len(params))
+	err := runtime.Bulk(ctx, len(params), concurrency, func(ctx context.Context, i int) error {
+		rsp, err := c.ListUsersWithResponse(ctx, &params[i], reqEditors...)
+		if err != nil {
+			return err
+		}
+		responses[i] = rsp
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// CreateUserWithResponse request returning *CreateUserResponse
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateUserResponse, error) {
+	rsp, err := c.CreateUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResponse(rsp)
+}
+
+// CreateUserWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) CreateUserWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.CreateUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, teamName string, id int, params *GetUserParams, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, teamName, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResponse(rsp)
+}
+
+// GetUserWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetUserWithBodyStream(ctx context.Context, teamName string, id int, params *GetUserParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetUser(ctx, teamName, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostBothWithBodyWithResponse request with arbitrary body returning *PostBothResponse
+func (c *ClientWithResponses) PostBothWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBothResponse(rsp)
+}
+
+// PostBothWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostBothWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostBothWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) PostBothWithResponse(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBoth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBothResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBothWithBodyStream(ctx context.Context, body PostBothJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostBoth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) PostBothWithOctetStreamBodyWithResponse(ctx context.Context, body PostBothOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithOctetStreamBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBothResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBothWithOctetStreamBodyWithBodyStream(ctx context.Context, body PostBothOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostBothWithOctetStreamBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostBothWithBinaryBodyWithResponse request with an unbuffered binary body returning *PostBothResponse
+func (c *ClientWithResponses) PostBothWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*PostBothResponse, error) {
+	rsp, err := c.PostBothWithBinaryBody(ctx, contentType, body, contentLength, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBothResponse(rsp)
+}
+
+// GetBothWithResponse request returning *GetBothResponse
+func (c *ClientWithResponses) GetBothWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetBothResponse, error) {
+	rsp, err := c.GetBoth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBothResponse(rsp)
+}
+
+// GetBothWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetBothWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetBoth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostFormWithBodyWithResponse request with arbitrary body returning *PostFormResponse
+func (c *ClientWithResponses) PostFormWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFormResponse, error) {
+	rsp, err := c.PostFormWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFormResponse(rsp)
+}
+
+// PostFormWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostFormWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostFormWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) PostFormWithFormdataBodyWithResponse(ctx context.Context, body PostFormFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostFormResponse, error) {
+	rsp, err := c.PostFormWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFormResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFormWithFormdataBodyWithBodyStream(ctx context.Context, body PostFormFormdataRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostFormWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostJsonWithBodyWithResponse request with arbitrary body returning *PostJsonResponse
+func (c *ClientWithResponses) PostJsonWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	rsp, err := c.PostJsonWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJsonResponse(rsp)
+}
+
+// PostJsonWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostJsonWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostJsonWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) PostJsonWithResponse(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*PostJsonResponse, error) {
+	rsp, err := c.PostJson(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostJsonResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostJsonWithBodyStream(ctx context.Context, body PostJsonJSONRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostJson(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// GetJsonWithResponse request returning *GetJsonResponse
+func (c *ClientWithResponses) GetJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonResponse, error) {
+	rsp, err := c.GetJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJsonResponse(rsp)
+}
+
+// GetJsonWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetJsonWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostMultipartWithBodyWithResponse request with arbitrary body returning *PostMultipartResponse
+func (c *ClientWithResponses) PostMultipartWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostMultipartResponse, error) {
+	rsp, err := c.PostMultipartWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMultipartResponse(rsp)
+}
+
+// PostMultipartWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostMultipartWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostMultipartWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostMultipartWithMultipartBodyWithResponse request with a streamed multipart/form-data body returning *PostMultipartResponse
+func (c *ClientWithResponses) PostMultipartWithMultipartBodyWithResponse(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*PostMultipartResponse, error) {
+	rsp, err := c.PostMultipartWithMultipartBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostMultipartResponse(rsp)
+}
+
+// PostGalleryWithBodyWithResponse request with arbitrary body returning *PostGalleryResponse
+func (c *ClientWithResponses) PostGalleryWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostGalleryResponse, error) {
+	rsp, err := c.PostGalleryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGalleryResponse(rsp)
+}
+
+// PostGalleryWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostGalleryWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostGalleryWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostGalleryWithMultipartBodyWithResponse request with a streamed multipart/form-data body returning *PostGalleryResponse
+func (c *ClientWithResponses) PostGalleryWithMultipartBodyWithResponse(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*PostGalleryResponse, error) {
+	rsp, err := c.PostGalleryWithMultipartBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostGalleryResponse(rsp)
+}
+
+// PostDocumentWithBodyWithResponse request with arbitrary body returning *PostDocumentResponse
+func (c *ClientWithResponses) PostDocumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDocumentResponse, error) {
+	rsp, err := c.PostDocumentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDocumentResponse(rsp)
+}
+
+// PostDocumentWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostDocumentWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostDocumentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostDocumentWithMultipartBodyWithResponse request with a streamed multipart/form-data body returning *PostDocumentResponse
+func (c *ClientWithResponses) PostDocumentWithMultipartBodyWithResponse(ctx context.Context, body *runtime.MultipartBody, reqEditors ...RequestEditorFn) (*PostDocumentResponse, error) {
+	rsp, err := c.PostDocumentWithMultipartBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDocumentResponse(rsp)
+}
+
+// PostOtherWithBodyWithResponse request with arbitrary body returning *PostOtherResponse
+func (c *ClientWithResponses) PostOtherWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
+	rsp, err := c.PostOtherWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOtherResponse(rsp)
+}
+
+// PostOtherWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostOtherWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostOtherWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) PostOtherWithOctetStreamBodyWithResponse(ctx context.Context, body PostOtherOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
+	rsp, err := c.PostOtherWithOctetStreamBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOtherResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostOtherWithOctetStreamBodyWithBodyStream(ctx context.Context, body PostOtherOctetStreamRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostOtherWithOctetStreamBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostOtherWithBinaryBodyWithResponse request with an unbuffered binary body returning *PostOtherResponse
+func (c *ClientWithResponses) PostOtherWithBinaryBodyWithResponse(ctx context.Context, contentType string, body io.Reader, contentLength int64, reqEditors ...RequestEditorFn) (*PostOtherResponse, error) {
+	rsp, err := c.PostOtherWithBinaryBody(ctx, contentType, body, contentLength, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostOtherResponse(rsp)
+}
+
+// GetOtherWithResponse request returning *GetOtherResponse
+func (c *ClientWithResponses) GetOtherWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOtherResponse, error) {
+	rsp, err := c.GetOther(ctx, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseGetOtherResponse(rsp)
 }
 
-// GetJsonWithTrailingSlashWithResponse request returning *GetJsonWithTrailingSlashResponse
-func (c *ClientWithResponses) GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error) {
-	rsp, err := c.GetJsonWithTrailingSlash(ctx, reqEditors...)
+// GetOtherWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetOtherWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetOther(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostProtobufWithBodyWithResponse request with arbitrary body returning *PostProtobufResponse
+func (c *ClientWithResponses) PostProtobufWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProtobufResponse, error) {
+	rsp, err := c.PostProtobufWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProtobufResponse(rsp)
+}
+
+// PostProtobufWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostProtobufWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostProtobufWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) PostProtobufWithProtobufBodyWithResponse(ctx context.Context, body PostProtobufProtobufRequestBody, reqEditors ...RequestEditorFn) (*PostProtobufResponse, error) {
+	rsp, err := c.PostProtobufWithProtobufBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProtobufResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostProtobufWithProtobufBodyWithBodyStream(ctx context.Context, body PostProtobufProtobufRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostProtobufWithProtobufBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// GetJsonWithTrailingSlashWithResponse request returning *GetJsonWithTrailingSlashResponse
+func (c *ClientWithResponses) GetJsonWithTrailingSlashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJsonWithTrailingSlashResponse, error) {
+	rsp, err := c.GetJsonWithTrailingSlash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJsonWithTrailingSlashResponse(rsp)
+}
+
+// GetJsonWithTrailingSlashWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetJsonWithTrailingSlashWithBodyStream(ctx context.Context, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetJsonWithTrailingSlash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostXmlWithBodyWithResponse request with arbitrary body returning *PostXmlResponse
+func (c *ClientWithResponses) PostXmlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostXmlResponse, error) {
+	rsp, err := c.PostXmlWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostXmlResponse(rsp)
+}
+
+// PostXmlWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostXmlWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostXmlWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) PostXmlWithXMLBodyWithResponse(ctx context.Context, body PostXmlXMLRequestBody, reqEditors ...RequestEditorFn) (*PostXmlResponse, error) {
+	rsp, err := c.PostXmlWithXMLBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostXmlResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostXmlWithXMLBodyWithBodyStream(ctx context.Context, body PostXmlXMLRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostXmlWithXMLBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// PostYamlWithBodyWithResponse request with arbitrary body returning *PostYamlResponse
+func (c *ClientWithResponses) PostYamlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostYamlResponse, error) {
+	rsp, err := c.PostYamlWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostYamlResponse(rsp)
+}
+
+// PostYamlWithBodyWithBodyStream request with arbitrary body returning the unbuffered response body
+func (c *ClientWithResponses) PostYamlWithBodyWithBodyStream(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostYamlWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+func (c *ClientWithResponses) PostYamlWithYAMLBodyWithResponse(ctx context.Context, body PostYamlYAMLRequestBody, reqEditors ...RequestEditorFn) (*PostYamlResponse, error) {
+	rsp, err := c.PostYamlWithYAMLBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostYamlResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostYamlWithYAMLBodyWithBodyStream(ctx context.Context, body PostYamlYAMLRequestBody, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.PostYamlWithYAMLBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// ParseGetAgentResponse parses an HTTP response from a GetAgentWithResponse call
+func ParseGetAgentResponse(rsp *http.Response) (*GetAgentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAgentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetCachedResponse parses an HTTP response from a GetCachedWithResponse call
+func ParseGetCachedResponse(rsp *http.Response) (*GetCachedResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCachedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventsResponse parses an HTTP response from a GetEventsWithResponse call
+func ParseGetEventsResponse(rsp *http.Response) (*GetEventsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListFilesResponse parses an HTTP response from a ListFilesWithResponse call
+func ParseListFilesResponse(rsp *http.Response) (*ListFilesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFilesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUploadImageResponse parses an HTTP response from a UploadImageWithResponse call
+func ParseUploadImageResponse(rsp *http.Response) (*UploadImageResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadImageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUploadReportResponse parses an HTTP response from a UploadReportWithResponse call
+func ParseUploadReportResponse(rsp *http.Response) (*UploadReportResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetReportResponse parses an HTTP response from a GetReportWithResponse call
+func ParseGetReportResponse(rsp *http.Response) (*GetReportResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "application/octet-stream") && rsp.StatusCode == 200:
+		dest := bodyBytes
+		response.OctetStream200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReverseBytesResponse parses an HTTP response from a ReverseBytesWithResponse call
+func ParseReverseBytesResponse(rsp *http.Response) (*ReverseBytesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReverseBytesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "application/octet-stream") && rsp.StatusCode == 200:
+		dest := bodyBytes
+		response.OctetStream200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUsersResponse parses an HTTP response from a ListUsersWithResponse call
+func ParseListUsersResponse(rsp *http.Response) (*ListUsersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserResponse parses an HTTP response from a CreateUserWithResponse call
+func ParseCreateUserResponse(rsp *http.Response) (*CreateUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBothResponse parses an HTTP response from a PostBothWithResponse call
+func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBothResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetBothResponse parses an HTTP response from a GetBothWithResponse call
+func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBothResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostFormResponse parses an HTTP response from a PostFormWithResponse call
+func ParsePostFormResponse(rsp *http.Response) (*PostFormResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFormResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostJsonResponse parses an HTTP response from a PostJsonWithResponse call
+func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetJsonResponse parses an HTTP response from a GetJsonWithResponse call
+func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostMultipartResponse parses an HTTP response from a PostMultipartWithResponse call
+func ParsePostMultipartResponse(rsp *http.Response) (*PostMultipartResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetJsonWithTrailingSlashResponse(rsp)
+
+	response := &PostMultipartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// ParsePostBothResponse parses an HTTP response from a PostBothWithResponse call
-func ParsePostBothResponse(rsp *http.Response) (*PostBothResponse, error) {
+// ParsePostGalleryResponse parses an HTTP response from a PostGalleryWithResponse call
+func ParsePostGalleryResponse(rsp *http.Response) (*PostGalleryResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostGalleryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostDocumentResponse parses an HTTP response from a PostDocumentWithResponse call
+func ParsePostDocumentResponse(rsp *http.Response) (*PostDocumentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostOtherResponse parses an HTTP response from a PostOtherWithResponse call
+func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostOtherResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetOtherResponse parses an HTTP response from a GetOtherWithResponse call
+func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOtherResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostProtobufResponse parses an HTTP response from a PostProtobufWithResponse call
+func ParsePostProtobufResponse(rsp *http.Response) (*PostProtobufResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostProtobufResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "protobuf") && rsp.StatusCode == 200:
+		var dest wrapperspb.StringValue
+		if err := proto.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.Protobuf200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJsonWithTrailingSlashResponse parses an HTTP response from a GetJsonWithTrailingSlashWithResponse call
+func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrailingSlashResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJsonWithTrailingSlashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostXmlResponse parses an HTTP response from a PostXmlWithResponse call
+func ParsePostXmlResponse(rsp *http.Response) (*PostXmlResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostXmlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostYamlResponse parses an HTTP response from a PostYamlWithResponse call
+func ParsePostYamlResponse(rsp *http.Response) (*PostYamlResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostBothResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	response := &PostYamlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest SchemaObject
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// NewApiKeyCookieAPIKeyProvider returns a RequestEditorFn which sends apiKey
+// as the session cookie of the apiKeyCookie security scheme.
+func NewApiKeyCookieAPIKeyProvider(apiKey string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.AddCookie(&http.Cookie{Name: "session", Value: apiKey})
+		return nil
+	}
+}
+
+// NewApiKeyHeaderAPIKeyProvider returns a RequestEditorFn which sends apiKey
+// as the X-API-Key header of the apiKeyHeader security scheme.
+func NewApiKeyHeaderAPIKeyProvider(apiKey string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-API-Key", apiKey)
+		return nil
+	}
+}
+
+// NewApiKeyQueryAPIKeyProvider returns a RequestEditorFn which sends apiKey
+// as the api_key query parameter of the apiKeyQuery security scheme.
+func NewApiKeyQueryAPIKeyProvider(apiKey string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		query.Set("api_key", apiKey)
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+// NewBasicAuthBasicAuthProvider returns a RequestEditorFn which sends the
+// username and password of the basicAuth security scheme with basic authentication.
+func NewBasicAuthBasicAuthProvider(username, password string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.SetBasicAuth(username, password)
+		return nil
+	}
+}
+
+// NewBearerAuthBearerTokenProvider returns a RequestEditorFn which sends token
+// in the Authorization header, as required by the bearerAuth security scheme.
+func NewBearerAuthBearerTokenProvider(token string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+// ServiceAuthClientCredentials returns the configuration of the serviceAuth OAuth2
+// client credentials flow, for use with NewClientCredentialsProvider. When no
+// scopes are given, all the scopes declared by the flow are requested.
+func ServiceAuthClientCredentials(clientID, clientSecret string, scopes ...string) *clientcredentials.Config {
+	if len(scopes) == 0 {
+		scopes = []string{"users:read", "users:write"}
+	}
+	return &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     "https://auth.example.com/oauth/token",
+		Scopes:       scopes,
+	}
+}
+
+// NewClientCredentialsProvider returns a RequestEditorFn which authorizes
+// requests with tokens from an OAuth2 client credentials flow. Tokens are
+// cached, and fetched again once they expire, using ctx.
+func NewClientCredentialsProvider(ctx context.Context, config *clientcredentials.Config) RequestEditorFn {
+	tokens := config.TokenSource(ctx)
+	return func(ctx context.Context, req *http.Request) error {
+		token, err := tokens.Token()
+		if err != nil {
+			return fmt.Errorf("error fetching OAuth2 token: %w", err)
+		}
+		token.SetAuthHeader(req)
+		return nil
+	}
+}
+
+// ProductionServerRegion is a value of the region variable of the ProductionServer URL.
+type ProductionServerRegion string
+
+// Defines values for ProductionServerRegion.
+const (
+	ProductionServerRegionEu ProductionServerRegion = "eu"
+	ProductionServerRegionUs ProductionServerRegion = "us"
+)
+
+// ProductionServerVariables are the variables of the ProductionServer URL. Variables left
+// empty take their default value.
+type ProductionServerVariables struct {
+	Port   string                 // Defaults to "443"
+	Region ProductionServerRegion // the region of the deployment, defaults to "us"
+}
+
+// ProductionServerURL returns the ProductionServer URL, https://{region}.api.example.com:{port}/v1,
+// with the given variables.
+func ProductionServerURL(variables ProductionServerVariables) (string, error) {
+	serverURL := "https://{region}.api.example.com:{port}/v1"
+
+	value0 := variables.Port
+	if value0 == "" {
+		value0 = "443"
+	}
+	serverURL = strings.ReplaceAll(serverURL, "{port}", value0)
+
+	value1 := string(variables.Region)
+	if value1 == "" {
+		value1 = "us"
+	}
+	switch ProductionServerRegion(value1) {
+	case ProductionServerRegionEu, ProductionServerRegionUs:
+	default:
+		return "", fmt.Errorf("invalid value %q for server variable region", value1)
+	}
+	serverURL = strings.ReplaceAll(serverURL, "{region}", value1)
+
+	return serverURL, nil
+}
+
+// WithProductionServer sets the server of the client to the ProductionServer URL, with the
+// given variables.
+func WithProductionServer(variables ProductionServerVariables) ClientOption {
+	return func(c *Client) error {
+		serverURL, err := ProductionServerURL(variables)
+		if err != nil {
+			return err
+		}
+		c.Server = serverURL
+		return nil
+	}
+}
+
+// SandboxServerURL is the SandboxServer URL.
+const SandboxServerURL = "https://sandbox.example.com/v1"
+
+// WithSandboxServer sets the server of the client to SandboxServerURL.
+func WithSandboxServer() ClientOption {
+	return func(c *Client) error {
+		c.Server = SandboxServerURL
+		return nil
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /agent)
+	GetAgent(ctx echo.Context, params GetAgentParams) error
+
+	// (GET /cached)
+	GetCached(ctx echo.Context) error
+
+	// (GET /events)
+	GetEvents(ctx echo.Context) error
+
+	// (GET /files)
+	ListFiles(ctx echo.Context, params ListFilesParams) error
+
+	// (PUT /images)
+	UploadImage(ctx echo.Context) error
+
+	// (PUT /reports)
+	UploadReport(ctx echo.Context) error
+
+	// (GET /reports/{id})
+	GetReport(ctx echo.Context, id string) error
+
+	// (POST /reverse)
+	ReverseBytes(ctx echo.Context) error
+
+	// (GET /users)
+	ListUsers(ctx echo.Context, params ListUsersParams) error
+
+	// (POST /users)
+	CreateUser(ctx echo.Context) error
+
+	// (GET /users/{teamName}/{id})
+	GetUser(ctx echo.Context, teamName string, id int, params GetUserParams) error
+
+	// (POST /with_both_bodies)
+	PostBoth(ctx echo.Context) error
+
+	// (GET /with_both_responses)
+	GetBoth(ctx echo.Context) error
+
+	// (POST /with_form_body)
+	PostForm(ctx echo.Context) error
+
+	// (POST /with_json_body)
+	PostJson(ctx echo.Context) error
+
+	// (GET /with_json_response)
+	GetJson(ctx echo.Context) error
+
+	// (POST /with_multipart_body)
+	PostMultipart(ctx echo.Context) error
+
+	// (POST /with_multipart_encoding)
+	PostGallery(ctx echo.Context) error
+
+	// (POST /with_multipart_ref)
+	PostDocument(ctx echo.Context) error
+
+	// (POST /with_other_body)
+	PostOther(ctx echo.Context) error
+
+	// (GET /with_other_response)
+	GetOther(ctx echo.Context) error
+
+	// (POST /with_protobuf_body)
+	PostProtobuf(ctx echo.Context) error
+
+	// (GET /with_trailing_slash/)
+	GetJsonWithTrailingSlash(ctx echo.Context) error
+
+	// (POST /with_xml_body)
+	PostXml(ctx echo.Context) error
+
+	// (POST /with_yaml_body)
+	PostYaml(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+	Logger  runtime.OperationLogger
+}
+
+// GetAgent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAgent(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgentParams
 
-	return response, nil
-}
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "User-Agent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
+		var UserAgent string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for User-Agent, got %d", n))
+		}
 
-// ParseGetBothResponse parses an HTTP response from a GetBothWithResponse call
-func ParseGetBothResponse(rsp *http.Response) (*GetBothResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, valueList[0], &UserAgent)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter User-Agent: %s", err)).SetInternal(err)
+		}
 
-	response := &GetBothResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		params.UserAgent = &UserAgent
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAgent(ctx, params)
+	return err
 }
 
-// ParsePostJsonResponse parses an HTTP response from a PostJsonWithResponse call
-func ParsePostJsonResponse(rsp *http.Response) (*PostJsonResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetCached converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCached(ctx echo.Context) error {
+	var err error
 
-	response := &PostJsonResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCached(ctx)
+	return err
+}
 
-	return response, nil
+// GetEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEvents(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetEvents(ctx)
+	return err
 }
 
-// ParseGetJsonResponse parses an HTTP response from a GetJsonWithResponse call
-func ParseGetJsonResponse(rsp *http.Response) (*GetJsonResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ListFiles converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFiles(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFilesParams
+	// ------------- Required query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "path", ctx.QueryParams(), &params.Path)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err)).SetInternal(err)
 	}
 
-	response := &GetJsonResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err)).SetInternal(err)
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListFiles(ctx, params)
+	return err
 }
 
-// ParsePostOtherResponse parses an HTTP response from a PostOtherWithResponse call
-func ParsePostOtherResponse(rsp *http.Response) (*PostOtherResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// UploadImage converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadImage(ctx echo.Context) error {
+	var err error
 
-	response := &PostOtherResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UploadImage(ctx)
+	return err
+}
 
-	return response, nil
+// UploadReport converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadReport(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UploadReport(ctx)
+	return err
 }
 
-// ParseGetOtherResponse parses an HTTP response from a GetOtherWithResponse call
-func ParseGetOtherResponse(rsp *http.Response) (*GetOtherResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
 	}
 
-	response := &GetOtherResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetReport(ctx, id)
+	return err
+}
 
-	return response, nil
+// ReverseBytes converts echo context to params.
+func (w *ServerInterfaceWrapper) ReverseBytes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReverseBytes(ctx)
+	return err
 }
 
-// ParseGetJsonWithTrailingSlashResponse parses an HTTP response from a GetJsonWithTrailingSlashWithResponse call
-func ParseGetJsonWithTrailingSlashResponse(rsp *http.Response) (*GetJsonWithTrailingSlashResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ListUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListUsers(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+	// ------------- Optional query parameter "team" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team", ctx.QueryParams(), &params.Team)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team: %s", err)).SetInternal(err)
 	}
 
-	response := &GetJsonWithTrailingSlashResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err)).SetInternal(err)
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListUsers(ctx, params)
+	return err
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+// CreateUser converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateUser(ctx echo.Context) error {
+	var err error
 
-	// (POST /with_both_bodies)
-	PostBoth(ctx echo.Context) error
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateUser(ctx)
+	return err
+}
 
-	// (GET /with_both_responses)
-	GetBoth(ctx echo.Context) error
+// GetUser converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "teamName" -------------
+	var teamName string
 
-	// (POST /with_json_body)
-	PostJson(ctx echo.Context) error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "teamName", runtime.ParamLocationPath, ctx.Param("teamName"), &teamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter teamName: %s", err)).SetInternal(err)
+	}
 
-	// (GET /with_json_response)
-	GetJson(ctx echo.Context) error
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	// (POST /with_other_body)
-	PostOther(ctx echo.Context) error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
+	}
 
-	// (GET /with_other_response)
-	GetOther(ctx echo.Context) error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserParams
+	// ------------- Optional query parameter "verbose" -------------
 
-	// (GET /with_trailing_slash/)
-	GetJsonWithTrailingSlash(ctx echo.Context) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "verbose", ctx.QueryParams(), &params.Verbose)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter verbose: %s", err)).SetInternal(err)
+	}
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetUser(ctx, teamName, id, params)
+	return err
 }
 
 // PostBoth converts echo context to params.
@@ -922,6 +4958,15 @@ func (w *ServerInterfaceWrapper) GetBoth(ctx echo.Context) error {
 	return err
 }
 
+// PostForm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostForm(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostForm(ctx)
+	return err
+}
+
 // PostJson converts echo context to params.
 func (w *ServerInterfaceWrapper) PostJson(ctx echo.Context) error {
 	var err error
@@ -942,6 +4987,33 @@ func (w *ServerInterfaceWrapper) GetJson(ctx echo.Context) error {
 	return err
 }
 
+// PostMultipart converts echo context to params.
+func (w *ServerInterfaceWrapper) PostMultipart(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostMultipart(ctx)
+	return err
+}
+
+// PostGallery converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGallery(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostGallery(ctx)
+	return err
+}
+
+// PostDocument converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDocument(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostDocument(ctx)
+	return err
+}
+
 // PostOther converts echo context to params.
 func (w *ServerInterfaceWrapper) PostOther(ctx echo.Context) error {
 	var err error
@@ -960,6 +5032,15 @@ func (w *ServerInterfaceWrapper) GetOther(ctx echo.Context) error {
 	return err
 }
 
+// PostProtobuf converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProtobuf(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostProtobuf(ctx)
+	return err
+}
+
 // GetJsonWithTrailingSlash converts echo context to params.
 func (w *ServerInterfaceWrapper) GetJsonWithTrailingSlash(ctx echo.Context) error {
 	var err error
@@ -971,6 +5052,24 @@ func (w *ServerInterfaceWrapper) GetJsonWithTrailingSlash(ctx echo.Context) erro
 	return err
 }
 
+// PostXml converts echo context to params.
+func (w *ServerInterfaceWrapper) PostXml(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostXml(ctx)
+	return err
+}
+
+// PostYaml converts echo context to params.
+func (w *ServerInterfaceWrapper) PostYaml(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostYaml(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -994,33 +5093,276 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	RegisterHandlersWithOptions(router, si, EchoServerOptions{BaseURL: baseURL})
+}
+
+// EchoServerOptions provides options for registering the handlers.
+type EchoServerOptions struct {
+	BaseURL string
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// additional options.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options EchoServerOptions) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
+		Logger:  options.Logger,
 	}
 
-	router.POST(baseURL+"/with_both_bodies", wrapper.PostBoth)
-	router.GET(baseURL+"/with_both_responses", wrapper.GetBoth)
-	router.POST(baseURL+"/with_json_body", wrapper.PostJson)
-	router.GET(baseURL+"/with_json_response", wrapper.GetJson)
-	router.POST(baseURL+"/with_other_body", wrapper.PostOther)
-	router.GET(baseURL+"/with_other_response", wrapper.GetOther)
-	router.GET(baseURL+"/with_trailing_slash/", wrapper.GetJsonWithTrailingSlash)
+	router.GET(options.BaseURL+"/agent", wrapper.logged("GetAgent", wrapper.GetAgent))
+	router.GET(options.BaseURL+"/cached", wrapper.logged("GetCached", wrapper.GetCached))
+	router.GET(options.BaseURL+"/events", wrapper.logged("GetEvents", wrapper.GetEvents))
+	router.GET(options.BaseURL+"/files", wrapper.logged("ListFiles", wrapper.ListFiles))
+	router.PUT(options.BaseURL+"/images", wrapper.logged("UploadImage", wrapper.UploadImage))
+	router.PUT(options.BaseURL+"/reports", wrapper.logged("UploadReport", wrapper.UploadReport))
+	router.GET(options.BaseURL+"/reports/:id", wrapper.logged("GetReport", wrapper.GetReport))
+	router.POST(options.BaseURL+"/reverse", wrapper.logged("ReverseBytes", wrapper.ReverseBytes))
+	router.GET(options.BaseURL+"/users", wrapper.logged("ListUsers", wrapper.ListUsers))
+	router.POST(options.BaseURL+"/users", wrapper.logged("CreateUser", wrapper.CreateUser))
+	router.GET(options.BaseURL+"/users/:teamName/:id", wrapper.logged("GetUser", wrapper.GetUser))
+	router.POST(options.BaseURL+"/with_both_bodies", wrapper.logged("PostBoth", wrapper.PostBoth))
+	router.GET(options.BaseURL+"/with_both_responses", wrapper.logged("GetBoth", wrapper.GetBoth))
+	router.POST(options.BaseURL+"/with_form_body", wrapper.logged("PostForm", wrapper.PostForm))
+	router.POST(options.BaseURL+"/with_json_body", wrapper.logged("PostJson", wrapper.PostJson))
+	router.GET(options.BaseURL+"/with_json_response", wrapper.logged("GetJson", wrapper.GetJson))
+	router.POST(options.BaseURL+"/with_multipart_body", wrapper.logged("PostMultipart", wrapper.PostMultipart))
+	router.POST(options.BaseURL+"/with_multipart_encoding", wrapper.logged("PostGallery", wrapper.PostGallery))
+	router.POST(options.BaseURL+"/with_multipart_ref", wrapper.logged("PostDocument", wrapper.PostDocument))
+	router.POST(options.BaseURL+"/with_other_body", wrapper.logged("PostOther", wrapper.PostOther))
+	router.GET(options.BaseURL+"/with_other_response", wrapper.logged("GetOther", wrapper.GetOther))
+	router.POST(options.BaseURL+"/with_protobuf_body", wrapper.logged("PostProtobuf", wrapper.PostProtobuf))
+	router.GET(options.BaseURL+"/with_trailing_slash/", wrapper.logged("GetJsonWithTrailingSlash", wrapper.GetJsonWithTrailingSlash))
+	router.POST(options.BaseURL+"/with_xml_body", wrapper.logged("PostXml", wrapper.PostXml))
+	router.POST(options.BaseURL+"/with_yaml_body", wrapper.logged("PostYaml", wrapper.PostYaml))
 
 }
 
+// logged returns the handler of an operation, logging its calls when the
+// wrapper has a logger.
+func (w *ServerInterfaceWrapper) logged(operationID string, handler echo.HandlerFunc) echo.HandlerFunc {
+	if w.Logger == nil {
+		return handler
+	}
+	return func(ctx echo.Context) error {
+		start := time.Now()
+		err := handler(ctx)
+		statusCode := ctx.Response().Status
+		if err != nil && !ctx.Response().Committed {
+			// The response is written by the error handler, after this returns.
+			statusCode = http.StatusInternalServerError
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				statusCode = httpErr.Code
+			}
+		}
+		w.Logger.LogOperation(ctx.Request().Context(), runtime.NewOperationRecord(operationID, ctx.Request(), statusCode, ctx.Response().Header(), time.Since(start), err))
+		return err
+	}
+}
+
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		id := runtime.RequestCorrelationID(req.Header, "X-Request-ID")
+		ctx.Response().Header().Set("X-Request-ID", id)
+		ctx.SetRequest(req.WithContext(runtime.ContextWithCorrelationID(req.Context(), id)))
+		return next(ctx)
+	}
+}
+
+// PostBothBodies holds the body of a PostBoth request, decoded by
+// DecodePostBothBody. Only the field of the request's content type is set.
+type PostBothBodies struct {
+	JSON        *PostBothJSONRequestBody
+	OctetStream *PostBothOctetStreamRequestBody
+	// Raw is the body of a content type without a typed body, such as one
+	// declared without a schema, and RawContentType is its media type.
+	Raw            []byte
+	RawContentType string
+}
+
+// DecodePostBothBody decodes the body of a PostBoth request according to its
+// Content-Type, failing with a *runtime.UnsupportedMediaTypeError for content
+// types the operation doesn't accept.
+func DecodePostBothBody(r *http.Request) (*PostBothBodies, error) {
+	supported := []string{"application/json", "application/octet-stream", "application/pdf"}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: r.Header.Get("Content-Type"), Supported: supported}
+	}
+
+	bodies := &PostBothBodies{}
+	switch mediaType {
+	case "application/json":
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		var body PostBothJSONRequestBody
+		if err := json.Unmarshal(data, &body); err != nil {
+			return nil, err
+		}
+		bodies.JSON = &body
+	case "application/octet-stream":
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		body := PostBothOctetStreamRequestBody(data)
+		bodies.OctetStream = &body
+	default:
+		if !runtime.MatchMediaRanges(mediaType, []string{"application/pdf"}) {
+			return nil, &runtime.UnsupportedMediaTypeError{ContentType: mediaType, Supported: supported}
+		}
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		bodies.Raw = data
+		bodies.RawContentType = mediaType
+	}
+	return bodies, nil
+}
+
+// PostMultipartBodies holds the body of a PostMultipart request, decoded by
+// DecodePostMultipartBody. Only the field of the request's content type is set.
+type PostMultipartBodies struct {
+	Multipart *PostMultipartMultipartRequestBody
+}
+
+// DecodePostMultipartBody decodes the body of a PostMultipart request according to its
+// Content-Type, failing with a *runtime.UnsupportedMediaTypeError for content
+// types the operation doesn't accept.
+func DecodePostMultipartBody(r *http.Request) (*PostMultipartBodies, error) {
+	supported := []string{"multipart/form-data"}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: r.Header.Get("Content-Type"), Supported: supported}
+	}
+
+	bodies := &PostMultipartBodies{}
+	switch mediaType {
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return nil, err
+		}
+		var body PostMultipartMultipartRequestBody
+		if err := runtime.BindMultipart(r.MultipartForm, &body); err != nil {
+			return nil, err
+		}
+		bodies.Multipart = &body
+	default:
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: mediaType, Supported: supported}
+	}
+	return bodies, nil
+}
+
+// PostGalleryBodies holds the body of a PostGallery request, decoded by
+// DecodePostGalleryBody. Only the field of the request's content type is set.
+type PostGalleryBodies struct {
+	Multipart *PostGalleryMultipartRequestBody
+}
+
+// DecodePostGalleryBody decodes the body of a PostGallery request according to its
+// Content-Type, failing with a *runtime.UnsupportedMediaTypeError for content
+// types the operation doesn't accept.
+func DecodePostGalleryBody(r *http.Request) (*PostGalleryBodies, error) {
+	supported := []string{"multipart/form-data"}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: r.Header.Get("Content-Type"), Supported: supported}
+	}
+
+	bodies := &PostGalleryBodies{}
+	switch mediaType {
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return nil, err
+		}
+		var body PostGalleryMultipartRequestBody
+		if err := runtime.BindMultipartWithEncoding(r.MultipartForm, &body, PostGalleryMultipartEncodings); err != nil {
+			return nil, err
+		}
+		bodies.Multipart = &body
+	default:
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: mediaType, Supported: supported}
+	}
+	return bodies, nil
+}
+
+// PostDocumentBodies holds the body of a PostDocument request, decoded by
+// DecodePostDocumentBody. Only the field of the request's content type is set.
+type PostDocumentBodies struct {
+	Multipart *PostDocumentMultipartRequestBody
+}
+
+// DecodePostDocumentBody decodes the body of a PostDocument request according to its
+// Content-Type, failing with a *runtime.UnsupportedMediaTypeError for content
+// types the operation doesn't accept.
+func DecodePostDocumentBody(r *http.Request) (*PostDocumentBodies, error) {
+	supported := []string{"multipart/form-data"}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: r.Header.Get("Content-Type"), Supported: supported}
+	}
+
+	bodies := &PostDocumentBodies{}
+	switch mediaType {
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return nil, err
+		}
+		var body PostDocumentMultipartRequestBody
+		if err := runtime.BindMultipart(r.MultipartForm, &body); err != nil {
+			return nil, err
+		}
+		bodies.Multipart = &body
+	default:
+		return nil, &runtime.UnsupportedMediaTypeError{ContentType: mediaType, Supported: supported}
+	}
+	return bodies, nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8yUz24TMRDGX2U1cFyyKdz2CAdUJAgikTiEqHK8k9jVrm1mJq1W0b47GicliahCkGjV",
-	"SzTO/NE338/rLdjYpRgwCEO9BbYOO5PDaQ4ny1u0oudEMSGJx5xdeWL5YjrUg/QJoQYW8mENQwkU28cS",
-	"msGfG0/YQD3fVZVHoxaDlviwitrcIFvySXwMUMPMeS4EWbi4dygOqRCHxYfWY5DChGYffvfiviGnGBi5",
-	"MITFGgOSEWwKG4nQStv/CFBC6y0GzjpDXgQ+X89UvXhR+TBDlmKKdIcEJdwh8U7K1Wg8GmthTBhM8lDD",
-	"u9F4dAUlJCMu+1Pde3E3y5h/mr1pKXK2Uo00utd1AzV8jSzvozjYuYN6anqtszEIhtxiUmq9zU3VLauM",
-	"B1gavSZcQQ2vqgPNao+yOuGo/h6PilZQ3rAQmu505CpSZwRqWPpgqIfyD5gnNIU2mP/YOw912LSt1hw5",
-	"cZTdwhof8eIjHqw4qn07Hr9UE4bDjipJaffnWX9S5c/C+p8IZfUP2XOAfut/QkAqi9FuyEsP9XwLk4RZ",
-	"wBx07ojQNFDuYtN0PsBiWBx2ifo+XIBionUXs3i2j2Un/xIWhwXOw/hfV1zI+NaH9Q23hl31t2uij/Fs",
-	"3zLVjhd6b4bhVwAAAP//2pHiCAkHAAA=",
+	"H4sIAAAAAAAC/8xaX3PbuBH/KhzczfSFFO1LnvSWOHdp2qRJ/ad1J9VkIHJFIgYBHABK1niUz36zAEiJ",
+	"EiVRsXPnl1gkFovd3/7FMg8kk5WSAoQ1ZPxATFZCRd3PNzKrKxAWfystFWjLwK1kUtiwMJO6opaMyZQJ",
+	"qpckJnapgIyJsZqJgqxiYpnlgLRbK6uYaPi9ZhpyMv4cyOKW+aRlJadfIbPI6spJ99E/74g1Y9rYf9Gq",
+	"77CYaDlECkcVb7CarGJyY0DvHsfyDXZMWChA40GCVgMOYjkJpLuKrmJiIKs1s0unsT+PKvZPWF5Ieccc",
+	"fybImGT+sTmVGDCGSbG2g9+Fcvlffweag273l/6x3X+bvPr0LsEdezn8uwa9bBn87p7a/VSxL3f9u6fU",
+	"sOxVbcvWz3DdvV2Tl9YqRwxUg96hfu1e75Ib0HOWQUM/43LhPZUzEPZCQw7CMsqDj0vlIa0NaDPWQHMy",
+	"Jvgncm9IHFYWmlk8JtNALYRFdGl5B+JG8yCBGacprW05gntaKQ6jTFapxDepo3QWbWyMr38hK3zFxEyi",
+	"GDmYTDNl0XBjcl0yE1kw1kSLEmwJOrIlRBdOlYiKPPz8L7PlJRglhQETUQ1RAQI0tZBHmdQaMsuX/0dX",
+	"4CwDYZzTBDN9eHe9EZrkGoyNrkDPHbZz0MaLcj46G50hoVQgqGJkTF6MzkbnJCaK2tJhmNIi5IIC3B+M",
+	"EYq6vENU34J95Qhwi6YVWNCGjD/v8T8MtaTZ4JNRXyxNYqIb1XH9l7OXu0Da0psschJGC2oiA84aCH6a",
+	"0ayE/JDgF55i56yzrSRIleIsc1vTr0YK72SN7D9rmJEx+SldZ9rUr5q0k9CcWF0VaOSkpFMOUcgOccDM",
+	"yfLrNS26p+1mnffU2OSDzNmMQX6YGMlf7IPSnx+V1Ii/2SgrqSggb8CEeVNB9oH5q6c4CqaFe+vZJcZq",
+	"oNURkXch89siOYuCVEHGGeOwX8T3zNjfHMWOp1LO5eISMMkgglbXEPdmPwwKspnnPekB6+xhDveKyxzI",
+	"eEa52XOYpYXpBAmzUJne2hdeUK3psjd6zvpN7hCLapGHHOT0C2iyihZ+u6p74LxRXNL8HRIFRMDY1zJf",
+	"bpnbsUmV2HLjo31Ft54iaquhOcEd6dOBlXrtwxqU1PaYSpeO6qBOm/lAZhb6PflHquhV6eoYk/uE5VAp",
+	"aUFkywTLNBZ8r1Zy3VaqBoj0geWrQyHdQtGX2UMsBGdl+UlxMTkp5z4S473woft38+2FFyB5w4yShvkt",
+	"h9PvbeJhSi5DT3IUhbaXdNK97LOxkJGpszIIunZgLN2uzitpemx26QleLy2Y5+LBf6php6h5HAWg2sj3",
+	"jd2hynAT+sI+T9/OyyjskaTfXzwK6Nu3dofJIzuRtkAcaklQ056a0VNnUWCssqEtRpJ+t7tw7bNjvKPB",
+	"+ZP1Ul7wfrv7Bt63964hFnfu/Ldgm8vdTnYL8m5a3F/6yM+NDqOpzJc/pS65Yb4boe39DXSDyOeP0W1y",
+	"7T3D2X00Bz2VBikxLlyqCIe+Xl7CrCPSZdAazzDpt3OH+Lfzh+a41bdzzNUpuu9wiQcIi1LdCFMrTDOQ",
+	"d4T6Lpww4PorkcsObTCma92OlqEeAfqLUKvwiS3ad9ezdejuifnGCXr2TqXkQMUThP33BA31oRLy44LZ",
+	"8stUun/yMP7oD/VPEuuJLQdXl0fdl+InqxZdTirH+DtWvUTN+RY8HTvt89cWn12bPkNkNl0Aqb9Mg0H3",
+	"O8BvUleDHeA+WSwWCXJOas1BZDL3icb9ZM3FQGoPlLFLN7JQTMEb4KxiNnS3a6W6czqxbyK4Zrp9c9qY",
+	"5nXLYOxvXadctrpjv30Tv5NcDT1jgBn+Ydwg8E+Iw9Olb1YPxUkr/w+Mk81ZqysbHxU4AT4T5Dtys8HY",
+	"/6Z5xQSZrCZrXaqaW6aotgPM8aGhPWiTlmPqQiKnlnaV2555+6n2gDn8/rn0o5xxDcFmwO6H4S3l3NfA",
+	"k0HYPGA9fgj7rkPoNvOE2N/z068Kiu4l7ja5pBaS95g7utjmMKM1t2R8fhb338aa8N8e0DRpCeU9mI3W",
+	"crcZZIDtniALPZGRNcwO27f9bPQoLz8Uwu0JJyohbQl6QKB+RLrncD3uE39I4lwrcDhzPlVboLS0clrP",
+	"BmD7KZCe0B403HsHLe1Q/D4ppCdNwlIhZcFhVEhORTGSukgbTilSmPROyIVIF5oqBdqo6ejKKfcfyt19",
+	"7JGzi79U8N0bMGSlhDyaNzSt8aymjDNRfDGcmjI9VpDxq9N12HKFO555hb6v+AC3vK34cI+s+I9qlI76",
+	"1OOO3usVchNsj9uSDgLuf/QE5JDnXwXdkv547MKH6GYC0d3yScu8zvAhMs2X1rrzCflBQ8GkWI2oYpuf",
+	"kscPSmq7Suf43XVONcPPgeEOrm2nbyEvX75w/9nBceou1Yb0z7qRFOd4+JSD4nIZ6jeIusI4gxplNWSy",
+	"coOMrtSGinwq7zvfvufnTWILc44rT0RWk9UfAwDEtjluciIAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
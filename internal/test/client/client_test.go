@@ -1,9 +1,29 @@
 package client
 
 import (
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gopkg.in/yaml.v2"
 )
 
 func TestTemp(t *testing.T) {
@@ -42,3 +62,1011 @@ func TestTemp(t *testing.T) {
 	assert.Equal(t, expectedURL, client3.Server)
 	assert.Equal(t, expectedURL, client4.Server)
 }
+
+func TestStreamResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("X-Custom", "value")
+		_, _ = w.Write([]byte("streamed content"))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.GetOtherWithBodyStream(context.Background())
+	require.NoError(t, err)
+	defer rsp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, "value", rsp.Header.Get("X-Custom"))
+
+	body, err := ioutil.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "streamed content", string(body))
+}
+
+func TestMultipartBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		content, _ := ioutil.ReadAll(file)
+		_, _ = fmt.Fprintf(w, "%s:%s:%s", r.FormValue("name"), header.Filename, content)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	body := runtime.NewMultipartBody().
+		AddField("name", "report").
+		AddFile("file", "report.csv", strings.NewReader("a,b,c"), "text/csv")
+
+	rsp, err := client.PostMultipartWithMultipartBodyWithResponse(context.Background(), body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, "report:report.csv:a,b,c", string(rsp.Body))
+}
+
+func TestTypedMultipartBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bodies, err := DecodePostMultipartBody(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body := bodies.Multipart
+		content, err := body.File.Bytes()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s:%s:%s:%s", *body.Name, body.File.Filename(), body.File.ContentType(), content)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	name := "report"
+	var file openapi_types.File
+	file.InitFromReader(strings.NewReader("a,b,c"), "report.csv", "text/csv")
+	body, err := NewPostMultipartMultipartBody(PostMultipartMultipartRequestBody{Name: &name, File: &file})
+	require.NoError(t, err)
+
+	rsp, err := client.PostMultipartWithMultipartBodyWithResponse(context.Background(), body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, "report:report.csv:text/csv:a,b,c", string(rsp.Body))
+}
+
+func TestReferencedMultipartBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bodies, err := DecodePostDocumentBody(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		content, err := bodies.Multipart.Content.Bytes()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s:%s:%s", bodies.Multipart.Title, bodies.Multipart.Content.Filename(), content)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	// The body of a schema with binary properties has files, unlike the type
+	// of the schema.
+	var content openapi_types.File
+	content.InitFromBytes([]byte("text"), "doc.txt", "text/plain")
+	body, err := NewPostDocumentMultipartBody(PostDocumentMultipartRequestBody{Title: "doc", Content: content})
+	require.NoError(t, err)
+
+	rsp, err := client.PostDocumentWithMultipartBodyWithResponse(context.Background(), body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, "doc:doc.txt:text", string(rsp.Body))
+	assert.IsType(t, "", Document{}.Content)
+}
+
+func TestMultipartEncodingBody(t *testing.T) {
+	var tags []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bodies, err := DecodePostGalleryBody(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body := bodies.Multipart
+		tags = r.MultipartForm.Value["tags"]
+		for _, image := range *body.Images {
+			_, _ = fmt.Fprintf(w, "%s:%s:%s;", image.Filename(), image.ContentType(), image.Header().Get("X-Rate-Limit"))
+		}
+		_, _ = fmt.Fprint(w, strings.Join(*body.Tags, "|"))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	// Images without a content type are sent with the first of their encoding.
+	var png, jpeg openapi_types.File
+	png.InitFromBytes([]byte("png"), "a.png", "")
+	jpeg.InitFromBytes([]byte("jpeg"), "b.jpg", "image/jpeg")
+	body, err := NewPostGalleryMultipartBody(PostGalleryMultipartRequestBody{
+		Tags:   &[]string{"a", "b"},
+		Images: &[]openapi_types.File{png, jpeg},
+	})
+	require.NoError(t, err)
+
+	rsp, err := client.PostGalleryWithMultipartBodyWithResponse(context.Background(), body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, "a.png:image/png:10;b.jpg:image/jpeg:10;a|b", string(rsp.Body))
+	// Tags aren't exploded, so they're sent in a single part.
+	assert.Equal(t, []string{"a,b"}, tags)
+
+	// Images of other content types are rejected by the server.
+	var gif openapi_types.File
+	gif.InitFromBytes([]byte("gif"), "c.gif", "image/gif")
+	body, err = NewPostGalleryMultipartBody(PostGalleryMultipartRequestBody{Images: &[]openapi_types.File{gif}})
+	require.NoError(t, err)
+
+	rsp, err = client.PostGalleryWithMultipartBodyWithResponse(context.Background(), body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rsp.StatusCode())
+}
+
+func TestAllowReservedQueryParams(t *testing.T) {
+	var rawQuery string
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rawQuery = r.URL.RawQuery
+		query = r.URL.Query()
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+
+	tags := []string{"a/b", "c&d"}
+	rsp, err := client.ListFiles(context.Background(), &ListFilesParams{Path: "/docs/v1:draft", Tags: &tags})
+	require.NoError(t, err)
+	rsp.Body.Close()
+
+	assert.Equal(t, "path=/docs/v1:draft&tags=a/b,c%26d", rawQuery)
+	assert.Equal(t, "/docs/v1:draft", query.Get("path"))
+	assert.Equal(t, "a/b,c&d", query.Get("tags"))
+}
+
+func TestFormBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, "1|2", r.PostForm.Get("scores"))
+		var body PostFormFormdataRequestBody
+		err := runtime.UnmarshalForm(r.PostForm, &body, map[string]runtime.FormEncoding{"scores": {Style: "pipeDelimited"}})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	body := PostFormFormdataRequestBody{
+		Name:   "a&b=c",
+		Tags:   &[]string{"x", "y"},
+		Scores: &[]int{1, 2},
+	}
+	rsp, err := client.PostFormWithFormdataBodyWithResponse(context.Background(), body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.JSONEq(t, `{"name":"a&b=c","tags":["x","y"],"scores":[1,2]}`, string(rsp.Body))
+}
+
+func TestXMLBody(t *testing.T) {
+	var roots []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			SchemaObject
+			XMLName xml.Name
+		}
+		if err := xml.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		roots = append(roots, body.XMLName.Local)
+		body.Role = "echoed-" + body.Role
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.PostXmlWithXMLBodyWithResponse(context.Background(), PostXmlXMLRequestBody{
+		FirstName: "Alex",
+		Role:      "admin",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.XML200)
+	assert.Equal(t, "Alex", rsp.XML200.FirstName)
+	assert.Equal(t, "echoed-admin", rsp.XML200.Role)
+
+	// The root element is named after the schema, not the body type.
+	assert.Equal(t, []string{"SchemaObject"}, roots)
+}
+
+func TestOctetStreamBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil || r.Header.Get("Content-Type") != "application/octet-stream" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for i, j := 0, len(body)-1; i < j; i, j = i+1, j-1 {
+			body[i], body[j] = body[j], body[i]
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.ReverseBytesWithOctetStreamBodyWithResponse(context.Background(), []byte{1, 2, 3})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.OctetStream200)
+	assert.Equal(t, []byte{3, 2, 1}, *rsp.OctetStream200)
+}
+
+func TestDecodeNegotiatedBody(t *testing.T) {
+	var decoded []*PostBothBodies
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bodies, err := DecodePostBothBody(r)
+		var unsupported *runtime.UnsupportedMediaTypeError
+		if errors.As(err, &unsupported) {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		require.NoError(t, err)
+		decoded = append(decoded, bodies)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL)
+	require.NoError(t, err)
+
+	_, err = client.PostBoth(context.Background(), PostBothJSONRequestBody{FirstName: "Alex", Role: "admin"})
+	require.NoError(t, err)
+	_, err = client.PostBothWithOctetStreamBody(context.Background(), []byte{1, 2})
+	require.NoError(t, err)
+	_, err = client.PostBothWithBody(context.Background(), "application/pdf", strings.NewReader("%PDF"))
+	require.NoError(t, err)
+	rsp, err := client.PostBothWithBody(context.Background(), "text/plain", strings.NewReader("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnsupportedMediaType, rsp.StatusCode)
+
+	require.Len(t, decoded, 3)
+	require.NotNil(t, decoded[0].JSON)
+	assert.Nil(t, decoded[0].OctetStream)
+	assert.Equal(t, "Alex", decoded[0].JSON.FirstName)
+	require.NotNil(t, decoded[1].OctetStream)
+	assert.Nil(t, decoded[1].JSON)
+	assert.Equal(t, PostBothOctetStreamRequestBody{1, 2}, *decoded[1].OctetStream)
+	// Media types declared without a schema are kept as they are.
+	assert.Nil(t, decoded[2].JSON)
+	assert.Equal(t, []byte("%PDF"), decoded[2].Raw)
+	assert.Equal(t, "application/pdf", decoded[2].RawContentType)
+}
+
+func TestYAMLBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body SchemaObject
+		if err := yaml.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body.Role = "echoed-" + body.Role
+		w.Header().Set("Content-Type", "application/yaml")
+		_ = yaml.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.PostYamlWithYAMLBodyWithResponse(context.Background(), PostYamlYAMLRequestBody{
+		FirstName: "Alex",
+		Role:      "admin",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, rsp.YAML200)
+	assert.Equal(t, "Alex", rsp.YAML200.FirstName)
+	assert.Equal(t, "echoed-admin", rsp.YAML200.Role)
+}
+
+func TestProtobufBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		var body wrapperspb.StringValue
+		if err := proto.Unmarshal(data, &body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, _ = proto.Marshal(wrapperspb.String("echoed-" + body.GetValue()))
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.PostProtobufWithProtobufBodyWithResponse(context.Background(), wrapperspb.String("value"))
+	require.NoError(t, err)
+	require.NotNil(t, rsp.Protobuf200)
+	assert.Equal(t, "echoed-value", rsp.Protobuf200.GetValue())
+}
+
+func TestEventStream(t *testing.T) {
+	var lastEventIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastEventIDs = append(lastEventIDs, r.Header.Get("Last-Event-ID"))
+		if len(lastEventIDs) > 1 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprint(w, "retry: 1\nid: 7\ndata: hello\n\n")
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	stream, err := client.GetEventsWithEventStream(context.Background())
+	require.NoError(t, err)
+	defer stream.Close()
+
+	require.True(t, stream.Next())
+	assert.Equal(t, runtime.Event{ID: "7", Event: "message", Data: "hello"}, stream.Event())
+	assert.False(t, stream.Next())
+	assert.NoError(t, stream.Err())
+	assert.Equal(t, []string{"", "7"}, lastEventIDs)
+}
+
+func TestRequestCompression(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+		zr, err := gzip.NewReader(r.Body)
+		require.NoError(t, err)
+		var body SchemaObject
+		require.NoError(t, json.NewDecoder(zr).Decode(&body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		_ = json.NewEncoder(zw).Encode(body)
+		_ = zw.Close()
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL, WithRequestCompression(10))
+	require.NoError(t, err)
+
+	rsp, err := client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{
+		FirstName: "Alex",
+		Role:      "admin",
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"firstName": "Alex", "role": "admin"}`, string(rsp.Body))
+
+	_, err = NewClient(server.URL, WithRequestCompression(0))
+	assert.Error(t, err)
+}
+
+func TestRequestSigner(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "signed", r.Header.Get("X-Signature"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var order []string
+	client, err := NewClient(server.URL,
+		WithRequestCompression(10),
+		WithRequestSigner(func(ctx context.Context, req *http.Request) error {
+			// The signer sees the request as it's sent, after compression.
+			assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+			order = append(order, "signer")
+			req.Header.Set("X-Signature", "signed")
+			return nil
+		}),
+		WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			order = append(order, "editor")
+			return nil
+		}))
+	require.NoError(t, err)
+
+	rsp, err := client.PostJson(context.Background(), PostJsonJSONRequestBody{FirstName: "Alex", Role: "admin"})
+	require.NoError(t, err)
+	_ = rsp.Body.Close()
+	assert.Equal(t, []string{"editor", "signer"}, order)
+}
+
+func TestConditionalRequests(t *testing.T) {
+	lastModified := time.Date(2022, 5, 1, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if since, err := http.ParseTime(r.Header.Get("If-Modified-Since")); err == nil && !lastModified.After(since) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+		_, _ = w.Write([]byte(`{"firstName": "Alex", "role": "admin"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.GetCachedWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.False(t, rsp.NotModified())
+	require.NotNil(t, rsp.JSON200)
+	assert.Equal(t, `"v1"`, rsp.ETag())
+	modified, ok := rsp.LastModified()
+	assert.True(t, ok)
+	assert.True(t, lastModified.Equal(modified))
+
+	rsp, err = client.GetCachedWithResponse(context.Background(), WithIfNoneMatch(rsp.ETag()))
+	require.NoError(t, err)
+	assert.True(t, rsp.NotModified())
+	assert.Nil(t, rsp.JSON200)
+
+	rsp, err = client.GetCachedWithResponse(context.Background(), WithIfModifiedSince(modified))
+	require.NoError(t, err)
+	assert.True(t, rsp.NotModified())
+	_, ok = rsp.LastModified()
+	assert.False(t, ok)
+}
+
+func TestResponseLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/users":
+			w.Header().Set("X-Team", "blue")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 42, "name": "Alex"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/users/blue/42":
+			assert.Equal(t, "true", r.URL.Query().Get("verbose"))
+			_, _ = w.Write([]byte(`{"id": 42, "name": "Alex"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	created, err := client.CreateUserWithResponse(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, created.JSON201)
+
+	user, err := created.FollowGetUser(context.Background(), client)
+	require.NoError(t, err)
+	require.NotNil(t, user.JSON200)
+	assert.Equal(t, "Alex", user.JSON200.Name)
+
+	missingID := CreateUserResponse{Body: []byte(`{}`), HTTPResponse: created.HTTPResponse}
+	_, err = missingID.FollowGetUser(context.Background(), client)
+	assert.Error(t, err)
+
+	noResponse := CreateUserResponse{Body: created.Body}
+	_, err = noResponse.FollowGetUser(context.Background(), client)
+	assert.EqualError(t, err, "can't follow the GetUser link without a response")
+}
+
+func TestClientCredentialsProvider(t *testing.T) {
+	var tokenRequests int
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "users:read users:write", r.PostForm.Get("scope"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "secret-token", "token_type": "bearer", "expires_in": 3600}`))
+	}))
+	defer tokenServer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret-token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "name": "Alex"}`))
+	}))
+	defer server.Close()
+
+	config := ServiceAuthClientCredentials("id", "secret")
+	assert.Equal(t, "https://auth.example.com/oauth/token", config.TokenURL)
+	config.TokenURL = tokenServer.URL
+
+	client, err := NewClientWithResponses(server.URL,
+		WithRequestEditorFn(NewClientCredentialsProvider(context.Background(), config)))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		rsp, err := client.GetUserWithResponse(context.Background(), "blue", 1, &GetUserParams{})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	}
+	assert.Equal(t, 1, tokenRequests)
+}
+
+func TestSecuritySchemeProviders(t *testing.T) {
+	var req *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	send := func(editor RequestEditorFn) {
+		client, err := NewClient(server.URL, WithRequestEditorFn(editor))
+		require.NoError(t, err)
+		rsp, err := client.GetCached(context.Background())
+		require.NoError(t, err)
+		_ = rsp.Body.Close()
+	}
+
+	send(NewApiKeyHeaderAPIKeyProvider("key"))
+	assert.Equal(t, "key", req.Header.Get("X-API-Key"))
+
+	send(NewApiKeyQueryAPIKeyProvider("key"))
+	assert.Equal(t, "key", req.URL.Query().Get("api_key"))
+
+	send(NewApiKeyCookieAPIKeyProvider("key"))
+	cookie, err := req.Cookie("session")
+	require.NoError(t, err)
+	assert.Equal(t, "key", cookie.Value)
+
+	send(NewBearerAuthBearerTokenProvider("token"))
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+
+	send(NewBasicAuthBasicAuthProvider("user", "pass"))
+	username, password, ok := req.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "pass", password)
+}
+
+func TestDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/monthly" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", `attachment; filename="monthly.csv"`)
+		w.Header().Set("X-Report-Rows", "2")
+		_, _ = w.Write([]byte("a,b\n1,2\n"))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	var out strings.Builder
+	rsp, err := client.DownloadGetReport(context.Background(), "monthly", &out)
+	require.NoError(t, err)
+	assert.Equal(t, "a,b\n1,2\n", out.String())
+	assert.Equal(t, int64(8), rsp.BytesWritten)
+	require.NotNil(t, rsp.ContentDisposition)
+	assert.Equal(t, `attachment; filename="monthly.csv"`, *rsp.ContentDisposition)
+	assert.Equal(t, 2, rsp.XReportRows)
+
+	out.Reset()
+	rsp, err = client.DownloadGetReport(context.Background(), "missing", &out)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, rsp.HTTPResponse.StatusCode)
+	assert.Empty(t, out.String())
+}
+
+func TestBinaryBodyUpload(t *testing.T) {
+	var contentType string
+	var contentLength int64
+	var transferEncoding []string
+	var body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		contentLength = r.ContentLength
+		transferEncoding = r.TransferEncoding
+		buf, _ := ioutil.ReadAll(r.Body)
+		body = string(buf)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	// A reader of unknown length with an explicit length.
+	reader := ioutil.NopCloser(strings.NewReader("a,b\n"))
+	rsp, err := client.UploadReportWithBinaryBodyWithResponse(context.Background(), "", reader, 4)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode())
+	assert.Equal(t, "application/octet-stream", contentType)
+	assert.Equal(t, int64(4), contentLength)
+	assert.Empty(t, transferEncoding)
+	assert.Equal(t, "a,b\n", body)
+
+	// Without a length, the body is chunked.
+	reader = ioutil.NopCloser(strings.NewReader("a,b\n"))
+	_, err = client.UploadReportWithBinaryBodyWithResponse(context.Background(), "text/csv", reader, -1)
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv", contentType)
+	assert.Equal(t, []string{"chunked"}, transferEncoding)
+	assert.Equal(t, "a,b\n", body)
+
+	_, err = client.UploadImageWithBinaryBodyWithResponse(context.Background(), "", ioutil.NopCloser(strings.NewReader("")), 0)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+	assert.Equal(t, int64(0), contentLength)
+	assert.Empty(t, transferEncoding)
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 42, "name": "Alex"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	first, err := client.CreateUserWithResponse(context.Background())
+	require.NoError(t, err)
+	second, err := client.CreateUserWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Len(t, keys[0], 36)
+	assert.NotEqual(t, keys[0], keys[1])
+	assert.Equal(t, keys[0], first.IdempotencyKey())
+	assert.Equal(t, keys[1], second.IdempotencyKey())
+
+	// Retrying with the key of the first attempt.
+	retry, err := client.CreateUserWithResponse(context.Background(), WithIdempotencyKey(first.IdempotencyKey()))
+	require.NoError(t, err)
+	assert.Equal(t, keys[0], keys[2])
+	assert.Equal(t, keys[0], retry.IdempotencyKey())
+
+	// Operations without an idempotency key aren't given one.
+	_, err = client.GetCachedWithResponse(context.Background(), WithIdempotencyKey("key"))
+	require.NoError(t, err)
+	assert.Empty(t, keys[3])
+}
+
+func TestServerURLs(t *testing.T) {
+	serverURL, err := ProductionServerURL(ProductionServerVariables{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://us.api.example.com:443/v1", serverURL)
+
+	serverURL, err = ProductionServerURL(ProductionServerVariables{Region: ProductionServerRegionEu, Port: "8443"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://eu.api.example.com:8443/v1", serverURL)
+
+	_, err = ProductionServerURL(ProductionServerVariables{Region: "mars"})
+	assert.Error(t, err)
+
+	client, err := NewClient("", WithProductionServer(ProductionServerVariables{Region: ProductionServerRegionEu}))
+	require.NoError(t, err)
+	assert.Equal(t, "https://eu.api.example.com:443/v1/", client.Server)
+
+	client, err = NewClient("", WithSandboxServer())
+	require.NoError(t, err)
+	assert.Equal(t, SandboxServerURL+"/", client.Server)
+}
+
+func TestServerFailover(t *testing.T) {
+	var hits []string
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits = append(hits, "unavailable")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+	available := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits = append(hits, "available")
+		var body SchemaObject
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "Alex", body.FirstName)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer available.Close()
+
+	client, err := NewClientWithResponses("", WithServers(runtime.ServerFailover, unavailable.URL, available.URL))
+	require.NoError(t, err)
+
+	rsp, err := client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{FirstName: "Alex", Role: "admin"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, []string{"unavailable", "available"}, hits)
+
+	hits = nil
+	client, err = NewClientWithResponses("", WithServers(runtime.ServerRoundRobin, available.URL, unavailable.URL))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		rsp, err = client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{FirstName: "Alex", Role: "admin"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	}
+	assert.Equal(t, []string{"available", "unavailable", "available"}, hits)
+}
+
+func TestLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = w.Write([]byte(`{"firstName":"Alex","role":"admin"}`))
+	}))
+	defer server.Close()
+
+	var records []runtime.OperationRecord
+	logger := runtime.OperationLoggerFunc(func(ctx context.Context, record runtime.OperationRecord) {
+		records = append(records, record)
+	})
+	client, err := NewClientWithResponses(server.URL, WithLogger(logger), WithRequestEditorFn(NewBearerAuthBearerTokenProvider("secret")))
+	require.NoError(t, err)
+
+	rsp, err := client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{FirstName: "Alex", Role: "admin"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+
+	require.Len(t, records, 1)
+	record := records[0]
+	assert.Equal(t, "PostJson", record.OperationID)
+	assert.Equal(t, http.MethodPost, record.Method)
+	assert.Equal(t, server.URL+"/with_json_body", record.URL)
+	assert.Equal(t, http.StatusOK, record.StatusCode)
+	assert.Equal(t, "REDACTED", record.RequestHeader.Get("Authorization"))
+	assert.Equal(t, "application/json", record.RequestHeader.Get("Content-Type"))
+	assert.Equal(t, "REDACTED", record.ResponseHeader.Get("Set-Cookie"))
+	assert.NoError(t, record.Err)
+
+	client, err = NewClientWithResponses("http://127.0.0.1:0", WithLogger(logger))
+	require.NoError(t, err)
+	_, err = client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{FirstName: "Alex", Role: "admin"})
+	require.Error(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, 0, records[1].StatusCode)
+	assert.Equal(t, err, records[1].Err)
+}
+
+func TestCallHooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	// A breaker opening after two failures of an operation.
+	type attemptKey struct{}
+	failures := map[string]int{}
+	errOpen := errors.New("circuit open")
+	before := func(ctx context.Context, operationID string) (context.Context, error) {
+		if failures[operationID] >= 2 {
+			return ctx, errOpen
+		}
+		return context.WithValue(ctx, attemptKey{}, operationID), nil
+	}
+	after := func(ctx context.Context, operationID string, rsp *http.Response, err error) {
+		assert.Equal(t, operationID, ctx.Value(attemptKey{}))
+		if err != nil || rsp.StatusCode >= 500 {
+			failures[operationID]++
+		}
+	}
+
+	client, err := NewClientWithResponses(server.URL, WithCallHooks(before, after))
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		rsp, err := client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{FirstName: "Alex", Role: "admin"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rsp.StatusCode())
+	}
+	_, err = client.PostJsonWithResponse(context.Background(), PostJsonJSONRequestBody{FirstName: "Alex", Role: "admin"})
+	assert.Equal(t, errOpen, err)
+	assert.Equal(t, map[string]int{"PostJson": 2}, failures)
+
+	// Other operations are still called.
+	rsp, err := client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, rsp.StatusCode())
+}
+
+func TestTransportOptions(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.UserAgent())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"firstName":"Alex","role":"admin"}`))
+	}))
+	defer server.Close()
+
+	// The server's certificate is only trusted with the TLS option.
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	_, err = client.GetJsonWithResponse(context.Background())
+	require.Error(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	var idleTimeout time.Duration
+	client, err = NewClientWithResponses(server.URL, WithTLSConfig(&tls.Config{RootCAs: roots}), WithTransport(func(transport *http.Transport) {
+		transport.IdleConnTimeout = time.Minute
+		idleTimeout = transport.IdleConnTimeout
+	}))
+	require.NoError(t, err)
+	rsp, err := client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode())
+	assert.Equal(t, time.Minute, idleTimeout)
+
+	client, err = NewClientWithResponses(server.URL, WithTLSConfig(&tls.Config{RootCAs: roots}), WithUserAgent("pets-cli/2.0"))
+	require.NoError(t, err)
+	_, err = client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Test-Server/1.0.0", "pets-cli/2.0"}, userAgents)
+
+	// User-Agent header parameters aren't replaced.
+	userAgent := "pets-cli/3.0"
+	_, err = client.GetAgent(context.Background(), &GetAgentParams{UserAgent: &userAgent})
+	require.NoError(t, err)
+	_, err = client.GetAgent(context.Background(), &GetAgentParams{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pets-cli/3.0", "pets-cli/2.0"}, userAgents[2:])
+
+	// Neither a client passed with WithHTTPClient nor the default transport
+	// are changed.
+	httpClient := &http.Client{Timeout: time.Minute}
+	client, err = NewClientWithResponses(server.URL, WithHTTPClient(httpClient), WithTLSConfig(&tls.Config{RootCAs: roots}))
+	require.NoError(t, err)
+	_, err = client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, httpClient.Transport)
+	if config := http.DefaultTransport.(*http.Transport).TLSClientConfig; config != nil {
+		assert.Nil(t, config.RootCAs)
+	}
+	assert.Equal(t, time.Minute, client.ClientInterface.(*Client).Client.(*http.Client).Timeout)
+
+	// Requests to plain HTTP servers are sent through the proxy.
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+	client, err = NewClientWithResponses("http://api.example.com", WithProxy(http.ProxyURL(proxyURL)))
+	require.NoError(t, err)
+	rsp, err = client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode())
+	assert.Equal(t, []string{"http://api.example.com/with_json_response"}, proxied)
+
+	// Transport options can't configure other doers.
+	_, err = NewClientWithResponses(server.URL, WithHTTPClient(doerFunc(http.DefaultClient.Do)), WithTLSConfig(&tls.Config{}))
+	assert.Error(t, err)
+}
+
+// doerFunc allows using a function as an HttpRequestDoer.
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestCorrelationID(t *testing.T) {
+	var ids []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids = append(ids, r.Header.Get("X-Request-ID"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"firstName":"Alex","role":"admin"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL, WithRequestEditorFn(PropagateCorrelationID))
+	require.NoError(t, err)
+
+	ctx := runtime.ContextWithCorrelationID(context.Background(), "abc-123")
+	rsp, err := client.GetJsonWithResponse(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "abc-123", rsp.CorrelationID())
+
+	// A correlation ID is generated when the context has none.
+	rsp, err = client.GetJsonWithResponse(context.Background())
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	assert.Equal(t, "abc-123", ids[0])
+	assert.NotEmpty(t, ids[1])
+	assert.Equal(t, ids[1], rsp.CorrelationID())
+}
+
+func TestBulkHelpers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("team") == "broken" {
+			http.Error(w, "broken", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `[{"id":%s,"name":%q}]`, r.URL.Query().Get("page"), r.URL.Query().Get("team"))
+	}))
+	defer server.Close()
+
+	client, err := NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+
+	team := "core"
+	params := make([]ListUsersParams, 10)
+	for i := range params {
+		page := i
+		params[i] = ListUsersParams{Team: &team, Page: &page}
+	}
+	responses, err := client.ListUsersBulkWithResponse(context.Background(), params, 3)
+	require.NoError(t, err)
+	require.Len(t, responses, len(params))
+	for i, rsp := range responses {
+		require.NotNil(t, rsp.JSON200)
+		require.Len(t, *rsp.JSON200, 1)
+		assert.Equal(t, i, (*rsp.JSON200)[0].Id)
+		assert.Equal(t, "core", (*rsp.JSON200)[0].Name)
+	}
+
+	// Error responses are returned like those of the other helpers, while
+	// failing calls fail the whole batch.
+	broken := "broken"
+	responses, err = client.ListUsersBulkWithResponse(context.Background(), []ListUsersParams{{Team: &broken}}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, responses[0].StatusCode())
+
+	failing, err := NewClientWithResponses(server.URL, WithHTTPClient(doerFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})))
+	require.NoError(t, err)
+	_, err = failing.ListUsersBulkWithResponse(context.Background(), params, 3)
+	assert.EqualError(t, err, "connection refused")
+}
@@ -0,0 +1,236 @@
+// Package constructors provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package constructors
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Error defines model for Error.
+type Error struct {
+	Code    int     `json:"code"`
+	Message *string `json:"message,omitempty"`
+}
+
+// NewPet defines model for NewPet.
+type NewPet struct {
+	Age  *int    `json:"age,omitempty"`
+	Name string  `json:"name"`
+	Tag  *string `json:"tag,omitempty"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Age    *int      `json:"age,omitempty"`
+	Labels *[]string `json:"labels,omitempty"`
+	Name   string    `json:"name"`
+	Owner  *string   `json:"owner"`
+	Tag    *string   `json:"tag,omitempty"`
+	Type   string    `json:"type"`
+}
+
+// Settings defines model for Settings.
+type Settings struct {
+	Locale               *string           `json:"locale,omitempty"`
+	Theme                *string           `json:"theme,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Getter for additional properties for Settings. Returns the specified
+// element and whether it was found
+func (a Settings) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Settings
+func (a *Settings) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Settings to handle AdditionalProperties
+func (a *Settings) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["locale"]; found {
+		err = json.Unmarshal(raw, &a.Locale)
+		if err != nil {
+			return fmt.Errorf("error reading 'locale': %w", err)
+		}
+		delete(object, "locale")
+	}
+
+	if raw, found := object["theme"]; found {
+		err = json.Unmarshal(raw, &a.Theme)
+		if err != nil {
+			return fmt.Errorf("error reading 'theme': %w", err)
+		}
+		delete(object, "theme")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Settings to handle AdditionalProperties
+func (a Settings) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Locale != nil {
+		object["locale"], err = json.Marshal(a.Locale)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'locale': %w", err)
+		}
+	}
+
+	if a.Theme != nil {
+		object["theme"], err = json.Marshal(a.Theme)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'theme': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// NewPetOption sets an optional property of a NewPet built by
+// NewNewPet.
+type NewPetOption func(*NewPet)
+
+// NewNewPet returns a NewPet with the required properties, and the
+// optional ones which the options set.
+func NewNewPet(name string, opts ...NewPetOption) NewPet {
+	m := NewPet{
+		Name: name,
+	}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	return m
+}
+
+// WithNewPetAge sets the age property of a NewPet.
+func WithNewPetAge(value int) NewPetOption {
+	return func(m *NewPet) {
+		m.Age = &value
+	}
+}
+
+// WithNewPetTag sets the tag property of a NewPet.
+func WithNewPetTag(value string) NewPetOption {
+	return func(m *NewPet) {
+		m.Tag = &value
+	}
+}
+
+// PetOption sets an optional property of a Pet built by
+// NewPetValue.
+type PetOption func(*Pet)
+
+// NewPetValue returns a Pet with the required properties, and the
+// optional ones which the options set.
+func NewPetValue(name string, pType string, opts ...PetOption) Pet {
+	m := Pet{
+		Name: name,
+		Type: pType,
+	}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	return m
+}
+
+// WithPetAge sets the age property of a Pet.
+func WithPetAge(value int) PetOption {
+	return func(m *Pet) {
+		m.Age = &value
+	}
+}
+
+// WithPetLabels sets the labels property of a Pet.
+func WithPetLabels(value []string) PetOption {
+	return func(m *Pet) {
+		m.Labels = &value
+	}
+}
+
+// WithPetOwner sets the owner property of a Pet.
+func WithPetOwner(value string) PetOption {
+	return func(m *Pet) {
+		m.Owner = &value
+	}
+}
+
+// WithPetTag sets the tag property of a Pet.
+func WithPetTag(value string) PetOption {
+	return func(m *Pet) {
+		m.Tag = &value
+	}
+}
+
+// SettingsOption sets an optional property of a Settings built by
+// NewSettings.
+type SettingsOption func(*Settings)
+
+// NewSettings returns a Settings with the required properties, and the
+// optional ones which the options set.
+func NewSettings(opts ...SettingsOption) Settings {
+	m := Settings{}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	return m
+}
+
+// WithSettingsLocale sets the locale property of a Settings.
+func WithSettingsLocale(value string) SettingsOption {
+	return func(m *Settings) {
+		m.Locale = &value
+	}
+}
+
+// WithSettingsTheme sets the theme property of a Settings.
+func WithSettingsTheme(value string) SettingsOption {
+	return func(m *Settings) {
+		m.Theme = &value
+	}
+}
+
+// WithSettingsAdditionalProperty sets an additional property of a
+// Settings.
+func WithSettingsAdditionalProperty(name string, value string) SettingsOption {
+	return func(m *Settings) {
+		if m.AdditionalProperties == nil {
+			m.AdditionalProperties = make(map[string]string)
+		}
+		m.AdditionalProperties[name] = value
+	}
+}
@@ -0,0 +1,27 @@
+package constructors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstructors(t *testing.T) {
+	pet := NewPetValue("Rex", "dog", WithPetTag("good"), WithPetLabels([]string{"a"}))
+	assert.Equal(t, "Rex", pet.Name)
+	assert.Equal(t, "dog", pet.Type)
+	assert.Equal(t, "good", *pet.Tag)
+	assert.Equal(t, []string{"a"}, *pet.Labels)
+	assert.Nil(t, pet.Age)
+	assert.Nil(t, pet.Owner)
+
+	newPet := NewNewPet("Rex", WithNewPetAge(3))
+	assert.Equal(t, "Rex", newPet.Name)
+	assert.Nil(t, newPet.Tag)
+	assert.Equal(t, 3, *newPet.Age)
+
+	settings := NewSettings(WithSettingsTheme("dark"), WithSettingsAdditionalProperty("font", "mono"))
+	assert.Equal(t, "dark", *settings.Theme)
+	assert.Nil(t, settings.Locale)
+	assert.Equal(t, map[string]string{"font": "mono"}, settings.AdditionalProperties)
+}
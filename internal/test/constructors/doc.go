@@ -0,0 +1,3 @@
+package constructors
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=constructors --generate types,skip-prune --option-constructors 2 -o constructors.gen.go constructors.yaml
@@ -0,0 +1,415 @@
+// Package contract provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package contract
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+)
+
+// NewThing defines model for NewThing.
+type NewThing struct {
+	Name string `json:"name"`
+	Size *int   `json:"size,omitempty"`
+}
+
+// Thing defines model for Thing.
+type Thing struct {
+	Id   *int   `json:"id,omitempty"`
+	Name string `json:"name"`
+	Size *int   `json:"size,omitempty"`
+}
+
+// SearchThingsFormdataBody defines parameters for SearchThings.
+type SearchThingsFormdataBody struct {
+	Limit *int   `json:"limit,omitempty"`
+	Query string `json:"query"`
+}
+
+// CreateThingJSONBody defines parameters for CreateThing.
+type CreateThingJSONBody NewThing
+
+// GetThingParams defines parameters for GetThing.
+type GetThingParams struct {
+	Fields  *[]GetThingParamsFields `json:"fields,omitempty"`
+	XTenant string                  `json:"X-Tenant"`
+	Session *openapi_types.UUID     `json:"session,omitempty"`
+}
+
+// GetThingParamsFields defines parameters for GetThing.
+type GetThingParamsFields string
+
+// SearchThingsFormdataRequestBody defines body for SearchThings for application/x-www-form-urlencoded ContentType.
+type SearchThingsFormdataRequestBody SearchThingsFormdataBody
+
+// CreateThingJSONRequestBody defines body for CreateThing for application/json ContentType.
+type CreateThingJSONRequestBody CreateThingJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (POST /search)
+	SearchThings(w http.ResponseWriter, r *http.Request)
+
+	// (POST /things)
+	CreateThing(w http.ResponseWriter, r *http.Request)
+
+	// (GET /things/{id})
+	GetThing(w http.ResponseWriter, r *http.Request, id int, params GetThingParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+
+// SearchThings operation middleware
+func (siw *ServerInterfaceWrapper) SearchThings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchThings(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CreateThing operation middleware
+func (siw *ServerInterfaceWrapper) CreateThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateThing(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetThing operation middleware
+func (siw *ServerInterfaceWrapper) GetThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetThingParams
+
+	// ------------- Optional query parameter "fields" -------------
+	if paramValue := r.URL.Query().Get("fields"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Tenant" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Tenant")]; found {
+		var XTenant string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Tenant", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Tenant", runtime.ParamLocationHeader, valueList[0], &XTenant)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Tenant", Err: err})
+			return
+		}
+
+		params.XTenant = XTenant
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Tenant is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Tenant", Err: err})
+		return
+	}
+
+	var cookie *http.Cookie
+
+	if cookie, err = r.Cookie("session"); err == nil {
+		var value openapi_types.UUID
+		err = runtime.BindStyledParameterWithLocation("simple", true, "session", runtime.ParamLocationCookie, cookie.Value, &value)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "session", Err: err})
+			return
+		}
+		params.Session = &value
+
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetThing(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/search", runtime.LogHandlerFunc(options.Logger, "SearchThings", wrapper.SearchThings))
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/things", runtime.LogHandlerFunc(options.Logger, "CreateThing", wrapper.CreateThing))
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/things/{id}", runtime.LogHandlerFunc(options.Logger, "GetThing", wrapper.GetThing))
+	})
+
+	return r
+}
+
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := runtime.RequestCorrelationID(r.Header, "X-Request-ID")
+		w.Header().Set("X-Request-ID", id)
+		next(w, r.WithContext(runtime.ContextWithCorrelationID(r.Context(), id)))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/6RUS2/bOBD+K8bsHmVLSrIXHTeHxQJFcmgOBQIfWHJsMRUfIUdxXEP/vSBpPSynrtFe",
+	"bIHz+uabb+YA3ChrNGryUB3A8xoVi58PuHuqpd6Gb+uMRUcSo0UzheGf9hahAk8uuHUZePk9GpTUUrUK",
+	"qjLrnaQm3KKDrsvA4WsrHQqonlOu9eBmvr4gp5BrqM2a5nED1fMB/na4gQr+ykfM+RFwPqDtsjlcKcKv",
+	"QyYedbOHilyLv8QlxQeo1l1wk3pjYvuSmmC9N5oc47Qg9OQhgzd0XhoNFZSrYlWEboxFzayECm5XxaqE",
+	"DCyjOsLLPTLH6/BpjafwH9Azkkb/L6CCz9Eeu/OQUKKnf43YB19uNKGOYczaRvIYmL8vd7vdcmOcWrau",
+	"Qc2NQDFO+HyojVQyZlHsPQ3vn+KcpQxeW3T745A/od5SDdVtNtfCjM0UdE7oqV8YTHzw1mifYN0UxYUu",
+	"X3yg+QD4zpRtUoQ2OorwjTUtQvW8DsI06uQtiaLMjlKGnRRbpKidYLgZDFsWDb20yyCBbMKhJFSx6iVt",
+	"9sLsu2fOsX1qXqDnTlpKcnmqcUFxzouNabUIPl0GeXr7uULuHTLCVOZagcyoG/e6J6Pv+fak4et28Jq5",
+	"lleAu67upOg5nzxyIxbUO42E5gcpupB8ix+Q+h9Sz6hljikkdD5pJ+QO+wuDTqSAecdT1saDWHx0eY4p",
+	"05YMOTcSG+FhmmeQG+pWDefzOKl1dn6RZ4rLJvMGxmNsLF0jE+jG2l+WT6iZpotdne38sQ9uzDeJYzKP",
+	"Pt7DaWy4TIyggraN3M1zrf/kDvRXvywGDL8t6ovion6z74q7kOjU48EsfMvrifS67scAMinqHHEHAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	var res = make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	var resolvePath = PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		var pathToFile = url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,169 @@
+// Package contract provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package contract
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/responsevalidator"
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/getkin/kin-openapi/openapi3filter"
+)
+
+// ContractTestCase is the request with which RunContractTests exercises an
+// operation, built from the examples of the spec.
+type ContractTestCase struct {
+	OperationID string
+	Method      string
+	Path        string // The path with its parameters and query
+	Header      http.Header
+	Body        string
+	Skip        string // Why the operation isn't exercised, when its request can't be built from the examples
+}
+
+// ContractTestCases returns the requests with which RunContractTests
+// exercises the operations, one for each.
+func ContractTestCases() []ContractTestCase {
+	return []ContractTestCase{
+		{
+			OperationID: "SearchThings",
+			Method:      "POST",
+			Path:        "/search",
+			Header: http.Header{
+				"Content-Type": []string{"application/x-www-form-urlencoded"},
+			},
+			Body: "limit=1&query=example",
+		},
+		{
+			OperationID: "CreateThing",
+			Method:      "POST",
+			Path:        "/things",
+			Header: http.Header{
+				"Content-Type": []string{"application/json"},
+			},
+			Body: "{\"name\":\"widget\",\"size\":3}",
+		},
+		{
+			OperationID: "GetThing",
+			Method:      "GET",
+			Path:        "/things/10?fields=name",
+			Header: http.Header{
+				"Cookie":   []string{"session=3fa85f64-5717-4562-b3fc-2c963f66afa6"},
+				"X-Tenant": []string{"acme"},
+			},
+			Body: "",
+		},
+	}
+}
+
+// RunContractTests sends every operation of the spec a request built from its
+// examples, and checks that the responses of the handler, such as the one
+// serving a ServerInterface implementation, conform to the spec, with a
+// subtest for each operation. The editors change the requests before they
+// are sent, to authenticate them for instance.
+func RunContractTests(t *testing.T, handler http.Handler, editors ...func(*http.Request)) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		t.Fatalf("error loading the spec: %s", err)
+	}
+	// The requests go straight to the handler, whatever the servers of the
+	// spec.
+	swagger.Servers = nil
+	validator, err := responsevalidator.NewResponseValidatorWithOptions(swagger, &responsevalidator.Options{
+		Options: openapi3filter.Options{IncludeResponseStatus: true},
+	})
+	if err != nil {
+		t.Fatalf("error creating the response validator: %s", err)
+	}
+
+	for _, tc := range ContractTestCases() {
+		tc := tc
+		t.Run(tc.OperationID, func(t *testing.T) {
+			if tc.Skip != "" {
+				t.Skip(tc.Skip)
+			}
+			req := httptest.NewRequest(tc.Method, tc.Path, strings.NewReader(tc.Body))
+			for name, values := range tc.Header {
+				req.Header[name] = append([]string(nil), values...)
+			}
+			for _, editor := range editors {
+				editor(req)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if err := validator.Validate(req.Context(), req, rec.Result()); err != nil {
+				t.Errorf("%s %s: %s", tc.Method, tc.Path, err)
+			}
+		})
+	}
+}
+
+// TestResponseExamples unmarshals every JSON response example of the spec
+// into the type of its response and marshals it back, failing when the type
+// doesn't know fields of the example, or loses or changes its values.
+func TestResponseExamples(t *testing.T) {
+	examples := []struct {
+		name    string
+		example string
+		value   interface{}
+	}{
+		{name: "SearchThings/200/none", example: "[]", value: new([]Thing)},
+		{name: "SearchThings/200/some", example: "[{\"id\":1,\"name\":\"widget\"},{\"id\":2,\"name\":\"gadget\",\"size\":1}]", value: new([]Thing)},
+		{name: "GetThing/200/example", example: "{\"id\":10,\"name\":\"widget\",\"size\":3}", value: new(Thing)},
+	}
+	for _, ex := range examples {
+		ex := ex
+		t.Run(ex.name, func(t *testing.T) {
+			if err := runtime.JSONRoundTrip([]byte(ex.example), ex.value); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// assertSchemaValid reports an error to t and returns false when body isn't
+// JSON valid against the component schema with the given name, of the
+// embedded spec.
+func assertSchemaValid(t testing.TB, name string, body []byte) bool {
+	t.Helper()
+	swagger, err := GetSwagger()
+	if err != nil {
+		t.Errorf("error loading the spec: %s", err)
+		return false
+	}
+	schema := swagger.Components.Schemas[name]
+	if schema == nil || schema.Value == nil {
+		t.Errorf("the spec has no %s schema", name)
+		return false
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		t.Errorf("invalid JSON of %s: %s", name, err)
+		return false
+	}
+	if err := schema.Value.VisitJSON(value); err != nil {
+		t.Errorf("invalid %s: %s", name, err)
+		return false
+	}
+	return true
+}
+
+// AssertNewThingValid reports an error to t and returns false when body
+// isn't JSON valid against the NewThing schema.
+func AssertNewThingValid(t testing.TB, body []byte) bool {
+	t.Helper()
+	return assertSchemaValid(t, "NewThing", body)
+}
+
+// AssertThingValid reports an error to t and returns false when body
+// isn't JSON valid against the Thing schema.
+func AssertThingValid(t testing.TB, body []byte) bool {
+	t.Helper()
+	return assertSchemaValid(t, "Thing", body)
+}
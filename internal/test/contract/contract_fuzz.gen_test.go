@@ -0,0 +1,63 @@
+//go:build go1.18
+
+// Package contract provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package contract
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// FuzzSearchThings sends requests made of mutated examples of the spec
+// to SearchThings of the handler which fuzzHandler returns, failing when
+// binding their parameters or handling them panics.
+func FuzzSearchThings(f *testing.F) {
+	handler := fuzzHandler(f)
+	f.Add("", []byte("limit=1&query=example"))
+	f.Fuzz(func(t *testing.T, query string, body []byte) {
+		path := "/search"
+		req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+		req.URL.RawQuery = query
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	})
+}
+
+// FuzzCreateThing sends requests made of mutated examples of the spec
+// to CreateThing of the handler which fuzzHandler returns, failing when
+// binding their parameters or handling them panics.
+func FuzzCreateThing(f *testing.F) {
+	handler := fuzzHandler(f)
+	f.Add("", []byte("{\"name\":\"widget\",\"size\":3}"))
+	f.Fuzz(func(t *testing.T, query string, body []byte) {
+		path := "/things"
+		req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+		req.URL.RawQuery = query
+		req.Header.Set("Content-Type", "application/json")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	})
+}
+
+// FuzzGetThing sends requests made of mutated examples of the spec
+// to GetThing of the handler which fuzzHandler returns, failing when
+// binding their parameters or handling them panics.
+func FuzzGetThing(f *testing.F) {
+	handler := fuzzHandler(f)
+	f.Add("10", "fields=name", "acme", "session=3fa85f64-5717-4562-b3fc-2c963f66afa6", []byte(""))
+	f.Fuzz(func(t *testing.T, path0 string, query string, header0 string, cookie string, body []byte) {
+		path := "/things/{id}"
+		path = strings.NewReplacer(
+			"{id}", url.PathEscape(path0),
+		).Replace(path)
+		req := httptest.NewRequest("GET", path, bytes.NewReader(body))
+		req.URL.RawQuery = query
+		req.Header.Set("X-Tenant", header0)
+		req.Header.Set("Cookie", cookie)
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	})
+}
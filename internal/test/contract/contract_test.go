@@ -0,0 +1,102 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+// things is a ServerInterface implementation which keeps the parameters it
+// is called with.
+type things struct {
+	id     int
+	params GetThingParams
+	search SearchThingsFormdataBody
+}
+
+var _ ServerInterface = (*things)(nil)
+
+func (s *things) SearchThings(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := runtime.UnmarshalForm(r.PostForm, &s.search, nil); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, http.StatusOK, []Thing{})
+}
+
+func (s *things) CreateThing(w http.ResponseWriter, r *http.Request) {
+	var body CreateThingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	id := 1
+	writeJSON(w, http.StatusCreated, Thing{Id: &id, Name: body.Name, Size: body.Size})
+}
+
+func (s *things) GetThing(w http.ResponseWriter, r *http.Request, id int, params GetThingParams) {
+	s.id, s.params = id, params
+	writeJSON(w, http.StatusOK, Thing{Id: &id, Name: "widget"})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// fuzzHandler returns the handler of the fuzz targets.
+func fuzzHandler(tb testing.TB) http.Handler {
+	return Handler(&things{})
+}
+
+func TestContract(t *testing.T) {
+	s := &things{}
+	RunContractTests(t, Handler(s))
+
+	// The server got the examples of the parameters.
+	assert.Equal(t, 10, s.id)
+	assert.Equal(t, "acme", s.params.XTenant)
+	if assert.NotNil(t, s.params.Fields) {
+		assert.Equal(t, []GetThingParamsFields{"name"}, *s.params.Fields)
+	}
+	if assert.NotNil(t, s.params.Session) {
+		assert.Equal(t, "3fa85f64-5717-4562-b3fc-2c963f66afa6", s.params.Session.String())
+	}
+	assert.Equal(t, "example", s.search.Query)
+}
+
+// errorRecorder is a testing.TB recording the errors reported to it.
+type errorRecorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *errorRecorder) Helper() {}
+
+func (r *errorRecorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestSchemaAssertions(t *testing.T) {
+	assert.True(t, AssertThingValid(t, []byte(`{"id":1,"name":"widget","size":3}`)))
+	assert.True(t, AssertNewThingValid(t, []byte(`{"name":"widget"}`)))
+
+	r := &errorRecorder{TB: t}
+	assert.False(t, AssertThingValid(r, []byte(`{"id":1,"name":"widget","size":0}`)))
+	assert.False(t, AssertNewThingValid(r, []byte(`{"size":1}`)))
+	assert.False(t, AssertNewThingValid(r, []byte(`{`)))
+	if assert.Len(t, r.errors, 3) {
+		assert.Contains(t, r.errors[0], "invalid Thing")
+		assert.Contains(t, r.errors[1], `property "name" is missing`)
+		assert.Contains(t, r.errors[2], "invalid JSON of NewThing")
+	}
+}
@@ -0,0 +1,5 @@
+package contract
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=contract --generate types,chi-server,spec -o contract.gen.go contract.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=contract --generate contract-tests,example-tests,schema-assertions -o contract.gen_test.go contract.yaml
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=contract --generate fuzz-tests -o contract_fuzz.gen_test.go contract.yaml
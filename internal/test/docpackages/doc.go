@@ -0,0 +1,3 @@
+package docpackages
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=api --document-packages=github.com/deepmap/oapi-codegen/internal/test/docpackages --generate=types,client,spec -o . spec.yaml
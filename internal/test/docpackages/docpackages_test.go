@@ -0,0 +1,42 @@
+package docpackages
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/internal/test/docpackages/api"
+	"github.com/deepmap/oapi-codegen/internal/test/docpackages/commonowners"
+	"github.com/deepmap/oapi-codegen/internal/test/docpackages/commonpets"
+)
+
+func TestDocumentPackages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"name": "Rex", "owner": {"name": "Ann"}}]`))
+	}))
+	defer server.Close()
+
+	client, err := api.NewClientWithResponses(server.URL)
+	require.NoError(t, err)
+	rsp, err := client.ListPetsWithResponse(context.Background())
+	require.NoError(t, err)
+
+	// The types of the referenced specs come from their own packages.
+	assert.Equal(t, &[]commonpets.Pet{{
+		Name:  "Rex",
+		Owner: &commonowners.Owner{Name: "Ann"},
+	}}, rsp.JSON200)
+}
+
+func TestGetSwagger(t *testing.T) {
+	// The embedded specs resolve the references between them.
+	swagger, err := api.GetSwagger()
+	require.NoError(t, err)
+	schema := swagger.Paths["/pets"].Get.Responses["200"].Value.Content["application/json"].Schema.Value
+	assert.Contains(t, schema.Items.Value.Properties["owner"].Value.Required, "name")
+}
@@ -0,0 +1,3 @@
+package factories
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=factories --generate types,property-generators,skip-prune --factories -o factories.gen.go factories.yaml
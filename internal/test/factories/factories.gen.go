@@ -0,0 +1,210 @@
+// Package factories provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package factories
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/deepmap/oapi-codegen/pkg/generators"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+)
+
+// Defines values for Kind.
+const (
+	KindCat Kind = "cat"
+
+	KindDog Kind = "dog"
+)
+
+// Kind defines model for Kind.
+type Kind string
+
+// Labels defines model for Labels.
+type Labels struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Node defines model for Node.
+type Node struct {
+	Children []Node `json:"children"`
+	Name     string `json:"name"`
+	Parent   *Node  `json:"parent,omitempty"`
+}
+
+// Owner defines model for Owner.
+type Owner struct {
+	Email openapi_types.Email `json:"email"`
+	Name  string              `json:"name"`
+}
+
+// Pet defines model for Pet.
+type Pet struct {
+	Age    *int                `json:"age,omitempty"`
+	Born   openapi_types.Date  `json:"born"`
+	Id     *openapi_types.UUID `json:"id,omitempty"`
+	Kind   Kind                `json:"kind"`
+	Name   string              `json:"name"`
+	Owner  Owner               `json:"owner"`
+	Tags   *[]string           `json:"tags,omitempty"`
+	Weight *float32            `json:"weight,omitempty"`
+}
+
+// Getter for additional properties for Labels. Returns the specified
+// element and whether it was found
+func (a Labels) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Labels
+func (a *Labels) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Labels to handle AdditionalProperties
+func (a *Labels) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Labels to handle AdditionalProperties
+func (a Labels) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// LabelsFactory returns a valid Labels, built from the examples
+// of the spec, with the overrides applied to it in order. It panics when the
+// examples don't fit the type.
+func LabelsFactory(overrides ...func(*Labels)) Labels {
+	var v Labels
+	if err := json.Unmarshal([]byte("{}"), &v); err != nil {
+		panic(fmt.Sprintf("error building Labels: %s", err))
+	}
+	for _, override := range overrides {
+		override(&v)
+	}
+	return v
+}
+
+// NodeFactory returns a valid Node, built from the examples
+// of the spec, with the overrides applied to it in order. It panics when the
+// examples don't fit the type.
+func NodeFactory(overrides ...func(*Node)) Node {
+	var v Node
+	if err := json.Unmarshal([]byte("{\"children\":[{\"children\":[{\"children\":[],\"name\":\"example\"}],\"name\":\"example\",\"parent\":{\"children\":[],\"name\":\"example\",\"parent\":{\"children\":[],\"name\":\"example\"}}}],\"name\":\"example\",\"parent\":{\"children\":[{\"children\":[],\"name\":\"example\",\"parent\":{\"children\":[],\"name\":\"example\"}}],\"name\":\"example\",\"parent\":{\"children\":[{\"children\":[],\"name\":\"example\"}],\"name\":\"example\",\"parent\":{\"children\":[],\"name\":\"example\",\"parent\":{\"children\":[],\"name\":\"example\"}}}}}"), &v); err != nil {
+		panic(fmt.Sprintf("error building Node: %s", err))
+	}
+	for _, override := range overrides {
+		override(&v)
+	}
+	return v
+}
+
+// OwnerFactory returns a valid Owner, built from the examples
+// of the spec, with the overrides applied to it in order. It panics when the
+// examples don't fit the type.
+func OwnerFactory(overrides ...func(*Owner)) Owner {
+	var v Owner
+	if err := json.Unmarshal([]byte("{\"email\":\"user@example.com\",\"name\":\"Alex\"}"), &v); err != nil {
+		panic(fmt.Sprintf("error building Owner: %s", err))
+	}
+	for _, override := range overrides {
+		override(&v)
+	}
+	return v
+}
+
+// PetFactory returns a valid Pet, built from the examples
+// of the spec, with the overrides applied to it in order. It panics when the
+// examples don't fit the type.
+func PetFactory(overrides ...func(*Pet)) Pet {
+	var v Pet
+	if err := json.Unmarshal([]byte("{\"age\":3,\"born\":\"2021-01-01\",\"id\":\"3fa85f64-5717-4562-b3fc-2c963f66afa6\",\"kind\":\"dog\",\"name\":\"examplexxx\",\"owner\":{\"email\":\"user@example.com\",\"name\":\"Alex\"},\"tags\":[\"example\",\"example\"],\"weight\":2.5}"), &v); err != nil {
+		panic(fmt.Sprintf("error building Pet: %s", err))
+	}
+	for _, override := range overrides {
+		override(&v)
+	}
+	return v
+}
+
+// GenKind returns a gopter generator of random Kind values,
+// which are valid against the schema of the type.
+func GenKind() gopter.Gen {
+	return gen.OneConstOf(Kind("dog"), Kind("cat"))
+}
+
+// GenLabels returns a gopter generator of random Labels values,
+// which are valid against the schema of the type.
+func GenLabels() gopter.Gen {
+	return generators.JSON("{}", new(Labels))
+}
+
+// GenNode returns a gopter generator of random Node values,
+// which are valid against the schema of the type.
+func GenNode() gopter.Gen {
+	return gen.Struct(reflect.TypeOf(Node{}), map[string]gopter.Gen{
+		"Children": generators.Slice(0, -1, generators.Lazy(GenNode), reflect.TypeOf([]Node(nil))),
+		"Name":     gen.RegexMatch("^[a-z]+$"),
+		"Parent":   generators.Ptr(generators.Lazy(GenNode), reflect.TypeOf((*Node)(nil))),
+	})
+}
+
+// GenOwner returns a gopter generator of random Owner values,
+// which are valid against the schema of the type.
+func GenOwner() gopter.Gen {
+	return gen.Struct(reflect.TypeOf(Owner{}), map[string]gopter.Gen{
+		"Email": generators.Email(),
+		"Name":  generators.String(0, -1),
+	})
+}
+
+// GenPet returns a gopter generator of random Pet values,
+// which are valid against the schema of the type.
+func GenPet() gopter.Gen {
+	return gen.Struct(reflect.TypeOf(Pet{}), map[string]gopter.Gen{
+		"Age":    generators.Ptr(gen.IntRange(-100, 100), reflect.TypeOf((*int)(nil))),
+		"Born":   generators.Date(),
+		"Id":     generators.Ptr(generators.UUID(), reflect.TypeOf((*openapi_types.UUID)(nil))),
+		"Kind":   generators.Lazy(GenKind),
+		"Name":   generators.String(10, -1),
+		"Owner":  generators.Lazy(GenOwner),
+		"Tags":   generators.Ptr(generators.Slice(2, -1, generators.String(0, -1), reflect.TypeOf([]string(nil))), reflect.TypeOf((*[]string)(nil))),
+		"Weight": generators.Ptr(gen.Float32Range(2.0000002, 102), reflect.TypeOf((*float32)(nil))),
+	})
+}
@@ -0,0 +1,67 @@
+package factories
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFactories(t *testing.T) {
+	pet := PetFactory()
+	assert.Equal(t, "examplexxx", pet.Name)
+	assert.Equal(t, KindDog, pet.Kind)
+	assert.Equal(t, "2021-01-01", pet.Born.String())
+	assert.NotNil(t, pet.Id)
+	if assert.NotNil(t, pet.Age) {
+		assert.Equal(t, 3, *pet.Age)
+	}
+	if assert.NotNil(t, pet.Weight) {
+		assert.Greater(t, *pet.Weight, float32(2))
+	}
+	if assert.NotNil(t, pet.Tags) {
+		assert.Len(t, *pet.Tags, 2)
+	}
+	assert.Equal(t, OwnerFactory(), pet.Owner)
+	assert.Equal(t, "Alex", pet.Owner.Name)
+
+	// Overrides apply in order, to a value of its own.
+	cat := PetFactory(func(p *Pet) {
+		p.Kind = KindCat
+		p.Name = "Felix"
+	}, func(p *Pet) {
+		p.Owner.Name = p.Name + "'s owner"
+	})
+	assert.Equal(t, KindCat, cat.Kind)
+	assert.Equal(t, "Felix's owner", cat.Owner.Name)
+	assert.Equal(t, KindDog, PetFactory().Kind)
+
+	assert.Empty(t, LabelsFactory().AdditionalProperties)
+}
+
+func TestPropertyGenerators(t *testing.T) {
+	properties := gopter.NewProperties(nil)
+	properties.Property("pets survive being marshaled", prop.ForAll(func(pet Pet) bool {
+		data, err := json.Marshal(pet)
+		if err != nil {
+			return false
+		}
+		var parsed Pet
+		if err := json.Unmarshal(data, &parsed); err != nil {
+			return false
+		}
+		return reflect.DeepEqual(pet, parsed)
+	}, GenPet()))
+	properties.Property("pets are valid", prop.ForAll(func(pet Pet) bool {
+		return len(pet.Name) >= 10 && (pet.Kind == KindDog || pet.Kind == KindCat) &&
+			(pet.Weight == nil || *pet.Weight > 2) && (pet.Tags == nil || len(*pet.Tags) >= 2)
+	}, GenPet()))
+	properties.Property("nodes end and match their patterns", prop.ForAll(func(node Node) bool {
+		return regexp.MustCompile("^[a-z]+$").MatchString(node.Name)
+	}, GenNode()))
+	properties.TestingRun(t)
+}
@@ -0,0 +1,4 @@
+// Package chi tests the logging middleware of chi servers.
+package chi
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=chi --generate types,chi-server --logging-middleware -o logging.gen.go ../logging.yaml
@@ -0,0 +1,40 @@
+package chi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+type server struct{}
+
+func (server) FindPetByID(w http.ResponseWriter, r *http.Request, id int) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	var records []runtime.OperationRecord
+	logger := runtime.OperationLoggerFunc(func(ctx context.Context, record runtime.OperationRecord) {
+		records = append(records, record)
+	})
+	r := chi.NewRouter()
+	r.Use(LoggingMiddleware(logger, "/api"))
+	HandlerWithOptions(server{}, ChiServerOptions{BaseURL: "/api", BaseRouter: r})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/pets/1", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/toys/1", nil))
+	require.Len(t, records, 2)
+	assert.Equal(t, "findPetByID", records[0].OperationID)
+	assert.Equal(t, "/pets/{id}", records[0].Path)
+	assert.Equal(t, http.StatusNoContent, records[0].StatusCode)
+	assert.Equal(t, "", records[1].OperationID)
+	assert.Equal(t, "", records[1].Path)
+	assert.Equal(t, http.StatusNotFound, records[1].StatusCode)
+}
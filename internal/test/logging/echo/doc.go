@@ -0,0 +1,4 @@
+// Package echo tests the logging middleware of echo servers.
+package echo
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=echo --generate types,server --logging-middleware -o logging.gen.go ../logging.yaml
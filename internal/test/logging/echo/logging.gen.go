@@ -0,0 +1,165 @@
+// Package echo provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package echo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/labstack/echo/v4"
+)
+
+// Routes is the route table of the operations of the spec, which
+// RoutesHandler lists.
+var Routes = []runtime.Route{
+	{OperationID: "findPetByID", Method: "GET", Path: "/pets/{id}"},
+}
+
+// RoutesHandler returns a handler listing Routes as JSON, which a server can
+// serve at a path of its choice for gateways and service catalogs.
+func RoutesHandler() http.Handler {
+	return runtime.RoutesHandler(Routes)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets/{id})
+	FindPetByID(ctx echo.Context, id int) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+	Logger  runtime.OperationLogger
+}
+
+// FindPetByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindPetByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindPetByID(ctx, id)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	RegisterHandlersWithOptions(router, si, EchoServerOptions{BaseURL: baseURL})
+}
+
+// EchoServerOptions provides options for registering the handlers.
+type EchoServerOptions struct {
+	BaseURL string
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// additional options.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options EchoServerOptions) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+		Logger:  options.Logger,
+	}
+
+	router.GET(options.BaseURL+"/pets/:id", wrapper.logged("FindPetByID", wrapper.FindPetByID))
+
+}
+
+// logged returns the handler of an operation, logging its calls when the
+// wrapper has a logger.
+func (w *ServerInterfaceWrapper) logged(operationID string, handler echo.HandlerFunc) echo.HandlerFunc {
+	if w.Logger == nil {
+		return handler
+	}
+	return func(ctx echo.Context) error {
+		start := time.Now()
+		err := handler(ctx)
+		statusCode := ctx.Response().Status
+		if err != nil && !ctx.Response().Committed {
+			// The response is written by the error handler, after this returns.
+			statusCode = http.StatusInternalServerError
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				statusCode = httpErr.Code
+			}
+		}
+		w.Logger.LogOperation(ctx.Request().Context(), runtime.NewOperationRecord(operationID, ctx.Request(), statusCode, ctx.Response().Header(), time.Since(start), err))
+		return err
+	}
+}
+
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		id := runtime.RequestCorrelationID(req.Header, "X-Request-ID")
+		ctx.Response().Header().Set("X-Request-ID", id)
+		ctx.SetRequest(req.WithContext(runtime.ContextWithCorrelationID(req.Context(), id)))
+		return next(ctx)
+	}
+}
+
+// loggedRoutes are the Routes by method and echo path, for LoggingMiddleware.
+var loggedRoutes = runtime.IndexRoutes(Routes, ":%s")
+
+// LoggingMiddleware returns an echo middleware logging every request with
+// logger, such as runtime.KeyValueLogger(slogLogger.Info), with its operation
+// and the path template of the operation rather than the path of the request,
+// so that the values logged are bounded. baseURL is the BaseURL of the
+// EchoServerOptions the handlers were registered with.
+func LoggingMiddleware(logger runtime.OperationLogger, baseURL string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+			statusCode := ctx.Response().Status
+			if err != nil && !ctx.Response().Committed {
+				// The response is written by the error handler, after this returns.
+				statusCode = http.StatusInternalServerError
+				if httpErr, ok := err.(*echo.HTTPError); ok {
+					statusCode = httpErr.Code
+				}
+			}
+			req := ctx.Request()
+			route := loggedRoutes[req.Method+" "+strings.TrimPrefix(ctx.Path(), baseURL)]
+			logger.LogOperation(req.Context(), runtime.NewRouteRecord(route, req, statusCode, ctx.Response().Header(), time.Since(start), err))
+			return err
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package echo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+type server struct{}
+
+func (server) FindPetByID(ctx echo.Context, id int) error {
+	if id == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "no such pet")
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	var records []runtime.OperationRecord
+	logger := runtime.OperationLoggerFunc(func(ctx context.Context, record runtime.OperationRecord) {
+		records = append(records, record)
+	})
+	e := echo.New()
+	e.Use(LoggingMiddleware(logger, "/api"))
+	RegisterHandlersWithBaseURL(e, server{}, "/api")
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/pets/1", nil))
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/pets/0", nil))
+	require.Len(t, records, 2)
+	assert.Equal(t, "findPetByID", records[0].OperationID)
+	assert.Equal(t, "/pets/{id}", records[0].Path)
+	assert.Equal(t, http.StatusNoContent, records[0].StatusCode)
+	assert.Equal(t, "findPetByID", records[1].OperationID)
+	assert.Equal(t, http.StatusNotFound, records[1].StatusCode)
+	assert.Error(t, records[1].Err)
+}
@@ -0,0 +1,4 @@
+// Package gin tests the logging middleware of gin servers.
+package gin
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=gin --generate types,gin --logging-middleware -o logging.gen.go ../logging.yaml
@@ -0,0 +1,137 @@
+// Package gin provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/gin-gonic/gin"
+)
+
+// Routes is the route table of the operations of the spec, which
+// RoutesHandler lists.
+var Routes = []runtime.Route{
+	{OperationID: "findPetByID", Method: "GET", Path: "/pets/{id}"},
+}
+
+// RoutesHandler returns a handler listing Routes as JSON, which a server can
+// serve at a path of its choice for gateways and service catalogs.
+func RoutesHandler() http.Handler {
+	return runtime.RoutesHandler(Routes)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /pets/{id})
+	FindPetByID(c *gin.Context, id int)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	Logger             runtime.OperationLogger
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// FindPetByID operation middleware
+func (siw *ServerInterfaceWrapper) FindPetByID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameter("simple", false, "id", c.Param("id"), &id)
+	if err != nil {
+		_ = c.Error(err)
+		c.JSON(http.StatusBadRequest, gin.H{"msg": fmt.Sprintf("Invalid format for parameter id: %s", err)})
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+	}
+
+	siw.Handler.FindPetByID(c, id)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router *gin.Engine, si ServerInterface) *gin.Engine {
+	return RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router *gin.Engine, si ServerInterface, options GinServerOptions) *gin.Engine {
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		Logger:             options.Logger,
+	}
+
+	router.GET(options.BaseURL+"/pets/:id", wrapper.logged("FindPetByID", wrapper.FindPetByID))
+
+	return router
+}
+
+// logged returns the handler of an operation, logging its calls when the
+// wrapper has a logger.
+func (siw *ServerInterfaceWrapper) logged(operationID string, handler gin.HandlerFunc) gin.HandlerFunc {
+	if siw.Logger == nil {
+		return handler
+	}
+	return func(c *gin.Context) {
+		start := time.Now()
+		handler(c)
+		var err error
+		if last := c.Errors.Last(); last != nil {
+			err = last
+		}
+		siw.Logger.LogOperation(c.Request.Context(), runtime.NewOperationRecord(operationID, c.Request, c.Writer.Status(), c.Writer.Header(), time.Since(start), err))
+	}
+}
+
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(c *gin.Context) {
+	id := runtime.RequestCorrelationID(c.Request.Header, "X-Request-ID")
+	c.Header("X-Request-ID", id)
+	c.Request = c.Request.WithContext(runtime.ContextWithCorrelationID(c.Request.Context(), id))
+}
+
+// loggedRoutes are the Routes by method and gin path, for LoggingMiddleware.
+var loggedRoutes = runtime.IndexRoutes(Routes, ":%s")
+
+// LoggingMiddleware returns a gin middleware logging every request with
+// logger, such as runtime.KeyValueLogger(slogLogger.Info), with its operation
+// and the path template of the operation rather than the path of the request,
+// so that the values logged are bounded. baseURL is the BaseURL of the
+// GinServerOptions the handlers were registered with.
+func LoggingMiddleware(logger runtime.OperationLogger, baseURL string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		var err error
+		if last := c.Errors.Last(); last != nil {
+			err = last
+		}
+		route := loggedRoutes[c.Request.Method+" "+strings.TrimPrefix(c.FullPath(), baseURL)]
+		logger.LogOperation(c.Request.Context(), runtime.NewRouteRecord(route, c.Request, c.Writer.Status(), c.Writer.Header(), time.Since(start), err))
+	}
+}
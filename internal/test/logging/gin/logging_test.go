@@ -0,0 +1,40 @@
+package gin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+type server struct{}
+
+func (server) FindPetByID(c *gin.Context, id int) {
+	c.Status(http.StatusNoContent)
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var records []runtime.OperationRecord
+	logger := runtime.OperationLoggerFunc(func(ctx context.Context, record runtime.OperationRecord) {
+		records = append(records, record)
+	})
+	router := gin.New()
+	router.Use(LoggingMiddleware(logger, "/api"))
+	RegisterHandlersWithOptions(router, server{}, GinServerOptions{BaseURL: "/api"})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/pets/1", nil))
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/toys/1", nil))
+	require.Len(t, records, 2)
+	assert.Equal(t, "findPetByID", records[0].OperationID)
+	assert.Equal(t, "/pets/{id}", records[0].Path)
+	assert.Equal(t, http.StatusNoContent, records[0].StatusCode)
+	assert.Equal(t, "", records[1].OperationID)
+	assert.Equal(t, http.StatusNotFound, records[1].StatusCode)
+}
@@ -0,0 +1,3 @@
+package operationservers
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=operationservers --generate types,client,chi-server -o operationservers.gen.go operationservers.yaml
@@ -0,0 +1,56 @@
+package operationservers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDoer struct {
+	urls []string
+}
+
+func (d *recordingDoer) Do(req *http.Request) (*http.Response, error) {
+	d.urls = append(d.urls, req.URL.String())
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+func TestOperationServers(t *testing.T) {
+	ctx := context.Background()
+	doer := &recordingDoer{}
+	client, err := NewClient(ProductionServerURL, WithHTTPClient(doer))
+	require.NoError(t, err)
+
+	_, err = client.ListPets(ctx)
+	require.NoError(t, err)
+	_, err = client.UploadFileWithBody(ctx, "application/octet-stream", strings.NewReader("data"))
+	require.NoError(t, err)
+	_, err = client.GetReport(ctx)
+	require.NoError(t, err)
+	// The servers of an operation override those of its path.
+	_, err = client.ReplaceFileWithBody(ctx, "application/octet-stream", strings.NewReader("data"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://api.example.com/v1/pets",
+		"https://us.uploads.example.com/files",
+		"https://api.example.com/v2/reports",
+		"https://mirror.example.com/files",
+	}, doer.urls)
+
+	doer.urls = nil
+	client, err = NewClient("http://localhost:8080", WithHTTPClient(doer),
+		WithUploadFileUploadsServer(UploadFileUploadsServerVariables{Region: UploadFileUploadsServerRegionEu}))
+	require.NoError(t, err)
+	_, err = client.UploadFileWithBody(ctx, "application/octet-stream", strings.NewReader("data"))
+	require.NoError(t, err)
+	_, err = client.GetReport(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"https://eu.uploads.example.com/files",
+		"http://localhost:8080/v2/reports",
+	}, doer.urls)
+}
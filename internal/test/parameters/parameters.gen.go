@@ -7,14 +7,18 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
@@ -23,15 +27,22 @@ import (
 
 // ComplexObject defines model for ComplexObject.
 type ComplexObject struct {
-	Id      int    `json:"Id"`
-	IsAdmin bool   `json:"IsAdmin"`
-	Object  Object `json:"Object"`
+	Id      int    `json:"Id" xml:"Id"`
+	IsAdmin bool   `json:"IsAdmin" xml:"IsAdmin"`
+	Object  Object `json:"Object" xml:"Object"`
 }
 
 // Object defines model for Object.
 type Object struct {
-	FirstName string `json:"firstName"`
-	Role      string `json:"role"`
+	FirstName string `json:"firstName" xml:"firstName"`
+	Role      string `json:"role" xml:"role"`
+}
+
+// GetContentTypedParams defines parameters for GetContentTyped.
+type GetContentTypedParams struct {
+	// object sent as XML
+	Obj      *Object `json:"obj,omitempty"`
+	XVerbose *bool   `json:"X-Verbose,omitempty"`
 }
 
 // GetCookieParams defines parameters for GetCookie.
@@ -94,6 +105,18 @@ type GetDeepObjectParams struct {
 	DeepObj ComplexObject `json:"deepObj"`
 }
 
+// GetQueryDelimitedParams defines parameters for GetQueryDelimited.
+type GetQueryDelimitedParams struct {
+	// space delimited array
+	Sa *[]int32 `json:"sa,omitempty"`
+
+	// pipe delimited array
+	Pa *[]string `json:"pa,omitempty"`
+
+	// exploded space delimited array
+	Esa *[]int32 `json:"esa,omitempty"`
+}
+
 // GetQueryFormParams defines parameters for GetQueryForm.
 type GetQueryFormParams struct {
 	// exploded array
@@ -127,6 +150,19 @@ type GetQueryFormParams struct {
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
+// ResponseValidatorFn is the function signature for the ResponseValidator callback function
+type ResponseValidatorFn func(ctx context.Context, req *http.Request, rsp *http.Response) error
+
+// BeforeCallFn is called before every call of an operation. When it returns
+// an error, the request isn't sent and the error is returned to the caller,
+// which allows short-circuiting failing operations. The returned context is
+// passed to the AfterCallFn of the call.
+type BeforeCallFn func(ctx context.Context, operationID string) (context.Context, error)
+
+// AfterCallFn is called after every call of an operation which was let
+// through by the BeforeCallFn, with the response unless the call failed.
+type AfterCallFn func(ctx context.Context, operationID string, rsp *http.Response, err error)
+
 // Doer performs HTTP requests.
 //
 // The standard http.Client implements this interface.
@@ -149,6 +185,38 @@ type Client struct {
 	// A list of callbacks for modifying requests which are generated before sending over
 	// the network.
 	RequestEditors []RequestEditorFn
+
+	// A callback for checking responses received over the network before
+	// they are returned to the caller.
+	ResponseValidator ResponseValidatorFn
+
+	// When greater than zero, request bodies of at least this many bytes are
+	// gzip compressed, and gzip encoded responses are accepted and
+	// transparently decompressed.
+	CompressionThreshold int64
+
+	// A callback for signing requests, called after the request editors and
+	// compression, once nothing else will change the request.
+	RequestSigner RequestEditorFn
+
+	// When set, requests are sent to the servers of the pool, of which Server
+	// is the first, following the pool's policy.
+	ServerPool *runtime.ServerPool
+
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+
+	// Callbacks called around every call of an operation, for circuit
+	// breakers and the like.
+	BeforeCall BeforeCallFn
+	AfterCall  AfterCallFn
+
+	// The User-Agent header sent with requests, unless they have one as a
+	// parameter or a request editor sets another one.
+	UserAgent string
+
+	// The transport cloned by the transport options, which they share.
+	clonedTransport *http.Transport
 }
 
 // ClientOption allows setting custom parameters during construction
@@ -158,7 +226,8 @@ type ClientOption func(*Client) error
 func NewClient(server string, opts ...ClientOption) (*Client, error) {
 	// create a client with sane default values
 	client := Client{
-		Server: server,
+		Server:    server,
+		UserAgent: "Test-Server/1.0.0",
 	}
 	// mutate client and add all optional params
 	for _, o := range opts {
@@ -186,6 +255,85 @@ func WithHTTPClient(doer HttpRequestDoer) ClientOption {
 	}
 }
 
+// WithTLSConfig sets the TLS configuration of the client's transport, such
+// as client certificates or root CAs.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.TLSClientConfig = config
+		return nil
+	}
+}
+
+// WithProxy sets the function returning the proxy of each request, such as
+// http.ProxyURL(proxyURL). By default, the proxy is read from the
+// environment, as with http.ProxyFromEnvironment.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		transport.Proxy = proxy
+		return nil
+	}
+}
+
+// WithTransport allows tuning the client's transport, such as its timeouts
+// and connection pool, with a function called on it.
+func WithTransport(configure func(*http.Transport)) ClientOption {
+	return func(c *Client) error {
+		transport, err := c.transport()
+		if err != nil {
+			return err
+		}
+		configure(transport)
+		return nil
+	}
+}
+
+// transport returns the *http.Transport of the client's doer, which transport
+// options configure. The doer and its transport are cloned the first time, from
+// http.DefaultTransport when there's no transport, so that neither a doer set
+// with WithHTTPClient nor the defaults of net/http are changed.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.Client == nil {
+		c.Client = &http.Client{}
+	}
+	httpClient, ok := c.Client.(*http.Client)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the doer to be an *http.Client, not %T", c.Client)
+	}
+	if c.clonedTransport != nil && httpClient.Transport == c.clonedTransport {
+		return c.clonedTransport, nil
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("transport options require the transport to be an *http.Transport, not %T", transport)
+	}
+	cloned := *httpClient
+	cloned.Transport = httpTransport.Clone()
+	c.Client = &cloned
+	c.clonedTransport = cloned.Transport.(*http.Transport)
+	return c.clonedTransport, nil
+}
+
+// WithUserAgent sets the User-Agent header sent with requests, which is
+// Test-Server/1.0.0 by default.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = userAgent
+		return nil
+	}
+}
+
 // WithRequestEditorFn allows setting up a callback function, which will be
 // called right before sending the request. This can be used to mutate the request.
 func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
@@ -195,11 +343,133 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 	}
 }
 
+// WithResponseValidator allows setting up a callback function, which will be
+// called on every response before it is returned. This can be used to check
+// that responses conform to the specification.
+func WithResponseValidator(fn ResponseValidatorFn) ClientOption {
+	return func(c *Client) error {
+		c.ResponseValidator = fn
+		return nil
+	}
+}
+
+// WithIfNoneMatch returns a RequestEditorFn for making a request conditional
+// on the resource no longer matching the given ETag, in which case the
+// server may respond with 304 Not Modified.
+func WithIfNoneMatch(etag string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-None-Match", etag)
+		return nil
+	}
+}
+
+// WithIfModifiedSince returns a RequestEditorFn for making a request
+// conditional on the resource having been modified after the given time, in
+// which case the server may respond with 304 Not Modified.
+func WithIfModifiedSince(t time.Time) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("If-Modified-Since", t.UTC().Format(http.TimeFormat))
+		return nil
+	}
+}
+
+// PropagateCorrelationID is a RequestEditorFn sending the correlation ID of the
+// request context in the X-Request-ID header, generating one when the context has
+// none. Generated servers add the correlation ID of the requests they handle to
+// their context, so that it's passed on to the services they call.
+func PropagateCorrelationID(ctx context.Context, req *http.Request) error {
+	if req.Header.Get("X-Request-ID") != "" {
+		return nil
+	}
+	id := runtime.CorrelationIDFromContext(ctx)
+	if id == "" {
+		id = runtime.NewCorrelationID()
+	}
+	req.Header.Set("X-Request-ID", id)
+	return nil
+}
+
+// WithRequestCompression enables gzip compression of request bodies which are
+// at least minSize bytes long. The client also advertises that it accepts
+// gzip encoded responses, which are decompressed before they're parsed.
+func WithRequestCompression(minSize int64) ClientOption {
+	return func(c *Client) error {
+		if minSize <= 0 {
+			return fmt.Errorf("compression threshold must be positive, got %d", minSize)
+		}
+		c.CompressionThreshold = minSize
+		return nil
+	}
+}
+
+// WithServers sets several servers serving the API, of which requests are
+// sent to one following the policy. Whatever the policy, requests are sent to
+// the next server when one is unavailable. Servers given with NewClient are
+// replaced by these.
+func WithServers(policy runtime.ServerPolicy, servers ...string) ClientOption {
+	return func(c *Client) error {
+		pool, err := runtime.NewServerPool(policy, servers...)
+		if err != nil {
+			return err
+		}
+		c.Server = pool.Servers()[0]
+		c.ServerPool = pool
+		return nil
+	}
+}
+
+// WithLogger sets a logger, which receives a record of every call of an
+// operation, with its duration and status code. Credentials are redacted from
+// the recorded URL and headers.
+func WithLogger(logger runtime.OperationLogger) ClientOption {
+	return func(c *Client) error {
+		c.Logger = logger
+		return nil
+	}
+}
+
+// WithCallHooks sets callbacks called before and after every call of an
+// operation, either of which may be nil. This allows plugging in a circuit
+// breaker per operation, which lets the before hook fail calls of the
+// operations it considers unavailable, and learns from the outcome of calls
+// in the after hook.
+func WithCallHooks(before BeforeCallFn, after AfterCallFn) ClientOption {
+	return func(c *Client) error {
+		c.BeforeCall = before
+		c.AfterCall = after
+		return nil
+	}
+}
+
+// WithRecorder replays the responses recorded in the directory, in
+// runtime.RecorderReplay mode, or sends requests with the client's doer and
+// records their responses there, in runtime.RecorderRecord mode, for tests
+// which don't reach the server. Options changing the transport must come
+// before it.
+func WithRecorder(mode runtime.RecorderMode, dir string) ClientOption {
+	return func(c *Client) error {
+		c.Client = runtime.NewRecorder(mode, dir, c.Client)
+		return nil
+	}
+}
+
+// WithRequestSigner sets a callback for signing requests, which is called
+// right before sending them, after any request editor and compression.
+func WithRequestSigner(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestSigner = fn
+		return nil
+	}
+}
+
 // The interface specification for the client above.
 type ClientInterface interface {
 	// GetContentObject request
 	GetContentObject(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetContentTyped request
+	GetContentTyped(ctx context.Context, id int32, params *GetContentTypedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetCookie request
 	GetCookie(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -236,6 +506,9 @@ type ClientInterface interface {
 	// GetDeepObject request
 	GetDeepObject(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetQueryDelimited request
+	GetQueryDelimited(ctx context.Context, params *GetQueryDelimitedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetQueryForm request
 	GetQueryForm(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -267,7 +540,19 @@ func (c *Client) GetContentObject(ctx context.Context, param ComplexObject, reqE
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetContentObject", req)
+}
+
+func (c *Client) GetContentTyped(ctx context.Context, id int32, params *GetContentTypedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContentTypedRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "GetContentTyped", req)
 }
 
 func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -279,7 +564,7 @@ func (c *Client) GetCookie(ctx context.Context, params *GetCookieParams, reqEdit
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetCookie", req)
 }
 
 func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -291,7 +576,7 @@ func (c *Client) GetHeader(ctx context.Context, params *GetHeaderParams, reqEdit
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetHeader", req)
 }
 
 func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -303,7 +588,7 @@ func (c *Client) GetLabelExplodeArray(ctx context.Context, param []int32, reqEdi
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetLabelExplodeArray", req)
 }
 
 func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -315,7 +600,7 @@ func (c *Client) GetLabelExplodeObject(ctx context.Context, param Object, reqEdi
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetLabelExplodeObject", req)
 }
 
 func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -327,7 +612,7 @@ func (c *Client) GetLabelNoExplodeArray(ctx context.Context, param []int32, reqE
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetLabelNoExplodeArray", req)
 }
 
 func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -339,7 +624,7 @@ func (c *Client) GetLabelNoExplodeObject(ctx context.Context, param Object, reqE
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetLabelNoExplodeObject", req)
 }
 
 func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -351,7 +636,7 @@ func (c *Client) GetMatrixExplodeArray(ctx context.Context, id []int32, reqEdito
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetMatrixExplodeArray", req)
 }
 
 func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -363,7 +648,7 @@ func (c *Client) GetMatrixExplodeObject(ctx context.Context, id Object, reqEdito
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetMatrixExplodeObject", req)
 }
 
 func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -375,7 +660,7 @@ func (c *Client) GetMatrixNoExplodeArray(ctx context.Context, id []int32, reqEdi
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetMatrixNoExplodeArray", req)
 }
 
 func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -387,7 +672,7 @@ func (c *Client) GetMatrixNoExplodeObject(ctx context.Context, id Object, reqEdi
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetMatrixNoExplodeObject", req)
 }
 
 func (c *Client) GetPassThrough(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -399,7 +684,7 @@ func (c *Client) GetPassThrough(ctx context.Context, param string, reqEditors ..
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetPassThrough", req)
 }
 
 func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -411,7 +696,19 @@ func (c *Client) GetDeepObject(ctx context.Context, params *GetDeepObjectParams,
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetDeepObject", req)
+}
+
+func (c *Client) GetQueryDelimited(ctx context.Context, params *GetQueryDelimitedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQueryDelimitedRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "GetQueryDelimited", req)
 }
 
 func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -423,7 +720,7 @@ func (c *Client) GetQueryForm(ctx context.Context, params *GetQueryFormParams, r
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetQueryForm", req)
 }
 
 func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -435,7 +732,7 @@ func (c *Client) GetSimpleExplodeArray(ctx context.Context, param []int32, reqEd
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetSimpleExplodeArray", req)
 }
 
 func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -447,7 +744,7 @@ func (c *Client) GetSimpleExplodeObject(ctx context.Context, param Object, reqEd
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetSimpleExplodeObject", req)
 }
 
 func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -459,7 +756,7 @@ func (c *Client) GetSimpleNoExplodeArray(ctx context.Context, param []int32, req
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetSimpleNoExplodeArray", req)
 }
 
 func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -471,7 +768,7 @@ func (c *Client) GetSimpleNoExplodeObject(ctx context.Context, param Object, req
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetSimpleNoExplodeObject", req)
 }
 
 func (c *Client) GetSimplePrimitive(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -483,7 +780,7 @@ func (c *Client) GetSimplePrimitive(ctx context.Context, param int32, reqEditors
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetSimplePrimitive", req)
 }
 
 func (c *Client) GetStartingWithNumber(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -495,7 +792,7 @@ func (c *Client) GetStartingWithNumber(ctx context.Context, n1param string, reqE
 	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+	return c.do(ctx, "GetStartingWithNumber", req)
 }
 
 // NewGetContentObjectRequest generates requests for GetContentObject
@@ -517,7 +814,41 @@ func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Reque
 	}
 
 	operationPath := fmt.Sprintf("/contentObject/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetContentTypedRequest generates requests for GetContentTyped
+func NewGetContentTypedRequest(server string, id int32, params *GetContentTypedParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/contentTyped/%s", pathParam0)
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -526,11 +857,36 @@ func NewGetContentObjectRequest(server string, param ComplexObject) (*http.Reque
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Obj != nil {
+
+		if queryParamBuf, err := xml.Marshal(*params.Obj); err != nil {
+			return nil, err
+		} else {
+			queryValues.Add("obj", string(queryParamBuf))
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if params.XVerbose != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Verbose", runtime.ParamLocationHeader, *params.XVerbose)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Verbose", headerParam0)
+	}
+
 	return req, nil
 }
 
@@ -544,7 +900,7 @@ func NewGetCookieRequest(server string, params *GetCookieParams) (*http.Request,
 	}
 
 	operationPath := fmt.Sprintf("/cookie")
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -693,7 +1049,7 @@ func NewGetHeaderRequest(server string, params *GetHeaderParams) (*http.Request,
 	}
 
 	operationPath := fmt.Sprintf("/header")
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -817,7 +1173,7 @@ func NewGetLabelExplodeArrayRequest(server string, param []int32) (*http.Request
 	}
 
 	operationPath := fmt.Sprintf("/labelExplodeArray/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -851,7 +1207,7 @@ func NewGetLabelExplodeObjectRequest(server string, param Object) (*http.Request
 	}
 
 	operationPath := fmt.Sprintf("/labelExplodeObject/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -885,7 +1241,7 @@ func NewGetLabelNoExplodeArrayRequest(server string, param []int32) (*http.Reque
 	}
 
 	operationPath := fmt.Sprintf("/labelNoExplodeArray/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -919,7 +1275,7 @@ func NewGetLabelNoExplodeObjectRequest(server string, param Object) (*http.Reque
 	}
 
 	operationPath := fmt.Sprintf("/labelNoExplodeObject/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -953,7 +1309,7 @@ func NewGetMatrixExplodeArrayRequest(server string, id []int32) (*http.Request,
 	}
 
 	operationPath := fmt.Sprintf("/matrixExplodeArray/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -987,7 +1343,7 @@ func NewGetMatrixExplodeObjectRequest(server string, id Object) (*http.Request,
 	}
 
 	operationPath := fmt.Sprintf("/matrixExplodeObject/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1021,7 +1377,7 @@ func NewGetMatrixNoExplodeArrayRequest(server string, id []int32) (*http.Request
 	}
 
 	operationPath := fmt.Sprintf("/matrixNoExplodeArray/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1055,7 +1411,7 @@ func NewGetMatrixNoExplodeObjectRequest(server string, id Object) (*http.Request
 	}
 
 	operationPath := fmt.Sprintf("/matrixNoExplodeObject/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1086,7 +1442,7 @@ func NewGetPassThroughRequest(server string, param string) (*http.Request, error
 	}
 
 	operationPath := fmt.Sprintf("/passThrough/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1113,7 +1469,7 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 	}
 
 	operationPath := fmt.Sprintf("/queryDeepObject")
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1123,17 +1479,99 @@ func NewGetDeepObjectRequest(server string, params *GetDeepObjectParams) (*http.
 	}
 
 	queryValues := queryURL.Query()
+	var rawQueries []string
 
 	if queryFrag, err := runtime.StyleParamWithLocation("deepObject", true, "deepObj", runtime.ParamLocationQuery, params.DeepObj); err != nil {
 		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	} else if queryFrag != "" {
+		rawQueries = append(rawQueries, queryFrag)
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+	// deepObject parameters, which keep their brackets, and parameters
+	// allowing reserved characters are already escaped.
+	for _, rawQuery := range rawQueries {
+		if queryURL.RawQuery != "" {
+			queryURL.RawQuery += "&"
+		}
+		queryURL.RawQuery += rawQuery
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
+	}
+
+	return req, nil
+}
+
+// NewGetQueryDelimitedRequest generates requests for GetQueryDelimited
+func NewGetQueryDelimitedRequest(server string, params *GetQueryDelimitedParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/queryDelimited")
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Sa != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("spaceDelimited", false, "sa", runtime.ParamLocationQuery, *params.Sa); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Pa != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("pipeDelimited", false, "pa", runtime.ParamLocationQuery, *params.Pa); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Esa != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("spaceDelimited", true, "esa", runtime.ParamLocationQuery, *params.Esa); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
 			}
 		}
+
 	}
 
 	queryURL.RawQuery = queryValues.Encode()
@@ -1156,7 +1594,7 @@ func NewGetQueryFormRequest(server string, params *GetQueryFormParams) (*http.Re
 	}
 
 	operationPath := fmt.Sprintf("/queryForm")
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1332,7 +1770,7 @@ func NewGetSimpleExplodeArrayRequest(server string, param []int32) (*http.Reques
 	}
 
 	operationPath := fmt.Sprintf("/simpleExplodeArray/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1366,7 +1804,7 @@ func NewGetSimpleExplodeObjectRequest(server string, param Object) (*http.Reques
 	}
 
 	operationPath := fmt.Sprintf("/simpleExplodeObject/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1400,7 +1838,7 @@ func NewGetSimpleNoExplodeArrayRequest(server string, param []int32) (*http.Requ
 	}
 
 	operationPath := fmt.Sprintf("/simpleNoExplodeArray/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1434,7 +1872,7 @@ func NewGetSimpleNoExplodeObjectRequest(server string, param Object) (*http.Requ
 	}
 
 	operationPath := fmt.Sprintf("/simpleNoExplodeObject/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1468,7 +1906,7 @@ func NewGetSimplePrimitiveRequest(server string, param int32) (*http.Request, er
 	}
 
 	operationPath := fmt.Sprintf("/simplePrimitive/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1499,7 +1937,7 @@ func NewGetStartingWithNumberRequest(server string, n1param string) (*http.Reque
 	}
 
 	operationPath := fmt.Sprintf("/startingWithNumber/%s", pathParam0)
-	if operationPath[0] == '/' {
+	if strings.HasPrefix(operationPath, "/") {
 		operationPath = "." + operationPath
 	}
 
@@ -1517,6 +1955,9 @@ func NewGetStartingWithNumberRequest(server string, n1param string) (*http.Reque
 }
 
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	if c.UserAgent != "" && req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
 			return err
@@ -1530,16 +1971,83 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+// do sends the request of an operation, calling the call hooks around it and
+// logging the call when the client has been configured to.
+func (c *Client) do(ctx context.Context, operationID string, req *http.Request) (rsp *http.Response, err error) {
+	// Doers such as runtime.Recorder find the operation in the request context.
+	req = req.WithContext(runtime.ContextWithOperationID(req.Context(), operationID))
+	if c.Logger != nil {
+		start := time.Now()
+		defer func() {
+			c.Logger.LogOperation(ctx, runtime.NewClientOperationRecord(operationID, req, rsp, time.Since(start), err))
+		}()
+	}
+	hookCtx := ctx
+	if c.BeforeCall != nil {
+		if hookCtx, err = c.BeforeCall(ctx, operationID); err != nil {
+			return nil, err
+		}
+	}
+	rsp, err = c.send(ctx, req)
+	if c.AfterCall != nil {
+		c.AfterCall(hookCtx, operationID, rsp, err)
+	}
+	return rsp, err
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
+// send sends the request, compressing it, signing it, failing over to other
+// servers and validating the response when the client has been configured to.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GzipRequestBody(req, c.CompressionThreshold); err != nil {
+			return nil, err
+		}
+		if req.Header.Get("Accept-Encoding") == "" {
+			req.Header.Set("Accept-Encoding", "gzip")
+		}
+	}
+	transmit := func(req *http.Request) (*http.Response, error) {
+		if c.RequestSigner != nil {
+			if err := c.RequestSigner(ctx, req); err != nil {
+				return nil, err
+			}
+		}
+		return c.Client.Do(req)
+	}
+	var rsp *http.Response
+	var err error
+	if c.ServerPool != nil {
+		rsp, err = c.ServerPool.Do(req, transmit)
+	} else {
+		rsp, err = transmit(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.CompressionThreshold > 0 {
+		if err := runtime.GunzipResponseBody(rsp); err != nil {
+			return nil, err
+		}
+	}
+	if c.ResponseValidator != nil {
+		if err := c.ResponseValidator(ctx, req, rsp); err != nil {
+			_ = rsp.Body.Close()
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
 		return nil, err
 	}
 	return &ClientWithResponses{client}, nil
@@ -1557,72 +2065,134 @@ func WithBaseURL(baseURL string) ClientOption {
 	}
 }
 
+// StreamResponse gives unbuffered access to a response, for large downloads
+// and long-poll endpoints. The caller is responsible for closing Body.
+type StreamResponse struct {
+	Body         io.ReadCloser
+	Header       http.Header
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// newStreamResponse wraps an HTTP response without reading its body.
+func newStreamResponse(rsp *http.Response) *StreamResponse {
+	return &StreamResponse{
+		Body:         rsp.Body,
+		Header:       rsp.Header,
+		HTTPResponse: rsp,
+	}
+}
+
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
 	// GetContentObject request
 	GetContentObjectWithResponse(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*GetContentObjectResponse, error)
+	GetContentObjectWithBodyStream(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// GetContentTyped request
+	GetContentTypedWithResponse(ctx context.Context, id int32, params *GetContentTypedParams, reqEditors ...RequestEditorFn) (*GetContentTypedResponse, error)
+	GetContentTypedWithBodyStream(ctx context.Context, id int32, params *GetContentTypedParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetCookie request
 	GetCookieWithResponse(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*GetCookieResponse, error)
+	GetCookieWithBodyStream(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetHeader request
 	GetHeaderWithResponse(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*GetHeaderResponse, error)
+	GetHeaderWithBodyStream(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetLabelExplodeArray request
 	GetLabelExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelExplodeArrayResponse, error)
+	GetLabelExplodeArrayWithBodyStream(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetLabelExplodeObject request
 	GetLabelExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelExplodeObjectResponse, error)
+	GetLabelExplodeObjectWithBodyStream(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetLabelNoExplodeArray request
 	GetLabelNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeArrayResponse, error)
+	GetLabelNoExplodeArrayWithBodyStream(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetLabelNoExplodeObject request
 	GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeObjectResponse, error)
+	GetLabelNoExplodeObjectWithBodyStream(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetMatrixExplodeArray request
 	GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error)
+	GetMatrixExplodeArrayWithBodyStream(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetMatrixExplodeObject request
 	GetMatrixExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixExplodeObjectResponse, error)
+	GetMatrixExplodeObjectWithBodyStream(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetMatrixNoExplodeArray request
 	GetMatrixNoExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeArrayResponse, error)
+	GetMatrixNoExplodeArrayWithBodyStream(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetMatrixNoExplodeObject request
 	GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeObjectResponse, error)
+	GetMatrixNoExplodeObjectWithBodyStream(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetPassThrough request
 	GetPassThroughWithResponse(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error)
+	GetPassThroughWithBodyStream(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetDeepObject request
 	GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error)
+	GetDeepObjectWithBodyStream(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
+
+	// GetQueryDelimited request
+	GetQueryDelimitedWithResponse(ctx context.Context, params *GetQueryDelimitedParams, reqEditors ...RequestEditorFn) (*GetQueryDelimitedResponse, error)
+	GetQueryDelimitedWithBodyStream(ctx context.Context, params *GetQueryDelimitedParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetQueryForm request
 	GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error)
+	GetQueryFormWithBodyStream(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetSimpleExplodeArray request
 	GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error)
+	GetSimpleExplodeArrayWithBodyStream(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetSimpleExplodeObject request
 	GetSimpleExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleExplodeObjectResponse, error)
+	GetSimpleExplodeObjectWithBodyStream(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetSimpleNoExplodeArray request
 	GetSimpleNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeArrayResponse, error)
+	GetSimpleNoExplodeArrayWithBodyStream(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetSimpleNoExplodeObject request
 	GetSimpleNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeObjectResponse, error)
+	GetSimpleNoExplodeObjectWithBodyStream(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetSimplePrimitive request
 	GetSimplePrimitiveWithResponse(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetSimplePrimitiveResponse, error)
+	GetSimplePrimitiveWithBodyStream(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 
 	// GetStartingWithNumber request
 	GetStartingWithNumberWithResponse(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*GetStartingWithNumberResponse, error)
+	GetStartingWithNumberWithBodyStream(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*StreamResponse, error)
 }
 
 type GetContentObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1641,6 +2211,46 @@ func (r GetContentObjectResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetContentObjectResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type GetContentTypedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	Text200      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetContentTypedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContentTypedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetContentTypedResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetCookieResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1662,6 +2272,15 @@ func (r GetCookieResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetCookieResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetHeaderResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1683,9 +2302,19 @@ func (r GetHeaderResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetHeaderResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetLabelExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1704,9 +2333,19 @@ func (r GetLabelExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetLabelExplodeArrayResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetLabelExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1725,9 +2364,19 @@ func (r GetLabelExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetLabelExplodeObjectResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetLabelNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1746,9 +2395,19 @@ func (r GetLabelNoExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetLabelNoExplodeArrayResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetLabelNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1767,9 +2426,19 @@ func (r GetLabelNoExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetLabelNoExplodeObjectResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetMatrixExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1788,9 +2457,19 @@ func (r GetMatrixExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetMatrixExplodeArrayResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetMatrixExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1809,9 +2488,19 @@ func (r GetMatrixExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetMatrixExplodeObjectResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetMatrixNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1830,9 +2519,19 @@ func (r GetMatrixNoExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetMatrixNoExplodeArrayResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetMatrixNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1851,9 +2550,19 @@ func (r GetMatrixNoExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetMatrixNoExplodeObjectResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetPassThroughResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1872,6 +2581,15 @@ func (r GetPassThroughResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetPassThroughResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetDeepObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1893,9 +2611,50 @@ func (r GetDeepObjectResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetDeepObjectResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
+type GetQueryDelimitedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	Text200      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQueryDelimitedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQueryDelimitedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetQueryDelimitedResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetQueryFormResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1914,9 +2673,19 @@ func (r GetQueryFormResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetQueryFormResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetSimpleExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1935,9 +2704,19 @@ func (r GetSimpleExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetSimpleExplodeArrayResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetSimpleExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1956,9 +2735,19 @@ func (r GetSimpleExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetSimpleExplodeObjectResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetSimpleNoExplodeArrayResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1977,9 +2766,19 @@ func (r GetSimpleNoExplodeArrayResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetSimpleNoExplodeArrayResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetSimpleNoExplodeObjectResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -1998,9 +2797,19 @@ func (r GetSimpleNoExplodeObjectResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetSimpleNoExplodeObjectResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetSimplePrimitiveResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -2019,9 +2828,19 @@ func (r GetSimplePrimitiveResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetSimplePrimitiveResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 type GetStartingWithNumberResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	Text200      *string
 }
 
 // Status returns HTTPResponse.Status
@@ -2040,6 +2859,15 @@ func (r GetStartingWithNumberResponse) StatusCode() int {
 	return 0
 }
 
+// CorrelationID returns the correlation ID of the call, returned by the server
+// in the X-Request-ID header, or otherwise the one the request was sent with.
+func (r GetStartingWithNumberResponse) CorrelationID() string {
+	if r.HTTPResponse == nil {
+		return ""
+	}
+	return runtime.ResponseCorrelationID(r.HTTPResponse, "X-Request-ID")
+}
+
 // GetContentObjectWithResponse request returning *GetContentObjectResponse
 func (c *ClientWithResponses) GetContentObjectWithResponse(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*GetContentObjectResponse, error) {
 	rsp, err := c.GetContentObject(ctx, param, reqEditors...)
@@ -2049,6 +2877,33 @@ func (c *ClientWithResponses) GetContentObjectWithResponse(ctx context.Context,
 	return ParseGetContentObjectResponse(rsp)
 }
 
+// GetContentObjectWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetContentObjectWithBodyStream(ctx context.Context, param ComplexObject, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetContentObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// GetContentTypedWithResponse request returning *GetContentTypedResponse
+func (c *ClientWithResponses) GetContentTypedWithResponse(ctx context.Context, id int32, params *GetContentTypedParams, reqEditors ...RequestEditorFn) (*GetContentTypedResponse, error) {
+	rsp, err := c.GetContentTyped(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContentTypedResponse(rsp)
+}
+
+// GetContentTypedWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetContentTypedWithBodyStream(ctx context.Context, id int32, params *GetContentTypedParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetContentTyped(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetCookieWithResponse request returning *GetCookieResponse
 func (c *ClientWithResponses) GetCookieWithResponse(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*GetCookieResponse, error) {
 	rsp, err := c.GetCookie(ctx, params, reqEditors...)
@@ -2058,6 +2913,15 @@ func (c *ClientWithResponses) GetCookieWithResponse(ctx context.Context, params
 	return ParseGetCookieResponse(rsp)
 }
 
+// GetCookieWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetCookieWithBodyStream(ctx context.Context, params *GetCookieParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetCookie(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetHeaderWithResponse request returning *GetHeaderResponse
 func (c *ClientWithResponses) GetHeaderWithResponse(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*GetHeaderResponse, error) {
 	rsp, err := c.GetHeader(ctx, params, reqEditors...)
@@ -2067,6 +2931,15 @@ func (c *ClientWithResponses) GetHeaderWithResponse(ctx context.Context, params
 	return ParseGetHeaderResponse(rsp)
 }
 
+// GetHeaderWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetHeaderWithBodyStream(ctx context.Context, params *GetHeaderParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetHeader(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetLabelExplodeArrayWithResponse request returning *GetLabelExplodeArrayResponse
 func (c *ClientWithResponses) GetLabelExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelExplodeArrayResponse, error) {
 	rsp, err := c.GetLabelExplodeArray(ctx, param, reqEditors...)
@@ -2076,6 +2949,15 @@ func (c *ClientWithResponses) GetLabelExplodeArrayWithResponse(ctx context.Conte
 	return ParseGetLabelExplodeArrayResponse(rsp)
 }
 
+// GetLabelExplodeArrayWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetLabelExplodeArrayWithBodyStream(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetLabelExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetLabelExplodeObjectWithResponse request returning *GetLabelExplodeObjectResponse
 func (c *ClientWithResponses) GetLabelExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelExplodeObjectResponse, error) {
 	rsp, err := c.GetLabelExplodeObject(ctx, param, reqEditors...)
@@ -2085,6 +2967,15 @@ func (c *ClientWithResponses) GetLabelExplodeObjectWithResponse(ctx context.Cont
 	return ParseGetLabelExplodeObjectResponse(rsp)
 }
 
+// GetLabelExplodeObjectWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetLabelExplodeObjectWithBodyStream(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetLabelExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetLabelNoExplodeArrayWithResponse request returning *GetLabelNoExplodeArrayResponse
 func (c *ClientWithResponses) GetLabelNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeArrayResponse, error) {
 	rsp, err := c.GetLabelNoExplodeArray(ctx, param, reqEditors...)
@@ -2094,6 +2985,15 @@ func (c *ClientWithResponses) GetLabelNoExplodeArrayWithResponse(ctx context.Con
 	return ParseGetLabelNoExplodeArrayResponse(rsp)
 }
 
+// GetLabelNoExplodeArrayWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetLabelNoExplodeArrayWithBodyStream(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetLabelNoExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetLabelNoExplodeObjectWithResponse request returning *GetLabelNoExplodeObjectResponse
 func (c *ClientWithResponses) GetLabelNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetLabelNoExplodeObjectResponse, error) {
 	rsp, err := c.GetLabelNoExplodeObject(ctx, param, reqEditors...)
@@ -2103,6 +3003,15 @@ func (c *ClientWithResponses) GetLabelNoExplodeObjectWithResponse(ctx context.Co
 	return ParseGetLabelNoExplodeObjectResponse(rsp)
 }
 
+// GetLabelNoExplodeObjectWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetLabelNoExplodeObjectWithBodyStream(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetLabelNoExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetMatrixExplodeArrayWithResponse request returning *GetMatrixExplodeArrayResponse
 func (c *ClientWithResponses) GetMatrixExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixExplodeArrayResponse, error) {
 	rsp, err := c.GetMatrixExplodeArray(ctx, id, reqEditors...)
@@ -2112,6 +3021,15 @@ func (c *ClientWithResponses) GetMatrixExplodeArrayWithResponse(ctx context.Cont
 	return ParseGetMatrixExplodeArrayResponse(rsp)
 }
 
+// GetMatrixExplodeArrayWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetMatrixExplodeArrayWithBodyStream(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetMatrixExplodeArray(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetMatrixExplodeObjectWithResponse request returning *GetMatrixExplodeObjectResponse
 func (c *ClientWithResponses) GetMatrixExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixExplodeObjectResponse, error) {
 	rsp, err := c.GetMatrixExplodeObject(ctx, id, reqEditors...)
@@ -2121,6 +3039,15 @@ func (c *ClientWithResponses) GetMatrixExplodeObjectWithResponse(ctx context.Con
 	return ParseGetMatrixExplodeObjectResponse(rsp)
 }
 
+// GetMatrixExplodeObjectWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetMatrixExplodeObjectWithBodyStream(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetMatrixExplodeObject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetMatrixNoExplodeArrayWithResponse request returning *GetMatrixNoExplodeArrayResponse
 func (c *ClientWithResponses) GetMatrixNoExplodeArrayWithResponse(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeArrayResponse, error) {
 	rsp, err := c.GetMatrixNoExplodeArray(ctx, id, reqEditors...)
@@ -2130,6 +3057,15 @@ func (c *ClientWithResponses) GetMatrixNoExplodeArrayWithResponse(ctx context.Co
 	return ParseGetMatrixNoExplodeArrayResponse(rsp)
 }
 
+// GetMatrixNoExplodeArrayWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetMatrixNoExplodeArrayWithBodyStream(ctx context.Context, id []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetMatrixNoExplodeArray(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetMatrixNoExplodeObjectWithResponse request returning *GetMatrixNoExplodeObjectResponse
 func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithResponse(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*GetMatrixNoExplodeObjectResponse, error) {
 	rsp, err := c.GetMatrixNoExplodeObject(ctx, id, reqEditors...)
@@ -2139,6 +3075,15 @@ func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithResponse(ctx context.C
 	return ParseGetMatrixNoExplodeObjectResponse(rsp)
 }
 
+// GetMatrixNoExplodeObjectWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetMatrixNoExplodeObjectWithBodyStream(ctx context.Context, id Object, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetMatrixNoExplodeObject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetPassThroughWithResponse request returning *GetPassThroughResponse
 func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*GetPassThroughResponse, error) {
 	rsp, err := c.GetPassThrough(ctx, param, reqEditors...)
@@ -2148,6 +3093,15 @@ func (c *ClientWithResponses) GetPassThroughWithResponse(ctx context.Context, pa
 	return ParseGetPassThroughResponse(rsp)
 }
 
+// GetPassThroughWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetPassThroughWithBodyStream(ctx context.Context, param string, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetPassThrough(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetDeepObjectWithResponse request returning *GetDeepObjectResponse
 func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*GetDeepObjectResponse, error) {
 	rsp, err := c.GetDeepObject(ctx, params, reqEditors...)
@@ -2157,6 +3111,33 @@ func (c *ClientWithResponses) GetDeepObjectWithResponse(ctx context.Context, par
 	return ParseGetDeepObjectResponse(rsp)
 }
 
+// GetDeepObjectWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetDeepObjectWithBodyStream(ctx context.Context, params *GetDeepObjectParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetDeepObject(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
+// GetQueryDelimitedWithResponse request returning *GetQueryDelimitedResponse
+func (c *ClientWithResponses) GetQueryDelimitedWithResponse(ctx context.Context, params *GetQueryDelimitedParams, reqEditors ...RequestEditorFn) (*GetQueryDelimitedResponse, error) {
+	rsp, err := c.GetQueryDelimited(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQueryDelimitedResponse(rsp)
+}
+
+// GetQueryDelimitedWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetQueryDelimitedWithBodyStream(ctx context.Context, params *GetQueryDelimitedParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetQueryDelimited(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetQueryFormWithResponse request returning *GetQueryFormResponse
 func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*GetQueryFormResponse, error) {
 	rsp, err := c.GetQueryForm(ctx, params, reqEditors...)
@@ -2166,13 +3147,31 @@ func (c *ClientWithResponses) GetQueryFormWithResponse(ctx context.Context, para
 	return ParseGetQueryFormResponse(rsp)
 }
 
+// GetQueryFormWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetQueryFormWithBodyStream(ctx context.Context, params *GetQueryFormParams, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetQueryForm(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetSimpleExplodeArrayWithResponse request returning *GetSimpleExplodeArrayResponse
 func (c *ClientWithResponses) GetSimpleExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleExplodeArrayResponse, error) {
 	rsp, err := c.GetSimpleExplodeArray(ctx, param, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSimpleExplodeArrayResponse(rsp)
+	return ParseGetSimpleExplodeArrayResponse(rsp)
+}
+
+// GetSimpleExplodeArrayWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetSimpleExplodeArrayWithBodyStream(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetSimpleExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
 }
 
 // GetSimpleExplodeObjectWithResponse request returning *GetSimpleExplodeObjectResponse
@@ -2184,6 +3183,15 @@ func (c *ClientWithResponses) GetSimpleExplodeObjectWithResponse(ctx context.Con
 	return ParseGetSimpleExplodeObjectResponse(rsp)
 }
 
+// GetSimpleExplodeObjectWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetSimpleExplodeObjectWithBodyStream(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetSimpleExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetSimpleNoExplodeArrayWithResponse request returning *GetSimpleNoExplodeArrayResponse
 func (c *ClientWithResponses) GetSimpleNoExplodeArrayWithResponse(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeArrayResponse, error) {
 	rsp, err := c.GetSimpleNoExplodeArray(ctx, param, reqEditors...)
@@ -2193,6 +3201,15 @@ func (c *ClientWithResponses) GetSimpleNoExplodeArrayWithResponse(ctx context.Co
 	return ParseGetSimpleNoExplodeArrayResponse(rsp)
 }
 
+// GetSimpleNoExplodeArrayWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetSimpleNoExplodeArrayWithBodyStream(ctx context.Context, param []int32, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetSimpleNoExplodeArray(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetSimpleNoExplodeObjectWithResponse request returning *GetSimpleNoExplodeObjectResponse
 func (c *ClientWithResponses) GetSimpleNoExplodeObjectWithResponse(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*GetSimpleNoExplodeObjectResponse, error) {
 	rsp, err := c.GetSimpleNoExplodeObject(ctx, param, reqEditors...)
@@ -2202,6 +3219,15 @@ func (c *ClientWithResponses) GetSimpleNoExplodeObjectWithResponse(ctx context.C
 	return ParseGetSimpleNoExplodeObjectResponse(rsp)
 }
 
+// GetSimpleNoExplodeObjectWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetSimpleNoExplodeObjectWithBodyStream(ctx context.Context, param Object, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetSimpleNoExplodeObject(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetSimplePrimitiveWithResponse request returning *GetSimplePrimitiveResponse
 func (c *ClientWithResponses) GetSimplePrimitiveWithResponse(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*GetSimplePrimitiveResponse, error) {
 	rsp, err := c.GetSimplePrimitive(ctx, param, reqEditors...)
@@ -2211,6 +3237,15 @@ func (c *ClientWithResponses) GetSimplePrimitiveWithResponse(ctx context.Context
 	return ParseGetSimplePrimitiveResponse(rsp)
 }
 
+// GetSimplePrimitiveWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetSimplePrimitiveWithBodyStream(ctx context.Context, param int32, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetSimplePrimitive(ctx, param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // GetStartingWithNumberWithResponse request returning *GetStartingWithNumberResponse
 func (c *ClientWithResponses) GetStartingWithNumberWithResponse(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*GetStartingWithNumberResponse, error) {
 	rsp, err := c.GetStartingWithNumber(ctx, n1param, reqEditors...)
@@ -2220,6 +3255,15 @@ func (c *ClientWithResponses) GetStartingWithNumberWithResponse(ctx context.Cont
 	return ParseGetStartingWithNumberResponse(rsp)
 }
 
+// GetStartingWithNumberWithBodyStream request returning the unbuffered response body
+func (c *ClientWithResponses) GetStartingWithNumberWithBodyStream(ctx context.Context, n1param string, reqEditors ...RequestEditorFn) (*StreamResponse, error) {
+	rsp, err := c.GetStartingWithNumber(ctx, n1param, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return newStreamResponse(rsp), nil
+}
+
 // ParseGetContentObjectResponse parses an HTTP response from a GetContentObjectWithResponse call
 func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2233,6 +3277,36 @@ func ParseGetContentObjectResponse(rsp *http.Response) (*GetContentObjectRespons
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetContentTypedResponse parses an HTTP response from a GetContentTypedWithResponse call
+func ParseGetContentTypedResponse(rsp *http.Response) (*GetContentTypedResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetContentTypedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2281,6 +3355,13 @@ func ParseGetLabelExplodeArrayResponse(rsp *http.Response) (*GetLabelExplodeArra
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2297,6 +3378,13 @@ func ParseGetLabelExplodeObjectResponse(rsp *http.Response) (*GetLabelExplodeObj
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2313,6 +3401,13 @@ func ParseGetLabelNoExplodeArrayResponse(rsp *http.Response) (*GetLabelNoExplode
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2329,6 +3424,13 @@ func ParseGetLabelNoExplodeObjectResponse(rsp *http.Response) (*GetLabelNoExplod
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2345,6 +3447,13 @@ func ParseGetMatrixExplodeArrayResponse(rsp *http.Response) (*GetMatrixExplodeAr
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2361,6 +3470,13 @@ func ParseGetMatrixExplodeObjectResponse(rsp *http.Response) (*GetMatrixExplodeO
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2377,6 +3493,13 @@ func ParseGetMatrixNoExplodeArrayResponse(rsp *http.Response) (*GetMatrixNoExplo
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2393,6 +3516,13 @@ func ParseGetMatrixNoExplodeObjectResponse(rsp *http.Response) (*GetMatrixNoExpl
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2409,6 +3539,13 @@ func ParseGetPassThroughResponse(rsp *http.Response) (*GetPassThroughResponse, e
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2428,6 +3565,29 @@ func ParseGetDeepObjectResponse(rsp *http.Response) (*GetDeepObjectResponse, err
 	return response, nil
 }
 
+// ParseGetQueryDelimitedResponse parses an HTTP response from a GetQueryDelimitedWithResponse call
+func ParseGetQueryDelimitedResponse(rsp *http.Response) (*GetQueryDelimitedResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQueryDelimitedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetQueryFormResponse parses an HTTP response from a GetQueryFormWithResponse call
 func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
@@ -2441,6 +3601,13 @@ func ParseGetQueryFormResponse(rsp *http.Response) (*GetQueryFormResponse, error
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2457,6 +3624,13 @@ func ParseGetSimpleExplodeArrayResponse(rsp *http.Response) (*GetSimpleExplodeAr
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2473,6 +3647,13 @@ func ParseGetSimpleExplodeObjectResponse(rsp *http.Response) (*GetSimpleExplodeO
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2489,6 +3670,13 @@ func ParseGetSimpleNoExplodeArrayResponse(rsp *http.Response) (*GetSimpleNoExplo
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2505,6 +3693,13 @@ func ParseGetSimpleNoExplodeObjectResponse(rsp *http.Response) (*GetSimpleNoExpl
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2521,6 +3716,13 @@ func ParseGetSimplePrimitiveResponse(rsp *http.Response) (*GetSimplePrimitiveRes
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -2537,15 +3739,252 @@ func ParseGetStartingWithNumberResponse(rsp *http.Response) (*GetStartingWithNum
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "text/plain") && rsp.StatusCode == 200:
+		dest := string(bodyBytes)
+		response.Text200 = &dest
+
+	}
+
 	return response, nil
 }
 
+// Server1URL is the Server1 URL.
+const Server1URL = "http://openapitest.deepmap.ai"
+
+// WithServer1 sets the server of the client to Server1URL.
+func WithServer1() ClientOption {
+	return func(c *Client) error {
+		c.Server = Server1URL
+		return nil
+	}
+}
+
+// The path templates of the operations, as written in the spec.
+const (
+	GetContentObjectPathTemplate         = "/contentObject/{param}"
+	GetContentTypedPathTemplate          = "/contentTyped/{id}"
+	GetCookiePathTemplate                = "/cookie"
+	GetHeaderPathTemplate                = "/header"
+	GetLabelExplodeArrayPathTemplate     = "/labelExplodeArray/{.param*}"
+	GetLabelExplodeObjectPathTemplate    = "/labelExplodeObject/{.param*}"
+	GetLabelNoExplodeArrayPathTemplate   = "/labelNoExplodeArray/{.param}"
+	GetLabelNoExplodeObjectPathTemplate  = "/labelNoExplodeObject/{.param}"
+	GetMatrixExplodeArrayPathTemplate    = "/matrixExplodeArray/{.id*}"
+	GetMatrixExplodeObjectPathTemplate   = "/matrixExplodeObject/{.id*}"
+	GetMatrixNoExplodeArrayPathTemplate  = "/matrixNoExplodeArray/{.id}"
+	GetMatrixNoExplodeObjectPathTemplate = "/matrixNoExplodeObject/{.id}"
+	GetPassThroughPathTemplate           = "/passThrough/{param}"
+	GetDeepObjectPathTemplate            = "/queryDeepObject"
+	GetQueryDelimitedPathTemplate        = "/queryDelimited"
+	GetQueryFormPathTemplate             = "/queryForm"
+	GetSimpleExplodeArrayPathTemplate    = "/simpleExplodeArray/{param*}"
+	GetSimpleExplodeObjectPathTemplate   = "/simpleExplodeObject/{param*}"
+	GetSimpleNoExplodeArrayPathTemplate  = "/simpleNoExplodeArray/{param}"
+	GetSimpleNoExplodeObjectPathTemplate = "/simpleNoExplodeObject/{param}"
+	GetSimplePrimitivePathTemplate       = "/simplePrimitive/{param}"
+	GetStartingWithNumberPathTemplate    = "/startingWithNumber/{1param}"
+)
+
+// PathGetContentObject returns the path of GetContentObject, /contentObject/{param},
+// with the path parameters styled as the client sends them.
+func PathGetContentObject(param ComplexObject) (string, error) {
+	pathParamBuf0, err := json.Marshal(param)
+	if err != nil {
+		return "", err
+	}
+	pathParam0 := string(pathParamBuf0)
+	return fmt.Sprintf("/contentObject/%s", pathParam0), nil
+}
+
+// PathGetContentTyped returns the path of GetContentTyped, /contentTyped/{id},
+// with the path parameters styled as the client sends them.
+func PathGetContentTyped(id int32) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/contentTyped/%s", pathParam0), nil
+}
+
+// PathGetCookie returns the path of GetCookie, /cookie.
+func PathGetCookie() (string, error) {
+	return GetCookiePathTemplate, nil
+}
+
+// PathGetHeader returns the path of GetHeader, /header.
+func PathGetHeader() (string, error) {
+	return GetHeaderPathTemplate, nil
+}
+
+// PathGetLabelExplodeArray returns the path of GetLabelExplodeArray, /labelExplodeArray/{.param*},
+// with the path parameters styled as the client sends them.
+func PathGetLabelExplodeArray(param []int32) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("label", true, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/labelExplodeArray/%s", pathParam0), nil
+}
+
+// PathGetLabelExplodeObject returns the path of GetLabelExplodeObject, /labelExplodeObject/{.param*},
+// with the path parameters styled as the client sends them.
+func PathGetLabelExplodeObject(param Object) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("label", true, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/labelExplodeObject/%s", pathParam0), nil
+}
+
+// PathGetLabelNoExplodeArray returns the path of GetLabelNoExplodeArray, /labelNoExplodeArray/{.param},
+// with the path parameters styled as the client sends them.
+func PathGetLabelNoExplodeArray(param []int32) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("label", false, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/labelNoExplodeArray/%s", pathParam0), nil
+}
+
+// PathGetLabelNoExplodeObject returns the path of GetLabelNoExplodeObject, /labelNoExplodeObject/{.param},
+// with the path parameters styled as the client sends them.
+func PathGetLabelNoExplodeObject(param Object) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("label", false, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/labelNoExplodeObject/%s", pathParam0), nil
+}
+
+// PathGetMatrixExplodeArray returns the path of GetMatrixExplodeArray, /matrixExplodeArray/{.id*},
+// with the path parameters styled as the client sends them.
+func PathGetMatrixExplodeArray(id []int32) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("matrix", true, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/matrixExplodeArray/%s", pathParam0), nil
+}
+
+// PathGetMatrixExplodeObject returns the path of GetMatrixExplodeObject, /matrixExplodeObject/{.id*},
+// with the path parameters styled as the client sends them.
+func PathGetMatrixExplodeObject(id Object) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("matrix", true, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/matrixExplodeObject/%s", pathParam0), nil
+}
+
+// PathGetMatrixNoExplodeArray returns the path of GetMatrixNoExplodeArray, /matrixNoExplodeArray/{.id},
+// with the path parameters styled as the client sends them.
+func PathGetMatrixNoExplodeArray(id []int32) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("matrix", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/matrixNoExplodeArray/%s", pathParam0), nil
+}
+
+// PathGetMatrixNoExplodeObject returns the path of GetMatrixNoExplodeObject, /matrixNoExplodeObject/{.id},
+// with the path parameters styled as the client sends them.
+func PathGetMatrixNoExplodeObject(id Object) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("matrix", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/matrixNoExplodeObject/%s", pathParam0), nil
+}
+
+// PathGetPassThrough returns the path of GetPassThrough, /passThrough/{param},
+// with the path parameters styled as the client sends them.
+func PathGetPassThrough(param string) (string, error) {
+	pathParam0 := param
+	return fmt.Sprintf("/passThrough/%s", pathParam0), nil
+}
+
+// PathGetDeepObject returns the path of GetDeepObject, /queryDeepObject.
+func PathGetDeepObject() (string, error) {
+	return GetDeepObjectPathTemplate, nil
+}
+
+// PathGetQueryDelimited returns the path of GetQueryDelimited, /queryDelimited.
+func PathGetQueryDelimited() (string, error) {
+	return GetQueryDelimitedPathTemplate, nil
+}
+
+// PathGetQueryForm returns the path of GetQueryForm, /queryForm.
+func PathGetQueryForm() (string, error) {
+	return GetQueryFormPathTemplate, nil
+}
+
+// PathGetSimpleExplodeArray returns the path of GetSimpleExplodeArray, /simpleExplodeArray/{param*},
+// with the path parameters styled as the client sends them.
+func PathGetSimpleExplodeArray(param []int32) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("simple", true, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/simpleExplodeArray/%s", pathParam0), nil
+}
+
+// PathGetSimpleExplodeObject returns the path of GetSimpleExplodeObject, /simpleExplodeObject/{param*},
+// with the path parameters styled as the client sends them.
+func PathGetSimpleExplodeObject(param Object) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("simple", true, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/simpleExplodeObject/%s", pathParam0), nil
+}
+
+// PathGetSimpleNoExplodeArray returns the path of GetSimpleNoExplodeArray, /simpleNoExplodeArray/{param},
+// with the path parameters styled as the client sends them.
+func PathGetSimpleNoExplodeArray(param []int32) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("simple", false, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/simpleNoExplodeArray/%s", pathParam0), nil
+}
+
+// PathGetSimpleNoExplodeObject returns the path of GetSimpleNoExplodeObject, /simpleNoExplodeObject/{param},
+// with the path parameters styled as the client sends them.
+func PathGetSimpleNoExplodeObject(param Object) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("simple", false, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/simpleNoExplodeObject/%s", pathParam0), nil
+}
+
+// PathGetSimplePrimitive returns the path of GetSimplePrimitive, /simplePrimitive/{param},
+// with the path parameters styled as the client sends them.
+func PathGetSimplePrimitive(param int32) (string, error) {
+	pathParam0, err := runtime.StyleParamWithLocation("simple", false, "param", runtime.ParamLocationPath, param)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/simplePrimitive/%s", pathParam0), nil
+}
+
+// PathGetStartingWithNumber returns the path of GetStartingWithNumber, /startingWithNumber/{1param},
+// with the path parameters styled as the client sends them.
+func PathGetStartingWithNumber(n1param string) (string, error) {
+	pathParam0 := n1param
+	return fmt.Sprintf("/startingWithNumber/%s", pathParam0), nil
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
 	// (GET /contentObject/{param})
 	GetContentObject(ctx echo.Context, param ComplexObject) error
 
+	// (GET /contentTyped/{id})
+	GetContentTyped(ctx echo.Context, id int32, params GetContentTypedParams) error
+
 	// (GET /cookie)
 	GetCookie(ctx echo.Context, params GetCookieParams) error
 
@@ -2582,6 +4021,9 @@ type ServerInterface interface {
 	// (GET /queryDeepObject)
 	GetDeepObject(ctx echo.Context, params GetDeepObjectParams) error
 
+	// (GET /queryDelimited)
+	GetQueryDelimited(ctx echo.Context, params GetQueryDelimitedParams) error
+
 	// (GET /queryForm)
 	GetQueryForm(ctx echo.Context, params GetQueryFormParams) error
 
@@ -2607,6 +4049,7 @@ type ServerInterface interface {
 // ServerInterfaceWrapper converts echo contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
+	Logger  runtime.OperationLogger
 }
 
 // GetContentObject converts echo context to params.
@@ -2625,6 +4068,54 @@ func (w *ServerInterfaceWrapper) GetContentObject(ctx echo.Context) error {
 	return err
 }
 
+// GetContentTyped converts echo context to params.
+func (w *ServerInterfaceWrapper) GetContentTyped(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetContentTypedParams
+	// ------------- Optional query parameter "obj" -------------
+
+	if paramValue := ctx.QueryParam("obj"); paramValue != "" {
+
+		var value Object
+		err = xml.Unmarshal([]byte(paramValue), &value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Error unmarshaling parameter 'obj' as XML")
+		}
+		params.Obj = &value
+
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Verbose" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Verbose")]; found {
+		var XVerbose bool
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Verbose, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Verbose", runtime.ParamLocationHeader, valueList[0], &XVerbose)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Verbose: %s", err)).SetInternal(err)
+		}
+
+		params.XVerbose = &XVerbose
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetContentTyped(ctx, id, params)
+	return err
+}
+
 // GetCookie converts echo context to params.
 func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 	var err error
@@ -2637,7 +4128,7 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 		var value int32
 		err = runtime.BindStyledParameterWithLocation("simple", false, "p", runtime.ParamLocationCookie, cookie.Value, &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter p: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter p: %s", err)).SetInternal(err)
 		}
 		params.P = &value
 
@@ -2648,7 +4139,7 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 		var value int32
 		err = runtime.BindStyledParameterWithLocation("simple", true, "ep", runtime.ParamLocationCookie, cookie.Value, &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ep: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ep: %s", err)).SetInternal(err)
 		}
 		params.Ep = &value
 
@@ -2659,7 +4150,7 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 		var value []int32
 		err = runtime.BindStyledParameterWithLocation("simple", true, "ea", runtime.ParamLocationCookie, cookie.Value, &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ea: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ea: %s", err)).SetInternal(err)
 		}
 		params.Ea = &value
 
@@ -2670,7 +4161,7 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 		var value []int32
 		err = runtime.BindStyledParameterWithLocation("simple", false, "a", runtime.ParamLocationCookie, cookie.Value, &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter a: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter a: %s", err)).SetInternal(err)
 		}
 		params.A = &value
 
@@ -2681,7 +4172,7 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 		var value Object
 		err = runtime.BindStyledParameterWithLocation("simple", true, "eo", runtime.ParamLocationCookie, cookie.Value, &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eo: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eo: %s", err)).SetInternal(err)
 		}
 		params.Eo = &value
 
@@ -2692,7 +4183,7 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 		var value Object
 		err = runtime.BindStyledParameterWithLocation("simple", false, "o", runtime.ParamLocationCookie, cookie.Value, &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter o: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter o: %s", err)).SetInternal(err)
 		}
 		params.O = &value
 
@@ -2719,7 +4210,7 @@ func (w *ServerInterfaceWrapper) GetCookie(ctx echo.Context) error {
 		var value string
 		err = runtime.BindStyledParameterWithLocation("simple", true, "1s", runtime.ParamLocationCookie, cookie.Value, &value)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter 1s: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter 1s: %s", err)).SetInternal(err)
 		}
 		params.N1s = &value
 
@@ -2748,7 +4239,7 @@ func (w *ServerInterfaceWrapper) GetHeader(ctx echo.Context) error {
 
 		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Primitive", runtime.ParamLocationHeader, valueList[0], &XPrimitive)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Primitive: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Primitive: %s", err)).SetInternal(err)
 		}
 
 		params.XPrimitive = &XPrimitive
@@ -2763,7 +4254,7 @@ func (w *ServerInterfaceWrapper) GetHeader(ctx echo.Context) error {
 
 		err = runtime.BindStyledParameterWithLocation("simple", true, "X-Primitive-Exploded", runtime.ParamLocationHeader, valueList[0], &XPrimitiveExploded)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Primitive-Exploded: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Primitive-Exploded: %s", err)).SetInternal(err)
 		}
 
 		params.XPrimitiveExploded = &XPrimitiveExploded
@@ -2778,7 +4269,7 @@ func (w *ServerInterfaceWrapper) GetHeader(ctx echo.Context) error {
 
 		err = runtime.BindStyledParameterWithLocation("simple", true, "X-Array-Exploded", runtime.ParamLocationHeader, valueList[0], &XArrayExploded)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Array-Exploded: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Array-Exploded: %s", err)).SetInternal(err)
 		}
 
 		params.XArrayExploded = &XArrayExploded
@@ -2793,7 +4284,7 @@ func (w *ServerInterfaceWrapper) GetHeader(ctx echo.Context) error {
 
 		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Array", runtime.ParamLocationHeader, valueList[0], &XArray)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Array: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Array: %s", err)).SetInternal(err)
 		}
 
 		params.XArray = &XArray
@@ -2808,7 +4299,7 @@ func (w *ServerInterfaceWrapper) GetHeader(ctx echo.Context) error {
 
 		err = runtime.BindStyledParameterWithLocation("simple", true, "X-Object-Exploded", runtime.ParamLocationHeader, valueList[0], &XObjectExploded)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Object-Exploded: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Object-Exploded: %s", err)).SetInternal(err)
 		}
 
 		params.XObjectExploded = &XObjectExploded
@@ -2823,7 +4314,7 @@ func (w *ServerInterfaceWrapper) GetHeader(ctx echo.Context) error {
 
 		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Object", runtime.ParamLocationHeader, valueList[0], &XObject)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Object: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Object: %s", err)).SetInternal(err)
 		}
 
 		params.XObject = &XObject
@@ -2853,7 +4344,7 @@ func (w *ServerInterfaceWrapper) GetHeader(ctx echo.Context) error {
 
 		err = runtime.BindStyledParameterWithLocation("simple", false, "1-Starting-With-Number", runtime.ParamLocationHeader, valueList[0], &N1StartingWithNumber)
 		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter 1-Starting-With-Number: %s", err))
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter 1-Starting-With-Number: %s", err)).SetInternal(err)
 		}
 
 		params.N1StartingWithNumber = &N1StartingWithNumber
@@ -2872,7 +4363,7 @@ func (w *ServerInterfaceWrapper) GetLabelExplodeArray(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameterWithLocation("label", true, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2888,7 +4379,7 @@ func (w *ServerInterfaceWrapper) GetLabelExplodeObject(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameterWithLocation("label", true, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2904,7 +4395,7 @@ func (w *ServerInterfaceWrapper) GetLabelNoExplodeArray(ctx echo.Context) error
 
 	err = runtime.BindStyledParameterWithLocation("label", false, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2920,7 +4411,7 @@ func (w *ServerInterfaceWrapper) GetLabelNoExplodeObject(ctx echo.Context) error
 
 	err = runtime.BindStyledParameterWithLocation("label", false, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2936,7 +4427,7 @@ func (w *ServerInterfaceWrapper) GetMatrixExplodeArray(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameterWithLocation("matrix", true, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2952,7 +4443,7 @@ func (w *ServerInterfaceWrapper) GetMatrixExplodeObject(ctx echo.Context) error
 
 	err = runtime.BindStyledParameterWithLocation("matrix", true, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2968,7 +4459,7 @@ func (w *ServerInterfaceWrapper) GetMatrixNoExplodeArray(ctx echo.Context) error
 
 	err = runtime.BindStyledParameterWithLocation("matrix", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -2984,7 +4475,7 @@ func (w *ServerInterfaceWrapper) GetMatrixNoExplodeObject(ctx echo.Context) erro
 
 	err = runtime.BindStyledParameterWithLocation("matrix", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3015,7 +4506,7 @@ func (w *ServerInterfaceWrapper) GetDeepObject(ctx echo.Context) error {
 
 	err = runtime.BindQueryParameter("deepObject", true, true, "deepObj", ctx.QueryParams(), &params.DeepObj)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deepObj: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter deepObj: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3023,6 +4514,38 @@ func (w *ServerInterfaceWrapper) GetDeepObject(ctx echo.Context) error {
 	return err
 }
 
+// GetQueryDelimited converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQueryDelimited(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQueryDelimitedParams
+	// ------------- Optional query parameter "sa" -------------
+
+	err = runtime.BindQueryParameter("spaceDelimited", false, false, "sa", ctx.QueryParams(), &params.Sa)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sa: %s", err)).SetInternal(err)
+	}
+
+	// ------------- Optional query parameter "pa" -------------
+
+	err = runtime.BindQueryParameter("pipeDelimited", false, false, "pa", ctx.QueryParams(), &params.Pa)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pa: %s", err)).SetInternal(err)
+	}
+
+	// ------------- Optional query parameter "esa" -------------
+
+	err = runtime.BindQueryParameter("spaceDelimited", true, false, "esa", ctx.QueryParams(), &params.Esa)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter esa: %s", err)).SetInternal(err)
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetQueryDelimited(ctx, params)
+	return err
+}
+
 // GetQueryForm converts echo context to params.
 func (w *ServerInterfaceWrapper) GetQueryForm(ctx echo.Context) error {
 	var err error
@@ -3033,49 +4556,49 @@ func (w *ServerInterfaceWrapper) GetQueryForm(ctx echo.Context) error {
 
 	err = runtime.BindQueryParameter("form", true, false, "ea", ctx.QueryParams(), &params.Ea)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ea: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ea: %s", err)).SetInternal(err)
 	}
 
 	// ------------- Optional query parameter "a" -------------
 
 	err = runtime.BindQueryParameter("form", false, false, "a", ctx.QueryParams(), &params.A)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter a: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter a: %s", err)).SetInternal(err)
 	}
 
 	// ------------- Optional query parameter "eo" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "eo", ctx.QueryParams(), &params.Eo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eo: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter eo: %s", err)).SetInternal(err)
 	}
 
 	// ------------- Optional query parameter "o" -------------
 
 	err = runtime.BindQueryParameter("form", false, false, "o", ctx.QueryParams(), &params.O)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter o: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter o: %s", err)).SetInternal(err)
 	}
 
 	// ------------- Optional query parameter "ep" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "ep", ctx.QueryParams(), &params.Ep)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ep: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ep: %s", err)).SetInternal(err)
 	}
 
 	// ------------- Optional query parameter "p" -------------
 
 	err = runtime.BindQueryParameter("form", false, false, "p", ctx.QueryParams(), &params.P)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter p: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter p: %s", err)).SetInternal(err)
 	}
 
 	// ------------- Optional query parameter "ps" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "ps", ctx.QueryParams(), &params.Ps)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ps: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ps: %s", err)).SetInternal(err)
 	}
 
 	// ------------- Optional query parameter "co" -------------
@@ -3095,7 +4618,7 @@ func (w *ServerInterfaceWrapper) GetQueryForm(ctx echo.Context) error {
 
 	err = runtime.BindQueryParameter("form", true, false, "1s", ctx.QueryParams(), &params.N1s)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter 1s: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter 1s: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3111,7 +4634,7 @@ func (w *ServerInterfaceWrapper) GetSimpleExplodeArray(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameterWithLocation("simple", true, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3127,7 +4650,7 @@ func (w *ServerInterfaceWrapper) GetSimpleExplodeObject(ctx echo.Context) error
 
 	err = runtime.BindStyledParameterWithLocation("simple", true, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3143,7 +4666,7 @@ func (w *ServerInterfaceWrapper) GetSimpleNoExplodeArray(ctx echo.Context) error
 
 	err = runtime.BindStyledParameterWithLocation("simple", false, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3159,7 +4682,7 @@ func (w *ServerInterfaceWrapper) GetSimpleNoExplodeObject(ctx echo.Context) erro
 
 	err = runtime.BindStyledParameterWithLocation("simple", false, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3175,7 +4698,7 @@ func (w *ServerInterfaceWrapper) GetSimplePrimitive(ctx echo.Context) error {
 
 	err = runtime.BindStyledParameterWithLocation("simple", false, "param", runtime.ParamLocationPath, ctx.Param("param"), &param)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter param: %s", err)).SetInternal(err)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
@@ -3219,56 +4742,109 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+	RegisterHandlersWithOptions(router, si, EchoServerOptions{BaseURL: baseURL})
+}
+
+// EchoServerOptions provides options for registering the handlers.
+type EchoServerOptions struct {
+	BaseURL string
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
+}
+
+// RegisterHandlersWithOptions adds each server route to the EchoRouter, with
+// additional options.
+func RegisterHandlersWithOptions(router EchoRouter, si ServerInterface, options EchoServerOptions) {
 
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
+		Logger:  options.Logger,
+	}
+
+	router.GET(options.BaseURL+"/contentObject/:param", wrapper.logged("GetContentObject", wrapper.GetContentObject))
+	router.GET(options.BaseURL+"/contentTyped/:id", wrapper.logged("GetContentTyped", wrapper.GetContentTyped))
+	router.GET(options.BaseURL+"/cookie", wrapper.logged("GetCookie", wrapper.GetCookie))
+	router.GET(options.BaseURL+"/header", wrapper.logged("GetHeader", wrapper.GetHeader))
+	router.GET(options.BaseURL+"/labelExplodeArray/:param", wrapper.logged("GetLabelExplodeArray", wrapper.GetLabelExplodeArray))
+	router.GET(options.BaseURL+"/labelExplodeObject/:param", wrapper.logged("GetLabelExplodeObject", wrapper.GetLabelExplodeObject))
+	router.GET(options.BaseURL+"/labelNoExplodeArray/:param", wrapper.logged("GetLabelNoExplodeArray", wrapper.GetLabelNoExplodeArray))
+	router.GET(options.BaseURL+"/labelNoExplodeObject/:param", wrapper.logged("GetLabelNoExplodeObject", wrapper.GetLabelNoExplodeObject))
+	router.GET(options.BaseURL+"/matrixExplodeArray/:id", wrapper.logged("GetMatrixExplodeArray", wrapper.GetMatrixExplodeArray))
+	router.GET(options.BaseURL+"/matrixExplodeObject/:id", wrapper.logged("GetMatrixExplodeObject", wrapper.GetMatrixExplodeObject))
+	router.GET(options.BaseURL+"/matrixNoExplodeArray/:id", wrapper.logged("GetMatrixNoExplodeArray", wrapper.GetMatrixNoExplodeArray))
+	router.GET(options.BaseURL+"/matrixNoExplodeObject/:id", wrapper.logged("GetMatrixNoExplodeObject", wrapper.GetMatrixNoExplodeObject))
+	router.GET(options.BaseURL+"/passThrough/:param", wrapper.logged("GetPassThrough", wrapper.GetPassThrough))
+	router.GET(options.BaseURL+"/queryDeepObject", wrapper.logged("GetDeepObject", wrapper.GetDeepObject))
+	router.GET(options.BaseURL+"/queryDelimited", wrapper.logged("GetQueryDelimited", wrapper.GetQueryDelimited))
+	router.GET(options.BaseURL+"/queryForm", wrapper.logged("GetQueryForm", wrapper.GetQueryForm))
+	router.GET(options.BaseURL+"/simpleExplodeArray/:param", wrapper.logged("GetSimpleExplodeArray", wrapper.GetSimpleExplodeArray))
+	router.GET(options.BaseURL+"/simpleExplodeObject/:param", wrapper.logged("GetSimpleExplodeObject", wrapper.GetSimpleExplodeObject))
+	router.GET(options.BaseURL+"/simpleNoExplodeArray/:param", wrapper.logged("GetSimpleNoExplodeArray", wrapper.GetSimpleNoExplodeArray))
+	router.GET(options.BaseURL+"/simpleNoExplodeObject/:param", wrapper.logged("GetSimpleNoExplodeObject", wrapper.GetSimpleNoExplodeObject))
+	router.GET(options.BaseURL+"/simplePrimitive/:param", wrapper.logged("GetSimplePrimitive", wrapper.GetSimplePrimitive))
+	router.GET(options.BaseURL+"/startingWithNumber/:1param", wrapper.logged("GetStartingWithNumber", wrapper.GetStartingWithNumber))
+
+}
+
+// logged returns the handler of an operation, logging its calls when the
+// wrapper has a logger.
+func (w *ServerInterfaceWrapper) logged(operationID string, handler echo.HandlerFunc) echo.HandlerFunc {
+	if w.Logger == nil {
+		return handler
+	}
+	return func(ctx echo.Context) error {
+		start := time.Now()
+		err := handler(ctx)
+		statusCode := ctx.Response().Status
+		if err != nil && !ctx.Response().Committed {
+			// The response is written by the error handler, after this returns.
+			statusCode = http.StatusInternalServerError
+			if httpErr, ok := err.(*echo.HTTPError); ok {
+				statusCode = httpErr.Code
+			}
+		}
+		w.Logger.LogOperation(ctx.Request().Context(), runtime.NewOperationRecord(operationID, ctx.Request(), statusCode, ctx.Response().Header(), time.Since(start), err))
+		return err
 	}
+}
 
-	router.GET(baseURL+"/contentObject/:param", wrapper.GetContentObject)
-	router.GET(baseURL+"/cookie", wrapper.GetCookie)
-	router.GET(baseURL+"/header", wrapper.GetHeader)
-	router.GET(baseURL+"/labelExplodeArray/:param", wrapper.GetLabelExplodeArray)
-	router.GET(baseURL+"/labelExplodeObject/:param", wrapper.GetLabelExplodeObject)
-	router.GET(baseURL+"/labelNoExplodeArray/:param", wrapper.GetLabelNoExplodeArray)
-	router.GET(baseURL+"/labelNoExplodeObject/:param", wrapper.GetLabelNoExplodeObject)
-	router.GET(baseURL+"/matrixExplodeArray/:id", wrapper.GetMatrixExplodeArray)
-	router.GET(baseURL+"/matrixExplodeObject/:id", wrapper.GetMatrixExplodeObject)
-	router.GET(baseURL+"/matrixNoExplodeArray/:id", wrapper.GetMatrixNoExplodeArray)
-	router.GET(baseURL+"/matrixNoExplodeObject/:id", wrapper.GetMatrixNoExplodeObject)
-	router.GET(baseURL+"/passThrough/:param", wrapper.GetPassThrough)
-	router.GET(baseURL+"/queryDeepObject", wrapper.GetDeepObject)
-	router.GET(baseURL+"/queryForm", wrapper.GetQueryForm)
-	router.GET(baseURL+"/simpleExplodeArray/:param", wrapper.GetSimpleExplodeArray)
-	router.GET(baseURL+"/simpleExplodeObject/:param", wrapper.GetSimpleExplodeObject)
-	router.GET(baseURL+"/simpleNoExplodeArray/:param", wrapper.GetSimpleNoExplodeArray)
-	router.GET(baseURL+"/simpleNoExplodeObject/:param", wrapper.GetSimpleNoExplodeObject)
-	router.GET(baseURL+"/simplePrimitive/:param", wrapper.GetSimplePrimitive)
-	router.GET(baseURL+"/startingWithNumber/:1param", wrapper.GetStartingWithNumber)
-
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		id := runtime.RequestCorrelationID(req.Header, "X-Request-ID")
+		ctx.Response().Header().Set("X-Request-ID", id)
+		ctx.SetRequest(req.WithContext(runtime.ContextWithCorrelationID(req.Context(), id)))
+		return next(ctx)
+	}
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xa34+bOBD+V9DcPZ1ISNo33qrer5Wu295lpZ5U7YMXJsE9wK7t7O0q4n8/2UAAQwgk",
-	"YTe9ty7MzDfzefzVHrKDgCWcpZgqCf4OBErOUonmjxVNeIx/FY/0k4ClClOl/6nwSXk8JjTVf8kgwoSY",
-	"588cwQepBE03kGWZCyHKQFCuKEvBh3eONHGdEsthD18xUKBN8zgG/T3TVk8f85f+DrhgHIWieXI3YQ2N",
-	"pgo3KCBz4Ua+C5M8qeLlA2MxklS/rIL9KHANPvzgVfV7Bbj3scpH4LctFRiC/6V0djV0hXPfCNvMcU2F",
-	"VLckwQ5iXBAs7nphoRortxbq3nBK0zXTzjENsFic1ADBh5s7HV1RpcPDHUrlrFA8ogAXHlHIfBmW88V8",
-	"oQ0Zx5RwCj68nS/mS3CBExWZ/L1ivfP6vB0ngiSZfrNBU64uluh11asBv6F6X3cwoQRJUKGQ4H9p9A/h",
-	"PKaBcfa+SmZ1Ud/yNBujYAN8kza4JQ0GGepcKrHF7N5t9vibxeIQ3t7OszZCZjC9gLF/KPazYSxaNDQ3",
-	"BBc0oYo+akN84jELEfw1iSUWhQVlmLI0cGtUrZlIiMo3wds34Lb2ROYOQtT0HADEsxELlNAhQpDnobCk",
-	"AUsVJnIQ/v5JjtaRTyuNPr6nS2NPCys3zCBeWCOhYVJmQ7cR+yg4DXGq7d6sJMgNKg47KwgYtEnQ7xyp",
-	"iFA03Tj/UhU56TZ5MFLZGWUpG0TY0t1Ul3Qbx0YpIiQhij6l+D23OFcpojJMke7fs081l0k1owd69kvR",
-	"5i+iIu1E3mnr7iReTFMOZPXKytLOKt9m3WRNITSHMvju9KZdSBGoLOgE9bFjLmerwnr2mapodltaj1ak",
-	"mDxgXCyyaURvNzfS81Pv8e4P262tWF1tNuRkdpmN4IJUz+bcayqES5736pyVJ+KxpB06GF+CtSG7ZHJ+",
-	"bllXVx3np+nXQ1BdPP5HfbWvv9lZI4g72lrnMPfavZUQJeiT1Vo07N94H1pOp2w8Gk7eU3l10xG276lR",
-	"jJ2uVUcoG9dMk5HTkioaDiDnAkL1PXdUW6fGsXaGSl17V3Ei5V0k2HYTDRmVfarMewdlIwatrzIG+7ZF",
-	"8fwzIq+moIdKrlkduemGiLz/6mJgqzrDPPTJHWKd+qtGCaucDx2mTSq/MpH01f7n3uhI6YMuuVb1F5uU",
-	"VXVrVxh5ybWyerGkhl12bc6mn6JZiJcA3Jd6bB5jVzvN0Lin2ssBOoXGHcDpH8m98ljASva0KaQVZNQQ",
-	"8ixtz7/UNY9JA268q5bb9c4J8hJhMtYa385G0HY9k4LJGLIP4MfPTKsOvyueFUzP3PAvs6sux6uYFkzG",
-	"0v4DxHB+6p9LLGZOYmJA80xJQ/F/ymeqonxW7O2WA6houU14QVlOfEPRDJtfP+R5b0UMPkRKcd/zip8+",
-	"KJRqrs/5CeFzQiG7z/4LAAD//734if8YIwAA",
+	"H4sIAAAAAAAC/9xaW3OjNhT+K8xpnzrExLtvvGW2t8xssts60+7MTh5kOImVAtJKcpqMx/+9IwEGxMWy",
+	"A4m3bwkcnct3Pn1GBzYQsZSzDDMlIdyAQMlZJtH8s6ApT/DP4pK+ErFMYab0nwqfVMATQjP9n4xWmBJz",
+	"/ZkjhCCVoNk9bLdbH2KUkaBcUZZBCBeeNH69MpbHlg8YKdCmuR8T/QPTVk+f8pvhBrhgHIWieXKXcS0a",
+	"zRTeo4CtD5fyIk5pVru5ZCxBkumblbMfBd5BCD8EVf1BETz4VOUj8NuaCowh/Fou9nXoKs5tw20zxzsq",
+	"pLomKXYA44NgSdcNK6qx8muubg2mNLtjenFCIyyak5lAcHV5o70rqrR7uEGpvAWKRxTgwyMKmbdhPjuf",
+	"nWtDxjEjnEII72fnszn4wIlamfyDot95fcGGE0HSrb5zj6ZcXSzRfdXdgN9QfagvMK4ESVGhkBB+bfCH",
+	"cJ7QyCwOHiSzWDTUniYxCjQgNGmDX8JgIkMdSyXWuL31mxx/d37eF29nF1gbYWtiluDcPHOMgw2NXYAx",
+	"xoO49O2rOyZSonKuv38Hfov6PTjQuA2C39uJpzRxb0S9A809nu9oT2KmPCK9L1cfoUju2xrFc5UdWz7A",
+	"1ncCwN7Nu3pXSGIUlc8vZ3+hWDLdqFG7zf6hONxiY9FqbhMaLmhKFX3UhvjEExYjhHckkViUE5VuSiKD",
+	"fxANfKeImgc9AfHFEYsosUeEIM+uYUkjLFWYSqf4uyt5tI58WmkM4T1dGjtYWCmPTriwRkJuP1ybzg3p",
+	"CsFxEacS92YlUW5QYdhZQcSgDYK+50lFhKLZvfcvVSsvW6dLFH1e5rIBhP1D3VSXbJ0kRikKPRpQit9L",
+	"xXqZUrSF73NtyaSaMRD67JeC5q+iIu1ELrR1dxKvpik9Wb2xsrSzyrdZN1hTCE1fBt+d3rQLKRyVBR2h",
+	"PrbP+dmisD77m6rV2XVpfbAiJWSJSdFkQ8RgMzPS89PgM+tHe1lbsbpo5vIcPs5G8EGqZ3PKMRWO+rxX",
+	"x6w8/xwKWt8xaAzUXHbJ5Phcsy5W7cenuW4AoLp4/I94tau/yawDgNtLrZcg99bcSokS9MmiFo2HN95V",
+	"a9ExG4/Gg8iMwam8uukA23HqIMSO16o9kB1GpsnAaUkVjR3AGUGovmdGtXXqMNReoFKnzipOpLxZCba+",
+	"X7kMRj9X5keN/zrG6m8y9DTzu58ReTXz7iu5ZrXnpBsj8uGjizU2jHPXRzPEeuqviBJXOfc9TBcIJPq4",
+	"i/EQAH80LfeAIDmJ0ItLc2/wgGnhIccaWlVImHSq5DtGBJQfmy7vSbf1iqQvOR17KLfdeXgvqP0cw1cA",
+	"deyN+SsT6V5GGqM9ZHSavdiAjY+XXgoHzl6srF4tKbcZjI3Z9MNdK+IYAXel7hsT2tVO8y5jSGlGC+gV",
+	"qtQTZ3hS/MbTKivZ44bjlpODZuMvUrb8c4Hm07vDIGbRWna646u8RJgMtcYL/ANgO50B1mQI2efC/Y/y",
+	"i451JzzCmh45989DFl0LT2KINRlKu/di7vjU3+JZyByFhAN5poSh+E3R7zDyVxjBZu4ARWvZhOfm+cQH",
+	"Z42w+QQrz3stEghhpRQPg6D4/kqhVLMYkaeEzwiF7e32vwEAOLmTuJ0nAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
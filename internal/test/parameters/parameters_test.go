@@ -25,7 +25,9 @@ type testServer struct {
 	primitiveString *string
 	cookieParams    *GetCookieParams
 	queryParams     *GetQueryFormParams
+	delimitedParams *GetQueryDelimitedParams
 	headerParams    *GetHeaderParams
+	contentParams   *GetContentTypedParams
 }
 
 func (t *testServer) reset() {
@@ -38,7 +40,9 @@ func (t *testServer) reset() {
 	t.primitiveString = nil
 	t.cookieParams = nil
 	t.queryParams = nil
+	t.delimitedParams = nil
 	t.headerParams = nil
+	t.contentParams = nil
 }
 
 //  (GET /contentObject/{param})
@@ -47,6 +51,13 @@ func (t *testServer) GetContentObject(ctx echo.Context, param ComplexObject) err
 	return nil
 }
 
+//  (GET /contentTyped/{id})
+func (t *testServer) GetContentTyped(ctx echo.Context, id int32, params GetContentTypedParams) error {
+	t.primitive = &id
+	t.contentParams = &params
+	return nil
+}
+
 //  (GET /labelExplodeArray/{.param*})
 func (t *testServer) GetLabelExplodeArray(ctx echo.Context, param []int32) error {
 	t.array = param
@@ -143,6 +154,12 @@ func (t *testServer) GetSimplePrimitive(ctx echo.Context, param int32) error {
 	return nil
 }
 
+//  (GET /queryDelimited)
+func (t *testServer) GetQueryDelimited(ctx echo.Context, params GetQueryDelimitedParams) error {
+	t.delimitedParams = &params
+	return nil
+}
+
 //  (GET /queryForm)
 func (t *testServer) GetQueryForm(ctx echo.Context, params GetQueryFormParams) error {
 	t.queryParams = &params
@@ -695,6 +712,31 @@ func TestClientQueryParams(t *testing.T) {
 	assert.EqualValues(t, qParams, *ts.queryParams)
 	ts.reset()
 
+	// Check space and pipe delimited params
+	dParams := GetQueryDelimitedParams{
+		Sa:  &expectedArray1,
+		Pa:  &[]string{"a b", "c"},
+		Esa: &expectedArray2,
+	}
+	req, err = NewGetQueryDelimitedRequest(server, &dParams)
+	assert.NoError(t, err)
+	assert.Equal(t, "esa=6&esa=7&esa=8&pa=a+b%7Cc&sa=3+4+5", req.URL.RawQuery)
+	doRequest(t, e, http.StatusOK, req)
+	require.NotNil(t, ts.delimitedParams)
+	assert.Equal(t, dParams, *ts.delimitedParams)
+	ts.reset()
+
+	// Check deepObject params, which keep their brackets and are escaped
+	deepObject := expectedComplexObject
+	deepObject.Object.FirstName = "Marcin & co"
+	req, err = NewGetDeepObjectRequest(server, &GetDeepObjectParams{DeepObj: deepObject})
+	assert.NoError(t, err)
+	assert.Equal(t, "deepObj[Id]=12345&deepObj[IsAdmin]=true&deepObj[Object][firstName]=Marcin+%26+co&deepObj[Object][role]=annoyed_at_swagger", req.URL.RawQuery)
+	doRequest(t, e, http.StatusOK, req)
+	require.NotNil(t, ts.complexObject)
+	assert.Equal(t, deepObject, *ts.complexObject)
+	ts.reset()
+
 	// Check cookie params
 	cParams := GetCookieParams{
 		Ea:  &expectedArray1,
@@ -713,6 +755,24 @@ func TestClientQueryParams(t *testing.T) {
 	assert.EqualValues(t, cParams, *ts.cookieParams)
 	ts.reset()
 
+	// Check cookie params with characters which aren't allowed in cookies
+	specialString := `a;b "c"\ 100% é`
+	specialObject := Object{FirstName: "Alex Smith", Role: "a,b"}
+	cParams = GetCookieParams{
+		O:   &specialObject,
+		N1s: &specialString,
+	}
+	req, err = NewGetCookieRequest(server, &cParams)
+	assert.NoError(t, err)
+	cookie, err := req.Cookie("1s")
+	require.NoError(t, err)
+	assert.Equal(t, "a%3Bb%20%22c%22%5C%20100%25%20%C3%A9", cookie.Value)
+	doRequest(t, e, http.StatusOK, req)
+	require.NotNil(t, ts.cookieParams)
+	assert.Equal(t, specialString, *ts.cookieParams.N1s)
+	assert.Equal(t, "Alex Smith", ts.cookieParams.O.FirstName)
+	ts.reset()
+
 	// Check Header parameters
 	hParams := GetHeaderParams{
 		XArrayExploded:       &expectedArray1,
@@ -731,3 +791,69 @@ func TestClientQueryParams(t *testing.T) {
 	assert.EqualValues(t, hParams, *ts.headerParams)
 	ts.reset()
 }
+
+func TestClientContentParams(t *testing.T) {
+	var ts testServer
+	e := echo.New()
+	RegisterHandlers(e, &ts)
+
+	// Plain text parameters are typed by their schema, and XML ones are
+	// marshaled with encoding/xml.
+	verbose := true
+	params := GetContentTypedParams{
+		Obj:      &Object{FirstName: "Alex", Role: "admin"},
+		XVerbose: &verbose,
+	}
+	req, err := NewGetContentTypedRequest("http://example.com", 42, &params)
+	require.NoError(t, err)
+	assert.Equal(t, "/contentTyped/42", req.URL.Path)
+	assert.Equal(t, "<Object><firstName>Alex</firstName><role>admin</role></Object>", req.URL.Query().Get("obj"))
+	assert.Equal(t, "true", req.Header.Get("X-Verbose"))
+
+	doRequest(t, e, http.StatusOK, req)
+	require.NotNil(t, ts.primitive)
+	assert.Equal(t, int32(42), *ts.primitive)
+	require.NotNil(t, ts.contentParams)
+	assert.Equal(t, params, *ts.contentParams)
+
+	result := testutil.NewRequest().Get("/contentTyped/forty-two").Go(t, e)
+	assert.Equal(t, http.StatusBadRequest, result.Code())
+}
+
+func TestPaths(t *testing.T) {
+	var ts testServer
+	e := echo.New()
+	RegisterHandlers(e, &ts)
+
+	expectedObject := Object{
+		FirstName: "Alex",
+		Role:      "admin",
+	}
+	expectedArray := []int32{3, 4, 5}
+
+	assert.Equal(t, "/simplePrimitive/{param}", GetSimplePrimitivePathTemplate)
+
+	path, err := PathGetSimplePrimitive(5)
+	require.NoError(t, err)
+	assert.Equal(t, "/simplePrimitive/5", path)
+	req, err := NewGetSimplePrimitiveRequest("http://example.com", 5)
+	require.NoError(t, err)
+	assert.Equal(t, path, req.URL.Path)
+
+	path, err = PathGetMatrixExplodeObject(expectedObject)
+	require.NoError(t, err)
+	assert.Equal(t, "/matrixExplodeObject/;firstName=Alex;role=admin", path)
+	doRequest(t, e, http.StatusOK, httptest.NewRequest(http.MethodGet, path, nil))
+	assert.EqualValues(t, &expectedObject, ts.object)
+	ts.reset()
+
+	path, err = PathGetLabelNoExplodeArray(expectedArray)
+	require.NoError(t, err)
+	doRequest(t, e, http.StatusOK, httptest.NewRequest(http.MethodGet, path, nil))
+	assert.EqualValues(t, expectedArray, ts.array)
+	ts.reset()
+
+	path, err = PathGetQueryForm()
+	require.NoError(t, err)
+	assert.Equal(t, GetQueryFormPathTemplate, path)
+}
@@ -0,0 +1,3 @@
+package routes
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=routes --generate types,chi-server,routes -o routes.gen.go routes.yaml
@@ -0,0 +1,40 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+type server struct{}
+
+func (server) ListPets(w http.ResponseWriter, r *http.Request) {}
+
+func (server) GetPet(w http.ResponseWriter, r *http.Request, id int) {}
+
+func (server) DeletePet(w http.ResponseWriter, r *http.Request, id int) {}
+
+func TestRoutesHandler(t *testing.T) {
+	r := chi.NewRouter()
+	r.Method(http.MethodGet, "/.well-known/routes", RoutesHandler())
+	HandlerFromMux(server{}, r)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/.well-known/routes", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var routes []runtime.Route
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &routes))
+	assert.Equal(t, []runtime.Route{
+		{OperationID: "listPets", Method: http.MethodGet, Path: "/pets"},
+		{OperationID: "deletePet", Method: http.MethodDelete, Path: "/pets/{id}", Deprecated: true},
+		{OperationID: "getPet", Method: http.MethodGet, Path: "/pets/{id}"},
+	}, routes)
+}
@@ -0,0 +1,3 @@
+package sensitive
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=sensitive --generate types,skip-prune --log-valuers --build-tags go1.21 -o sensitive.gen.go sensitive.yaml
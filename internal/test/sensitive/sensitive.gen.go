@@ -0,0 +1,140 @@
+//go:build go1.21
+
+// Package sensitive provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version (devel) DO NOT EDIT.
+package sensitive
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// Account defines model for Account.
+type Account struct {
+	Key         *string      `json:"apiKey,omitempty"`
+	Credentials *Credentials `json:"credentials,omitempty"`
+	Password    *string      `json:"password,omitempty"`
+	User        string       `json:"user"`
+}
+
+// Credentials defines model for Credentials.
+type Credentials struct {
+	Token                *string           `json:"token,omitempty"`
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Getter for additional properties for Credentials. Returns the specified
+// element and whether it was found
+func (a Credentials) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Credentials
+func (a *Credentials) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Credentials to handle AdditionalProperties
+func (a *Credentials) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["token"]; found {
+		err = json.Unmarshal(raw, &a.Token)
+		if err != nil {
+			return fmt.Errorf("error reading 'token': %w", err)
+		}
+		delete(object, "token")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Credentials to handle AdditionalProperties
+func (a Credentials) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Token != nil {
+		object["token"], err = json.Marshal(a.Token)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'token': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// String returns the fields of the Account as the %+v verb of fmt does,
+// with those of the sensitive properties masked.
+func (s Account) String() string {
+	return runtime.SensitiveString(s, "Key", "Password")
+}
+
+// LogValue returns the fields of the Account as a group of attributes
+// named after the properties, with those of the sensitive ones masked. Unset
+// optional properties are left out.
+func (s Account) LogValue() slog.Value {
+	var attrs []slog.Attr
+	if s.Key != nil {
+		attrs = append(attrs, slog.String("apiKey", runtime.Redacted))
+	}
+	if s.Credentials != nil {
+		attrs = append(attrs, slog.Any("credentials", s.Credentials))
+	}
+	if s.Password != nil {
+		attrs = append(attrs, slog.String("password", runtime.Redacted))
+	}
+	attrs = append(attrs, slog.Any("user", s.User))
+	return slog.GroupValue(attrs...)
+}
+
+// String returns the fields of the Credentials as the %+v verb of fmt does,
+// with those of the sensitive properties masked.
+func (s Credentials) String() string {
+	return runtime.SensitiveString(s, "Token")
+}
+
+// LogValue returns the fields of the Credentials as a group of attributes
+// named after the properties, with those of the sensitive ones masked. Unset
+// optional properties are left out.
+func (s Credentials) LogValue() slog.Value {
+	var attrs []slog.Attr
+	if s.Token != nil {
+		attrs = append(attrs, slog.String("token", runtime.Redacted))
+	}
+	if s.AdditionalProperties != nil {
+		attrs = append(attrs, slog.Any("additionalProperties", s.AdditionalProperties))
+	}
+	return slog.GroupValue(attrs...)
+}
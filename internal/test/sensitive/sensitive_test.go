@@ -0,0 +1,47 @@
+//go:build go1.21
+
+package sensitive
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSensitive(t *testing.T) {
+	password, key, token := "hunter2", "k-123", "t-456"
+	account := Account{
+		User:        "alex",
+		Password:    &password,
+		Key:         &key,
+		Credentials: &Credentials{Token: &token, AdditionalProperties: map[string]string{"scope": "read"}},
+	}
+
+	formatted := fmt.Sprintf("%v", account)
+	assert.Equal(t, "{Key:REDACTED Credentials:{Token:REDACTED AdditionalProperties:map[scope:read]} Password:REDACTED User:alex}", formatted)
+	assert.Equal(t, "{Key:<nil> Credentials:<nil> Password:<nil> User:sam}", Account{User: "sam"}.String())
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+		if a.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+		return a
+	}})).Info("signed in", "account", account)
+	assert.JSONEq(t, `{
+		"level": "INFO",
+		"msg": "signed in",
+		"account": {
+			"apiKey": "REDACTED",
+			"credentials": {"token": "REDACTED", "additionalProperties": {"scope": "read"}},
+			"password": "REDACTED",
+			"user": "alex"
+		}
+	}`, buf.String())
+	for _, secret := range []string{password, key, token} {
+		assert.NotContains(t, buf.String(), secret)
+	}
+}
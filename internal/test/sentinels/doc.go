@@ -0,0 +1,3 @@
+package sentinels
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=sentinels --generate types,client --sentinel-errors -o sentinels.gen.go sentinels.yaml
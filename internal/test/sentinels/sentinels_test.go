@@ -0,0 +1,58 @@
+package sentinels
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	status := http.StatusOK
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"name":"Rex","message":"oops"}`))
+	}))
+	defer ts.Close()
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+
+	rsp, err := client.GetPetWithResponse(context.Background(), 1)
+	require.NoError(t, err)
+	assert.NoError(t, rsp.Err())
+
+	status = http.StatusNotFound
+	rsp, err = client.GetPetWithResponse(context.Background(), 1)
+	require.NoError(t, err)
+	err = rsp.Err()
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.False(t, errors.Is(err, ErrConflict))
+	assert.EqualError(t, err, "status code 404: not found")
+	var statusErr *runtime.StatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusNotFound, statusErr.StatusCode)
+	assert.JSONEq(t, `{"name":"Rex","message":"oops"}`, string(statusErr.Body))
+
+	// 409 is declared by addPet only.
+	status = http.StatusConflict
+	rsp, err = client.GetPetWithResponse(context.Background(), 1)
+	require.NoError(t, err)
+	err = rsp.Err()
+	assert.False(t, errors.Is(err, ErrConflict))
+	assert.EqualError(t, err, "unexpected status code 409")
+
+	added, err := client.AddPetWithResponse(context.Background(), AddPetJSONRequestBody{Name: "Rex"})
+	require.NoError(t, err)
+	assert.True(t, errors.Is(added.Err(), ErrConflict))
+
+	status = http.StatusInternalServerError
+	added, err = client.AddPetWithResponse(context.Background(), AddPetJSONRequestBody{Name: "Rex"})
+	require.NoError(t, err)
+	assert.EqualError(t, added.Err(), "unexpected status code 500")
+}
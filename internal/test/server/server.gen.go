@@ -529,6 +529,8 @@ type ChiServerOptions struct {
 	BaseRouter       chi.Router
 	Middlewares      []MiddlewareFunc
 	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// When set, every call of an operation is logged with it.
+	Logger runtime.OperationLogger
 }
 
 // HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
@@ -564,35 +566,46 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	}
 
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/every-type-optional", wrapper.GetEveryTypeOptional)
+		r.Get(options.BaseURL+"/every-type-optional", runtime.LogHandlerFunc(options.Logger, "GetEveryTypeOptional", wrapper.GetEveryTypeOptional))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/get-simple", wrapper.GetSimple)
+		r.Get(options.BaseURL+"/get-simple", runtime.LogHandlerFunc(options.Logger, "GetSimple", wrapper.GetSimple))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/get-with-args", wrapper.GetWithArgs)
+		r.Get(options.BaseURL+"/get-with-args", runtime.LogHandlerFunc(options.Logger, "GetWithArgs", wrapper.GetWithArgs))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/get-with-references/{global_argument}/{argument}", wrapper.GetWithReferences)
+		r.Get(options.BaseURL+"/get-with-references/{global_argument}/{argument}", runtime.LogHandlerFunc(options.Logger, "GetWithReferences", wrapper.GetWithReferences))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/get-with-type/{content_type}", wrapper.GetWithContentType)
+		r.Get(options.BaseURL+"/get-with-type/{content_type}", runtime.LogHandlerFunc(options.Logger, "GetWithContentType", wrapper.GetWithContentType))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/reserved-keyword", wrapper.GetReservedKeyword)
+		r.Get(options.BaseURL+"/reserved-keyword", runtime.LogHandlerFunc(options.Logger, "GetReservedKeyword", wrapper.GetReservedKeyword))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/resource/{argument}", wrapper.CreateResource)
+		r.Post(options.BaseURL+"/resource/{argument}", runtime.LogHandlerFunc(options.Logger, "CreateResource", wrapper.CreateResource))
 	})
 	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/resource2/{inline_argument}", wrapper.CreateResource2)
+		r.Post(options.BaseURL+"/resource2/{inline_argument}", runtime.LogHandlerFunc(options.Logger, "CreateResource2", wrapper.CreateResource2))
 	})
 	r.Group(func(r chi.Router) {
-		r.Put(options.BaseURL+"/resource3/{fallthrough}", wrapper.UpdateResource3)
+		r.Put(options.BaseURL+"/resource3/{fallthrough}", runtime.LogHandlerFunc(options.Logger, "UpdateResource3", wrapper.UpdateResource3))
 	})
 	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/response-with-reference", wrapper.GetResponseWithReference)
+		r.Get(options.BaseURL+"/response-with-reference", runtime.LogHandlerFunc(options.Logger, "GetResponseWithReference", wrapper.GetResponseWithReference))
 	})
 
 	return r
 }
+
+// CorrelationIDMiddleware adds the correlation ID of requests, taken from the
+// X-Request-ID header or generated when absent, to the request context, where
+// runtime.CorrelationIDFromContext finds it, and to the response headers.
+func CorrelationIDMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := runtime.RequestCorrelationID(r.Header, "X-Request-ID")
+		w.Header().Set("X-Request-ID", id)
+		next(w, r.WithContext(runtime.ContextWithCorrelationID(r.Context(), id)))
+	}
+}
@@ -1,12 +1,14 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -60,3 +62,59 @@ func TestErrorHandlerFuncBackwardsCompatible(t *testing.T) {
 	assert.Equal(t, "text/plain; charset=utf-8", req.Header.Get("Content-Type"))
 	assert.Equal(t, "Query argument required_argument is required, but not found\n", string(b))
 }
+
+func TestLogger(t *testing.T) {
+	m := ServerInterfaceMock{}
+	m.GetSimpleFunc = func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}
+
+	var records []runtime.OperationRecord
+	h := HandlerWithOptions(&m, ChiServerOptions{
+		Logger: runtime.OperationLoggerFunc(func(ctx context.Context, record runtime.OperationRecord) {
+			records = append(records, record)
+		}),
+	})
+
+	req := httptest.NewRequest("GET", "http://openapitest.deepmap.ai/get-simple?access_token=secret", nil)
+	req.Header.Set("Cookie", "session=secret")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	req = httptest.NewRequest("GET", "http://openapitest.deepmap.ai/get-with-args", nil)
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, "GetSimple", records[0].OperationID)
+		assert.Equal(t, http.StatusAccepted, records[0].StatusCode)
+		assert.Equal(t, "http://openapitest.deepmap.ai/get-simple?access_token=REDACTED", records[0].URL)
+		assert.Equal(t, "REDACTED", records[0].RequestHeader.Get("Cookie"))
+		assert.Equal(t, "GetWithArgs", records[1].OperationID)
+		assert.Equal(t, http.StatusBadRequest, records[1].StatusCode)
+	}
+}
+
+func TestCorrelationIDMiddleware(t *testing.T) {
+	m := ServerInterfaceMock{}
+	var id string
+	m.GetSimpleFunc = func(w http.ResponseWriter, r *http.Request) {
+		id = runtime.CorrelationIDFromContext(r.Context())
+	}
+
+	h := HandlerWithOptions(&m, ChiServerOptions{
+		Middlewares: []MiddlewareFunc{CorrelationIDMiddleware},
+	})
+
+	req := httptest.NewRequest("GET", "http://openapitest.deepmap.ai/get-simple", nil)
+	req.Header.Set("X-Request-ID", "abc-123")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	assert.Equal(t, "abc-123", id)
+	assert.Equal(t, "abc-123", rr.Header().Get("X-Request-ID"))
+
+	req = httptest.NewRequest("GET", "http://openapitest.deepmap.ai/get-simple", nil)
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+	assert.NotEmpty(t, id)
+	assert.NotEqual(t, "abc-123", id)
+	assert.Equal(t, id, rr.Header().Get("X-Request-ID"))
+}
@@ -0,0 +1,3 @@
+package signature
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=signature --generate types,client,chi-server -o signature.gen.go signature.yaml
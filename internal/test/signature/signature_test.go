@@ -0,0 +1,57 @@
+package signature
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type server struct{}
+
+func (server) AddPet(w http.ResponseWriter, r *http.Request, params AddPetParams) {
+	var pet Pet
+	if err := json.NewDecoder(r.Body).Decode(&pet); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(pet)
+}
+
+func TestSignature(t *testing.T) {
+	secret := []byte("secret")
+	ts := httptest.NewServer(VerifySignatures(secret)(Handler(server{})))
+	defer ts.Close()
+
+	timestamp := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("X-Timestamp", "1700000000")
+		return nil
+	}
+	dryRun := true
+	params := &AddPetParams{DryRun: &dryRun}
+
+	client, err := NewClientWithResponses(ts.URL, WithRequestEditorFn(timestamp), WithRequestSigner(SignRequests(secret)))
+	require.NoError(t, err)
+	rsp, err := client.AddPetWithResponse(context.Background(), params, AddPetJSONRequestBody{Name: "Rex"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, rsp.StatusCode())
+	assert.Equal(t, "Rex", rsp.JSON201.Name)
+
+	other, err := NewClientWithResponses(ts.URL, WithRequestEditorFn(timestamp), WithRequestSigner(SignRequests([]byte("other"))))
+	require.NoError(t, err)
+	rsp, err = other.AddPetWithResponse(context.Background(), params, AddPetJSONRequestBody{Name: "Rex"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rsp.StatusCode())
+
+	unsigned, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+	rsp, err = unsigned.AddPetWithResponse(context.Background(), params, AddPetJSONRequestBody{Name: "Rex"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rsp.StatusCode())
+}
@@ -0,0 +1,46 @@
+package swagger2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwagger2Client(t *testing.T) {
+	var requests []*http.Request
+	var bodies []NewPet
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		if r.Method == http.MethodPost {
+			var body NewPet
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			bodies = append(bodies, body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":1,"name":"Rex"}`))
+	}))
+	defer ts.Close()
+	client, err := NewClient(ts.URL + "/v1")
+	require.NoError(t, err)
+
+	tags := []string{"dog", "cat"}
+	_, err = client.ListPets(context.Background(), &ListPetsParams{Tags: &tags})
+	require.NoError(t, err)
+	_, err = client.AddPet(context.Background(), AddPetJSONRequestBody{Name: "Rex"})
+	require.NoError(t, err)
+	_, err = client.FindPetByID(context.Background(), 1)
+	require.NoError(t, err)
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, "/v1/pets", requests[0].URL.Path)
+	assert.Equal(t, []string{"dog", "cat"}, requests[0].URL.Query()["tags"])
+	assert.Equal(t, "application/json", requests[1].Header.Get("Content-Type"))
+	assert.Equal(t, []NewPet{{Name: "Rex"}}, bodies)
+	assert.Equal(t, "/v1/pets/1", requests[2].URL.Path)
+	assert.Equal(t, "https://api.example.com/v1", Server1URL)
+}
@@ -0,0 +1,3 @@
+package tagpackages
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=models --tag-packages=github.com/deepmap/oapi-codegen/internal/test/tagpackages --generate=types,client,chi-server -o . spec.yaml
@@ -0,0 +1,85 @@
+package tagpackages
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/internal/test/tagpackages/models"
+	"github.com/deepmap/oapi-codegen/internal/test/tagpackages/owners"
+	"github.com/deepmap/oapi-codegen/internal/test/tagpackages/pets"
+)
+
+type petsServer struct {
+	pets []models.Pet
+}
+
+func (s *petsServer) ListPets(w http.ResponseWriter, r *http.Request, params pets.ListPetsParams) {
+	var result []models.Pet
+	for _, pet := range s.pets {
+		if params.Kind == nil || pet.Kind == *params.Kind {
+			result = append(result, pet)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func (s *petsServer) AddPet(w http.ResponseWriter, r *http.Request) {
+	var pet models.Pet
+	if err := json.NewDecoder(r.Body).Decode(&pet); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.pets = append(s.pets, pet)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(pet)
+}
+
+type ownersServer struct{}
+
+func (ownersServer) GetOwner(w http.ResponseWriter, r *http.Request, id models.Id) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"name":"Alex","pets":[{"name":"Rex","kind":"dog"}]}`))
+}
+
+func TestTagPackages(t *testing.T) {
+	petsAPI := httptest.NewServer(pets.Handler(&petsServer{}))
+	defer petsAPI.Close()
+	petsClient, err := pets.NewClientWithResponses(petsAPI.URL)
+	require.NoError(t, err)
+
+	// Operations with several tags are generated in the package of their
+	// first tag, with the shared types of the common package.
+	created, err := petsClient.AddPetWithResponse(context.Background(), pets.AddPetJSONRequestBody{Name: "Tom", Kind: models.KindCat})
+	require.NoError(t, err)
+	require.NotNil(t, created.JSON201)
+	assert.Equal(t, models.Pet{Name: "Tom", Kind: models.KindCat}, *created.JSON201)
+
+	kind := models.KindCat
+	listed, err := petsClient.ListPetsWithResponse(context.Background(), &pets.ListPetsParams{Kind: &kind})
+	require.NoError(t, err)
+	require.NotNil(t, listed.JSON200)
+	assert.Equal(t, []models.Pet{{Name: "Tom", Kind: models.KindCat}}, *listed.JSON200)
+
+	ownersAPI := httptest.NewServer(owners.Handler(ownersServer{}))
+	defer ownersAPI.Close()
+	ownersClient, err := owners.NewClientWithResponses(ownersAPI.URL)
+	require.NoError(t, err)
+
+	owner, err := ownersClient.GetOwnerWithResponse(context.Background(), models.Id(1))
+	require.NoError(t, err)
+	require.NotNil(t, owner.JSON200)
+	assert.Equal(t, []models.Pet{{Name: "Rex", Kind: models.KindDog}}, owner.JSON200.Pets)
+
+	// Operations without tags stay in the common package.
+	var _ models.ServerInterface
+	_, err = models.NewClient("https://example.com")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,3 @@
+package testclient
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=testclient --generate types,client,chi-server,test-client -o testclient.gen.go testclient.yaml
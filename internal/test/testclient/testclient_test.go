@@ -0,0 +1,99 @@
+package testclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// things is a ServerInterface implementation keeping things in memory.
+type things struct {
+	mu     sync.Mutex
+	things []Thing
+}
+
+var _ ServerInterface = (*things)(nil)
+
+func (s *things) CreateThing(w http.ResponseWriter, r *http.Request) {
+	var body CreateThingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	thing := Thing{Id: len(s.things) + 1, Name: body.Name}
+	s.things = append(s.things, thing)
+	writeJSON(w, http.StatusCreated, thing)
+}
+
+func (s *things) GetThing(w http.ResponseWriter, r *http.Request, id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id < 1 || id > len(s.things) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.things[id-1])
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestTestClient(t *testing.T) {
+	var editedRequests int
+	client := NewTestClient(t, &things{}, WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		editedRequests++
+		return nil
+	}))
+
+	created, err := client.CreateThingWithResponse(context.Background(), CreateThingJSONRequestBody{Name: "widget"})
+	require.NoError(t, err)
+	require.NotNil(t, created.JSON201)
+	assert.Equal(t, Thing{Id: 1, Name: "widget"}, *created.JSON201)
+
+	got, err := client.GetThingWithResponse(context.Background(), 1)
+	require.NoError(t, err)
+	require.NotNil(t, got.JSON200)
+	assert.Equal(t, "widget", got.JSON200.Name)
+
+	missing, err := client.GetThingWithResponse(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, missing.StatusCode())
+
+	assert.Equal(t, 3, editedRequests)
+	assert.NotEmpty(t, client.Server.URL)
+}
+
+func TestRecordedClient(t *testing.T) {
+	dir := t.TempDir()
+	recording := NewTestClient(t, &things{}, WithRecorder(runtime.RecorderRecord, dir))
+	_, err := recording.CreateThingWithResponse(context.Background(), CreateThingJSONRequestBody{Name: "widget"})
+	require.NoError(t, err)
+	_, err = recording.GetThingWithResponse(context.Background(), 1)
+	require.NoError(t, err)
+
+	// The replaying client doesn't reach any server.
+	replaying, err := NewClientWithResponses("http://localhost:1", WithRecorder(runtime.RecorderReplay, dir))
+	require.NoError(t, err)
+	created, err := replaying.CreateThingWithResponse(context.Background(), CreateThingJSONRequestBody{Name: "widget"})
+	require.NoError(t, err)
+	require.NotNil(t, created.JSON201)
+	assert.Equal(t, Thing{Id: 1, Name: "widget"}, *created.JSON201)
+	got, err := replaying.GetThingWithResponse(context.Background(), 1)
+	require.NoError(t, err)
+	require.NotNil(t, got.JSON200)
+	assert.Equal(t, "widget", got.JSON200.Name)
+
+	_, err = replaying.GetThingWithResponse(context.Background(), 2)
+	assert.Error(t, err)
+}
@@ -0,0 +1,3 @@
+package text
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=text --generate types,client,chi-server -o text.gen.go text.yaml
@@ -0,0 +1,93 @@
+package text
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// server is a ServerInterface implementation which keeps the people it
+// imports.
+type server struct {
+	people []Person
+}
+
+var _ ServerInterface = (*server)(nil)
+
+func (s *server) Echo(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(strings.ToUpper(string(body))))
+}
+
+func (s *server) ListPeople(w http.ResponseWriter, r *http.Request) {
+	data, err := runtime.MarshalCSV(s.people)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	_, _ = w.Write(data)
+}
+
+func (s *server) ImportPeople(w http.ResponseWriter, r *http.Request) {
+	// Rows are decoded as they're read, rather than buffering the body.
+	d := runtime.NewCSVDecoder(r.Body)
+	for {
+		var person Person
+		if err := d.Decode(&person); err == io.EOF {
+			break
+		} else if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.people = append(s.people, person)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) GetReport(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/csv")
+	_, _ = w.Write([]byte("total\n2\n"))
+}
+
+func TestTextBodies(t *testing.T) {
+	ts := httptest.NewServer(Handler(&server{}))
+	defer ts.Close()
+	client, err := NewClientWithResponses(ts.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	echo, err := client.EchoWithTextBodyWithResponse(ctx, "hello")
+	require.NoError(t, err)
+	require.NotNil(t, echo.Text200)
+	assert.Equal(t, "HELLO", *echo.Text200)
+
+	age := 30
+	people := []Person{{Name: "Alex", Age: &age}, {Name: "Sam, Jr."}}
+	imported, err := client.ImportPeopleWithCSVBodyWithResponse(ctx, people)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, imported.StatusCode())
+
+	listed, err := client.ListPeopleWithResponse(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, listed.CSV200)
+	assert.Equal(t, people, *listed.CSV200)
+
+	report, err := client.GetReportWithResponse(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, report.CSV200)
+	assert.Equal(t, "total\n2\n", *report.CSV200)
+}
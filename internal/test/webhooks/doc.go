@@ -0,0 +1,3 @@
+package webhooks
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen --package=webhooks --generate types,client,chi-server -o webhooks.gen.go webhooks.yaml
@@ -0,0 +1,83 @@
+package webhooks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// consumer is a WebhookInterface implementation recording the adoptions.
+type consumer struct {
+	adoptions  []Adoption
+	deliveries []string
+}
+
+var _ WebhookInterface = (*consumer)(nil)
+
+func (c *consumer) PetAdopted(w http.ResponseWriter, r *http.Request, params PetAdoptedParams, body PetAdoptedJSONRequestBody) {
+	c.adoptions = append(c.adoptions, Adoption(body))
+	c.deliveries = append(c.deliveries, params.XDelivery)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestWebhooks(t *testing.T) {
+	secret := []byte("secret")
+	c := &consumer{}
+	server := httptest.NewServer(NewPetAdoptedWebhookHandler(c, WebhookHandlerOptions{
+		Verify: runtime.VerifyHMACSHA256(secret, "X-Signature"),
+	}))
+	defer server.Close()
+
+	sender, err := NewWebhookSender(WithRequestSigner(runtime.SignHMACSHA256(secret, "X-Signature")))
+	require.NoError(t, err)
+	adoption := Adoption{PetId: 1, Owner: "alice"}
+	rsp, err := sender.SendPetAdopted(context.Background(), server.URL+"/hooks/adoptions",
+		&PetAdoptedParams{XDelivery: "d1"}, PetAdoptedJSONRequestBody(adoption))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rsp.StatusCode)
+	assert.Equal(t, []Adoption{adoption}, c.adoptions)
+	assert.Equal(t, []string{"d1"}, c.deliveries)
+
+	// Webhooks signed with another secret are rejected before being handled.
+	forger, err := NewWebhookSender(WithRequestSigner(runtime.SignHMACSHA256([]byte("guess"), "X-Signature")))
+	require.NoError(t, err)
+	rsp, err = forger.SendPetAdopted(context.Background(), server.URL,
+		&PetAdoptedParams{XDelivery: "d2"}, PetAdoptedJSONRequestBody(adoption))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rsp.StatusCode)
+	assert.Len(t, c.adoptions, 1)
+
+	rsp, err = http.Get(server.URL)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusMethodNotAllowed, rsp.StatusCode)
+
+	// Webhooks missing their parameters, or whose body can't be decoded, aren't
+	// handled.
+	rsp, err = sender.SendPetAdoptedWithBody(context.Background(), server.URL,
+		&PetAdoptedParams{XDelivery: "d3"}, "application/json", strings.NewReader("{"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rsp.StatusCode)
+	rsp, err = sender.SendPetAdopted(context.Background(), server.URL,
+		&PetAdoptedParams{XDelivery: "d4"}, PetAdoptedJSONRequestBody(adoption),
+		func(ctx context.Context, req *http.Request) error {
+			req.Header.Del("X-Delivery")
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rsp.StatusCode)
+	assert.Len(t, c.adoptions, 1)
+}
+
+func TestWebhookRequestURL(t *testing.T) {
+	// Webhooks are sent to exactly the URL of their subscriber.
+	req, err := NewPetAdoptedRequestWithBody("https://example.com/hooks?token=abc",
+		&PetAdoptedParams{XDelivery: "d1"}, "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/hooks?token=abc", req.URL.String())
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"text/template"
+)
+
+// SchemaAssertionDefinition describes the assertion that payloads are valid
+// against a component schema, AssertFooValid.
+type SchemaAssertionDefinition struct {
+	TypeName   string // The name of the type of the schema, which names the assertion
+	SchemaName string // The name of the schema in the components of the spec
+}
+
+// DescribeSchemaAssertions describes the assertions of the component schemas
+// of the given types.
+func DescribeSchemaAssertions(types []TypeDefinition) []SchemaAssertionDefinition {
+	var assertions []SchemaAssertionDefinition
+	seen := make(map[string]bool)
+	for _, td := range types {
+		if seen[td.TypeName] || td.Schema.ProtoMessage {
+			continue
+		}
+		seen[td.TypeName] = true
+		assertions = append(assertions, SchemaAssertionDefinition{
+			TypeName:   td.TypeName,
+			SchemaName: td.JsonName,
+		})
+	}
+	return assertions
+}
+
+// GenerateSchemaAssertions generates an assertion of the validity of JSON
+// payloads against each of the component schemas of the given types, which
+// validates them with the embedded spec.
+func GenerateSchemaAssertions(t *template.Template, types []TypeDefinition) (string, error) {
+	return GenerateTemplates([]string{"schema-assertions.tmpl"}, t, DescribeSchemaAssertions(types))
+}
@@ -0,0 +1,37 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeSchemaAssertions(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Schema Assertions Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    pet-owner:
+      type: object
+      properties:
+        name:
+          type: string
+    Name:
+      type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	types, err := GenerateTypesForSchemas(nil, swagger.Components.Schemas, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []SchemaAssertionDefinition{
+		{TypeName: "Name", SchemaName: "Name"},
+		{TypeName: "PetOwner", SchemaName: "pet-owner"},
+	}, DescribeSchemaAssertions(types))
+}
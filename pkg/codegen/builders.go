@@ -0,0 +1,109 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"text/template"
+)
+
+// BuilderDefinition describes the builder of the request body type of an
+// operation, such as AddPetJSONRequestBody, which sets its properties one at a
+// time, and checks the required ones were set.
+type BuilderDefinition struct {
+	TypeName                 string
+	Fields                   []BuilderFieldDefinition
+	Required                 int    // The number of required properties, whose fields have a RequiredIndex
+	AdditionalPropertiesType string // The type of the additional properties, when the body has any
+}
+
+// BuilderFieldDefinition describes a property set by a builder, with a method
+// named after its field.
+type BuilderFieldDefinition struct {
+	PropertyField
+	RequiredIndex int // The index of the property among the required ones, or -1
+}
+
+// DescribeBuilders describes the builders of the request bodies of the
+// operations whose schemas have at least the given number of properties. The
+// schemas are looked up among the given types and those of the operations, so
+// the bodies of types in other packages get none. Clashes of the names of the
+// builders with those of the types, or of functions generated for the
+// operations, are an error.
+func DescribeBuilders(types []TypeDefinition, ops []OperationDefinition, minProperties int) ([]BuilderDefinition, error) {
+	taken := generatedNames(types, ops)
+	typesByName := make(map[string]TypeDefinition)
+	for _, td := range types {
+		typesByName[td.TypeName] = td
+	}
+	for _, op := range ops {
+		for _, td := range op.TypeDefinitions {
+			typesByName[td.TypeName] = td
+		}
+	}
+
+	var builders []BuilderDefinition
+	for _, op := range ops {
+		for _, body := range op.Bodies {
+			// Bodies of component schemas refer to them through a type of
+			// the operation, such as AddPetJSONBody.
+			td, found := typesByName[body.Schema.TypeDecl()]
+			for depth := 0; found && len(td.Schema.Properties) == 0 && depth < 2; depth++ {
+				td, found = typesByName[td.Schema.TypeDecl()]
+			}
+			if !found {
+				continue
+			}
+			s := td.Schema
+			if s.IsRef() || s.AliasOnly || s.ProtoMessage || len(s.Properties) < minProperties {
+				continue
+			}
+
+			builder := BuilderDefinition{TypeName: body.TypeDef(op.OperationId).TypeName}
+			for _, p := range s.Properties {
+				// Read-only properties aren't sent to servers.
+				if p.ReadOnly {
+					continue
+				}
+				field := BuilderFieldDefinition{PropertyField: describePropertyField(p, true), RequiredIndex: -1}
+				if p.Required {
+					field.RequiredIndex = builder.Required
+					builder.Required++
+				}
+				builder.Fields = append(builder.Fields, field)
+			}
+			if s.HasAdditionalProperties {
+				builder.AdditionalPropertiesType = s.AdditionalPropertiesType.TypeDecl()
+			}
+			owner := "the builder of " + builder.TypeName
+			for _, name := range []string{builder.TypeName + "Builder", "Build" + builder.TypeName} {
+				if err := claimName(taken, name, owner); err != nil {
+					return nil, err
+				}
+			}
+			builders = append(builders, builder)
+		}
+	}
+	return builders, nil
+}
+
+// GenerateBuilders generates the builders of the request bodies of the
+// operations with at least the number of properties the BodyBuilders option
+// gives.
+func GenerateBuilders(t *template.Template, types []TypeDefinition, ops []OperationDefinition) (string, error) {
+	builders, err := DescribeBuilders(types, ops, options.BodyBuilders)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"builders.tmpl"}, t, builders)
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderNameClash(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Builders
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: addPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+                tag:
+                  type: string
+      responses:
+        '201':
+          description: Added
+components:
+  schemas:
+    %s:
+      type: string
+`
+	generate := func(schemaName string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(fmt.Sprintf(spec, schemaName)))
+		require.NoError(t, err)
+		return Generate(swagger, "api", Options{GenerateTypes: true, GenerateClient: true, SkipPrune: true, BodyBuilders: 2})
+	}
+
+	_, err := generate("AddPetJSONRequestBodyBuilder")
+	assert.EqualError(t, err, "error generating type definitions: error generating request body builders: the name AddPetJSONRequestBodyBuilder of the builder of AddPetJSONRequestBody is already that of type AddPetJSONRequestBodyBuilder")
+
+	code, err := generate("Pet")
+	require.NoError(t, err)
+	assert.Contains(t, code, "func BuildAddPetJSONRequestBody() *AddPetJSONRequestBodyBuilder {")
+	assert.Contains(t, code, "func (b *AddPetJSONRequestBodyBuilder) WithTag(value string) *AddPetJSONRequestBodyBuilder {")
+}
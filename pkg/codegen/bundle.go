@@ -0,0 +1,343 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// bundler moves the components which references of a spec point to in other
+// documents into the spec's own components, so the code for them is generated
+// along with the rest rather than imported with an import mapping.
+type bundler struct {
+	components *openapi3.Components
+	names      map[interface{}]string             // The local name of each component, by value
+	used       map[string]map[string]bool         // The local names in use, by kind of component
+	external   map[interface{}]*externalComponent // The components of other documents, by value
+}
+
+// externalComponent is a component of another document.
+type externalComponent struct {
+	kind      string      // The kind of component, such as schemas
+	ref       string      // The reference the component is named after
+	key       string      // The path of references to the component which orders it
+	sourceRef interface{} // One of the references to the component, such as a *openapi3.SchemaRef
+}
+
+// bundleExternalRefs inlines the components of other documents, whether files
+// or URLs, which the spec refers to, and makes the references to them local.
+// Components are named after the last element of their reference, such as Pet
+// for ./pets.yaml#/components/schemas/Pet, and are numbered when the name is
+// already taken by another component, so a second Pet becomes Pet2. A
+// reference to a whole document is named after the document.
+func bundleExternalRefs(swagger *openapi3.T) {
+	b := &bundler{
+		components: &swagger.Components,
+		names:      make(map[interface{}]string),
+		used:       make(map[string]map[string]bool),
+		external:   make(map[interface{}]*externalComponent),
+	}
+
+	// The spec's own components keep their names, while those which are
+	// references are bundled like the others.
+	c := &swagger.Components
+	for name, ref := range c.Schemas {
+		b.register("schemas", name, ref.Ref, ref.Value)
+	}
+	for name, ref := range c.Parameters {
+		b.register("parameters", name, ref.Ref, ref.Value)
+	}
+	for name, ref := range c.Headers {
+		b.register("headers", name, ref.Ref, ref.Value)
+	}
+	for name, ref := range c.RequestBodies {
+		b.register("requestBodies", name, ref.Ref, ref.Value)
+	}
+	for name, ref := range c.Responses {
+		b.register("responses", name, ref.Ref, ref.Value)
+	}
+	for name, ref := range c.SecuritySchemes {
+		b.register("securitySchemes", name, ref.Ref, ref.Value)
+	}
+	for name, ref := range c.Examples {
+		b.register("examples", name, ref.Ref, ref.Value)
+	}
+	for name, ref := range c.Links {
+		b.register("links", name, ref.Ref, ref.Value)
+	}
+	for name, ref := range c.Callbacks {
+		b.register("callbacks", name, ref.Ref, ref.Value)
+	}
+
+	// The spec is walked in no particular order, so for the names of the
+	// external components not to depend on it, they are found one level of
+	// references at a time, and ordered by the path of references leading to
+	// them. References within other documents which look local are then told
+	// apart by the references to those documents.
+	var components []*externalComponent
+	level := b.collect(func(fn func(RefWrapper) (bool, error)) { _ = walkSwagger(swagger, fn) }, "")
+	for len(level) > 0 {
+		components = append(components, level...)
+		var next []*externalComponent
+		for _, parent := range level {
+			next = append(next, b.collect(func(fn func(RefWrapper) (bool, error)) { walkValue(parent.sourceRef, fn) }, parent.key)...)
+		}
+		level = next
+	}
+
+	sort.SliceStable(components, func(i, j int) bool {
+		ci, cj := components[i], components[j]
+		if ci.kind != cj.kind {
+			return ci.kind < cj.kind
+		}
+		if ni, nj := refName(ci.ref), refName(cj.ref); ni != nj {
+			return ni < nj
+		}
+		return ci.key < cj.key
+	})
+	for _, component := range components {
+		_, value, _ := refValue(component.sourceRef)
+		name := b.uniqueName(component.kind, refName(component.ref))
+		b.register(component.kind, name, "", value)
+		b.add(name, component.sourceRef)
+	}
+
+	// The added components are walked along with the others, so the
+	// references they hold are made local as well.
+	_ = walkSwagger(swagger, b.localize)
+}
+
+// register records the local name of a component, which only takes the name
+// when the component is a reference to another one.
+func (b *bundler) register(kind, name, ref string, value interface{}) {
+	if b.used[kind] == nil {
+		b.used[kind] = make(map[string]bool)
+	}
+	b.used[kind][name] = true
+	if _, found := b.names[value]; !found && ref == "" {
+		b.names[value] = name
+	}
+}
+
+// collect returns the external components which are newly found by walk,
+// without walking them, sorted by the path of references leading to them from
+// the one given by parentKey.
+//
+// Components are told apart by their value rather than by their reference, as
+// references within other documents which look local refer to the components
+// of those documents.
+func (b *bundler) collect(walk func(func(RefWrapper) (bool, error)), parentKey string) []*externalComponent {
+	var found []*externalComponent
+	walk(func(ref RefWrapper) (bool, error) {
+		if ref.Ref == "" {
+			return true, nil
+		}
+		kind, value, ok := refValue(ref.SourceRef)
+		if !ok {
+			return false, nil
+		}
+		if _, local := b.names[value]; local {
+			return false, nil
+		}
+		key := parentKey + " " + ref.Ref
+		if component, seen := b.external[value]; seen {
+			// Components found at the same level are ordered by the first
+			// of their paths.
+			if component.key > key && strings.Count(component.key, " ") == strings.Count(key, " ") {
+				component.key, component.ref = key, ref.Ref
+			}
+			return false, nil
+		}
+		component := &externalComponent{kind: kind, ref: ref.Ref, key: key, sourceRef: ref.SourceRef}
+		b.external[value] = component
+		found = append(found, component)
+		return false, nil
+	})
+	sort.Slice(found, func(i, j int) bool { return found[i].key < found[j].key })
+	return found
+}
+
+// localize makes a reference point to the local component with its value.
+func (b *bundler) localize(ref RefWrapper) (bool, error) {
+	if ref.Ref == "" {
+		return true, nil
+	}
+	kind, value, ok := refValue(ref.SourceRef)
+	if !ok {
+		return false, nil
+	}
+	if name, found := b.names[value]; found {
+		setRef(ref.SourceRef, "#/components/"+kind+"/"+name)
+	}
+	return false, nil
+}
+
+// refValue returns the kind of component a reference points to, and its
+// value, unless it's unresolved.
+func refValue(sourceRef interface{}) (string, interface{}, bool) {
+	switch r := sourceRef.(type) {
+	case *openapi3.SchemaRef:
+		return "schemas", r.Value, r.Value != nil
+	case *openapi3.ParameterRef:
+		return "parameters", r.Value, r.Value != nil
+	case *openapi3.HeaderRef:
+		return "headers", r.Value, r.Value != nil
+	case *openapi3.RequestBodyRef:
+		return "requestBodies", r.Value, r.Value != nil
+	case *openapi3.ResponseRef:
+		return "responses", r.Value, r.Value != nil
+	case *openapi3.SecuritySchemeRef:
+		return "securitySchemes", r.Value, r.Value != nil
+	case *openapi3.ExampleRef:
+		return "examples", r.Value, r.Value != nil
+	case *openapi3.LinkRef:
+		return "links", r.Value, r.Value != nil
+	case *openapi3.CallbackRef:
+		return "callbacks", r.Value, r.Value != nil
+	}
+	return "", nil, false
+}
+
+// walkValue walks the value of a reference, as if it weren't one.
+func walkValue(sourceRef interface{}, doFn func(RefWrapper) (bool, error)) {
+	switch r := sourceRef.(type) {
+	case *openapi3.SchemaRef:
+		_ = walkSchemaRef(&openapi3.SchemaRef{Value: r.Value}, doFn)
+	case *openapi3.ParameterRef:
+		_ = walkParameterRef(&openapi3.ParameterRef{Value: r.Value}, doFn)
+	case *openapi3.HeaderRef:
+		_ = walkHeaderRef(&openapi3.HeaderRef{Value: r.Value}, doFn)
+	case *openapi3.RequestBodyRef:
+		_ = walkRequestBodyRef(&openapi3.RequestBodyRef{Value: r.Value}, doFn)
+	case *openapi3.ResponseRef:
+		_ = walkResponseRef(&openapi3.ResponseRef{Value: r.Value}, doFn)
+	case *openapi3.SecuritySchemeRef:
+		_ = walkSecuritySchemeRef(&openapi3.SecuritySchemeRef{Value: r.Value}, doFn)
+	case *openapi3.ExampleRef:
+		_ = walkExampleRef(&openapi3.ExampleRef{Value: r.Value}, doFn)
+	case *openapi3.LinkRef:
+		_ = walkLinkRef(&openapi3.LinkRef{Value: r.Value}, doFn)
+	case *openapi3.CallbackRef:
+		_ = walkCallbackRef(&openapi3.CallbackRef{Value: r.Value}, doFn)
+	}
+}
+
+// setRef sets the reference of a component.
+func setRef(sourceRef interface{}, ref string) {
+	switch r := sourceRef.(type) {
+	case *openapi3.SchemaRef:
+		r.Ref = ref
+	case *openapi3.ParameterRef:
+		r.Ref = ref
+	case *openapi3.HeaderRef:
+		r.Ref = ref
+	case *openapi3.RequestBodyRef:
+		r.Ref = ref
+	case *openapi3.ResponseRef:
+		r.Ref = ref
+	case *openapi3.SecuritySchemeRef:
+		r.Ref = ref
+	case *openapi3.ExampleRef:
+		r.Ref = ref
+	case *openapi3.LinkRef:
+		r.Ref = ref
+	case *openapi3.CallbackRef:
+		r.Ref = ref
+	}
+}
+
+// add adds the value of a reference to the spec's components.
+func (b *bundler) add(name string, sourceRef interface{}) {
+	c := b.components
+	switch r := sourceRef.(type) {
+	case *openapi3.SchemaRef:
+		if c.Schemas == nil {
+			c.Schemas = make(openapi3.Schemas)
+		}
+		c.Schemas[name] = &openapi3.SchemaRef{Value: r.Value}
+	case *openapi3.ParameterRef:
+		if c.Parameters == nil {
+			c.Parameters = make(openapi3.ParametersMap)
+		}
+		c.Parameters[name] = &openapi3.ParameterRef{Value: r.Value}
+	case *openapi3.HeaderRef:
+		if c.Headers == nil {
+			c.Headers = make(openapi3.Headers)
+		}
+		c.Headers[name] = &openapi3.HeaderRef{Value: r.Value}
+	case *openapi3.RequestBodyRef:
+		if c.RequestBodies == nil {
+			c.RequestBodies = make(openapi3.RequestBodies)
+		}
+		c.RequestBodies[name] = &openapi3.RequestBodyRef{Value: r.Value}
+	case *openapi3.ResponseRef:
+		if c.Responses == nil {
+			c.Responses = make(openapi3.Responses)
+		}
+		c.Responses[name] = &openapi3.ResponseRef{Value: r.Value}
+	case *openapi3.SecuritySchemeRef:
+		if c.SecuritySchemes == nil {
+			c.SecuritySchemes = make(openapi3.SecuritySchemes)
+		}
+		c.SecuritySchemes[name] = &openapi3.SecuritySchemeRef{Value: r.Value}
+	case *openapi3.ExampleRef:
+		if c.Examples == nil {
+			c.Examples = make(openapi3.Examples)
+		}
+		c.Examples[name] = &openapi3.ExampleRef{Value: r.Value}
+	case *openapi3.LinkRef:
+		if c.Links == nil {
+			c.Links = make(openapi3.Links)
+		}
+		c.Links[name] = &openapi3.LinkRef{Value: r.Value}
+	case *openapi3.CallbackRef:
+		if c.Callbacks == nil {
+			c.Callbacks = make(openapi3.Callbacks)
+		}
+		c.Callbacks[name] = &openapi3.CallbackRef{Value: r.Value}
+	}
+}
+
+// uniqueName returns name, or name followed by the first number making it
+// unique among the components of the given kind.
+func (b *bundler) uniqueName(kind, name string) string {
+	if !b.used[kind][name] {
+		return name
+	}
+	for i := 2; ; i++ {
+		if numbered := fmt.Sprintf("%s%d", name, i); !b.used[kind][numbered] {
+			return numbered
+		}
+	}
+}
+
+// refName returns the name of the component a reference points to, which is
+// the last element of its JSON pointer, or the name of the document for
+// references to a whole document.
+func refName(ref string) string {
+	if i := strings.Index(ref, "#"); i >= 0 {
+		pointer := strings.TrimRight(ref[i+1:], "/")
+		if j := strings.LastIndex(pointer, "/"); j >= 0 && j < len(pointer)-1 {
+			return pointer[j+1:]
+		}
+		ref = ref[:i]
+	}
+	name := path.Base(ref)
+	return strings.TrimSuffix(name, path.Ext(name))
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import "sync"
+
+// nameCache memoizes the Go names of schemas and the Go types of references
+// while a spec is generated, since the same ones are converted thousands of
+// times in large specs. It's only set during generation, as the conversions
+// depend on the options and the schemas of the spec.
+var nameCache *conversionCache
+
+// conversionCache is safe to use from the workers generating in parallel.
+type conversionCache struct {
+	typeNames sync.Map // The Go type names of schema names
+	refTypes  sync.Map // The Go types of references
+}
+
+// startNameCache starts memoizing conversions for a new generation, once the
+// global state it depends on is set.
+func startNameCache() {
+	nameCache = &conversionCache{}
+}
+
+// stopNameCache stops memoizing conversions once generation is done, so that
+// later changes of the options are taken into account.
+func stopNameCache() {
+	nameCache = nil
+}
+
+// typeName returns schemaNameToTypeName(name), converting it only once.
+func (c *conversionCache) typeName(name string) string {
+	if c == nil {
+		return schemaNameToTypeName(name)
+	}
+	if typeName, ok := c.typeNames.Load(name); ok {
+		return typeName.(string)
+	}
+	typeName := schemaNameToTypeName(name)
+	c.typeNames.Store(name, typeName)
+	return typeName
+}
+
+// refType returns refPathToGoType(refPath, true), converting it only once.
+// Errors aren't memoized, as generation stops at the first of them.
+func (c *conversionCache) refType(refPath string) (string, error) {
+	if c == nil {
+		return refPathToGoType(refPath, true)
+	}
+	if goType, ok := c.refTypes.Load(refPath); ok {
+		return goType.(string), nil
+	}
+	goType, err := refPathToGoType(refPath, true)
+	if err != nil {
+		return "", err
+	}
+	c.refTypes.Store(refPath, goType)
+	return goType, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameCache(t *testing.T) {
+	defer func() { options = Options{} }()
+
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(largeSpec(3)))
+	require.NoError(t, err)
+
+	// Thing0 is referred to by its operation and its schema, but it's only
+	// converted once.
+	var conversions int32
+	code, err := Generate(swagger, "api", Options{
+		GenerateTypes:  true,
+		GenerateClient: true,
+		NameNormalizer: func(name string) string {
+			if name == "Thing0" {
+				atomic.AddInt32(&conversions, 1)
+			}
+			return ToCamelCase(name)
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "type Thing0 struct {")
+	assert.Contains(t, code, "JSON200      *Thing0")
+	assert.Equal(t, int32(1), conversions)
+
+	// Once generation is done, conversions follow the options again.
+	assert.Nil(t, nameCache)
+	options = Options{NameNormalizer: func(name string) string { return "X" + name }}
+	assert.Equal(t, "XThing0", SchemaNameToTypeName("Thing0"))
+	goType, err := RefPathToGoType("#/components/schemas/Thing0")
+	require.NoError(t, err)
+	assert.Equal(t, "XThing0", goType)
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// CallbackDefinitions returns the operations of the callbacks of the given
+// operations, whose names are unique among them. Callbacks have no path:
+// they're sent to the URL which a runtime expression, such as
+// {$request.body#/callbackUrl}, takes from the request of their operation.
+// Operations without an ID are named after their operation and callback.
+func CallbackDefinitions(swagger *openapi3.T, operations []OperationDefinition) ([]OperationDefinition, error) {
+	operationNames := newNameResolver("operation")
+	for _, op := range operations {
+		operationNames.unique(op.OperationId, op.OperationId, op.Spec.Tags)
+	}
+
+	var callbacks []OperationDefinition
+	for _, op := range operations {
+		for _, name := range SortedCallbackKeys(op.Spec.Callbacks) {
+			callback := op.Spec.Callbacks[name].Value
+			if callback == nil {
+				continue
+			}
+			items := openapi3.Paths(*callback)
+			count := 0
+			for _, item := range items {
+				count += len(item.Operations())
+			}
+			callbackName := op.OperationId + normalizeName(name)
+			definitions, err := describeOperations(swagger, items, false, operationNames, func(opName, _ string) (string, error) {
+				if count > 1 {
+					return generateDefaultOperationID(opName, callbackName)
+				}
+				return callbackName, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error describing callback %s of %s: %w", name, op.OperationId, err)
+			}
+			for i := range definitions {
+				definitions[i].Callback = name
+				definitions[i].CallbackURL = definitions[i].Path
+				definitions[i].Path = ""
+			}
+			callbacks = append(callbacks, definitions...)
+		}
+	}
+
+	if err := operationNames.err(); err != nil {
+		return nil, err
+	}
+	return callbacks, nil
+}
+
+// GenerateCallbackSender generates a CallbackSender, which servers invoke the
+// callbacks of their operations with, along with the builders of its requests
+// and functions evaluating the URLs of the callbacks.
+func GenerateCallbackSender(t *template.Template, callbacks []OperationDefinition) (string, error) {
+	return generateOutboundSender(t, outboundOperations{Kind: "Callback", Operations: callbacks})
+}
+
+// GenerateCallbackHandlers generates the CallbackInterface which receivers of
+// callbacks implement to handle them, and the net/http handlers serving it,
+// which verify the signatures of the callbacks.
+func GenerateCallbackHandlers(t *template.Template, callbacks []OperationDefinition) (string, error) {
+	return generateOutboundHandlers(t, outboundOperations{Kind: "Callback", Operations: callbacks})
+}
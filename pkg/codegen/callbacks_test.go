@@ -0,0 +1,93 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const callbacksDefinition = `
+openapi: 3.0.3
+info:
+  title: Callbacks
+  version: 1.0.0
+paths:
+  /subscriptions:
+    post:
+      operationId: subscribe
+      responses:
+        '201':
+          description: Subscribed
+      callbacks:
+        onEvent:
+          '{$request.query.url}':
+            post:
+              requestBody:
+                content:
+                  application/json:
+                    schema:
+                      $ref: '#/components/schemas/Event'
+              responses:
+                '204':
+                  description: Received
+        onCancel:
+          '{$request.query.url}/cancelled':
+            post:
+              operationId: cancelled
+              responses:
+                '204':
+                  description: Received
+            delete:
+              responses:
+                '204':
+                  description: Received
+components:
+  schemas:
+    Event:
+      type: object
+      properties:
+        kind:
+          type: string
+`
+
+func TestCallbackDefinitions(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(callbacksDefinition))
+	require.NoError(t, err)
+
+	ops, err := OperationDefinitions(swagger)
+	require.NoError(t, err)
+	callbacks, err := CallbackDefinitions(swagger, ops)
+	require.NoError(t, err)
+
+	var names []string
+	for _, callback := range callbacks {
+		names = append(names, callback.Method+" "+callback.Callback+" "+callback.OperationId+" "+callback.CallbackURL)
+		assert.Empty(t, callback.Path)
+	}
+	assert.Equal(t, []string{
+		"DELETE onCancel DeleteSubscribeOnCancel {$request.query.url}/cancelled",
+		"POST onCancel Cancelled {$request.query.url}/cancelled",
+		"POST onEvent SubscribeOnEvent {$request.query.url}",
+	}, names)
+}
+
+func TestGenerateCallbacks(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(callbacksDefinition))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "callbacks", Options{
+		GenerateTypes:     true,
+		GenerateClient:    true,
+		GenerateGinServer: true,
+	})
+	require.NoError(t, err)
+	// The schema of the callback isn't pruned.
+	assert.Contains(t, code, "type Event struct {")
+	assert.Contains(t, code, "func SubscribeOnEventURL(r *http.Request, body []byte, pathParams map[string]string) (string, error) {")
+	assert.Contains(t, code, `runtime.ExpandCallbackURL("{$request.query.url}", r, body, pathParams)`)
+	assert.Contains(t, code, "func (s *CallbackSender) SendSubscribeOnEvent(ctx context.Context, url string, body SubscribeOnEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+	assert.Contains(t, code, "func NewCancelledCallbackHandler(si CallbackInterface, options CallbackHandlerOptions) http.Handler {")
+	assert.NotContains(t, code, "WebhookSender")
+}
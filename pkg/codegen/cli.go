@@ -0,0 +1,139 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// CLIDefinition describes the cobra command tree of the cli target, which has
+// a command for every operation, under that of its first tag.
+type CLIDefinition struct {
+	Server   string                 // The default of --server, the first server of the spec with the defaults of its variables
+	Commands []CLICommandDefinition // The commands of the operations without tags
+	Groups   []CLIGroupDefinition   // The commands of the tags, sorted by name
+}
+
+// CLIGroupDefinition describes the command of a tag, whose subcommands are
+// those of its operations.
+type CLIGroupDefinition struct {
+	Name     string // The name of the command, such as pet-store
+	Tag      string
+	Commands []CLICommandDefinition
+}
+
+// CLICommandDefinition describes the command calling an operation.
+type CLICommandDefinition struct {
+	Name        string // The name of the command, such as list-pets
+	Operation   OperationDefinition
+	Flags       []CLIFlagDefinition // The flags of the parameters, in the order of AllParams
+	ContentType string              // The default of --content-type, if the operation has a body
+}
+
+// CLIFlagDefinition describes the flag of a parameter.
+type CLIFlagDefinition struct {
+	Name  string // The name of the parameter, or, if it's taken, its location and name, as in query-server, numbered if that's taken too
+	Index int    // The index of the flag among those of the command
+	Param ParameterDefinition
+}
+
+// cliReservedFlags are the flags of the commands of operations which aren't
+// those of parameters.
+var cliReservedFlags = []string{"server", "body", "content-type", "help"}
+
+// cliCommandName turns a name of the spec into the name of a command, so
+// ListPets becomes list-pets.
+func cliCommandName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(ToScreamingSnakeCase(ToCamelCase(name)), "_", "-"))
+}
+
+// DescribeCLI describes the command tree of the cli target.
+func DescribeCLI(ops []OperationDefinition, servers openapi3.Servers) CLIDefinition {
+	var cli CLIDefinition
+	if len(servers) > 0 && servers[0] != nil {
+		cli.Server = servers[0].URL
+		for name, variable := range servers[0].Variables {
+			if variable != nil {
+				cli.Server = strings.ReplaceAll(cli.Server, "{"+name+"}", variable.Default)
+			}
+		}
+	}
+
+	groups := map[string]*CLIGroupDefinition{}
+	for _, op := range ops {
+		command := describeCLICommand(op)
+		if len(op.Spec.Tags) == 0 {
+			cli.Commands = append(cli.Commands, command)
+			continue
+		}
+		name := cliCommandName(op.Spec.Tags[0])
+		group, found := groups[name]
+		if !found {
+			group = &CLIGroupDefinition{Name: name, Tag: op.Spec.Tags[0]}
+			groups[name] = group
+		}
+		group.Commands = append(group.Commands, command)
+	}
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		cli.Groups = append(cli.Groups, *groups[name])
+	}
+	return cli
+}
+
+func describeCLICommand(op OperationDefinition) CLICommandDefinition {
+	command := CLICommandDefinition{
+		Name:      cliCommandName(op.OperationId),
+		Operation: op,
+	}
+	taken := map[string]bool{}
+	for _, name := range cliReservedFlags {
+		taken[name] = true
+	}
+	for _, param := range op.AllParams() {
+		name := param.ParamName
+		if taken[name] {
+			name = param.In + "-" + param.ParamName
+		}
+		// A parameter may be named after the location and name of another.
+		for i := 2; taken[name]; i++ {
+			name = fmt.Sprintf("%s-%s-%d", param.In, param.ParamName, i)
+		}
+		taken[name] = true
+		command.Flags = append(command.Flags, CLIFlagDefinition{Name: name, Index: len(command.Flags), Param: param})
+	}
+	if len(op.Bodies) > 0 {
+		command.ContentType = op.Bodies[0].ContentType
+	} else if op.HasBody() {
+		if contentTypes := SortedContentKeys(op.Spec.RequestBody.Value.Content); len(contentTypes) > 0 {
+			command.ContentType = contentTypes[0]
+		}
+	}
+	return command
+}
+
+// GenerateCLI generates NewCommand, which returns the command tree of the
+// operations, calling them with the client.
+func GenerateCLI(t *template.Template, ops []OperationDefinition, servers openapi3.Servers) (string, error) {
+	return GenerateTemplates([]string{"cli.tmpl"}, t, DescribeCLI(ops, servers))
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCLIFlagNames(t *testing.T) {
+	command := describeCLICommand(OperationDefinition{
+		OperationId: "AddPet",
+		Spec:        &openapi3.Operation{},
+		QueryParams: []ParameterDefinition{
+			{ParamName: "query-body", In: "query"},
+			{ParamName: "body", In: "query"},
+		},
+		HeaderParams: []ParameterDefinition{
+			{ParamName: "body", In: "header"},
+		},
+	})
+	var names []string
+	for _, flag := range command.Flags {
+		names = append(names, flag.Name)
+	}
+	assert.Equal(t, []string{"query-body", "query-body-2", "header-body"}, names)
+}
+
+func TestCLIWithoutOperations(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: No Paths
+  version: 1.0.0
+paths: {}
+`))
+	require.NoError(t, err)
+
+	// The client factory is only declared for the commands which use it, as
+	// it wouldn't compile unused. internal/test/cli/nopaths compiles the code.
+	code, err := Generate(swagger, "api", Options{GenerateTypes: true, GenerateClient: true, GenerateCLI: true})
+	require.NoError(t, err)
+	assert.Contains(t, code, "func NewCommand(use string, opts ...ClientOption) *cobra.Command {")
+	assert.NotContains(t, code, "newClient")
+}
@@ -18,45 +18,124 @@ import (
 	"bufio"
 	"bytes"
 	"embed"
+	"errors"
 	"fmt"
+	"go/build/constraint"
+	"go/token"
 	"io/fs"
+	"path"
+	"regexp"
 	"runtime/debug"
 	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"golang.org/x/tools/imports"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/deepmap/oapi-codegen/pkg/util"
 )
 
 // Embed the templates directory
 //go:embed templates
 var templates embed.FS
 
-// Options defines the optional code to generate.
+// Options defines the optional code to generate. They cover everything the
+// oapi-codegen command does, and are stable: fields are only added, with zero
+// values keeping the code generated as it was, so that code setting them keeps
+// compiling and generating the same code across minor versions.
 type Options struct {
-	GenerateChiServer  bool              // GenerateChiServer specifies whether to generate chi server boilerplate
-	GenerateEchoServer bool              // GenerateEchoServer specifies whether to generate echo server boilerplate
-	GenerateGinServer  bool              // GenerateGinServer specifies whether to generate echo server boilerplate
-	GenerateClient     bool              // GenerateClient specifies whether to generate client boilerplate
-	GenerateTypes      bool              // GenerateTypes specifies whether to generate type definitions
-	EmbedSpec          bool              // Whether to embed the swagger spec in the generated code
-	SkipFmt            bool              // Whether to skip go imports on the generated code
-	SkipPrune          bool              // Whether to skip pruning unused components on the generated code
-	AliasTypes         bool              // Whether to alias types if possible
-	IncludeTags        []string          // Only include operations that have one of these tags. Ignored when empty.
-	ExcludeTags        []string          // Exclude operations that have one of these tags. Ignored when empty.
-	UserTemplates      map[string]string // Override built-in templates from user-provided files
-	ImportMapping      map[string]string // ImportMapping specifies the golang package path for each external reference
-	ExcludeSchemas     []string          // Exclude from generation schemas with given names. Ignored when empty.
-	OldMergeSchemas    bool              // Schema merging for allOf was changed in a big way, when true, the old way is used
-	ResponseTypeSuffix string            // The suffix used for responses types
+	GenerateChiServer   bool                   // GenerateChiServer specifies whether to generate chi server boilerplate
+	GenerateEchoServer  bool                   // GenerateEchoServer specifies whether to generate echo server boilerplate
+	GenerateGinServer   bool                   // GenerateGinServer specifies whether to generate echo server boilerplate
+	GenerateClient      bool                   // GenerateClient specifies whether to generate client boilerplate
+	GenerateTypes       bool                   // GenerateTypes specifies whether to generate type definitions
+	EmbedSpec           bool                   // Whether to embed the swagger spec in the generated code
+	RedactSpec          SpecRedaction          // What's stripped from the embedded spec, while code is still generated for it
+	ContractTests       bool                   // Whether to generate contract tests of a server, for a _test.go file of the package of the server and embedded spec
+	ExampleTests        bool                   // Whether to generate TestResponseExamples, round tripping the JSON response examples through their types, for a _test.go file of the package of the types
+	FuzzTests           bool                   // Whether to generate fuzz targets of the handlers of a server, for a _test.go file of its package which defines fuzzHandler
+	PropertyGenerators  bool                   // Whether to generate gopter generators of the types of the components, for the package of the types
+	SchemaAssertions    bool                   // Whether to generate AssertFooValid assertions of the validity of payloads against each component schema, for a package with the embedded spec
+	TestClient          bool                   // Whether to generate NewTestClient, a client of a test server serving a ServerInterface, along with a server
+	GenerateCLI         bool                   // Whether to generate NewCommand, a cobra command tree calling the operations with the client, along with a client
+	GeneratePaths       bool                   // Whether to generate the FooPathTemplate constants of the paths of the operations, and PathFoo functions building them
+	GenerateRoutes      bool                   // Whether to generate Routes, the route table of the operations, and RoutesHandler listing it as JSON
+	LoggingMiddleware   bool                   // Whether the servers get a LoggingMiddleware logging requests with their operation and path template, which finds them in Routes
+	SkipFmt             bool                   // Whether to skip go imports on the generated code
+	SkipPrune           bool                   // Whether to skip pruning unused components on the generated code
+	PruneUnreachable    bool                   // Whether pruning keeps only the components the operations use, directly or through other components, rather than those referenced at all, which keeps unused cycles of schemas
+	SkipInternal        bool                   // Whether to generate no code for the operations, parameters, properties and schemas marked x-internal: true
+	LogValuers          bool                   // Whether types with x-sensitive properties get log/slog LogValue methods masking them, along with String methods, which need Go 1.21
+	FileHeader          string                 // A header, such as a license, written as comments at the top of the generated code
+	BuildTags           string                 // When set, the build constraint of the generated code, such as "linux && !race"
+	GeneratedBanner     string                 // Replaces "Code generated by oapi-codegen ... DO NOT EDIT.", keeping its form
+	PostProcess         string                 // When set, a command through which the generated code is piped, such as gofumpt
+	AliasTypes          bool                   // Whether to alias types if possible
+	IncludeTags         []string               // Only include operations that have one of these tags. Ignored when empty.
+	ExcludeTags         []string               // Exclude operations that have one of these tags. Ignored when empty.
+	IncludeOperationIDs []string               // Only include operations whose ID matches one of these glob patterns. Ignored when empty.
+	ExcludeOperationIDs []string               // Exclude operations whose ID matches one of these glob patterns. Ignored when empty.
+	UserTemplates       map[string]string      // Override built-in templates from user-provided files
+	TemplateFunctions   template.FuncMap       // Additional functions for user-provided templates, which can't replace the built-in ones
+	TemplateData        map[string]interface{} // Data for user-provided templates, returned by their templateData function
+	ImportMapping       map[string]string      // ImportMapping specifies the golang package path for each external reference
+	BundleExternalRefs  bool                   // Whether to generate the components of other documents which the spec refers to along with its own, rather than importing them
+	ExcludeSchemas      []string               // Exclude from generation schemas with given names or matching given glob patterns. Ignored when empty.
+	OldMergeSchemas     bool                   // Schema merging for allOf was changed in a big way, when true, the old way is used
+	ResponseTypeSuffix  string                 // The suffix used for responses types
+	NameNormalizer      NameNormalizer         // Turns the names of the spec into those of types, enum values and methods, ToCamelCase when nil
+	NameCollisions      string                 // How collisions of the Go names of schemas, or of operations, are resolved: NameCollisionsError, NameCollisionsNumericSuffix or NameCollisionsTagPrefix. Ignored when empty.
+	ReportRename        func(Rename)           // When set, called with the renames resolving collisions of the generated code
+	ReportWarning       func(string)           // When set, called with warnings about the spec, such as operations which servers register but which are served at other hosts
+	ReportGeneration    func(GenerationStats)  // When set, called with the size, duration and peak heap of the generation of every package
+	ReservedWords       string                 // How Go keywords and predeclared identifiers are escaped in sanitized names, such as those of security providers: ReservedWordsUnderscorePrefix, the default, ReservedWordsValueSuffix or ReservedWordsPascalCase
+	ReservedWordsMap    map[string]string      // Replacements of given keywords and predeclared identifiers, which take precedence over ReservedWords
+	Transliterate       bool                   // Whether letters of names, such as país, are spelled in ASCII in Go identifiers, with Transliterations and DefaultTransliterations
+	Transliterations    map[rune]string        // The spellings of letters, such as 'ж': "zh", which take precedence over DefaultTransliterations
+	EnumNaming          string                 // How the constants of enums are named: EnumNamingTypePrefix, the default, EnumNamingShort or EnumNamingScreamingSnake
+	SkipEmailValidation bool                   // Whether strings of the email format are left as strings, rather than openapi_types.Email, which validates them
+	StrictParamContent  bool                   // Whether parameters whose content is neither JSON, XML nor plain text of a primitive schema are an error, rather than strings
+	DateLayout          string                 // The layout of the date format of string schemas, such as "20060102", which LayoutDate is generated for. Ignored when empty.
+	DurationFormat      string                 // The syntax of durations, the duration format of string schemas: DurationFormatGo, the default, or DurationFormatISO8601
+	YAMLPackage         string                 // The import path of the YAML library used for YAML bodies, gopkg.in/yaml.v2 when empty
+	MsgpackPackage      string                 // The import path of the MessagePack library used for MessagePack bodies, github.com/vmihailenco/msgpack/v5 when empty
+	AWSSigV4Service     string                 // When set, the client gets a WithAWSSigV4 option signing requests for this AWS service
+	AWSSigV4Region      string                 // The AWS region requests are signed for, required with AWSSigV4Service
+	CorrelationIDHeader string                 // The header carrying correlation IDs, X-Request-ID when empty
+	BulkHelpers         bool                   // Whether to generate helpers calling list operations with many parameter sets concurrently
+	SentinelErrors      bool                   // Whether to generate sentinel errors of the declared client error statuses, wrapped by the Err methods of the responses of the client
+	Factories           bool                   // Whether to generate a factory of valid values, built from examples, for every model
+	BodyBuilders        int                    // Request bodies with at least this many properties get builders, such as BuildAddPetJSONRequestBody().WithName(name).Build(); none do when zero
+	OptionConstructors  int                    // Models with at least this many optional properties get constructors, such as NewPet(name, WithPetTag(tag)); none do when zero
+	Plugins             []string               // The commands of generator plugins, which GeneratePluginFiles runs
+	SpecPlugins         []string               // The commands of spec plugins, which alter the spec before anything is generated for it, as runSpecPlugin
+	SpecHook            SpecHook               // When set, called with the spec before anything is generated for it, after SpecPlugins, so that it can be altered, once per spec with GenerateFiles
+	SchemaTransformers  []SchemaTransformer    // Wrap the generation of the Go schema of every schema, to inspect or alter it, the first being the outermost
+	LoadOptions         util.LoadOptions       // How GenerateDocumentPackages and GenerateFiles fetch specs at URLs
+	TagPackages         string                 // When set, the import path of the output directory, in which GenerateFiles generates a package for each tag, as GenerateTagPackages
+	DocumentPackages    string                 // When set, the import path of the output directory, in which GenerateFiles generates a package for each spec, as GenerateDocumentPackages
+	ComponentsPackage   string                 // When set, the import path of the package holding the types of the spec's components, which are then not generated
 }
 
+// SpecHook alters a spec before code is generated for it, such as to remove
+// its internal operations, rename its schemas or add extensions to them.
+type SpecHook func(swagger *openapi3.T) error
+
+// generatedBannerRegexp matches the comments marking generated code, without
+// their slashes.
+var generatedBannerRegexp = regexp.MustCompile(`^Code generated .* DO NOT EDIT\.$`)
+
 // We store options globally to simplify accessing them from all the codegen
 // functions.
 var options Options
 
+// Whether struct fields are given xml, yaml and msgpack tags, which is the
+// case when any body in the spec is declared with a matching media type.
+var generateXMLTags, generateYAMLTags, generateMsgpackTags bool
+
 // goImport represents a go package to be imported in the generated code
 type goImport struct {
 	Name string // package name
@@ -74,54 +153,306 @@ func (gi goImport) String() string {
 // importMap maps external OpenAPI specifications files/urls to external go packages
 type importMap map[string]goImport
 
-// GoImports returns a slice of go import statements
+// GoImports returns a sorted slice of go import statements, without the
+// duplicates of specs mapped to the same package
 func (im importMap) GoImports() []string {
 	goImports := make([]string, 0, len(im))
 	for _, v := range im {
-		goImports = append(goImports, v.String())
+		if !StringInArray(v.String(), goImports) {
+			goImports = append(goImports, v.String())
+		}
 	}
+	sort.Strings(goImports)
 	return goImports
 }
 
 var importMapping importMap
 
-func constructImportMapping(importMapping map[string]string) importMap {
+// componentsImport is the package holding the types of the spec's components,
+// when they aren't generated along with the operations.
+var componentsImport goImport
+
+// constructImportMapping returns the imports of the specs of the mapping,
+// whose packages are given as "path" or "path#alias". Packages without an
+// alias are named externalRef0, externalRef1 and so on.
+func constructImportMapping(importMapping map[string]string) (importMap, error) {
 	var (
 		pathToName = map[string]string{}
+		nameToPath = map[string]string{}
 		result     = importMap{}
 	)
 
-	{
-		var packagePaths []string
-		for _, packageName := range importMapping {
-			packagePaths = append(packagePaths, packageName)
+	// The specs are sorted so that conflicts are always reported the same way.
+	var packagePaths []string
+	for _, specPath := range SortedStringKeys(importMapping) {
+		packagePath, alias := splitImportAlias(importMapping[specPath])
+		if alias == "" {
+			packagePaths = append(packagePaths, packagePath)
+			continue
+		}
+		if !token.IsIdentifier(alias) || alias == "_" {
+			return nil, fmt.Errorf("invalid alias %q for package %s in import mapping", alias, packagePath)
+		}
+		if other, found := pathToName[packagePath]; found && other != alias {
+			return nil, fmt.Errorf("package %s has aliases %s and %s in import mapping", packagePath, other, alias)
 		}
-		sort.Strings(packagePaths)
+		if other, found := nameToPath[alias]; found && other != packagePath {
+			return nil, fmt.Errorf("alias %s is given to packages %s and %s in import mapping", alias, other, packagePath)
+		}
+		pathToName[packagePath] = alias
+		nameToPath[alias] = packagePath
+	}
+	sort.Strings(packagePaths)
 
-		for _, packagePath := range packagePaths {
-			if _, ok := pathToName[packagePath]; !ok {
-				pathToName[packagePath] = fmt.Sprintf("externalRef%d", len(pathToName))
-			}
+	n := 0
+	for _, packagePath := range packagePaths {
+		if _, ok := pathToName[packagePath]; ok {
+			continue
+		}
+		name := fmt.Sprintf("externalRef%d", n)
+		for nameToPath[name] != "" {
+			n++
+			name = fmt.Sprintf("externalRef%d", n)
 		}
+		n++
+		pathToName[packagePath] = name
+		nameToPath[name] = packagePath
 	}
 	for specPath, packagePath := range importMapping {
+		packagePath, _ = splitImportAlias(packagePath)
 		result[specPath] = goImport{Name: pathToName[packagePath], Path: packagePath}
 	}
-	return result
+	return result, nil
+}
+
+// splitImportAlias splits a package of the import mapping into its path and
+// its alias, which is empty when it has none.
+func splitImportAlias(packagePath string) (string, string) {
+	if i := strings.LastIndex(packagePath, "#"); i >= 0 {
+		return packagePath[:i], packagePath[i+1:]
+	}
+	return packagePath, ""
+}
+
+// externalRefs returns the sorted references to components of other specs
+// which are generated as Go types, such as ./common.yaml#/components/schemas/Pet.
+func externalRefs(swagger *openapi3.T) []string {
+	found := map[string]bool{}
+	_ = walkSwagger(swagger, func(ref RefWrapper) (bool, error) {
+		if ref.Ref == "" {
+			return true, nil
+		}
+		switch ref.SourceRef.(type) {
+		case *openapi3.SchemaRef, *openapi3.ParameterRef, *openapi3.ResponseRef, *openapi3.RequestBodyRef:
+			if parts := strings.Split(ref.Ref, "#"); len(parts) == 2 && parts[0] != "" {
+				found[ref.Ref] = true
+			}
+		}
+		return false, nil
+	})
+	refs := make([]string, 0, len(found))
+	for ref := range found {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+// checkExternalRefs returns an error listing all the references to components
+// of other specs which aren't in the import mapping, rather than failing on
+// the first one when generating code.
+func checkExternalRefs(swagger *openapi3.T) error {
+	var refs []string
+	for _, ref := range externalRefs(swagger) {
+		if _, found := importMapping[strings.Split(ref, "#")[0]]; !found {
+			refs = append(refs, ref)
+		}
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("unrecognized external references; please provide the known imports for these references using option --import-mapping:\n\t%s", strings.Join(refs, "\n\t"))
+}
+
+// visitContent calls fn for the media types of every request and response
+// body, and parameter content, in the spec, stopping early when fn returns
+// false.
+func visitContent(swagger *openapi3.T, fn func(mediaType string, content *openapi3.MediaType) bool) {
+	visit := func(content openapi3.Content) bool {
+		for _, mediaType := range SortedContentKeys(content) {
+			if !fn(mediaType, content[mediaType]) {
+				return false
+			}
+		}
+		return true
+	}
+	visitParams := func(params openapi3.Parameters) bool {
+		for _, param := range params {
+			if param.Value != nil && !visit(param.Value.Content) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, requestPath := range SortedPathsKeys(swagger.Paths) {
+		pathItem := swagger.Paths[requestPath]
+		if !visitParams(pathItem.Parameters) {
+			return
+		}
+		for _, method := range SortedOperationsKeys(pathItem.Operations()) {
+			op := pathItem.Operations()[method]
+			if !visitParams(op.Parameters) {
+				return
+			}
+			if op.RequestBody != nil && op.RequestBody.Value != nil && !visit(op.RequestBody.Value.Content) {
+				return
+			}
+			for _, responseName := range SortedResponsesKeys(op.Responses) {
+				response := op.Responses[responseName]
+				if response.Value != nil && !visit(response.Value.Content) {
+					return
+				}
+			}
+		}
+	}
+	for _, name := range SortedParameterKeys(swagger.Components.Parameters) {
+		param := swagger.Components.Parameters[name]
+		if param.Value != nil && !visit(param.Value.Content) {
+			return
+		}
+	}
+	for _, name := range SortedRequestBodyKeys(swagger.Components.RequestBodies) {
+		body := swagger.Components.RequestBodies[name]
+		if body.Value != nil && !visit(body.Value.Content) {
+			return
+		}
+	}
+	for _, name := range SortedResponsesKeys(swagger.Components.Responses) {
+		response := swagger.Components.Responses[name]
+		if response.Value != nil && !visit(response.Value.Content) {
+			return
+		}
+	}
+}
+
+// specHasContent returns whether any request or response body, or parameter,
+// in the spec is declared with a media type accepted by matches.
+func specHasContent(swagger *openapi3.T, matches func(mediaType string) bool) bool {
+	found := false
+	visitContent(swagger, func(mediaType string, _ *openapi3.MediaType) bool {
+		found = matches(mediaType)
+		return !found
+	})
+	return found
+}
+
+// protoImports returns the packages of the protobuf message types used by
+// bodies in the spec, which are given by the x-go-proto-type extension.
+func protoImports(swagger *openapi3.T) ([]string, error) {
+	var result []string
+	seen := make(map[string]bool)
+	var err error
+	visitContent(swagger, func(mediaType string, content *openapi3.MediaType) bool {
+		if !isMediaTypeProtobuf(mediaType) || content.Schema == nil || content.Schema.Value == nil {
+			return true
+		}
+		extension, ok := content.Schema.Value.Extensions[extPropGoProtoType]
+		if !ok {
+			return true
+		}
+		var gi goImport
+		gi, _, err = extParseGoProtoType(extension)
+		if err != nil {
+			err = fmt.Errorf("invalid value for %q: %w", extPropGoProtoType, err)
+			return false
+		}
+		if gi.Path != "" && !seen[gi.String()] {
+			seen[gi.String()] = true
+			result = append(result, gi.String())
+		}
+		return true
+	})
+	return result, err
 }
 
 // Generate uses the Go templating engine to generate all of our server wrappers from
 // the descriptions we've built up above from the schema objects.
 // opts defines
 func Generate(swagger *openapi3.T, packageName string, opts Options) (string, error) {
-	// This is global state
-	options = opts
+	if err := alterSpec(swagger, opts); err != nil {
+		return "", err
+	}
+	if err := prepareSpec(swagger, opts); err != nil {
+		return "", err
+	}
+	return generate(swagger, packageName, opts)
+}
 
-	importMapping = constructImportMapping(opts.ImportMapping)
+// alterSpec runs the spec plugins and the spec hook of the options on the
+// spec, once before anything is generated for it, as they needn't be
+// idempotent.
+func alterSpec(swagger *openapi3.T, opts Options) error {
+	for _, plugin := range opts.SpecPlugins {
+		if err := runSpecPlugin(plugin, swagger); err != nil {
+			return fmt.Errorf("spec plugin %q: %w", plugin, err)
+		}
+	}
+	if opts.SpecHook != nil {
+		if err := opts.SpecHook(swagger); err != nil {
+			return fmt.Errorf("error altering spec: %w", err)
+		}
+	}
+	return nil
+}
+
+// prepareSpec bundles, filters and prunes the spec as the options say, once
+// alterSpec has altered it, before generating code for it.
+func prepareSpec(swagger *openapi3.T, opts Options) error {
+	// Operations are filtered by the names normalized as the options say.
+	options = opts
 
+	if opts.BundleExternalRefs {
+		bundleExternalRefs(swagger)
+	}
+	if opts.SkipInternal {
+		if err := removeInternalElements(swagger); err != nil {
+			return fmt.Errorf("error removing internal elements: %w", err)
+		}
+	}
 	filterOperationsByTag(swagger, opts)
-	if !opts.SkipPrune {
-		pruneUnusedComponents(swagger)
+	if err := filterOperationsByOperationID(swagger, opts); err != nil {
+		return err
+	}
+	pruneComponents(swagger, opts)
+	detectContentTags(swagger)
+	return nil
+}
+
+// detectContentTags sets whether struct fields are given xml, yaml and
+// msgpack tags, from the media types of the bodies in the spec.
+func detectContentTags(swagger *openapi3.T) {
+	generateXMLTags = specHasContent(swagger, isMediaTypeXML)
+	generateYAMLTags = specHasContent(swagger, isMediaTypeYAML)
+	generateMsgpackTags = specHasContent(swagger, isMediaTypeMsgpack)
+}
+
+// initGlobalState sets the global state which the operations and types of the
+// spec are described with.
+func initGlobalState(swagger *openapi3.T, opts Options) error {
+	options = opts
+
+	mapping, err := constructImportMapping(opts.ImportMapping)
+	if err != nil {
+		return err
+	}
+	importMapping = mapping
+	if err := checkExternalRefs(swagger); err != nil {
+		return err
+	}
+	componentsImport = goImport{}
+	if opts.ComponentsPackage != "" {
+		componentsImport = goImport{Name: goPackageName(path.Base(opts.ComponentsPackage)), Path: opts.ComponentsPackage}
 	}
 
 	// if we are provided an override for the response type suffix update it
@@ -129,9 +460,78 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 		responseTypeSuffix = opts.ResponseTypeSuffix
 	}
 
+	if err := checkNameCollisions(opts.NameCollisions); err != nil {
+		return err
+	}
+	if err := checkReservedWords(opts); err != nil {
+		return err
+	}
+	if err := checkEnumNaming(opts.EnumNaming); err != nil {
+		return err
+	}
+	if err := checkDurationFormat(opts.DurationFormat); err != nil {
+		return err
+	}
+	if err := checkDateLayout(opts.DateLayout); err != nil {
+		return err
+	}
+	renames = nil
+	startNameCache()
+	return resolveSchemaNames(swagger)
+}
+
+// generate generates the code of a package for a spec whose operations have
+// already been filtered.
+func generate(swagger *openapi3.T, packageName string, opts Options) (string, error) {
+	defer stopNameCache()
+	var heap *heapSampler
+	start := time.Now()
+	if opts.ReportGeneration != nil {
+		heap = startHeapSampler()
+		defer heap.stop()
+	}
+	if err := initGlobalState(swagger, opts); err != nil {
+		return "", err
+	}
+
+	if opts.BuildTags != "" {
+		if _, err := constraint.Parse("//go:build " + opts.BuildTags); err != nil {
+			return "", fmt.Errorf("invalid build tags %q: %w", opts.BuildTags, err)
+		}
+	}
+	// Go tools only recognize generated code by this kind of comment.
+	if opts.GeneratedBanner != "" && !generatedBannerRegexp.MatchString(opts.GeneratedBanner) {
+		return "", fmt.Errorf("generated code banner %q doesn't match \"Code generated ... DO NOT EDIT.\"", opts.GeneratedBanner)
+	}
+
+	if opts.AWSSigV4Service != "" && opts.AWSSigV4Region == "" {
+		return "", fmt.Errorf("an AWS region is required to sign requests for the %s service", opts.AWSSigV4Service)
+	}
+
+	if opts.TestClient && !opts.GenerateChiServer && !opts.GenerateEchoServer && !opts.GenerateGinServer {
+		return "", errors.New("the test client is generated along with a server, whose handlers it serves")
+	}
+
+	if opts.GenerateCLI && !opts.GenerateClient {
+		return "", errors.New("the CLI is generated along with a client, which it calls")
+	}
+
 	// This creates the golang templates text package
 	TemplateFunctions["opts"] = func() Options { return options }
-	t := template.New("oapi-codegen").Funcs(TemplateFunctions)
+	TemplateFunctions["defaultUserAgent"] = func() string { return DefaultUserAgent(swagger.Info) }
+	TemplateFunctions["correlationIDHeader"] = func() string {
+		if options.CorrelationIDHeader != "" {
+			return options.CorrelationIDHeader
+		}
+		return runtime.DefaultCorrelationIDHeader
+	}
+	TemplateFunctions["templateData"] = func() map[string]interface{} { return options.TemplateData }
+	for name := range opts.TemplateFunctions {
+		if _, found := TemplateFunctions[name]; found {
+			return "", fmt.Errorf("template function %q is a built-in one", name)
+		}
+	}
+	t := template.New("oapi-codegen").Funcs(TemplateFunctions).Funcs(opts.TemplateFunctions)
 	// This parses all of our own template files into the template object
 	// above
 	err := LoadTemplates(templates, t)
@@ -154,9 +554,19 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 		return "", fmt.Errorf("error creating operation definitions: %w", err)
 	}
 
+	webhooks, err := WebhookDefinitions(swagger, ops)
+	if err != nil {
+		return "", fmt.Errorf("error creating webhook definitions: %w", err)
+	}
+
+	callbacks, err := CallbackDefinitions(swagger, append(ops, webhooks...))
+	if err != nil {
+		return "", fmt.Errorf("error creating callback definitions: %w", err)
+	}
+
 	var typeDefinitions, constantDefinitions string
 	if opts.GenerateTypes {
-		typeDefinitions, err = GenerateTypeDefinitions(t, swagger, ops, opts.ExcludeSchemas)
+		typeDefinitions, err = GenerateTypeDefinitions(t, swagger, append(append(ops, webhooks...), callbacks...), opts.ExcludeSchemas)
 		if err != nil {
 			return "", fmt.Errorf("error generating type definitions: %w", err)
 		}
@@ -192,6 +602,46 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 		}
 	}
 
+	var oidcVerifiersOut string
+	if opts.GenerateEchoServer || opts.GenerateChiServer || opts.GenerateGinServer {
+		oidcVerifiersOut, err = GenerateOIDCVerifiers(t, DescribeSecuritySchemes(swagger))
+		if err != nil {
+			return "", fmt.Errorf("error generating OpenID Connect verifiers: %w", err)
+		}
+	}
+
+	var bodyDecodersOut string
+	if opts.GenerateEchoServer || opts.GenerateChiServer || opts.GenerateGinServer {
+		bodyDecodersOut, err = GenerateRequestBodyDecoders(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating request body decoders: %w", err)
+		}
+	}
+
+	var webhookHandlersOut string
+	if (opts.GenerateEchoServer || opts.GenerateChiServer || opts.GenerateGinServer) && len(webhooks) > 0 {
+		webhookHandlersOut, err = GenerateWebhookHandlers(t, webhooks)
+		if err != nil {
+			return "", fmt.Errorf("error generating webhook handlers: %w", err)
+		}
+	}
+
+	var callbackHandlersOut string
+	if (opts.GenerateEchoServer || opts.GenerateChiServer || opts.GenerateGinServer) && len(callbacks) > 0 {
+		callbackHandlersOut, err = GenerateCallbackHandlers(t, callbacks)
+		if err != nil {
+			return "", fmt.Errorf("error generating callback handlers: %w", err)
+		}
+	}
+
+	var signatureOut string
+	if opts.GenerateClient || opts.GenerateEchoServer || opts.GenerateChiServer || opts.GenerateGinServer {
+		signatureOut, err = GenerateSignature(t, swagger)
+		if err != nil {
+			return "", fmt.Errorf("error generating signature scheme: %w", err)
+		}
+	}
+
 	var clientOut string
 	if opts.GenerateClient {
 		clientOut, err = GenerateClient(t, ops)
@@ -208,18 +658,162 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 		}
 	}
 
+	var sentinelErrorsOut string
+	if opts.GenerateClient && opts.SentinelErrors {
+		sentinelErrorsOut, err = GenerateSentinelErrors(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating sentinel errors: %w", err)
+		}
+	}
+
+	var securityProvidersOut string
+	if opts.GenerateClient {
+		securityProvidersOut, err = GenerateSecurityProviders(t, DescribeSecuritySchemes(swagger))
+		if err != nil {
+			return "", fmt.Errorf("error generating security providers: %w", err)
+		}
+	}
+
+	var serverURLsOut string
+	if opts.GenerateClient {
+		servers, err := DescribeServers(swagger.Servers)
+		if err != nil {
+			return "", fmt.Errorf("error describing servers: %w", err)
+		}
+		for _, op := range ops {
+			servers = append(servers, op.Servers...)
+		}
+		serverURLsOut, err = GenerateServerURLs(t, servers)
+		if err != nil {
+			return "", fmt.Errorf("error generating server URLs: %w", err)
+		}
+	}
+
+	var webhookSenderOut string
+	if opts.GenerateClient && len(webhooks) > 0 {
+		webhookSenderOut, err = GenerateWebhookSender(t, webhooks)
+		if err != nil {
+			return "", fmt.Errorf("error generating webhook sender: %w", err)
+		}
+	}
+
+	var callbackSenderOut string
+	if opts.GenerateClient && len(callbacks) > 0 {
+		callbackSenderOut, err = GenerateCallbackSender(t, callbacks)
+		if err != nil {
+			return "", fmt.Errorf("error generating callback sender: %w", err)
+		}
+	}
+
+	var testClientOut string
+	if opts.TestClient {
+		testClientOut, err = GenerateTestClient(t)
+		if err != nil {
+			return "", fmt.Errorf("error generating test client: %w", err)
+		}
+	}
+
+	var pathsOut string
+	if opts.GeneratePaths {
+		pathsOut, err = GeneratePaths(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating paths: %w", err)
+		}
+	}
+
+	// The logging middlewares of servers find the routes of requests in Routes.
+	generateRoutes := opts.GenerateRoutes || opts.LoggingMiddleware && (opts.GenerateChiServer || opts.GenerateEchoServer || opts.GenerateGinServer)
+	var routesOut string
+	if generateRoutes {
+		routesOut, err = GenerateRoutes(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating routes: %w", err)
+		}
+	}
+
+	var cliOut string
+	if opts.GenerateCLI {
+		cliOut, err = GenerateCLI(t, ops, swagger.Servers)
+		if err != nil {
+			return "", fmt.Errorf("error generating CLI: %w", err)
+		}
+	}
+
 	var inlinedSpec string
 	if opts.EmbedSpec {
-		inlinedSpec, err = GenerateInlinedSpec(t, importMapping, swagger)
+		embedded := swagger
+		if !opts.RedactSpec.isZero() {
+			embedded, err = redactSpec(swagger, opts.RedactSpec, opts)
+			if err != nil {
+				return "", fmt.Errorf("error redacting the embedded spec: %w", err)
+			}
+		}
+		inlinedSpec, err = GenerateInlinedSpec(t, importMapping, embedded)
 		if err != nil {
 			return "", fmt.Errorf("error generating Go handlers for Paths: %w", err)
 		}
 	}
 
+	var contractTestsOut string
+	if opts.ContractTests {
+		contractTestsOut, err = GenerateContractTests(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating contract tests: %w", err)
+		}
+	}
+
+	var exampleTestsOut string
+	if opts.ExampleTests {
+		exampleTestsOut, err = GenerateExampleTests(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating example tests: %w", err)
+		}
+	}
+
+	var fuzzTestsOut string
+	if opts.FuzzTests {
+		fuzzTestsOut, err = GenerateFuzzTests(t, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating fuzz tests: %w", err)
+		}
+	}
+
+	var schemaAssertionsOut string
+	if opts.SchemaAssertions {
+		schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas, opts.ExcludeSchemas)
+		if err != nil {
+			return "", fmt.Errorf("error generating Go types for component schemas: %w", err)
+		}
+		schemaAssertionsOut, err = GenerateSchemaAssertions(t, schemaTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating schema assertions: %w", err)
+		}
+	}
+
+	var propertyGeneratorsOut string
+	if opts.PropertyGenerators && opts.ComponentsPackage == "" {
+		componentTypes, err := generateTypesForComponents(t, swagger, opts.ExcludeSchemas)
+		if err != nil {
+			return "", err
+		}
+		propertyGeneratorsOut, err = GeneratePropertyGenerators(t, componentTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating property generators: %w", err)
+		}
+	}
+
 	var buf bytes.Buffer
 	w := bufio.NewWriter(&buf)
 
 	externalImports := importMapping.GoImports()
+	if componentsImport.Path != "" {
+		externalImports = append(externalImports, componentsImport.String())
+	}
+	protoPackages, err := protoImports(swagger)
+	if err != nil {
+		return "", fmt.Errorf("error generating imports: %w", err)
+	}
+	externalImports = append(externalImports, protoPackages...)
 	importsOut, err := GenerateImports(t, externalImports, packageName)
 	if err != nil {
 		return "", fmt.Errorf("error generating imports: %w", err)
@@ -249,6 +843,40 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 		if err != nil {
 			return "", fmt.Errorf("error writing client: %w", err)
 		}
+		_, err = w.WriteString(sentinelErrorsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing sentinel errors: %w", err)
+		}
+		_, err = w.WriteString(securityProvidersOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing security providers: %w", err)
+		}
+		_, err = w.WriteString(serverURLsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing server URLs: %w", err)
+		}
+		_, err = w.WriteString(webhookSenderOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing webhook sender: %w", err)
+		}
+		_, err = w.WriteString(callbackSenderOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing callback sender: %w", err)
+		}
+	}
+
+	if opts.GeneratePaths {
+		_, err = w.WriteString(pathsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing paths: %w", err)
+		}
+	}
+
+	if generateRoutes {
+		_, err = w.WriteString(routesOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing routes: %w", err)
+		}
 	}
 
 	if opts.GenerateEchoServer {
@@ -272,6 +900,41 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 		}
 	}
 
+	_, err = w.WriteString(bodyDecodersOut)
+	if err != nil {
+		return "", fmt.Errorf("error writing request body decoders: %w", err)
+	}
+	_, err = w.WriteString(oidcVerifiersOut)
+	if err != nil {
+		return "", fmt.Errorf("error writing OpenID Connect verifiers: %w", err)
+	}
+	_, err = w.WriteString(webhookHandlersOut)
+	if err != nil {
+		return "", fmt.Errorf("error writing webhook handlers: %w", err)
+	}
+	_, err = w.WriteString(callbackHandlersOut)
+	if err != nil {
+		return "", fmt.Errorf("error writing callback handlers: %w", err)
+	}
+	_, err = w.WriteString(signatureOut)
+	if err != nil {
+		return "", fmt.Errorf("error writing signature scheme: %w", err)
+	}
+
+	if opts.TestClient {
+		_, err = w.WriteString(testClientOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing test client: %w", err)
+		}
+	}
+
+	if opts.GenerateCLI {
+		_, err = w.WriteString(cliOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing CLI: %w", err)
+		}
+	}
+
 	if opts.EmbedSpec {
 		_, err = w.WriteString(inlinedSpec)
 		if err != nil {
@@ -279,51 +942,109 @@ func Generate(swagger *openapi3.T, packageName string, opts Options) (string, er
 		}
 	}
 
+	if opts.ContractTests {
+		_, err = w.WriteString(contractTestsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing contract tests: %w", err)
+		}
+	}
+
+	if opts.ExampleTests {
+		_, err = w.WriteString(exampleTestsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing example tests: %w", err)
+		}
+	}
+
+	if opts.FuzzTests {
+		_, err = w.WriteString(fuzzTestsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing fuzz tests: %w", err)
+		}
+	}
+
+	if opts.SchemaAssertions {
+		_, err = w.WriteString(schemaAssertionsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing schema assertions: %w", err)
+		}
+	}
+
+	if opts.PropertyGenerators {
+		_, err = w.WriteString(propertyGeneratorsOut)
+		if err != nil {
+			return "", fmt.Errorf("error writing property generators: %w", err)
+		}
+	}
+
 	err = w.Flush()
 	if err != nil {
 		return "", fmt.Errorf("error flushing output buffer: %w", err)
 	}
+	if heap != nil {
+		heap.sample()
+	}
 
 	// remove any byte-order-marks which break Go-Code
 	goCode := SanitizeCode(buf.String())
 
 	// The generation code produces unindented horrors. Use the Go Imports
 	// to make it all pretty.
-	if opts.SkipFmt {
-		return goCode, nil
+	if !opts.SkipFmt {
+		outBytes, err := imports.Process(packageName+".go", []byte(goCode), nil)
+		if err != nil {
+			fmt.Println(goCode)
+			return "", fmt.Errorf("error formatting Go code: %w", err)
+		}
+		goCode = string(outBytes)
 	}
 
-	outBytes, err := imports.Process(packageName+".go", []byte(goCode), nil)
-	if err != nil {
-		fmt.Println(goCode)
-		return "", fmt.Errorf("error formatting Go code: %w", err)
+	// House styles go further, with formatters reading the code on their
+	// standard input and writing it on their standard output.
+	if opts.PostProcess != "" {
+		outBytes, err := runCommand(opts.PostProcess, []byte(goCode))
+		if err != nil {
+			return "", fmt.Errorf("error post-processing Go code with %q: %w", opts.PostProcess, err)
+		}
+		goCode = string(outBytes)
 	}
-	return string(outBytes), nil
-}
 
-func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
-	schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
-	if err != nil {
-		return "", fmt.Errorf("error generating Go types for component schemas: %w", err)
+	if opts.ReportRename != nil {
+		for _, rename := range renames {
+			opts.ReportRename(rename)
+		}
 	}
-
-	paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
-	if err != nil {
-		return "", fmt.Errorf("error generating Go types for component parameters: %w", err)
+	if opts.ReportWarning != nil && (opts.GenerateEchoServer || opts.GenerateChiServer || opts.GenerateGinServer) {
+		servers, err := DescribeServers(swagger.Servers)
+		if err != nil {
+			return "", fmt.Errorf("error describing servers: %w", err)
+		}
+		for _, warning := range operationServerWarnings(servers, ops) {
+			opts.ReportWarning(warning)
+		}
 	}
-	allTypes := append(schemaTypes, paramTypes...)
-
-	responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
-	if err != nil {
-		return "", fmt.Errorf("error generating Go types for component responses: %w", err)
+	if heap != nil {
+		opts.ReportGeneration(GenerationStats{
+			Package:  packageName,
+			Size:     len(goCode),
+			Duration: time.Since(start),
+			PeakHeap: heap.stop(),
+		})
 	}
-	allTypes = append(allTypes, responseTypes...)
+	return goCode, nil
+}
 
-	bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
-	if err != nil {
-		return "", fmt.Errorf("error generating Go types for component request bodies: %w", err)
+func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []OperationDefinition, excludeSchemas []string) (string, error) {
+	var allTypes []TypeDefinition
+	// The types of the components are left to their own package when there is
+	// one, which the types of the operations refer to.
+	if options.ComponentsPackage == "" {
+		var err error
+		allTypes, err = generateTypesForComponents(t, swagger, excludeSchemas)
+		if err != nil {
+			return "", err
+		}
 	}
-	allTypes = append(allTypes, bodyTypes...)
 
 	paramTypesOut, err := GenerateTypesForOperations(t, ops)
 	if err != nil {
@@ -345,10 +1066,82 @@ func GenerateTypeDefinitions(t *template.Template, swagger *openapi3.T, ops []Op
 		return "", fmt.Errorf("error generating allOf boilerplate: %w", err)
 	}
 
-	typeDefinitions := strings.Join([]string{enumsOut, typesOut, paramTypesOut, allOfBoilerplate}, "")
+	sensitiveOut, err := GenerateSensitiveTypes(t, allTypes)
+	if err != nil {
+		return "", fmt.Errorf("error generating the methods of types with sensitive properties: %w", err)
+	}
+
+	var buildersOut string
+	if options.BodyBuilders > 0 {
+		buildersOut, err = GenerateBuilders(t, allTypes, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating request body builders: %w", err)
+		}
+	}
+
+	var constructorsOut string
+	if options.OptionConstructors > 0 {
+		constructorsOut, err = GenerateConstructors(t, allTypes, ops)
+		if err != nil {
+			return "", fmt.Errorf("error generating constructors: %w", err)
+		}
+	}
+
+	dateLayoutOut, err := GenerateDateLayout(t)
+	if err != nil {
+		return "", fmt.Errorf("error generating the date type of the layout: %w", err)
+	}
+
+	var factoriesOut string
+	if options.Factories {
+		factoriesOut, err = GenerateFactories(t, allTypes)
+		if err != nil {
+			return "", fmt.Errorf("error generating factories: %w", err)
+		}
+	}
+
+	typeDefinitions := strings.Join([]string{enumsOut, typesOut, paramTypesOut, allOfBoilerplate, sensitiveOut, buildersOut, constructorsOut, dateLayoutOut, factoriesOut}, "")
 	return typeDefinitions, nil
 }
 
+// GenerateDateLayout generates the LayoutDate type, which dates are written
+// with when the DateLayout option gives a layout of its own.
+func GenerateDateLayout(t *template.Template) (string, error) {
+	if !hasDateLayout() {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"date-layout.tmpl"}, t, options.DateLayout)
+}
+
+// generateTypesForComponents returns the type definitions for the schemas,
+// parameters, responses and request bodies of the spec's components.
+func generateTypesForComponents(t *template.Template, swagger *openapi3.T, excludeSchemas []string) ([]TypeDefinition, error) {
+	schemaTypes, err := GenerateTypesForSchemas(t, swagger.Components.Schemas, excludeSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Go types for component schemas: %w", err)
+	}
+
+	paramTypes, err := GenerateTypesForParameters(t, swagger.Components.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Go types for component parameters: %w", err)
+	}
+	allTypes := append(schemaTypes, paramTypes...)
+
+	responseTypes, err := GenerateTypesForResponses(t, swagger.Components.Responses)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Go types for component responses: %w", err)
+	}
+	allTypes = append(allTypes, responseTypes...)
+
+	bodyTypes, err := GenerateTypesForRequestBodies(t, swagger.Components.RequestBodies)
+	if err != nil {
+		return nil, fmt.Errorf("error generating Go types for component request bodies: %w", err)
+	}
+	allTypes = append(allTypes, bodyTypes...)
+
+	return allTypes, nil
+}
+
 // Generates operation ids, context keys, paths, etc. to be exported as constants
 func GenerateConstants(t *template.Template, ops []OperationDefinition) (string, error) {
 	constants := Constants{
@@ -378,30 +1171,45 @@ func GenerateConstants(t *template.Template, ops []OperationDefinition) (string,
 // Generates type definitions for any custom types defined in the
 // components/schemas section of the Swagger spec.
 func GenerateTypesForSchemas(t *template.Template, schemas map[string]*openapi3.SchemaRef, excludeSchemas []string) ([]TypeDefinition, error) {
-	excludeSchemasMap := make(map[string]bool)
-	for _, schema := range excludeSchemas {
-		excludeSchemasMap[schema] = true
-	}
-	types := make([]TypeDefinition, 0)
 	// We're going to define Go types for every object under components/schemas
+	var schemaNames []string
 	for _, schemaName := range SortedSchemaKeys(schemas) {
-		if _, ok := excludeSchemasMap[schemaName]; ok {
-			continue
+		if !schemaExcluded(schemaName, excludeSchemas) {
+			schemaNames = append(schemaNames, schemaName)
 		}
-		schemaRef := schemas[schemaName]
+	}
 
-		goSchema, err := GenerateGoSchema(schemaRef, []string{schemaName})
+	// The schemas are independent of each other, so they're converted
+	// concurrently.
+	definitions := make([]TypeDefinition, len(schemaNames))
+	err := forEachParallel(len(schemaNames), func(i int) error {
+		schemaName := schemaNames[i]
+		typeName := schemaTypeName(schemaName)
+		path := []string{schemaName}
+		if typeName != SchemaNameToTypeName(schemaName) {
+			// The types of its properties are named after those of renamed
+			// schemas, so that they don't collide either.
+			path = []string{typeName}
+		}
+		goSchema, err := GenerateGoSchema(schemas[schemaName], path)
 		if err != nil {
-			return nil, fmt.Errorf("error converting Schema %s to Go type: %w", schemaName, err)
+			return fmt.Errorf("error converting Schema %s to Go type: %w", schemaName, err)
 		}
-
-		types = append(types, TypeDefinition{
+		definitions[i] = TypeDefinition{
 			JsonName: schemaName,
-			TypeName: SchemaNameToTypeName(schemaName),
+			TypeName: typeName,
 			Schema:   goSchema,
-		})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		types = append(types, goSchema.GetAdditionalTypeDefs()...)
+	types := make([]TypeDefinition, 0)
+	for _, definition := range definitions {
+		types = append(types, definition)
+		types = append(types, definition.Schema.GetAdditionalTypeDefs()...)
 	}
 	return types, nil
 }
@@ -447,10 +1255,10 @@ func GenerateTypesForResponses(t *template.Template, responses openapi3.Response
 		responseOrRef := responses[responseName]
 
 		// We have to generate the response object. We're only going to
-		// handle application/json media types here. Other responses should
+		// handle JSON media types here. Other responses should
 		// simply be specified as strings or byte arrays.
 		response := responseOrRef.Value
-		jsonResponse, found := response.Content["application/json"]
+		jsonResponse, found := response.Content[jsonContentType(response.Content)]
 		if found {
 			goType, err := GenerateGoSchema(jsonResponse.Schema, []string{responseName})
 			if err != nil {
@@ -488,7 +1296,7 @@ func GenerateTypesForRequestBodies(t *template.Template, bodies map[string]*open
 		// As for responses, we will only generate Go code for JSON bodies,
 		// the other body formats are up to the user.
 		response := bodyOrRef.Value
-		jsonBody, found := response.Content["application/json"]
+		jsonBody, found := response.Content[jsonContentType(response.Content)]
 		if found {
 			goType, err := GenerateGoSchema(jsonBody.Schema, []string{bodyName})
 			if err != nil {
@@ -587,16 +1395,38 @@ func GenerateImports(t *template.Template, externalImports []string, packageName
 		}
 	}
 
+	// Fuzz targets need Go 1.18. The log/slog package of LogValue methods
+	// needs Go 1.21, which isn't made a build constraint, as it would leave
+	// the whole package out with older versions rather than fail to build it.
+	buildTags := options.BuildTags
+	var goVersion string
+	if options.FuzzTests {
+		goVersion = "go1.18"
+	}
+	if goVersion != "" {
+		if buildTags != "" {
+			buildTags = "(" + buildTags + ") && " + goVersion
+		} else {
+			buildTags = goVersion
+		}
+	}
+
 	context := struct {
 		ExternalImports []string
 		PackageName     string
 		ModuleName      string
 		Version         string
+		FileHeader      string
+		BuildTags       string
+		Banner          string
 	}{
 		ExternalImports: externalImports,
 		PackageName:     packageName,
 		ModuleName:      modulePath,
 		Version:         moduleVersion,
+		FileHeader:      StringToGoComment(options.FileHeader),
+		BuildTags:       buildTags,
+		Banner:          options.GeneratedBanner,
 	}
 
 	return GenerateTemplates([]string{"imports.tmpl"}, t, context)
@@ -630,6 +1460,62 @@ func GenerateAdditionalPropertyBoilerplate(t *template.Template, typeDefs []Type
 	return GenerateTemplates([]string{"additional-properties.tmpl"}, t, context)
 }
 
+// sensitiveType is a type with x-sensitive properties, whose generated String
+// and LogValue methods mask them.
+type sensitiveType struct {
+	TypeName             string
+	Fields               []sensitiveField
+	AdditionalProperties bool
+}
+
+// sensitiveField is a field of a sensitiveType.
+type sensitiveField struct {
+	Name      string // The name of the struct field
+	JSONName  string
+	Sensitive bool
+	Optional  bool // Whether the field is a pointer, which is nil when the property is unset
+}
+
+// GenerateSensitiveTypes generates the String methods of the types with
+// x-sensitive properties, which mask them, along with LogValue methods when
+// the LogValuers option is set.
+func GenerateSensitiveTypes(t *template.Template, typeDefs []TypeDefinition) (string, error) {
+	var types []sensitiveType
+	seen := map[string]bool{}
+	for _, td := range typeDefs {
+		if seen[td.TypeName] || td.Schema.AliasOnly || td.Schema.RefType != "" || (options.AliasTypes && td.CanAlias()) {
+			continue
+		}
+		seen[td.TypeName] = true
+
+		st := sensitiveType{TypeName: td.TypeName, AdditionalProperties: td.Schema.HasAdditionalProperties}
+		hasSensitive := false
+		for _, p := range td.Schema.Properties {
+			sensitive, err := p.sensitive()
+			if err != nil {
+				return "", fmt.Errorf("type %s: %w", td.TypeName, err)
+			}
+			hasSensitive = hasSensitive || sensitive
+			st.Fields = append(st.Fields, sensitiveField{
+				Name:      p.structFieldName(),
+				JSONName:  p.JsonFieldName,
+				Sensitive: sensitive,
+				Optional:  strings.HasPrefix(p.GoTypeDef(), "*"),
+			})
+		}
+		if !hasSensitive {
+			continue
+		}
+		for _, f := range st.Fields {
+			if f.Name == "String" || (options.LogValuers && f.Name == "LogValue") {
+				return "", fmt.Errorf("type %s has sensitive properties, but its %s field leaves no room for the method masking them", td.TypeName, f.Name)
+			}
+		}
+		types = append(types, st)
+	}
+	return GenerateTemplates([]string{"sensitive.tmpl"}, t, types)
+}
+
 // SanitizeCode runs sanitizers across the generated Go code to ensure the
 // generated code will be able to compile.
 func SanitizeCode(goCode string) string {
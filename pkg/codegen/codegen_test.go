@@ -5,13 +5,17 @@ import (
 	"go/format"
 	"io/ioutil"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
+	"text/template"
 
 	examplePetstoreClient "github.com/deepmap/oapi-codegen/examples/petstore-expanded"
 	examplePetstore "github.com/deepmap/oapi-codegen/examples/petstore-expanded/echo/api"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/golangci/lint-1"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExamplePetStoreCodeGeneration(t *testing.T) {
@@ -90,6 +94,126 @@ func TestExamplePetStoreCodeGenerationWithUserTemplates(t *testing.T) {
 	assert.Contains(t, code, "//blah")
 }
 
+func TestUserTemplateFunctionsAndData(t *testing.T) {
+	swagger, err := examplePetstore.GetSwagger()
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "api", Options{
+		GenerateTypes: true,
+		UserTemplates: map[string]string{"typedef.tmpl": `// Copyright {{shout (index templateData "owner")}}`},
+		TemplateFunctions: template.FuncMap{
+			"shout": strings.ToUpper,
+		},
+		TemplateData: map[string]interface{}{"owner": "Acme Corp"},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, code, "// Copyright ACME CORP")
+
+	// Built-in functions can't be replaced, as the built-in templates rely on
+	// them.
+	_, err = Generate(swagger, "api", Options{
+		GenerateTypes:     true,
+		TemplateFunctions: template.FuncMap{"lower": strings.ToUpper},
+	})
+	assert.EqualError(t, err, `template function "lower" is a built-in one`)
+}
+
+func TestUserTemplateExtensions(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: Extensions
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      x-rate-limit: 10
+      parameters:
+        - name: owner
+          in: query
+          x-sensitive: true
+          schema:
+            type: string
+      responses:
+        '200':
+          description: Pets
+          x-cache: {maxAge: 60}
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      x-table: pets
+      properties:
+        name:
+          type: string
+`))
+	require.NoError(t, err)
+
+	// Custom templates can act on the extensions of schemas, operations and
+	// parameters.
+	code, err := Generate(swagger, "api", Options{
+		GenerateTypes: true,
+		UserTemplates: map[string]string{
+			"typedef.tmpl":     `{{range .Types}}// {{.TypeName}} is stored in {{index .Schema.Extensions "x-table"}}` + "\n" + `{{end}}`,
+			"param-types.tmpl": `{{range .}}// {{.OperationId}} is limited to {{index .Extensions "x-rate-limit"}}{{range .QueryParams}}, {{.ParamName}} is sensitive: {{index .Extensions "x-sensitive"}}{{end}}` + "\n" + `{{end}}`,
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "// Pet is stored in pets")
+	assert.Contains(t, code, "// ListPets is limited to 10, owner is sensitive: true")
+
+	ops, err := OperationDefinitions(swagger)
+	require.NoError(t, err)
+	responses, err := ops[0].GetResponseTypeDefinitions()
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Equal(t, map[string]interface{}{"x-cache": map[string]interface{}{"maxAge": 60.0}}, responses[0].Extensions)
+}
+
+func TestOIDCVerifiers(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: OIDC
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      security:
+        - corporate-sso: [pets:read]
+      responses:
+        '204':
+          description: Pets
+components:
+  securitySchemes:
+    corporate-sso:
+      type: openIdConnect
+      openIdConnectUrl: https://sso.example.com/.well-known/openid-configuration
+`))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "api", Options{GenerateChiServer: true, GenerateClient: true})
+	require.NoError(t, err)
+	assert.Contains(t, code, `const CorporateSsoDiscoveryURL = "https://sso.example.com/.well-known/openid-configuration"`)
+	assert.Contains(t, code, `func NewCorporateSsoVerifier(ctx context.Context, audience string, options oidc.Options) (*oidc.Verifier, error) {
+	return oidc.NewVerifier(ctx, CorporateSsoDiscoveryURL, "corporate-sso", audience, options)
+}`)
+	// Clients send the tokens as bearer tokens.
+	assert.Contains(t, code, "func NewCorporateSsoBearerTokenProvider(token string) RequestEditorFn {")
+
+	code, err = Generate(swagger, "api", Options{GenerateClient: true})
+	require.NoError(t, err)
+	assert.NotContains(t, code, "NewCorporateSsoVerifier")
+}
+
 func TestExamplePetStoreParseFunction(t *testing.T) {
 
 	bodyBytes := []byte(`{"id": 5, "name": "testpet", "tag": "cat"}`)
@@ -163,7 +287,10 @@ type GetTestByNameResponse struct {
 	assert.Contains(t, code, "Top *int `json:\"$top,omitempty\"`")
 	assert.Contains(t, code, "func (c *Client) GetTestByName(ctx context.Context, name string, params *GetTestByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {")
 	assert.Contains(t, code, "func (c *ClientWithResponses) GetTestByNameWithResponse(ctx context.Context, name string, params *GetTestByNameParams, reqEditors ...RequestEditorFn) (*GetTestByNameResponse, error) {")
-	assert.Contains(t, code, "DeadSince *time.Time    `json:\"dead_since,omitempty\" tag1:\"value1\" tag2:\"value2\"`")
+	assert.Contains(t, code, "DeadSince *time.Time    `json:\"dead_since,omitempty\" tag1:\"value1\" tag2:\"value2\" xml:\"dead_since,omitempty\" yaml:\"dead_since,omitempty\"`")
+
+	// Check that XML responses are unmarshaled:
+	assert.Contains(t, code, "if err := xml.Unmarshal(bodyBytes, &dest); err != nil {")
 
 	// Make sure the generated code is valid:
 	linter := new(lint.Linter)
@@ -172,6 +299,493 @@ type GetTestByNameResponse struct {
 	assert.Len(t, problems, 0)
 }
 
+func TestYAMLPackage(t *testing.T) {
+	opts := Options{
+		GenerateClient: true,
+		GenerateTypes:  true,
+		YAMLPackage:    "sigs.k8s.io/yaml",
+	}
+
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", opts)
+	assert.NoError(t, err)
+
+	assert.Contains(t, code, `yaml "sigs.k8s.io/yaml"`)
+	assert.NotContains(t, code, `"gopkg.in/yaml.v2"`)
+	assert.Contains(t, code, "if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {")
+}
+
+func TestMsgpackBodies(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Msgpack Test
+  version: 1.0.0
+paths:
+  /thing:
+    post:
+      operationId: postThing
+      requestBody:
+        content:
+          application/msgpack:
+            schema:
+              $ref: '#/components/schemas/Thing'
+      responses:
+        200:
+          description: the stored thing
+          content:
+            application/msgpack:
+              schema:
+                $ref: '#/components/schemas/Thing'
+components:
+  schemas:
+    Thing:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: integer
+        label:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateClient: true,
+		GenerateTypes:  true,
+	})
+	assert.NoError(t, err)
+
+	assert.Contains(t, code, `msgpack "github.com/vmihailenco/msgpack/v5"`)
+	assert.Contains(t, code, "Id    int     `json:\"id\" msgpack:\"id\"`")
+	assert.Contains(t, code, "Label *string `json:\"label,omitempty\" msgpack:\"label,omitempty\"`")
+	assert.Contains(t, code, "func (c *Client) PostThingWithMsgpackBody(ctx context.Context, body PostThingMsgpackRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+	assert.Contains(t, code, "buf, err := msgpack.Marshal(body)")
+	assert.Contains(t, code, "Msgpack200   *Thing")
+	assert.Contains(t, code, "if err := msgpack.Unmarshal(bodyBytes, &dest); err != nil {")
+
+	code, err = Generate(swagger, "testswagger", Options{
+		GenerateClient: true,
+		GenerateTypes:  true,
+		MsgpackPackage: "github.com/shamaton/msgpack/v2",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, code, `msgpack "github.com/shamaton/msgpack/v2"`)
+}
+
+func TestVendorJSONBodies(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Vendor JSON Test
+  version: 1.0.0
+paths:
+  /thing:
+    post:
+      operationId: postThing
+      requestBody:
+        content:
+          application/vnd.myco.v2+json:
+            schema:
+              $ref: '#/components/schemas/Thing'
+      responses:
+        200:
+          description: the stored thing
+          content:
+            application/hal+json:
+              schema:
+                $ref: '#/components/schemas/Thing'
+            application/json:
+              schema:
+                type: string
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        label:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateClient: true,
+		GenerateTypes:  true,
+	})
+	assert.NoError(t, err)
+
+	// Vendor JSON bodies are the default ones, and keep their content type.
+	assert.Contains(t, code, "type PostThingJSONRequestBody PostThingJSONBody")
+	assert.Contains(t, code, "func (c *Client) PostThing(ctx context.Context, body PostThingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+	assert.Contains(t, code, `return NewPostThingRequestWithBody(server, "application/vnd.myco.v2+json", bodyReader)`)
+	// application/json is preferred over other JSON responses.
+	assert.Contains(t, code, "JSON200      *string")
+	assert.NotContains(t, code, "*Thing")
+}
+
+func TestMediaRangeResponses(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Media Range Test
+  version: 1.0.0
+paths:
+  /thing:
+    get:
+      operationId: getThing
+      responses:
+        200:
+          description: the thing, in any format
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  label:
+                    type: string
+            text/*:
+              schema:
+                type: string
+            '*/*': {}
+        default:
+          description: an error
+          content:
+            application/*: {}
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateClient: true,
+		GenerateTypes:  true,
+	})
+	assert.NoError(t, err)
+
+	assert.Regexp(t, `AnyText200\s+\*string`, code)
+	assert.Regexp(t, `Any200\s+\*\[\]byte`, code)
+	assert.Regexp(t, `AnyApplicationDefault \*\[\]byte`, code)
+	assert.NotContains(t, code, "unsupported")
+
+	// JSON is matched first, then the ranges of a type, then any content type.
+	jsonCase := strings.Index(code, `case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:`)
+	textCase := strings.Index(code, `case strings.HasPrefix(rsp.Header.Get("Content-Type"), "text/") && rsp.StatusCode == 200:`)
+	applicationCase := strings.Index(code, `case strings.HasPrefix(rsp.Header.Get("Content-Type"), "application/") && true:`)
+	anyCase := strings.Index(code, "case rsp.StatusCode == 200:\n\t\tdest := bodyBytes\n\t\tresponse.Any200 = &dest")
+	assert.True(t, jsonCase >= 0 && textCase > jsonCase && anyCase > textCase, "cases out of order")
+	// The default response is matched after the 200 ones.
+	assert.Greater(t, applicationCase, anyCase)
+	assert.Contains(t, code, "dest := string(bodyBytes)\n\t\tresponse.AnyText200 = &dest")
+}
+
+func TestStatusRangeResponses(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Status Range Test
+  version: 1.0.0
+paths:
+  /thing:
+    get:
+      operationId: getThing
+      responses:
+        200:
+          description: the thing
+          content:
+            application/xml:
+              schema:
+                $ref: '#/components/schemas/Thing'
+        2XX:
+          description: some other success
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Thing'
+        4xx:
+          description: a client error
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Error'
+        default:
+          description: any other error
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Error'
+components:
+  schemas:
+    Thing:
+      type: object
+      properties:
+        label:
+          type: string
+    Error:
+      type: object
+      properties:
+        message:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateClient: true,
+		GenerateTypes:  true,
+	})
+	assert.NoError(t, err)
+
+	assert.Regexp(t, `XML200\s+\*Thing`, code)
+	assert.Regexp(t, `JSON2XX\s+\*Thing`, code)
+	assert.Regexp(t, `JSON4xx\s+\*Error`, code)
+	assert.Regexp(t, `JSONDefault\s+\*Error`, code)
+
+	// Exact codes are matched first, then ranges, then the default response,
+	// whatever their content types.
+	exactCase := strings.Index(code, `case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:`)
+	successCase := strings.Index(code, `case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 2:`)
+	clientErrorCase := strings.Index(code, `case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:`)
+	defaultCase := strings.Index(code, `case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:`)
+	assert.True(t, exactCase >= 0 && successCase > exactCase && clientErrorCase > successCase && defaultCase > clientErrorCase, "cases out of order")
+}
+
+func TestStrictParamContent(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: Parameter Content
+  version: 1.0.0
+paths:
+  /things/{id}:
+    get:
+      operationId: getThing
+      parameters:
+        - name: id
+          in: path
+          required: true
+          content:
+            text/plain; charset=utf-8:
+              schema:
+                type: integer
+        - name: filter
+          in: query
+          content:
+            application/x-custom:
+              schema:
+                type: object
+      responses:
+        '204':
+          description: the thing
+`))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "api", Options{GenerateTypes: true, GenerateClient: true})
+	require.NoError(t, err)
+	assert.Contains(t, code, "func NewGetThingRequest(server string, id int, params *GetThingParams) (*http.Request, error) {")
+	assert.Regexp(t, `Filter \*string`, code)
+
+	_, err = Generate(swagger, "api", Options{GenerateTypes: true, GenerateClient: true, StrictParamContent: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parameter 'filter' has content of media types application/x-custom, which can't be decoded")
+}
+
+func TestRequestBodyDecoders(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Negotiated Test
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: addPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+          application/x-www-form-urlencoded:
+            schema:
+              $ref: '#/components/schemas/Pet'
+          multipart/form-data:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+                photo:
+                  type: string
+                  format: binary
+          image/*: {}
+      responses:
+        204:
+          description: added
+  /pets/{id}:
+    put:
+      operationId: updatePet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        204:
+          description: updated
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateTypes:     true,
+		GenerateClient:    true,
+		GenerateChiServer: true,
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, code, "func (c *Client) AddPetWithFormdataBody(")
+	assert.Contains(t, code, "func (c *Client) AddPetWithMultipartBody(")
+	assert.Contains(t, code, "func DecodeAddPetBody(r *http.Request) (*AddPetBodies, error) {")
+	assert.Contains(t, code, `supported := []string{"application/json", "application/x-www-form-urlencoded", "multipart/form-data", "image/*"}`)
+	assert.Contains(t, code, "if err := runtime.UnmarshalForm(r.PostForm, &body, nil); err != nil {")
+	assert.Contains(t, code, "if err := runtime.BindMultipart(r.MultipartForm, &body); err != nil {")
+	assert.Contains(t, code, "bodies.Multipart = &body")
+	// Media types without a schema are decoded as raw bytes.
+	assert.Contains(t, code, `if !runtime.MatchMediaRanges(mediaType, []string{"image/*"}) {`)
+	assert.Contains(t, code, "bodies.Raw = data")
+	// Bodies with a single content type need no negotiation.
+	assert.NotContains(t, code, "DecodeUpdatePetBody")
+}
+
+func TestMultipartEncodings(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Multipart Encoding Test
+  version: 1.0.0
+paths:
+  /photos:
+    post:
+      operationId: addPhotos
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+          multipart/form-data:
+            schema:
+              type: object
+              properties:
+                ids:
+                  type: array
+                  items:
+                    type: integer
+                photos:
+                  type: array
+                  items:
+                    type: string
+                    format: binary
+            encoding:
+              ids:
+                style: spaceDelimited
+              photos:
+                contentType: image/*
+                headers:
+                  X-Source:
+                    schema:
+                      type: string
+                      default: camera
+                  X-Unset:
+                    schema:
+                      type: string
+      responses:
+        204:
+          description: added
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateTypes:     true,
+		GenerateClient:    true,
+		GenerateChiServer: true,
+	})
+	require.NoError(t, err)
+
+	// Only headers with a default are sent.
+	assert.Contains(t, code, `var AddPhotosMultipartEncodings = map[string]runtime.MultipartEncoding{"ids": {Style: "spaceDelimited", Explode: false}, "photos": {ContentType: "image/*", Headers: map[string]string{"X-Source": "camera"}}}`)
+	assert.Regexp(t, `Photos \*\[\]openapi_types.File`, code)
+	assert.Contains(t, code, "return runtime.MarshalMultipartWithEncoding(body, AddPhotosMultipartEncodings)")
+	assert.Contains(t, code, "if err := runtime.BindMultipartWithEncoding(r.MultipartForm, &body, AddPhotosMultipartEncodings); err != nil {")
+}
+
+func TestAWSSigV4(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateClient:  true,
+		GenerateTypes:   true,
+		AWSSigV4Service: "execute-api",
+		AWSSigV4Region:  "eu-west-1",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, code, `"github.com/deepmap/oapi-codegen/pkg/securityprovider"`)
+	assert.Contains(t, code, "func WithAWSSigV4(credentials securityprovider.AWSCredentials) ClientOption {")
+	assert.Contains(t, code, `securityprovider.NewSecurityProviderAWSSigV4(credentials, "eu-west-1", "execute-api")`)
+
+	_, err = Generate(swagger, "testswagger", Options{
+		GenerateClient:  true,
+		AWSSigV4Service: "execute-api",
+	})
+	assert.Error(t, err)
+}
+
+func TestTestClient(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateClient:     true,
+		GenerateTypes:      true,
+		GenerateEchoServer: true,
+		TestClient:         true,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, code, "func NewTestClient(t testing.TB, si ServerInterface, opts ...ClientOption) *TestClient {")
+	assert.Contains(t, code, "handler := echo.New()\n\tRegisterHandlers(handler, si)")
+
+	code, err = Generate(swagger, "testswagger", Options{
+		GenerateClient:    true,
+		GenerateTypes:     true,
+		GenerateGinServer: true,
+		TestClient:        true,
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, code, "handler := RegisterHandlers(gin.New(), si)")
+
+	_, err = Generate(swagger, "testswagger", Options{
+		GenerateClient: true,
+		TestClient:     true,
+	})
+	assert.Error(t, err)
+}
+
 const testOpenAPIDefinition = `
 openapi: 3.0.1
 
@@ -312,3 +926,511 @@ components:
           type: string
           enum: [car, dog, oldage]
 `
+
+func TestCorrelationIDHeader(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateClient:      true,
+		GenerateChiServer:   true,
+		GenerateTypes:       true,
+		CorrelationIDHeader: "X-Correlation-ID",
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, code, `req.Header.Set("X-Correlation-ID", id)`)
+	assert.Contains(t, code, `runtime.ResponseCorrelationID(r.HTTPResponse, "X-Correlation-ID")`)
+	assert.Contains(t, code, `id := runtime.RequestCorrelationID(r.Header, "X-Correlation-ID")`)
+	assert.NotContains(t, code, "X-Request-ID")
+}
+
+func TestExcludeSchemas(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Excluded Schemas Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      x-go-type: json.RawMessage
+      properties:
+        name:
+          type: string
+    PetList:
+      type: array
+      items:
+        $ref: '#/components/schemas/Pet'
+    Owner:
+      type: object
+      properties:
+        name:
+          type: string
+    Household:
+      type: object
+      properties:
+        pet:
+          $ref: '#/components/schemas/Pet'
+        owner:
+          $ref: '#/components/schemas/Owner'
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	assert.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateTypes:  true,
+		SkipPrune:      true,
+		ExcludeSchemas: []string{"Pet*", "Owner"},
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, code, "type Pet ")
+	assert.NotContains(t, code, "type PetList ")
+	assert.NotContains(t, code, "type Owner ")
+	assert.Contains(t, code, "type Household struct")
+	// Excluded schemas are referred to by their custom Go type when they have
+	// one, and are otherwise expected in the package.
+	assert.Contains(t, code, "Pet   *json.RawMessage")
+	assert.Contains(t, code, "Owner *Owner")
+}
+
+func TestEnumNameClashes(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Enum Name Clashes Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Kind:
+      type: string
+      enum: ["", "Empty", "foo-bar", "foo_bar", "FooBar1"]
+`
+	var first string
+	for i := 0; i < 10; i++ {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		assert.NoError(t, err)
+
+		code, err := Generate(swagger, "testswagger", Options{GenerateTypes: true, SkipPrune: true})
+		assert.NoError(t, err)
+		if i == 0 {
+			first = code
+			continue
+		}
+		// Names which clash are numbered the same way every time.
+		assert.Equal(t, first, code)
+	}
+	assert.Contains(t, first, `KindEmpty Kind = ""`)
+	assert.Contains(t, first, `KindEmpty1 Kind = "Empty"`)
+	assert.Contains(t, first, `KindFooBar Kind = "foo-bar"`)
+	assert.Contains(t, first, `KindFooBar1 Kind = "foo_bar"`)
+	assert.Contains(t, first, `KindFooBar11 Kind = "FooBar1"`)
+}
+
+func TestEnumNaming(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Enum Naming Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    PetKind:
+      type: string
+      enum: [cat, http-dog]
+    Color:
+      type: string
+      enum: [red]
+      x-enum-naming: short
+`
+	generate := func(naming string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		return Generate(swagger, "testswagger", Options{GenerateTypes: true, SkipPrune: true, EnumNaming: naming})
+	}
+
+	code, err := generate("")
+	require.NoError(t, err)
+	assert.Contains(t, code, `PetKindCat PetKind = "cat"`)
+	assert.Contains(t, code, `Red Color = "red"`)
+
+	code, err = generate(EnumNamingShort)
+	require.NoError(t, err)
+	assert.Contains(t, code, `Cat PetKind = "cat"`)
+	assert.Contains(t, code, `HttpDog PetKind = "http-dog"`)
+
+	code, err = generate(EnumNamingScreamingSnake)
+	require.NoError(t, err)
+	assert.Contains(t, code, `PetKindCat PetKind = "cat"`)
+	assert.Contains(t, code, "PET_KIND_CAT = PetKindCat")
+	assert.Contains(t, code, "PET_KIND_HTTP_DOG = PetKindHttpDog")
+	assert.Contains(t, code, `Red Color = "red"`)
+	assert.NotContains(t, code, "RED =")
+
+	_, err = generate("snake")
+	assert.EqualError(t, err, `unknown enum naming scheme "snake"`)
+}
+
+func TestDurationFormat(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Duration Test
+  version: 1.0.0
+paths:
+  /jobs:
+    get:
+      operationId: listJobs
+      parameters:
+        - name: timeout
+          in: query
+          schema:
+            type: string
+            format: duration
+      responses:
+        '200':
+          description: The jobs
+components:
+  schemas:
+    Job:
+      type: object
+      properties:
+        timeout:
+          type: string
+          format: duration
+`
+	generate := func(format string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		return Generate(swagger, "testswagger", Options{GenerateTypes: true, GenerateClient: true, SkipPrune: true, DurationFormat: format})
+	}
+
+	code, err := generate("")
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(code, "Timeout *openapi_types.Duration `json:\"timeout,omitempty\"`"))
+
+	code, err = generate(DurationFormatISO8601)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(code, "Timeout *openapi_types.ISODuration `json:\"timeout,omitempty\"`"))
+	assert.NotContains(t, code, "openapi_types.Duration")
+
+	_, err = generate("seconds")
+	assert.EqualError(t, err, `unknown duration format "seconds"`)
+}
+
+func TestDateLayout(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Date Layout Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Day:
+      type: string
+      format: date
+    Report:
+      type: object
+      properties:
+        day:
+          type: string
+          format: date
+`
+	generate := func(layout string) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		return Generate(swagger, "testswagger", Options{GenerateTypes: true, SkipPrune: true, DateLayout: layout})
+	}
+
+	code, err := generate("")
+	require.NoError(t, err)
+	assert.Contains(t, code, "type Day openapi_types.Date")
+	assert.NotContains(t, code, "LayoutDate")
+
+	code, err = generate("2006-01-02")
+	require.NoError(t, err)
+	assert.NotContains(t, code, "LayoutDate")
+
+	code, err = generate("20060102")
+	require.NoError(t, err)
+	assert.Contains(t, code, `const LayoutDateFormat = "20060102"`)
+	assert.Contains(t, code, "type Day = LayoutDate")
+	assert.Contains(t, code, "Day *LayoutDate `json:\"day,omitempty\"`")
+
+	_, err = generate("2006-01")
+	assert.EqualError(t, err, `date layout "2006-01" doesn't write dates which read back as they were written, as "20060102" does`)
+}
+
+func TestSkipEmailValidation(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Email Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Contact:
+      type: object
+      properties:
+        email:
+          type: string
+          format: email
+`
+	for skip, expected := range map[bool]string{
+		false: "Email *openapi_types.Email `json:\"email,omitempty\"`",
+		true:  "Email *string `json:\"email,omitempty\"`",
+	} {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		code, err := Generate(swagger, "testswagger", Options{GenerateTypes: true, SkipPrune: true, SkipEmailValidation: skip})
+		require.NoError(t, err)
+		assert.Contains(t, code, expected)
+	}
+}
+
+func TestBigIntFormat(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Big Int Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Account:
+      type: object
+      required: [id]
+      properties:
+        id:
+          type: integer
+          format: bigint
+        balance:
+          type: integer
+          format: bigint
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	code, err := Generate(swagger, "testswagger", Options{GenerateTypes: true, SkipPrune: true})
+	require.NoError(t, err)
+	assert.Contains(t, code, `"math/big"`)
+	assert.Contains(t, code, "Balance *big.Int `json:\"balance,omitempty\"`")
+	assert.Contains(t, code, "Id      *big.Int `json:\"id\"`")
+}
+
+func TestImportMappingAliases(t *testing.T) {
+	mapping, err := constructImportMapping(map[string]string{
+		"a.yaml": "github.com/acme/models",
+		"b.yaml": "github.com/other/models#othermodels",
+		"c.yaml": "github.com/other/models#othermodels",
+		"d.yaml": "github.com/acme/pets",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, importMap{
+		"a.yaml": {Name: "externalRef0", Path: "github.com/acme/models"},
+		"b.yaml": {Name: "othermodels", Path: "github.com/other/models"},
+		"c.yaml": {Name: "othermodels", Path: "github.com/other/models"},
+		"d.yaml": {Name: "externalRef1", Path: "github.com/acme/pets"},
+	}, mapping)
+
+	_, err = constructImportMapping(map[string]string{"a.yaml": "github.com/acme/models#acme-models"})
+	assert.EqualError(t, err, `invalid alias "acme-models" for package github.com/acme/models in import mapping`)
+
+	_, err = constructImportMapping(map[string]string{
+		"a.yaml": "github.com/acme/models#models",
+		"b.yaml": "github.com/other/models#models",
+	})
+	assert.EqualError(t, err, "alias models is given to packages github.com/acme/models and github.com/other/models in import mapping")
+}
+
+func TestUnrecognizedExternalReferences(t *testing.T) {
+	load := func() *openapi3.T {
+		loader := openapi3.NewLoader()
+		loader.IsExternalRefsAllowed = true
+		swagger, err := loader.LoadFromFile("../../internal/test/externalref/spec.yaml")
+		require.NoError(t, err)
+		return swagger
+	}
+	opts := Options{GenerateTypes: true, SkipPrune: true}
+
+	// All the unrecognized references are reported at once.
+	_, err := Generate(load(), "externalref", opts)
+	assert.EqualError(t, err, "unrecognized external references; please provide the known imports for these references using option --import-mapping:\n"+
+		"\t./packageA/spec.yaml#/components/schemas/ObjectA\n"+
+		"\t./packageB/spec.yaml#/components/schemas/ObjectB")
+
+	opts.ImportMapping = map[string]string{
+		"./packageA/spec.yaml": "github.com/deepmap/oapi-codegen/internal/test/externalref/packageA#packagea",
+		"./packageB/spec.yaml": "github.com/deepmap/oapi-codegen/internal/test/externalref/packageB",
+	}
+	code, err := Generate(load(), "externalref", opts)
+	require.NoError(t, err)
+	assert.Contains(t, code, `packagea "github.com/deepmap/oapi-codegen/internal/test/externalref/packageA"`)
+	assert.Contains(t, code, "ObjectA *packagea.ObjectA")
+	assert.Contains(t, code, "ObjectB *externalRef0.ObjectB")
+}
+
+func TestFileHeaderBuildTagsAndBanner(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateTypes:   true,
+		FileHeader:      "Copyright Acme Corp.\nAll rights reserved.\n",
+		BuildTags:       "linux && !race",
+		GeneratedBanner: "Code generated by acme-gen. DO NOT EDIT.",
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(code, `// Copyright Acme Corp.
+// All rights reserved.
+
+//go:build linux && !race
+
+// Package testswagger provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by acme-gen. DO NOT EDIT.
+package testswagger
+`), code[:300])
+
+	_, err = Generate(swagger, "testswagger", Options{GenerateTypes: true, BuildTags: "linux &&"})
+	assert.Error(t, err)
+
+	// Go tools wouldn't recognize the code as generated.
+	_, err = Generate(swagger, "testswagger", Options{GenerateTypes: true, GeneratedBanner: "Generated by acme-gen."})
+	assert.EqualError(t, err, `generated code banner "Generated by acme-gen." doesn't match "Code generated ... DO NOT EDIT."`)
+}
+
+func TestNameNormalizer(t *testing.T) {
+	defer func() { options = Options{} }()
+
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateTypes:  true,
+		GenerateClient: true,
+		NameNormalizer: func(name string) string { return "X" + ToCamelCase(name) },
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "type XCatDead struct {")
+	assert.Contains(t, code, "*XCatDeadCause `json:\"cause,omitempty\"")
+	assert.Contains(t, code, "XCatDeadCauseXCar XCatDeadCause = \"car\"")
+	assert.Contains(t, code, "XGetTestByName(ctx context.Context, xName string, params *XGetTestByNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)")
+}
+
+func TestSchemaTransformers(t *testing.T) {
+	defer func() { options = Options{} }()
+
+	spec := `
+openapi: 3.0.1
+info:
+  title: Schema Transformers Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        id:
+          type: string
+        name:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	// IDs are never pointers, and the first transformer is the outermost.
+	// Schemas may be generated concurrently, so calls is guarded by mu.
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	record := func(call string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call)
+	}
+	code, err := Generate(swagger, "api", Options{
+		GenerateTypes: true,
+		SkipPrune:     true,
+		SchemaTransformers: []SchemaTransformer{
+			func(next SchemaGenerator) SchemaGenerator {
+				return func(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+					record("outer " + strings.Join(path, "."))
+					return next(sref, path)
+				}
+			},
+			func(next SchemaGenerator) SchemaGenerator {
+				return func(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+					record("inner " + strings.Join(path, "."))
+					schema, err := next(sref, path)
+					if len(path) > 0 && path[len(path)-1] == "id" {
+						schema.SkipOptionalPointer = true
+					}
+					return schema, err
+				}
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Regexp(t, `Id +string +`+"`json:\"id,omitempty\"`", code)
+	assert.Regexp(t, `Name +\*string +`+"`json:\"name,omitempty\"`", code)
+	assert.Equal(t, []string{"outer Pet", "inner Pet", "outer Pet.id", "inner Pet.id", "outer Pet.name", "inner Pet.name"}, calls)
+}
+
+func TestParameterGoName(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Parameter Names Test
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: page[size]
+          in: query
+          schema:
+            type: integer
+          x-go-name: PageSize
+        - name: X-Tenant
+          in: header
+          required: true
+          schema:
+            type: string
+          x-go-name: Tenant
+      responses:
+        200:
+          description: The pets
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "testswagger", Options{GenerateTypes: true, GenerateClient: true, GenerateChiServer: true})
+	require.NoError(t, err)
+	assert.Regexp(t, `PageSize +\*int +`+"`"+`json:"page\[size\],omitempty"`, code)
+	assert.Regexp(t, `Tenant +string +`+"`"+`json:"X-Tenant"`, code)
+	assert.Contains(t, code, "*params.PageSize")
+	assert.Contains(t, code, "&params.PageSize")
+	assert.Contains(t, code, "params.Tenant = Tenant")
+	assert.NotContains(t, code, "PageSize_")
+	assert.NotContains(t, code, "XTenant")
+
+	for value, reason := range map[string]string{
+		`"page[size]"`: `"page[size]" is not a Go identifier`,
+		`42`:           "failed to unmarshal json",
+	} {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(strings.Replace(spec, "x-go-name: PageSize", "x-go-name: "+value, 1)))
+		require.NoError(t, err)
+
+		_, err = Generate(swagger, "testswagger", Options{GenerateTypes: true, GenerateClient: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid value for "x-go-name" in param (page[size]): `+reason)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// ConstructorDefinition describes the constructor of a model, such as
+// NewPet(name, opts...), which takes its required properties, and options,
+// such as WithPetTag(tag), setting its optional ones.
+type ConstructorDefinition struct {
+	TypeName                 string
+	Name                     string // The name of the constructor
+	Required                 []ConstructorFieldDefinition
+	Optional                 []ConstructorFieldDefinition
+	AdditionalPropertiesType string // The type of the additional properties, when the model has any
+}
+
+// ConstructorFieldDefinition describes a property set by a constructor.
+type ConstructorFieldDefinition struct {
+	PropertyField
+	Variable string // The name of the argument of the constructor, for required properties
+	Option   string // The name of the option, for optional properties
+}
+
+// PropertyField describes the struct field of a property, which constructors
+// and builders set.
+type PropertyField struct {
+	Name     string // The name of the struct field
+	JSONName string
+	Type     string // The type of the value set, which the field points to when Pointer is set
+	Pointer  bool
+}
+
+// describePropertyField describes the struct field of a property. Optional
+// properties, whose fields are pointers, are set from the values they point
+// to when deref is set.
+func describePropertyField(p Property, deref bool) PropertyField {
+	field := PropertyField{
+		Name:     p.structFieldName(),
+		JSONName: p.JsonFieldName,
+		Type:     p.GoTypeDef(),
+	}
+	if deref && strings.HasPrefix(field.Type, "*") {
+		field.Type = strings.TrimPrefix(field.Type, "*")
+		field.Pointer = true
+	}
+	return field
+}
+
+// generatedNames returns the owners of the names which the types and the
+// functions generated for the operations take, by name, so that other
+// functions don't clash with them.
+func generatedNames(types []TypeDefinition, ops []OperationDefinition) map[string]string {
+	taken := map[string]string{
+		"NewClient":              "the client",
+		"NewClientWithResponses": "the client",
+	}
+	for _, td := range types {
+		taken[td.TypeName] = "type " + td.TypeName
+	}
+	for _, op := range ops {
+		for _, suffix := range []string{"", "WithBody"} {
+			taken["New"+op.OperationId+"Request"+suffix] = "operation " + op.OperationId
+		}
+		for _, body := range op.Bodies {
+			taken["New"+op.OperationId+"Request"+body.Suffix()] = "operation " + op.OperationId
+		}
+	}
+	return taken
+}
+
+// claimName records that owner takes name among taken, or returns an error
+// when it's already taken.
+func claimName(taken map[string]string, name, owner string) error {
+	if other, found := taken[name]; found {
+		return fmt.Errorf("the name %s of %s is already that of %s", name, owner, other)
+	}
+	taken[name] = owner
+	return nil
+}
+
+// constructorLocals are the names of the variables of constructors, which
+// their arguments mustn't take.
+var constructorLocals = map[string]bool{"opts": true, "opt": true, "m": true}
+
+// DescribeConstructors describes the constructors of the object types among
+// the given ones which have at least the given number of optional properties.
+// Constructors are named NewPet, unless a type, or a function generated for
+// the operations, takes the name, in which case they're named NewPetValue.
+// Other clashes of names are an error.
+func DescribeConstructors(types []TypeDefinition, ops []OperationDefinition, minOptional int) ([]ConstructorDefinition, error) {
+	taken := generatedNames(types, ops)
+	claim := func(name, owner string) error {
+		return claimName(taken, name, owner)
+	}
+
+	var constructors []ConstructorDefinition
+	seen := make(map[string]bool)
+	for _, td := range types {
+		s := td.Schema
+		if seen[td.TypeName] || s.IsRef() || s.AliasOnly || s.ProtoMessage || len(s.Properties) == 0 || (options.AliasTypes && td.CanAlias()) {
+			continue
+		}
+		seen[td.TypeName] = true
+
+		c := ConstructorDefinition{TypeName: td.TypeName}
+		for _, p := range s.Properties {
+			field := ConstructorFieldDefinition{PropertyField: describePropertyField(p, !p.Required)}
+			if p.Required {
+				field.Variable = constructorVariableName(field.Name)
+				c.Required = append(c.Required, field)
+				continue
+			}
+			field.Option = "With" + td.TypeName + field.Name
+			c.Optional = append(c.Optional, field)
+		}
+		if len(c.Optional) < minOptional {
+			continue
+		}
+		if s.HasAdditionalProperties {
+			c.AdditionalPropertiesType = s.AdditionalPropertiesType.TypeDecl()
+		}
+
+		owner := "the constructor of " + td.TypeName
+		c.Name = "New" + td.TypeName
+		if _, found := taken[c.Name]; found {
+			c.Name += "Value"
+		}
+		if err := claim(c.Name, owner); err != nil {
+			return nil, err
+		}
+		if err := claim(td.TypeName+"Option", "the options of "+td.TypeName); err != nil {
+			return nil, err
+		}
+		for _, field := range c.Optional {
+			if err := claim(field.Option, "the option of the "+field.JSONName+" property of "+td.TypeName); err != nil {
+				return nil, err
+			}
+		}
+		if c.AdditionalPropertiesType != "" {
+			if err := claim("With"+td.TypeName+"AdditionalProperty", "the option of the additional properties of "+td.TypeName); err != nil {
+				return nil, err
+			}
+		}
+		constructors = append(constructors, c)
+	}
+	return constructors, nil
+}
+
+// constructorVariableName returns the name of the argument of a constructor
+// setting the given field.
+func constructorVariableName(field string) string {
+	name := LowercaseFirstCharacter(field)
+	if IsGoKeyword(name) || constructorLocals[name] {
+		name = "p" + UppercaseFirstCharacter(name)
+	}
+	if unicode.IsNumber([]rune(name)[0]) {
+		name = "n" + name
+	}
+	return name
+}
+
+// GenerateConstructors generates the constructors of the object types with at
+// least the number of optional properties the OptionConstructors option
+// gives.
+func GenerateConstructors(t *template.Template, types []TypeDefinition, ops []OperationDefinition) (string, error) {
+	constructors, err := DescribeConstructors(types, ops, options.OptionConstructors)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"constructors.tmpl"}, t, constructors)
+}
@@ -0,0 +1,361 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// ContractTestCase describes the request with which the generated contract
+// tests exercise an operation, which is built from the examples of the spec.
+type ContractTestCase struct {
+	OperationID string
+	Method      string
+	Path        string               // The path with its parameters and query, such as /pets/1?limit=10
+	Headers     []ContractTestHeader // Sorted by name, with the Content-Type of the body
+	Body        string
+	Skip        string // Why the operation isn't exercised, when its request can't be built from the examples
+}
+
+// ContractTestHeader is a header of the request of a contract test.
+type ContractTestHeader struct {
+	Name  string
+	Value string
+}
+
+// DescribeContractTestCases builds a request for every operation, whose
+// parameters and body are the examples of the spec, or values made up from
+// their schemas when there are none. The cases of the operations whose
+// request can't be built from their examples are skipped, with the reason.
+func DescribeContractTestCases(ops []OperationDefinition) []ContractTestCase {
+	cases := make([]ContractTestCase, 0, len(ops))
+	for _, op := range ops {
+		c, err := describeContractTestCase(op)
+		if err != nil {
+			c = ContractTestCase{
+				OperationID: op.OperationId,
+				Method:      op.Method,
+				Path:        op.Path,
+				Skip:        fmt.Sprintf("the request of operation %s can't be built from its examples: %s", op.OperationId, err),
+			}
+		}
+		cases = append(cases, c)
+	}
+	return cases
+}
+
+func describeContractTestCase(op OperationDefinition) (ContractTestCase, error) {
+	r, err := describeExampleRequest(op)
+	if err != nil {
+		return ContractTestCase{}, err
+	}
+	c := ContractTestCase{
+		OperationID: op.OperationId,
+		Method:      op.Method,
+		Path:        op.Path,
+		Headers:     r.headers,
+		Body:        r.body,
+	}
+	for name, value := range r.pathParams {
+		c.Path = strings.Replace(c.Path, "{"+name+"}", value, -1)
+	}
+	if r.query != "" {
+		c.Path += "?" + r.query
+	}
+	if r.cookie != "" {
+		c.Headers = append(c.Headers, ContractTestHeader{Name: "Cookie", Value: r.cookie})
+	}
+	if r.contentType != "" {
+		c.Headers = append(c.Headers, ContractTestHeader{Name: "Content-Type", Value: r.contentType})
+	}
+	sort.Slice(c.Headers, func(i, j int) bool {
+		return c.Headers[i].Name < c.Headers[j].Name
+	})
+	return c, nil
+}
+
+// exampleRequest is a request to an operation made of the examples of the
+// spec, in the parts which generated tests build requests from.
+type exampleRequest struct {
+	pathParams  map[string]string    // The serialized path parameters, by name
+	query       string               // The raw query
+	headers     []ContractTestHeader // The header parameters, by canonical names
+	cookie      string               // The Cookie header of the cookie parameters
+	contentType string
+	body        string
+}
+
+func describeExampleRequest(op OperationDefinition) (exampleRequest, error) {
+	r := exampleRequest{pathParams: make(map[string]string)}
+	for _, param := range op.PathParams {
+		value, err := contractParamValue(param, runtime.ParamLocationPath)
+		if err != nil {
+			return r, err
+		}
+		r.pathParams[param.ParamName] = value
+	}
+
+	var query []string
+	for _, param := range op.QueryParams {
+		value, err := contractParamValue(param, runtime.ParamLocationQuery)
+		if err != nil {
+			return r, err
+		}
+		if value != "" {
+			query = append(query, value)
+		}
+	}
+	r.query = strings.Join(query, "&")
+
+	for _, param := range op.HeaderParams {
+		value, err := contractParamValue(param, runtime.ParamLocationHeader)
+		if err != nil {
+			return r, err
+		}
+		r.headers = append(r.headers, ContractTestHeader{Name: http.CanonicalHeaderKey(param.ParamName), Value: value})
+	}
+	var cookies []string
+	for _, param := range op.CookieParams {
+		value, err := contractParamValue(param, runtime.ParamLocationCookie)
+		if err != nil {
+			return r, err
+		}
+		cookies = append(cookies, param.ParamName+"="+value)
+	}
+	r.cookie = strings.Join(cookies, "; ")
+
+	if op.Spec.RequestBody != nil && op.Spec.RequestBody.Value != nil {
+		contentType, body, err := contractBody(op.Spec.RequestBody.Value.Content)
+		if err != nil {
+			return r, err
+		}
+		r.contentType = contentType
+		r.body = body
+	}
+	return r, nil
+}
+
+// contractParamValue serializes the example of a parameter as the request
+// of a contract test carries it. Query parameters come with their names, as
+// in name=value, and the others without. Cookies are in the simple style,
+// as generated servers bind them.
+func contractParamValue(param ParameterDefinition, location runtime.ParamLocation) (string, error) {
+	value := parameterExample(param.Spec)
+	if value == nil {
+		return "", fmt.Errorf("parameter '%s' has no example", param.ParamName)
+	}
+
+	if param.IsEncoded() || param.IsPassThrough() {
+		var s string
+		if str, ok := value.(string); ok && (param.IsPassThrough() || param.IsXml()) {
+			s = str
+		} else if param.IsXml() {
+			return "", fmt.Errorf("the example of XML parameter '%s' isn't a string of XML", param.ParamName)
+		} else {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return "", fmt.Errorf("error marshaling the example of parameter '%s': %w", param.ParamName, err)
+			}
+			s = string(data)
+		}
+		switch location {
+		case runtime.ParamLocationQuery:
+			return url.QueryEscape(param.ParamName) + "=" + url.QueryEscape(s), nil
+		case runtime.ParamLocationPath:
+			return url.PathEscape(s), nil
+		default:
+			return s, nil
+		}
+	}
+
+	style := param.Style()
+	if location == runtime.ParamLocationCookie {
+		style = "simple"
+	}
+	styled, err := runtime.StyleParamWithLocation(style, param.Explode(), param.ParamName, location, value)
+	if err != nil {
+		return "", fmt.Errorf("error styling the example of parameter '%s': %w", param.ParamName, err)
+	}
+	return styled, nil
+}
+
+// parameterExample returns the example of a parameter, which is its own, one
+// of its named examples, or that of its schema or content.
+func parameterExample(p *openapi3.Parameter) interface{} {
+	if p.Example != nil {
+		return p.Example
+	}
+	if example := firstNamedExample(p.Examples); example != nil {
+		return example
+	}
+	if p.Schema != nil {
+		return ExampleValue(p.Schema)
+	}
+	for _, contentType := range SortedContentKeys(p.Content) {
+		if example := mediaTypeExample(p.Content[contentType]); example != nil {
+			return example
+		}
+	}
+	return nil
+}
+
+// firstNamedExample returns the value of the first of named examples, in the
+// order of their names.
+func firstNamedExample(examples openapi3.Examples) interface{} {
+	for _, name := range SortedExampleKeys(examples) {
+		if ex := examples[name]; ex != nil && ex.Value != nil && ex.Value.Value != nil {
+			return ex.Value.Value
+		}
+	}
+	return nil
+}
+
+func mediaTypeExample(media *openapi3.MediaType) interface{} {
+	if media == nil {
+		return nil
+	}
+	if media.Example != nil {
+		return media.Example
+	}
+	if example := firstNamedExample(media.Examples); example != nil {
+		return example
+	}
+	return ExampleValue(media.Schema)
+}
+
+// contractMultipartBoundary is the boundary of the multipart bodies of
+// contract tests, which is fixed so that the generated code is stable.
+const contractMultipartBoundary = "contract-test-boundary"
+
+// contractBody picks the content type in which a contract test sends a
+// request body, preferring JSON, then forms, then text, and encodes the
+// example of the body in it.
+func contractBody(content openapi3.Content) (string, string, error) {
+	contentTypes := SortedContentKeys(content)
+	if len(contentTypes) == 0 {
+		return "", "", nil
+	}
+	rank := func(ct string) int {
+		switch {
+		case isMediaTypeJSON(ct):
+			return 0
+		case ct == "application/x-www-form-urlencoded":
+			return 1
+		case ct == "multipart/form-data":
+			return 2
+		case strings.HasPrefix(ct, "text/"):
+			return 3
+		default:
+			return 4
+		}
+	}
+	contentType := contentTypes[0]
+	for _, ct := range contentTypes {
+		if rank(ct) < rank(contentType) {
+			contentType = ct
+		}
+	}
+
+	example := mediaTypeExample(content[contentType])
+	switch rank(contentType) {
+	case 0:
+		data, err := json.Marshal(example)
+		if err != nil {
+			return "", "", fmt.Errorf("error marshaling the example of the %s body: %w", contentType, err)
+		}
+		return contentType, string(data), nil
+	case 1:
+		object, _ := example.(map[string]interface{})
+		var fields []string
+		for _, name := range sortedObjectKeys(object) {
+			styled, err := runtime.StyleParamWithLocation("form", true, name, runtime.ParamLocationQuery, object[name])
+			if err != nil {
+				return "", "", fmt.Errorf("error encoding the example of the form body: %w", err)
+			}
+			fields = append(fields, styled)
+		}
+		return contentType, strings.Join(fields, "&"), nil
+	case 2:
+		object, _ := example.(map[string]interface{})
+		var buf bytes.Buffer
+		w := multipart.NewWriter(&buf)
+		if err := w.SetBoundary(contractMultipartBoundary); err != nil {
+			return "", "", err
+		}
+		for _, name := range sortedObjectKeys(object) {
+			values, ok := object[name].([]interface{})
+			if !ok {
+				values = []interface{}{object[name]}
+			}
+			for _, value := range values {
+				s, ok := value.(string)
+				if !ok {
+					data, err := json.Marshal(value)
+					if err != nil {
+						return "", "", fmt.Errorf("error encoding the example of the multipart body: %w", err)
+					}
+					s = string(data)
+				}
+				if err := w.WriteField(name, s); err != nil {
+					return "", "", err
+				}
+			}
+		}
+		if err := w.Close(); err != nil {
+			return "", "", err
+		}
+		return w.FormDataContentType(), buf.String(), nil
+	default:
+		if s, ok := example.(string); ok {
+			return contentType, s, nil
+		}
+		data, err := json.Marshal(example)
+		if err != nil {
+			return "", "", fmt.Errorf("error marshaling the example of the %s body: %w", contentType, err)
+		}
+		return contentType, string(data), nil
+	}
+}
+
+// sortedObjectKeys returns the names of the properties of an example object
+// which have values, in order.
+func sortedObjectKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key, value := range object {
+		if value != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// GenerateContractTests generates the contract tests of a server, which send
+// every operation a request built from the examples of the spec and check
+// the responses against it.
+func GenerateContractTests(t *template.Template, ops []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"contract-tests.tmpl"}, t, DescribeContractTestCases(ops))
+}
@@ -0,0 +1,339 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExampleValue(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Examples Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Node:
+      type: object
+      required: [name]
+      properties:
+        id:
+          type: string
+          format: uuid
+          readOnly: true
+        name:
+          type: string
+          minLength: 10
+        code:
+          type: string
+          maxLength: 3
+        weight:
+          type: number
+          exclusiveMinimum: true
+          minimum: 2
+        count:
+          type: integer
+          minimum: 5
+          multipleOf: 5
+        kind:
+          type: string
+          enum: [leaf, branch]
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Node'
+    Pet:
+      example:
+        name: Rex
+      allOf:
+        - $ref: '#/components/schemas/Node'
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+
+	node := ExampleValue(swagger.Components.Schemas["Node"]).(map[string]interface{})
+	assert.NotContains(t, node, "id")
+	assert.Equal(t, "examplexxx", node["name"])
+	assert.Equal(t, "exa", node["code"])
+	assert.Equal(t, 2.5, node["weight"])
+	assert.Equal(t, float64(5), node["count"])
+	assert.Equal(t, "leaf", node["kind"])
+
+	// Recursive schemas end with objects of their required properties.
+	depth := 0
+	for value := interface{}(node); value != nil; depth++ {
+		children, _ := value.(map[string]interface{})["children"].([]interface{})
+		value = nil
+		if len(children) > 0 {
+			value = children[0]
+		}
+	}
+	assert.Equal(t, maxExampleDepth/2+1, depth)
+
+	assert.Equal(t, map[string]interface{}{"name": "Rex"}, ExampleValue(swagger.Components.Schemas["Pet"]))
+}
+
+func TestDescribeContractTestCases(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Contract Test
+  version: 1.0.0
+paths:
+  /items/{ids}:
+    put:
+      operationId: PutItems
+      parameters:
+        - name: ids
+          in: path
+          required: true
+          style: label
+          explode: true
+          schema:
+            type: array
+            items:
+              type: integer
+          example: [1, 2]
+        - name: filter
+          in: query
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  tag:
+                    type: string
+        - name: x-trace
+          in: header
+          schema:
+            type: string
+          examples:
+            b:
+              value: second
+            a:
+              value: first
+        - name: session
+          in: cookie
+          required: true
+          schema:
+            type: string
+      requestBody:
+        content:
+          text/plain:
+            schema:
+              type: string
+          multipart/form-data:
+            schema:
+              type: object
+              properties:
+                name:
+                  type: string
+                tags:
+                  type: array
+                  items:
+                    type: string
+      responses:
+        '204':
+          description: Updated
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	ops, err := OperationDefinitions(swagger)
+	require.NoError(t, err)
+
+	cases := DescribeContractTestCases(ops)
+	require.Len(t, cases, 1)
+	c := cases[0]
+	assert.Equal(t, "PutItems", c.OperationID)
+	assert.Equal(t, "PUT", c.Method)
+	assert.Equal(t, `/items/.1.2?filter=%7B%22tag%22%3A%22example%22%7D`, c.Path)
+	assert.Equal(t, []ContractTestHeader{
+		{Name: "Content-Type", Value: "multipart/form-data; boundary=" + contractMultipartBoundary},
+		{Name: "Cookie", Value: "session=example"},
+		{Name: "X-Trace", Value: "first"},
+	}, c.Headers)
+	assert.Contains(t, c.Body, "name=\"name\"\r\n\r\nexample\r\n")
+	assert.Contains(t, c.Body, "name=\"tags\"\r\n\r\nexample\r\n")
+}
+
+func TestSkippedContractTestCases(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Contract Test
+  version: 1.0.0
+paths:
+  /things:
+    get:
+      operationId: ListThings
+      parameters:
+        - name: p2
+          in: query
+          required: true
+          schema:
+            properties:
+              inner:
+                type: object
+                additionalProperties:
+                  type: string
+            required: [inner]
+      responses:
+        '204':
+          description: Listed
+  /documents:
+    get:
+      operationId: ListDocuments
+      parameters:
+        - name: obj
+          in: query
+          content:
+            application/xml:
+              schema:
+                type: object
+                properties:
+                  name:
+                    type: string
+      responses:
+        '204':
+          description: Listed
+  /pets/{id}:
+    get:
+      operationId: GetPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+          example: 7
+      responses:
+        '204':
+          description: Found
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	defer detectContentTags(&openapi3.T{})
+
+	// The operations whose examples can't be serialized are skipped, and the
+	// others are still exercised.
+	code, err := Generate(swagger, "api", Options{GenerateTypes: true, GenerateChiServer: true, EmbedSpec: true, ContractTests: true})
+	require.NoError(t, err)
+	assert.Contains(t, code, `Path:        "/pets/7",`)
+	assert.Contains(t, code, `Skip:        "the request of operation ListThings can't be built from its examples: error styling the example of parameter 'p2': `)
+	assert.Contains(t, code, `Skip:        "the request of operation ListDocuments can't be built from its examples: the example of XML parameter 'obj' isn't a string of XML",`)
+	assert.Contains(t, code, "t.Skip(tc.Skip)")
+}
+
+func TestDescribeFuzzTargets(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Fuzz Test
+  version: 1.0.0
+paths:
+  /orgs/{org}/users/{user}:
+    get:
+      operationId: GetUser
+      parameters:
+        - name: user
+          in: path
+          required: true
+          schema:
+            type: string
+        - name: org
+          in: path
+          required: true
+          schema:
+            type: integer
+        - name: x-request-id
+          in: header
+          schema:
+            type: string
+            format: uuid
+      responses:
+        '200':
+          description: The user
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	ops, err := OperationDefinitions(swagger)
+	require.NoError(t, err)
+
+	targets, err := DescribeFuzzTargets(ops)
+	require.NoError(t, err)
+	require.Len(t, targets, 1)
+	target := targets[0]
+	assert.Equal(t, "/orgs/{org}/users/{user}", target.Path)
+	assert.Equal(t, []FuzzArgument{{Name: "org", Seed: "1"}, {Name: "user", Seed: "example"}}, target.PathParams)
+	assert.Equal(t, []FuzzArgument{{Name: "X-Request-Id", Seed: "3fa85f64-5717-4562-b3fc-2c963f66afa6"}}, target.Headers)
+	assert.False(t, target.HasCookie)
+	assert.Empty(t, target.ContentType)
+}
+
+func TestDescribeExampleTests(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Example Test
+  version: 1.0.0
+paths:
+  /users/{id}:
+    get:
+      operationId: GetUser
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+      responses:
+        '200':
+          description: The user
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/User'
+              examples:
+                bob:
+                  value: {name: Bob}
+                alice:
+                  value: {name: Alice}
+        '404':
+          description: No such user
+          content:
+            application/json:
+              schema:
+                type: object
+                properties:
+                  message:
+                    type: string
+              example: {message: not found}
+            text/plain:
+              schema:
+                type: string
+              example: not found
+components:
+  schemas:
+    User:
+      type: object
+      properties:
+        name:
+          type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	ops, err := OperationDefinitions(swagger)
+	require.NoError(t, err)
+
+	cases, err := DescribeExampleTests(ops)
+	require.NoError(t, err)
+	assert.Equal(t, []ExampleTestCase{
+		{Name: "GetUser/200/alice", GoType: "User", Example: `{"name":"Alice"}`},
+		{Name: "GetUser/200/bob", GoType: "User", Example: `{"name":"Bob"}`},
+		{Name: "GetUser/404/example", GoType: "struct {\n    Message *string`json:\"message,omitempty\"`\n}", Example: `{"message":"not found"}`},
+	}, cases)
+}
@@ -0,0 +1,276 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// APIChange is a change of the code generated for a spec which breaks the code
+// using it.
+type APIChange struct {
+	Name        string // The Go name of what changed, such as Pet.Name or ClientInterface.FindPets
+	Description string // What changed, such as "field Pet.Name was removed"
+}
+
+func (c APIChange) String() string {
+	return c.Description
+}
+
+// DiffAPIs compares the code which the options generate for two revisions of
+// a spec, and returns the changes of the new one which break code using the
+// old one: removed types, fields, constants and methods, changed types and
+// method signatures, and new required fields. This is meant for review gates
+// of spec changes, and both specs are filtered and pruned the way Generate
+// does it.
+func DiffAPIs(oldSwagger, newSwagger *openapi3.T, opts Options) ([]APIChange, error) {
+	oldSurface, err := describeAPI(oldSwagger, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error describing the old spec: %w", err)
+	}
+	newSurface, err := describeAPI(newSwagger, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error describing the new spec: %w", err)
+	}
+	return diffAPISurfaces(oldSurface, newSurface), nil
+}
+
+// apiSurface is the part of the generated code which other code depends on.
+type apiSurface struct {
+	types     map[string]string              // Declarations of types, which are "struct" for structs
+	fields    map[string]map[string]apiField // Fields of struct types
+	constants map[string]string              // Types of constants
+	methods   map[string]string              // Signatures of interface methods, such as ClientInterface.FindPets
+}
+
+type apiField struct {
+	goType   string
+	required bool
+}
+
+// describeAPI returns the surface of the code generated for a spec.
+func describeAPI(swagger *openapi3.T, opts Options) (*apiSurface, error) {
+	defer stopNameCache()
+	if err := alterSpec(swagger, opts); err != nil {
+		return nil, err
+	}
+	if err := prepareSpec(swagger, opts); err != nil {
+		return nil, err
+	}
+	if err := initGlobalState(swagger, opts); err != nil {
+		return nil, err
+	}
+
+	s := &apiSurface{
+		types:     map[string]string{},
+		fields:    map[string]map[string]apiField{},
+		constants: map[string]string{},
+		methods:   map[string]string{},
+	}
+	if opts.GenerateTypes && opts.ComponentsPackage == "" {
+		types, err := generateTypesForComponents(nil, swagger, opts.ExcludeSchemas)
+		if err != nil {
+			return nil, err
+		}
+		for _, td := range types {
+			s.addType(td.TypeName, td.Schema)
+		}
+	}
+
+	ops, err := OperationDefinitions(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating operation definitions: %w", err)
+	}
+	for _, op := range ops {
+		if err := s.addOperation(op, opts); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// addType adds a type declaration, along with its fields and enum constants.
+func (s *apiSurface) addType(name string, schema Schema) {
+	if _, found := s.types[name]; found {
+		return
+	}
+	for constName := range schema.EnumValues {
+		s.constants[constName] = name
+	}
+	if schema.IsRef() || (len(schema.Properties) == 0 && !schema.HasAdditionalProperties) {
+		s.types[name] = schema.TypeDecl()
+		return
+	}
+
+	s.types[name] = "struct"
+	fields := map[string]apiField{}
+	for _, p := range schema.Properties {
+		fields[p.GoFieldName()] = apiField{goType: p.GoTypeDef(), required: p.Required}
+	}
+	if schema.HasAdditionalProperties {
+		fields["AdditionalProperties"] = apiField{goType: "map[string]" + schema.AdditionalPropertiesType.TypeDecl()}
+	}
+	s.fields[name] = fields
+}
+
+// addOperation adds the types of an operation, and the methods which the
+// targets of the options generate for it.
+func (s *apiSurface) addOperation(op OperationDefinition, opts Options) error {
+	opid := op.OperationId
+	if opts.GenerateTypes {
+		for _, td := range op.TypeDefinitions {
+			s.addType(td.TypeName, td.Schema)
+		}
+		for _, body := range op.Bodies {
+			s.addType(opid+body.NameTag+"RequestBody", body.Schema)
+		}
+	}
+
+	// Only the types of the arguments matter, not their names.
+	var args []string
+	for _, param := range op.PathParams {
+		args = append(args, param.TypeDef())
+	}
+
+	if opts.GenerateClient {
+		clientArgs := args
+		if op.RequiresParamObject() {
+			clientArgs = append(clientArgs, "*"+opid+"Params")
+		}
+		methods := map[string]string{}
+		if op.HasBody() {
+			methods[opid+"WithBody"] = methodSignature(append(clientArgs, "string", "io.Reader"))
+		} else {
+			methods[opid] = methodSignature(clientArgs)
+		}
+		for _, body := range op.Bodies {
+			methods[opid+body.Suffix()] = methodSignature(append(clientArgs, opid+body.NameTag+"RequestBody"))
+		}
+		if op.HasMultipartBody() {
+			methods[opid+"WithMultipartBody"] = methodSignature(append(clientArgs, "*runtime.MultipartBody"))
+		}
+		if op.BinaryBodyContentType() != "" {
+			methods[opid+"WithBinaryBody"] = methodSignature(append(clientArgs, "string", "io.Reader", "int64"))
+		}
+		for name, signature := range methods {
+			s.methods["ClientInterface."+name] = signature
+			s.methods["ClientWithResponsesInterface."+name+"WithResponse"] = signature
+		}
+
+		responses, err := op.GetResponseTypeDefinitions()
+		if err != nil {
+			return err
+		}
+		responseType := genResponseTypeName(opid)
+		s.types[responseType] = "struct"
+		s.fields[responseType] = map[string]apiField{}
+		for _, td := range responses {
+			s.fields[responseType][td.TypeName] = apiField{goType: "*" + td.Schema.TypeDecl()}
+		}
+	}
+
+	if opts.GenerateEchoServer || opts.GenerateChiServer || opts.GenerateGinServer {
+		serverArgs := args
+		if op.RequiresParamObject() {
+			serverArgs = append(serverArgs, opid+"Params")
+		}
+		s.methods["ServerInterface."+opid] = methodSignature(serverArgs)
+	}
+	return nil
+}
+
+// methodSignature returns the signature of a method taking arguments of the
+// given types, after those which every generated method takes.
+func methodSignature(args []string) string {
+	return "(" + strings.Join(args, ", ") + ")"
+}
+
+// diffAPISurfaces returns the changes of the new surface which break code using
+// the old one, sorted by name.
+func diffAPISurfaces(oldSurface, newSurface *apiSurface) []APIChange {
+	var changes []APIChange
+	change := func(name, format string, args ...interface{}) {
+		changes = append(changes, APIChange{Name: name, Description: fmt.Sprintf(format, args...)})
+	}
+
+	for _, name := range SortedStringKeys(oldSurface.types) {
+		oldDecl := oldSurface.types[name]
+		newDecl, found := newSurface.types[name]
+		switch {
+		case !found:
+			change(name, "type %s was removed", name)
+		case newDecl != oldDecl:
+			change(name, "type %s changed from %s to %s", name, oldDecl, newDecl)
+		case oldDecl == "struct":
+			oldFields, newFields := oldSurface.fields[name], newSurface.fields[name]
+			for _, fieldName := range sortedFieldNames(oldFields) {
+				oldField := oldFields[fieldName]
+				newField, found := newFields[fieldName]
+				qualified := name + "." + fieldName
+				switch {
+				case !found:
+					change(qualified, "field %s was removed", qualified)
+				case newField.goType != oldField.goType:
+					change(qualified, "field %s changed from %s to %s", qualified, oldField.goType, newField.goType)
+				case newField.required && !oldField.required:
+					change(qualified, "field %s became required", qualified)
+				}
+			}
+			for _, fieldName := range sortedFieldNames(newFields) {
+				if _, found := oldFields[fieldName]; !found && newFields[fieldName].required {
+					qualified := name + "." + fieldName
+					change(qualified, "field %s was added as a required one", qualified)
+				}
+			}
+		}
+	}
+
+	for _, name := range SortedStringKeys(oldSurface.constants) {
+		newType, found := newSurface.constants[name]
+		switch {
+		case !found:
+			change(name, "constant %s was removed", name)
+		case newType != oldSurface.constants[name]:
+			change(name, "constant %s changed from type %s to %s", name, oldSurface.constants[name], newType)
+		}
+	}
+
+	for _, name := range SortedStringKeys(oldSurface.methods) {
+		newSignature, found := newSurface.methods[name]
+		switch {
+		case !found:
+			change(name, "method %s was removed", name)
+		case newSignature != oldSurface.methods[name]:
+			change(name, "method %s changed from %s to %s", name, oldSurface.methods[name], newSignature)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+func sortedFieldNames(fields map[string]apiField) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
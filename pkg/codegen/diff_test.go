@@ -0,0 +1,162 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const diffOldSpec = `
+openapi: 3.0.1
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: integer
+      responses:
+        200:
+          description: The pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+      responses:
+        200:
+          description: The pets
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        tag:
+          type: string
+        kind:
+          $ref: '#/components/schemas/Kind'
+    Kind:
+      type: string
+      enum: [cat, dog]
+`
+
+const diffNewSpec = `
+openapi: 3.0.1
+info:
+  title: Pets
+  version: 2.0.0
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        200:
+          description: The pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+  /owners:
+    get:
+      operationId: listOwners
+      responses:
+        200:
+          description: The owners
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name, owner]
+      properties:
+        name:
+          type: string
+        owner:
+          type: string
+        color:
+          type: string
+        kind:
+          $ref: '#/components/schemas/Kind'
+    Kind:
+      type: string
+      enum: [cat]
+`
+
+func TestDiffAPIs(t *testing.T) {
+	loader := openapi3.NewLoader()
+	oldSwagger, err := loader.LoadFromData([]byte(diffOldSpec))
+	require.NoError(t, err)
+	newSwagger, err := loader.LoadFromData([]byte(diffNewSpec))
+	require.NoError(t, err)
+
+	changes, err := DiffAPIs(oldSwagger, newSwagger, Options{
+		GenerateTypes:      true,
+		GenerateClient:     true,
+		GenerateEchoServer: true,
+	})
+	require.NoError(t, err)
+
+	var descriptions []string
+	for _, change := range changes {
+		descriptions = append(descriptions, change.String())
+	}
+	assert.Equal(t, []string{
+		"method ClientInterface.GetPet changed from (int) to (string)",
+		"method ClientInterface.ListPets was removed",
+		"method ClientWithResponsesInterface.GetPetWithResponse changed from (int) to (string)",
+		"method ClientWithResponsesInterface.ListPetsWithResponse was removed",
+		"constant KindDog was removed",
+		"type ListPetsParams was removed",
+		"type ListPetsResponse was removed",
+		"field Pet.Owner was added as a required one",
+		"field Pet.Tag was removed",
+		"method ServerInterface.GetPet changed from (int) to (string)",
+		"method ServerInterface.ListPets was removed",
+	}, descriptions)
+
+	// Nothing breaks code using the same spec, loaded twice as generating
+	// code from a spec alters it.
+	oldSwagger, err = openapi3.NewLoader().LoadFromData([]byte(diffOldSpec))
+	require.NoError(t, err)
+	sameSwagger, err := openapi3.NewLoader().LoadFromData([]byte(diffOldSpec))
+	require.NoError(t, err)
+	changes, err = DiffAPIs(oldSwagger, sameSwagger, Options{GenerateTypes: true, GenerateClient: true})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/template"
+)
+
+// ExampleTestCase describes the test of a JSON response example, which is
+// unmarshaled into the type of the response and marshaled back.
+type ExampleTestCase struct {
+	Name    string // The name of the subtest, such as GetPet/200/example
+	GoType  string // The type of the response
+	Example string // The JSON of the example
+}
+
+// DescribeExampleTests describes a test for each example of the JSON
+// responses of the operations, their own or named ones.
+func DescribeExampleTests(ops []OperationDefinition) ([]ExampleTestCase, error) {
+	var cases []ExampleTestCase
+	for _, op := range ops {
+		tds, err := op.GetResponseTypeDefinitions()
+		if err != nil {
+			return nil, err
+		}
+		for _, td := range tds {
+			if !isMediaTypeJSON(td.ContentTypeName) {
+				continue
+			}
+			media := op.Spec.Responses[td.ResponseName].Value.Content[td.ContentTypeName]
+			var names []string
+			var examples []interface{}
+			if media.Example != nil {
+				names, examples = append(names, "example"), append(examples, media.Example)
+			}
+			for _, name := range SortedExampleKeys(media.Examples) {
+				if ex := media.Examples[name]; ex != nil && ex.Value != nil && ex.Value.Value != nil {
+					names, examples = append(names, name), append(examples, ex.Value.Value)
+				}
+			}
+			for i, name := range names {
+				data, err := json.Marshal(examples[i])
+				if err != nil {
+					return nil, fmt.Errorf("error marshaling the example %s of operation %s: %w", name, op.OperationId, err)
+				}
+				cases = append(cases, ExampleTestCase{
+					Name:    op.OperationId + "/" + td.ResponseName + "/" + name,
+					GoType:  td.Schema.TypeDecl(),
+					Example: string(data),
+				})
+			}
+		}
+	}
+	return cases, nil
+}
+
+// GenerateExampleTests generates a test of the JSON response examples of the
+// operations, which fails when the generated types don't fit them.
+func GenerateExampleTests(t *template.Template, ops []OperationDefinition) (string, error) {
+	cases, err := DescribeExampleTests(ops)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"example-tests.tmpl"}, t, cases)
+}
@@ -0,0 +1,190 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// maxExampleDepth is the depth of nested objects past which examples only
+// have required properties and arrays are empty when they may be, so that
+// recursive schemas end.
+const maxExampleDepth = 4
+
+// ExampleValue returns a value of a schema, in the form encoding/json
+// decodes JSON into, for requests and values built from the spec. It is the
+// example of the schema, its default or its first enum value when it has
+// one, and otherwise a value made up to satisfy its constraints. Read-only
+// properties are left out of objects, as they are of requests.
+func ExampleValue(ref *openapi3.SchemaRef) interface{} {
+	return exampleValue(ref, 0, false)
+}
+
+// exampleValue returns an example of a schema, with read-only properties
+// when readOnly is set, as values of models have.
+func exampleValue(ref *openapi3.SchemaRef, depth int, readOnly bool) interface{} {
+	if ref == nil || ref.Value == nil {
+		return nil
+	}
+	s := ref.Value
+	switch {
+	case s.Example != nil:
+		return s.Example
+	case s.Default != nil:
+		return s.Default
+	case len(s.Enum) > 0:
+		return s.Enum[0]
+	case len(s.AllOf) > 0:
+		return exampleOfAllOf(s, depth, readOnly)
+	case len(s.OneOf) > 0:
+		return exampleValue(s.OneOf[0], depth, readOnly)
+	case len(s.AnyOf) > 0:
+		return exampleValue(s.AnyOf[0], depth, readOnly)
+	}
+
+	switch s.Type {
+	case "string":
+		return exampleString(s)
+	case "integer":
+		return math.Round(exampleNumber(s, 1))
+	case "number":
+		return exampleNumber(s, 1.5)
+	case "boolean":
+		return true
+	case "array":
+		n := int(s.MinItems)
+		if n == 0 && depth < maxExampleDepth {
+			n = 1
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			items[i] = exampleValue(s.Items, depth+1, readOnly)
+		}
+		return items
+	default:
+		if len(s.Properties) == 0 && s.Type != "object" {
+			return "example"
+		}
+		return exampleObject(s, depth, readOnly)
+	}
+}
+
+func exampleObject(s *openapi3.Schema, depth int, readOnly bool) map[string]interface{} {
+	object := make(map[string]interface{})
+	required := make(map[string]bool)
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	for _, name := range SortedSchemaKeys(s.Properties) {
+		prop := s.Properties[name]
+		if prop.Value == nil || prop.Value.ReadOnly && !readOnly {
+			continue
+		}
+		if depth >= maxExampleDepth && !required[name] {
+			continue
+		}
+		object[name] = exampleValue(prop, depth+1, readOnly)
+	}
+	return object
+}
+
+// exampleOfAllOf merges the examples of the schemas of an allOf, which are
+// objects, unless there's a single one.
+func exampleOfAllOf(s *openapi3.Schema, depth int, readOnly bool) interface{} {
+	if len(s.AllOf) == 1 && len(s.Properties) == 0 {
+		return exampleValue(s.AllOf[0], depth, readOnly)
+	}
+	object := exampleObject(s, depth, readOnly)
+	for _, part := range s.AllOf {
+		if partObject, ok := exampleValue(part, depth, readOnly).(map[string]interface{}); ok {
+			for name, value := range partObject {
+				object[name] = value
+			}
+		}
+	}
+	return object
+}
+
+// exampleStrings are the examples of strings of the formats which have to be
+// written in a certain way.
+var exampleStrings = map[string]string{
+	"date":      "2021-01-01",
+	"date-time": "2021-01-01T00:00:00Z",
+	"duration":  "PT1H",
+	"uuid":      "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+	"email":     "user@example.com",
+	"uri":       "https://example.com",
+	"url":       "https://example.com",
+	"hostname":  "example.com",
+	"ipv4":      "192.0.2.1",
+	"ipv6":      "2001:db8::1",
+	"byte":      "ZXhhbXBsZQ==",
+	"bigint":    "1",
+}
+
+func exampleString(s *openapi3.Schema) string {
+	if s.Format == "date" && hasDateLayout() {
+		return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).Format(options.DateLayout)
+	}
+	if example, found := exampleStrings[s.Format]; found {
+		return example
+	}
+	example := "example"
+	if n := int(s.MinLength); len(example) < n {
+		example += strings.Repeat("x", n-len(example))
+	}
+	if s.MaxLength != nil && uint64(len(example)) > *s.MaxLength {
+		example = example[:*s.MaxLength]
+	}
+	return example
+}
+
+// exampleNumber returns the given number, unless it's outside the bounds of
+// the schema, in which case a bound is returned, moved inside when it's
+// exclusive.
+func exampleNumber(s *openapi3.Schema, n float64) float64 {
+	if s.MultipleOf != nil && *s.MultipleOf > 0 {
+		n = *s.MultipleOf
+	}
+	if s.Min != nil && (n < *s.Min || n == *s.Min && s.ExclusiveMin) {
+		n = *s.Min
+		if s.ExclusiveMin {
+			n += exampleStep(s)
+		}
+	}
+	if s.Max != nil && (n > *s.Max || n == *s.Max && s.ExclusiveMax) {
+		n = *s.Max
+		if s.ExclusiveMax {
+			n -= exampleStep(s)
+		}
+	}
+	return n
+}
+
+// exampleStep is the distance from an exclusive bound at which examples of
+// numbers are.
+func exampleStep(s *openapi3.Schema) float64 {
+	switch {
+	case s.MultipleOf != nil && *s.MultipleOf > 0:
+		return *s.MultipleOf
+	case s.Type == "integer":
+		return 1
+	default:
+		return 0.5
+	}
+}
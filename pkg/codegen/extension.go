@@ -3,6 +3,11 @@ package codegen
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
 const (
@@ -10,6 +15,26 @@ const (
 	extGoFieldName   = "x-go-name"
 	extPropOmitEmpty = "x-omitempty"
 	extPropExtraTags = "x-oapi-codegen-extra-tags"
+
+	extPropGoProtoType = "x-go-proto-type"
+
+	extPropIdempotencyKey = "x-idempotency-key"
+
+	extPropEnumNaming = "x-enum-naming"
+
+	extPropInternal = "x-internal"
+
+	extPropSensitive = "x-sensitive"
+
+	extPropSignature = "x-signature"
+
+	// defaultIdempotencyKeyHeader is the header idempotency keys are sent in,
+	// unless the extension names another one.
+	defaultIdempotencyKeyHeader = "Idempotency-Key"
+
+	// defaultSignatureHeader is the header signatures are sent in, unless the
+	// x-signature extension names another one.
+	defaultSignatureHeader = "X-Signature"
 )
 
 func extString(extPropValue interface{}) (string, error) {
@@ -46,6 +71,34 @@ func extParseOmitEmpty(extPropValue interface{}) (bool, error) {
 	return omitEmpty, nil
 }
 
+func extParseInternal(extPropValue interface{}) (bool, error) {
+	raw, ok := extPropValue.(json.RawMessage)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+
+	var internal bool
+	if err := json.Unmarshal(raw, &internal); err != nil {
+		return false, fmt.Errorf("failed to unmarshal json: %w", err)
+	}
+
+	return internal, nil
+}
+
+func extParseSensitive(extPropValue interface{}) (bool, error) {
+	raw, ok := extPropValue.(json.RawMessage)
+	if !ok {
+		return false, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+
+	var sensitive bool
+	if err := json.Unmarshal(raw, &sensitive); err != nil {
+		return false, fmt.Errorf("failed to unmarshal json: %w", err)
+	}
+
+	return sensitive, nil
+}
+
 func extExtraTags(extPropValue interface{}) (map[string]string, error) {
 	raw, ok := extPropValue.(json.RawMessage)
 	if !ok {
@@ -57,3 +110,138 @@ func extExtraTags(extPropValue interface{}) (map[string]string, error) {
 	}
 	return tags, nil
 }
+
+// extParseGoProtoType parses the protobuf message type of a schema. It may be
+// qualified with the import path of its package, such as
+// github.com/acme/api/pb.Pet, in which case the package is imported.
+func extParseGoProtoType(extPropValue interface{}) (goImport, string, error) {
+	str, err := extString(extPropValue)
+	if err != nil {
+		return goImport{}, "", err
+	}
+	slash := strings.LastIndex(str, "/")
+	if slash == -1 {
+		return goImport{}, str, nil
+	}
+	dot := strings.Index(str[slash:], ".")
+	if dot == -1 {
+		return goImport{}, "", fmt.Errorf("missing type name in %q", str)
+	}
+	importPath := str[:slash+dot]
+	pkgName := importPathPackageName(importPath)
+	return goImport{Name: pkgName, Path: importPath}, pkgName + str[slash+dot:], nil
+}
+
+// importPathPackageName returns the name a package is conventionally imported
+// as, which is the last element of its import path which isn't a major
+// version suffix, so that github.com/acme/api/v2 is imported as api.
+func importPathPackageName(importPath string) string {
+	elems := strings.Split(importPath, "/")
+	for i := len(elems) - 1; i > 0; i-- {
+		if !isMajorVersion(elems[i]) {
+			return elems[i]
+		}
+	}
+	return elems[0]
+}
+
+// isMajorVersion reports whether elem is a major version suffix of an import
+// path, such as v2.
+func isMajorVersion(elem string) bool {
+	if len(elem) < 2 || elem[0] != 'v' {
+		return false
+	}
+	for _, r := range elem[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// extParseIdempotencyKey returns the header in which an operation's
+// idempotency key is sent. The extension is either a boolean, enabling the
+// Idempotency-Key header, or the name of another header.
+func extParseIdempotencyKey(extPropValue interface{}) (string, error) {
+	raw, ok := extPropValue.(json.RawMessage)
+	if !ok {
+		return "", fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+
+	var enabled bool
+	if err := json.Unmarshal(raw, &enabled); err == nil {
+		if enabled {
+			return defaultIdempotencyKeyHeader, nil
+		}
+		return "", nil
+	}
+
+	var header string
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return "", fmt.Errorf("failed to unmarshal json: %w", err)
+	}
+	return header, nil
+}
+
+// extParseSignature returns the scheme of the signatures of requests which
+// the x-signature extension of a spec describes, with its header, algorithm,
+// signed components, prefix, encoding and the size of the largest body servers
+// verify. Signatures are HMAC-SHA256s of
+// bodies, in hex, sent in the X-Signature header, unless it says otherwise.
+func extParseSignature(extPropValue interface{}) (runtime.SignatureScheme, error) {
+	raw, ok := extPropValue.(json.RawMessage)
+	if !ok {
+		return runtime.SignatureScheme{}, fmt.Errorf("failed to convert type: %T", extPropValue)
+	}
+
+	var signature struct {
+		Header     string   `json:"header"`
+		Algorithm  string   `json:"algorithm"`
+		Components []string `json:"components"`
+		Prefix     string   `json:"prefix"`
+		Encoding   string   `json:"encoding"`
+
+		MaxBodySize int64 `json:"max-body-size"`
+	}
+	if err := json.Unmarshal(raw, &signature); err != nil {
+		return runtime.SignatureScheme{}, fmt.Errorf("failed to unmarshal json: %w", err)
+	}
+	scheme := runtime.SignatureScheme(signature)
+	if scheme.Header == "" {
+		scheme.Header = defaultSignatureHeader
+	}
+	if scheme.Algorithm == "" {
+		scheme.Algorithm = runtime.SignatureHMACSHA256
+	}
+	if scheme.Encoding == "" {
+		scheme.Encoding = runtime.SignatureEncodingHex
+	}
+	if len(scheme.Components) == 0 {
+		scheme.Components = []string{runtime.SignatureComponentBody}
+	}
+	if err := scheme.Validate(); err != nil {
+		return runtime.SignatureScheme{}, err
+	}
+	return scheme, nil
+}
+
+// extensionValues returns the x- extensions of an element of the spec, by
+// name, with their JSON values decoded into maps, slices, strings, float64s,
+// bools and nils, so that templates can act on custom extensions. Values
+// which aren't valid JSON are kept as they are.
+func extensionValues(props openapi3.ExtensionProps) map[string]interface{} {
+	if len(props.Extensions) == 0 {
+		return nil
+	}
+	values := make(map[string]interface{}, len(props.Extensions))
+	for name, extension := range props.Extensions {
+		values[name] = extension
+		if raw, ok := extension.(json.RawMessage); ok {
+			var value interface{}
+			if err := json.Unmarshal(raw, &value); err == nil {
+				values[name] = value
+			}
+		}
+	}
+	return values
+}
@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
 func Test_extTypeName(t *testing.T) {
@@ -48,3 +51,165 @@ func Test_extTypeName(t *testing.T) {
 		})
 	}
 }
+
+func Test_extParseGoProtoType(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      interface{}
+		wantImport goImport
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:  "unqualified",
+			value: json.RawMessage(`"pb.Pet"`),
+			want:  "pb.Pet",
+		},
+		{
+			name:       "qualified",
+			value:      json.RawMessage(`"github.com/acme/api.v1/pb.Pet"`),
+			wantImport: goImport{Name: "pb", Path: "github.com/acme/api.v1/pb"},
+			want:       "pb.Pet",
+		},
+		{
+			name:       "major version",
+			value:      json.RawMessage(`"github.com/acme/pb/v2.Pet"`),
+			wantImport: goImport{Name: "pb", Path: "github.com/acme/pb/v2"},
+			want:       "pb.Pet",
+		},
+		{
+			name:    "missing type name",
+			value:   json.RawMessage(`"github.com/acme/api/pb"`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotImport, got, err := extParseGoProtoType(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantImport, gotImport)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_extParseIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "enabled",
+			value: json.RawMessage(`true`),
+			want:  "Idempotency-Key",
+		},
+		{
+			name:  "disabled",
+			value: json.RawMessage(`false`),
+			want:  "",
+		},
+		{
+			name:  "custom header",
+			value: json.RawMessage(`"X-Request-Token"`),
+			want:  "X-Request-Token",
+		},
+		{
+			name:    "invalid",
+			value:   json.RawMessage(`{}`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extParseIdempotencyKey(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_extParseSignature(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    runtime.SignatureScheme
+		wantErr bool
+	}{
+		{
+			name:  "defaults",
+			value: json.RawMessage(`{}`),
+			want: runtime.SignatureScheme{
+				Header:     "X-Signature",
+				Algorithm:  runtime.SignatureHMACSHA256,
+				Components: []string{runtime.SignatureComponentBody},
+				Encoding:   runtime.SignatureEncodingHex,
+			},
+		},
+		{
+			name:  "custom",
+			value: json.RawMessage(`{"header": "Signature", "algorithm": "hmac-sha1", "components": ["method", "header:Date"], "prefix": "v1,", "encoding": "base64", "max-body-size": 1048576}`),
+			want: runtime.SignatureScheme{
+				Header:     "Signature",
+				Algorithm:  runtime.SignatureHMACSHA1,
+				Components: []string{runtime.SignatureComponentMethod, "header:Date"},
+				Prefix:     "v1,",
+				Encoding:   runtime.SignatureEncodingBase64,
+
+				MaxBodySize: 1048576,
+			},
+		},
+		{
+			name:    "unsupported algorithm",
+			value:   json.RawMessage(`{"algorithm": "md5"}`),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported component",
+			value:   json.RawMessage(`{"components": ["url"]}`),
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			value:   json.RawMessage(`true`),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extParseSignature(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_extensionValues(t *testing.T) {
+	values := extensionValues(openapi3.ExtensionProps{Extensions: map[string]interface{}{
+		"x-table":  json.RawMessage(`"pets"`),
+		"x-limits": json.RawMessage(`{"rate": 10, "burst": [1, 2]}`),
+		"x-null":   json.RawMessage(`null`),
+		"x-other":  "kept",
+	}})
+	assert.Equal(t, map[string]interface{}{
+		"x-table":  "pets",
+		"x-limits": map[string]interface{}{"rate": 10.0, "burst": []interface{}{1.0, 2.0}},
+		"x-null":   nil,
+		"x-other":  "kept",
+	}, values)
+
+	assert.Nil(t, extensionValues(openapi3.ExtensionProps{}))
+}
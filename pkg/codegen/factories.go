@@ -0,0 +1,88 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// FactoryDefinition describes the factory of a model, which builds values
+// of its type from an example of its schema.
+type FactoryDefinition struct {
+	TypeName string
+	Example  string // The JSON of the example which values are built from
+}
+
+// DescribeFactories describes the factories of the object types among the
+// given ones. Their examples have all the properties of the schemas, read-only
+// ones included, which are required by default.
+func DescribeFactories(types []TypeDefinition) ([]FactoryDefinition, error) {
+	var factories []FactoryDefinition
+	seen := make(map[string]bool)
+	for _, td := range types {
+		s := td.Schema
+		if seen[td.TypeName] || s.OAPISchema == nil || s.IsRef() || s.ProtoMessage {
+			continue
+		}
+		if len(s.Properties) == 0 && !s.HasAdditionalProperties {
+			continue
+		}
+		seen[td.TypeName] = true
+
+		value := exampleValue(&openapi3.SchemaRef{Value: s.OAPISchema}, 0, true)
+		if object, ok := value.(map[string]interface{}); ok {
+			setFileExamples(s, object)
+		}
+		example, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling the example of %s: %w", td.TypeName, err)
+		}
+		factories = append(factories, FactoryDefinition{
+			TypeName: td.TypeName,
+			Example:  string(example),
+		})
+	}
+	return factories, nil
+}
+
+// setFileExamples replaces the examples of the properties of a schema which
+// are files, as those of multipart bodies are, with base64 content, as files
+// are in JSON.
+func setFileExamples(s Schema, object map[string]interface{}) {
+	for _, p := range s.Properties {
+		if _, found := object[p.JsonFieldName]; !found {
+			continue
+		}
+		switch p.Schema.GoType {
+		case "openapi_types.File":
+			object[p.JsonFieldName] = exampleStrings["byte"]
+		case "[]openapi_types.File":
+			object[p.JsonFieldName] = []interface{}{exampleStrings["byte"]}
+		}
+	}
+}
+
+// GenerateFactories generates the factories of the object types among the
+// given ones.
+func GenerateFactories(t *template.Template, types []TypeDefinition) (string, error) {
+	factories, err := DescribeFactories(types)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"factories.tmpl"}, t, factories)
+}
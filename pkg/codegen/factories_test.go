@@ -0,0 +1,82 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeFactories(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Factories Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Thing:
+      type: object
+      required: [name]
+      properties:
+        id:
+          type: integer
+          readOnly: true
+        name:
+          type: string
+    Name:
+      type: string
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	types, err := GenerateTypesForSchemas(nil, swagger.Components.Schemas, nil)
+	require.NoError(t, err)
+
+	factories, err := DescribeFactories(types)
+	require.NoError(t, err)
+	assert.Equal(t, []FactoryDefinition{{TypeName: "Thing", Example: `{"id":1,"name":"example"}`}}, factories)
+}
+
+func TestDescribeMultipartBodyFactories(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Factories Test
+  version: 1.0.0
+paths:
+  /uploads:
+    post:
+      operationId: upload
+      requestBody:
+        content:
+          multipart/form-data:
+            schema:
+              type: object
+              required: [name, file, files]
+              properties:
+                name:
+                  type: string
+                file:
+                  type: string
+                  format: binary
+                files:
+                  type: array
+                  items:
+                    type: string
+                    format: binary
+      responses:
+        '204':
+          description: Uploaded
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	_, types, err := GenerateMultipartBodyDefinition("Upload", swagger.Paths["/uploads"].Post.RequestBody)
+	require.NoError(t, err)
+
+	// Files are base64 in JSON.
+	factories, err := DescribeFactories(types)
+	require.NoError(t, err)
+	assert.Equal(t, []FactoryDefinition{{TypeName: "UploadMultipartBody", Example: `{"file":"ZXhhbXBsZQ==","files":["ZXhhbXBsZQ=="],"name":"example"}`}}, factories)
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/deepmap/oapi-codegen/pkg/util"
+)
+
+// GenerateFiles generates all the files the oapi-codegen command writes for
+// the spec at specPath, which is a file path, a URL fetched as
+// opts.LoadOptions says, or "-" for opts.LoadOptions.Stdin, so that build tools can embed the generator rather
+// than run the command. The files are keyed by their slash-separated paths
+// relative to the output directory:
+//
+//   - The code of the package is in <packageName>.gen.go.
+//   - When opts.TagPackages or opts.DocumentPackages is set, the code of every
+//     package is in <name>/<name>.gen.go instead.
+//   - The files of opts.Plugins are in the directory of the package named
+//     packageName.
+func GenerateFiles(specPath string, packageName string, opts Options) (map[string]string, error) {
+	files := map[string]string{}
+	err := WriteFiles(specPath, packageName, opts, func(name string, content string) error {
+		files[name] = content
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// WriteFiles generates the files of GenerateFiles, calling write with the path
+// and the content of each one as soon as it's generated, rather than returning
+// them together. When packages are generated for tags or documents, every
+// package is formatted and written before the next one is generated, so only
+// the code of one of them is held in memory at a time.
+func WriteFiles(specPath string, packageName string, opts Options, write func(name string, content string) error) error {
+	if opts.TagPackages != "" && opts.DocumentPackages != "" {
+		return errors.New("tag packages and document packages can't be generated together")
+	}
+	if opts.DocumentPackages != "" && specPath == "-" {
+		return errors.New("document packages can't be generated for a spec read from the standard input")
+	}
+	swagger, err := util.LoadSwaggerWithOptions(specPath, opts.LoadOptions)
+	if err != nil {
+		return fmt.Errorf("error loading spec %s: %w", specPath, err)
+	}
+	// The spec is altered once, for its packages and its plugins alike.
+	if err := alterSpec(swagger, opts); err != nil {
+		return err
+	}
+
+	dir := ""
+	if opts.TagPackages != "" || opts.DocumentPackages != "" {
+		writePackage := func(pkg Package) error {
+			if opts.DocumentPackages != "" && pkg.Name == packageName {
+				// The plugins see the spec as its package was generated.
+				opts.ImportMapping = pkg.ImportMapping
+			}
+			return write(path.Join(pkg.Name, GeneratedFileName(pkg.Name)), pkg.Code)
+		}
+		if opts.DocumentPackages != "" {
+			err = generateDocumentPackages(swagger, specPath, opts.DocumentPackages, packageName, opts, writePackage)
+		} else {
+			err = generateTagPackages(swagger, opts.TagPackages, packageName, opts, writePackage)
+		}
+		if err != nil {
+			return err
+		}
+		dir = packageName
+	} else {
+		if err := prepareSpec(swagger, opts); err != nil {
+			return err
+		}
+		code, err := generate(swagger, packageName, opts)
+		if err != nil {
+			return err
+		}
+		if err := write(GeneratedFileName(packageName), code); err != nil {
+			return err
+		}
+	}
+
+	if len(opts.Plugins) > 0 {
+		pluginFiles, err := generatePluginFiles(swagger, packageName, opts)
+		if err != nil {
+			return err
+		}
+		for _, file := range pluginFiles {
+			if err := write(path.Join(dir, file.Name), file.Content); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GeneratedFileName returns the name of the file of the code generated for a
+// package, such as api.gen.go.
+func GeneratedFileName(packageName string) string {
+	return packageName + ".gen.go"
+}
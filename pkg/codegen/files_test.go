@@ -0,0 +1,110 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateFiles(t *testing.T) {
+	const spec = "../../internal/test/externalref/spec.yaml"
+	opts := Options{
+		GenerateTypes: true,
+		SkipPrune:     true,
+		ImportMapping: map[string]string{
+			"./packageA/spec.yaml": "example.com/packagea",
+			"./packageB/spec.yaml": "example.com/packageb",
+		},
+	}
+
+	files, err := GenerateFiles(spec, "externalref", opts)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Contains(t, files["externalref.gen.go"], "package externalref")
+
+	// The files of plugins are next to the code of the package. Both are
+	// generated from the spec, which is altered once.
+	os.Setenv(testPluginEnv, "providers")
+	defer os.Unsetenv(testPluginEnv)
+	opts.Plugins = []string{os.Args[0] + " -test.run=TestPluginProcess"}
+	altered := 0
+	opts.SpecHook = func(*openapi3.T) error {
+		altered++
+		return nil
+	}
+	files, err = GenerateFiles(spec, "externalref", opts)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.Contains(t, files["providers.gen.go"], "package externalref")
+	assert.Equal(t, 1, altered)
+
+	// Every package is in its own directory, and so are the files of plugins.
+	opts.ImportMapping = nil
+	opts.DocumentPackages = "example.com/api"
+	altered = 0
+	files, err = GenerateFiles(spec, "externalref", opts)
+	require.NoError(t, err)
+	require.Len(t, files, 4)
+	assert.Equal(t, 3, altered)
+	assert.Contains(t, files["externalref/externalref.gen.go"], `packageaspec "example.com/api/packageaspec"`)
+	assert.Contains(t, files["packageaspec/packageaspec.gen.go"], "package packageaspec")
+	assert.Contains(t, files["packagebspec/packagebspec.gen.go"], "type ObjectB struct")
+	assert.Contains(t, files["externalref/providers.gen.go"], "package externalref")
+
+	opts.TagPackages = "example.com/api"
+	_, err = GenerateFiles(spec, "externalref", opts)
+	assert.EqualError(t, err, "tag packages and document packages can't be generated together")
+
+	_, err = GenerateFiles("missing.yaml", "api", Options{GenerateTypes: true})
+	assert.Error(t, err)
+}
+
+func TestWriteFiles(t *testing.T) {
+	var written []string
+	var stats []GenerationStats
+	opts := Options{
+		GenerateTypes:    true,
+		SkipPrune:        true,
+		DocumentPackages: "example.com/api",
+		ReportGeneration: func(s GenerationStats) {
+			stats = append(stats, s)
+		},
+	}
+	err := WriteFiles("../../internal/test/externalref/spec.yaml", "externalref", opts, func(name string, content string) error {
+		// Every package is written before the next one is generated.
+		assert.Len(t, stats, len(written)+1)
+		assert.Equal(t, len(content), stats[len(written)].Size)
+		written = append(written, name)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"externalref/externalref.gen.go", "packageaspec/packageaspec.gen.go", "packagebspec/packagebspec.gen.go"}, written)
+	require.Len(t, stats, 3)
+	for i, name := range []string{"externalref", "packageaspec", "packagebspec"} {
+		assert.Equal(t, name, stats[i].Package)
+		assert.NotZero(t, stats[i].PeakHeap)
+		assert.NotZero(t, stats[i].Duration)
+	}
+
+	err = WriteFiles("../../internal/test/externalref/spec.yaml", "externalref", opts, func(name string, content string) error {
+		return errors.New("disk full")
+	})
+	assert.EqualError(t, err, "disk full")
+}
@@ -1,6 +1,12 @@
 package codegen
 
-import "github.com/getkin/kin-openapi/openapi3"
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
 
 func filterOperationsByTag(swagger *openapi3.T, opts Options) {
 	if len(opts.ExcludeTags) > 0 {
@@ -44,3 +50,193 @@ func operationHasTag(op *openapi3.Operation, tags []string) bool {
 	}
 	return false
 }
+
+// filterOperationsByOperationID removes the operations whose ID matches none of
+// the glob patterns of IncludeOperationIDs, when there are any, or one of those
+// of ExcludeOperationIDs. Operations without an ID are matched by the name
+// generated for them.
+func filterOperationsByOperationID(swagger *openapi3.T, opts Options) error {
+	if len(opts.IncludeOperationIDs) == 0 && len(opts.ExcludeOperationIDs) == 0 {
+		return nil
+	}
+	for _, pattern := range append(opts.IncludeOperationIDs, opts.ExcludeOperationIDs...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid operation ID pattern %q: %w", pattern, err)
+		}
+	}
+
+	for requestPath, pathItem := range swagger.Paths {
+		for method, op := range pathItem.Operations() {
+			operationID := op.OperationID
+			if operationID == "" {
+				var err error
+				if operationID, err = generateDefaultOperationID(method, requestPath); err != nil {
+					return err
+				}
+			}
+			included := len(opts.IncludeOperationIDs) == 0 || operationIDMatches(operationID, opts.IncludeOperationIDs)
+			if !included || operationIDMatches(operationID, opts.ExcludeOperationIDs) {
+				pathItem.SetOperation(method, nil)
+			}
+		}
+	}
+	return nil
+}
+
+// operationIDMatches returns true if operationID, or the Go name generated
+// from it, matches any of the glob patterns.
+func operationIDMatches(operationID string, patterns []string) bool {
+	for _, pattern := range patterns {
+		for _, name := range []string{operationID, normalizeName(operationID)} {
+			if matched, _ := path.Match(pattern, name); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// schemaExcluded returns true if the component schema with the given name is
+// one of the excluded ones, given by their names or glob patterns.
+func schemaExcluded(name string, excludeSchemas []string) bool {
+	for _, pattern := range excludeSchemas {
+		if matched, _ := path.Match(pattern, name); matched || pattern == name {
+			return true
+		}
+	}
+	return false
+}
+
+// removeInternalElements removes the operations, parameters, properties and
+// component schemas marked x-internal: true from the spec, so no code is
+// generated for them. Internal schemas still used by what's left are an error.
+func removeInternalElements(swagger *openapi3.T) error {
+	for requestPath, pathItem := range swagger.Paths {
+		params, err := removeInternalParameters(pathItem.Parameters)
+		if err != nil {
+			return fmt.Errorf("path %s: %w", requestPath, err)
+		}
+		pathItem.Parameters = params
+		for method, op := range pathItem.Operations() {
+			internal, err := isInternal(op.Extensions)
+			if err != nil {
+				return fmt.Errorf("operation %s %s: %w", method, requestPath, err)
+			}
+			if internal {
+				pathItem.SetOperation(method, nil)
+				continue
+			}
+			if op.Parameters, err = removeInternalParameters(op.Parameters); err != nil {
+				return fmt.Errorf("operation %s %s: %w", method, requestPath, err)
+			}
+		}
+		if len(pathItem.Operations()) == 0 {
+			delete(swagger.Paths, requestPath)
+		}
+	}
+
+	for name, param := range swagger.Components.Parameters {
+		if param.Value == nil {
+			continue
+		}
+		internal, err := isInternal(param.Value.Extensions)
+		if err != nil {
+			return fmt.Errorf("parameter %s: %w", name, err)
+		}
+		if internal {
+			delete(swagger.Components.Parameters, name)
+		}
+	}
+
+	var internalSchemas []string
+	for name, schema := range swagger.Components.Schemas {
+		if schema.Value == nil {
+			continue
+		}
+		internal, err := isInternal(schema.Value.Extensions)
+		if err != nil {
+			return fmt.Errorf("schema %s: %w", name, err)
+		}
+		if internal {
+			delete(swagger.Components.Schemas, name)
+			internalSchemas = append(internalSchemas, name)
+		}
+	}
+
+	// Schemas are shared by the refs resolved to them, so each is only
+	// visited once, which also stops at recursive schemas.
+	seen := map[*openapi3.Schema]bool{}
+	var walkErr error
+	_ = walkSwagger(swagger, func(ref RefWrapper) (bool, error) {
+		schemaRef, ok := ref.SourceRef.(*openapi3.SchemaRef)
+		if !ok {
+			return true, nil
+		}
+		if schemaRef.Value == nil || seen[schemaRef.Value] {
+			return false, nil
+		}
+		seen[schemaRef.Value] = true
+		if err := removeInternalProperties(schemaRef.Value); err != nil && walkErr == nil {
+			walkErr = err
+		}
+		return true, nil
+	})
+	if walkErr != nil {
+		return walkErr
+	}
+
+	if len(internalSchemas) > 0 {
+		refs := findComponentRefs(swagger)
+		sort.Strings(internalSchemas)
+		for _, name := range internalSchemas {
+			if stringInSlice("#/components/schemas/"+name, refs) {
+				return fmt.Errorf("schema %s is marked %s, but is still used by elements which aren't", name, extPropInternal)
+			}
+		}
+	}
+	return nil
+}
+
+// removeInternalParameters returns the parameters which aren't marked
+// x-internal: true.
+func removeInternalParameters(params openapi3.Parameters) (openapi3.Parameters, error) {
+	var kept openapi3.Parameters
+	for _, param := range params {
+		if param.Value != nil {
+			internal, err := isInternal(param.Value.Extensions)
+			if err != nil {
+				return nil, fmt.Errorf("parameter %s: %w", param.Value.Name, err)
+			}
+			if internal {
+				continue
+			}
+		}
+		kept = append(kept, param)
+	}
+	return kept, nil
+}
+
+// removeInternalProperties removes the properties of the schema marked
+// x-internal: true, which are no longer required either.
+func removeInternalProperties(schema *openapi3.Schema) error {
+	for name, property := range schema.Properties {
+		if property.Value == nil {
+			continue
+		}
+		internal, err := isInternal(property.Value.Extensions)
+		if err != nil {
+			return fmt.Errorf("property %s: %w", name, err)
+		}
+		if !internal {
+			continue
+		}
+		delete(schema.Properties, name)
+		for i, required := range schema.Required {
+			if required == name {
+				schema.Required = append(schema.Required[:i:i], schema.Required[i+1:]...)
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -1,10 +1,12 @@
 package codegen
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFilterOperationsByTag(t *testing.T) {
@@ -51,3 +53,130 @@ func TestFilterOperationsByTag(t *testing.T) {
 		assert.NotContains(t, code, `"/cat"`)
 	})
 }
+
+func TestFilterOperationsByOperationID(t *testing.T) {
+	packageName := "testswagger"
+	generate := func(opts Options) (string, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+		assert.NoError(t, err)
+		opts.GenerateClient = true
+		opts.GenerateEchoServer = true
+		opts.GenerateTypes = true
+		return Generate(swagger, packageName, opts)
+	}
+
+	t.Run("include operation IDs", func(t *testing.T) {
+		code, err := generate(Options{IncludeOperationIDs: []string{"get*ByName"}})
+		assert.NoError(t, err)
+		assert.Contains(t, code, `"/test/:name"`)
+		assert.NotContains(t, code, `"/cat"`)
+	})
+
+	t.Run("exclude operation IDs", func(t *testing.T) {
+		code, err := generate(Options{ExcludeOperationIDs: []string{"GetCat*"}})
+		assert.NoError(t, err)
+		assert.Contains(t, code, `"/test/:name"`)
+		assert.NotContains(t, code, `"/cat"`)
+	})
+
+	t.Run("include and exclude operation IDs", func(t *testing.T) {
+		code, err := generate(Options{IncludeOperationIDs: []string{"get*"}, ExcludeOperationIDs: []string{"getCatStatus"}})
+		assert.NoError(t, err)
+		assert.Contains(t, code, `"/test/:name"`)
+		assert.NotContains(t, code, `"/cat"`)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := generate(Options{IncludeOperationIDs: []string{"get["}})
+		assert.EqualError(t, err, `invalid operation ID pattern "get[": syntax error in pattern`)
+	})
+}
+
+const internalTestSpec = `
+openapi: 3.0.1
+info:
+  title: Internal
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+        - name: X-Debug
+          in: header
+          x-internal: true
+          schema:
+            type: boolean
+      responses:
+        200:
+          description: the pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+  /debug:
+    get:
+      operationId: debugState
+      x-internal: true
+      responses:
+        200:
+          description: the state
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/State'
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name, ownerNotes]
+      properties:
+        name:
+          type: string
+        ownerNotes:
+          type: string
+          x-internal: true
+        audit:
+          $ref: '#/components/schemas/Audit'
+    Audit:
+      type: object
+      x-internal: true
+    State:
+      type: object
+`
+
+func TestSkipInternal(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(internalTestSpec))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "internal", Options{
+		GenerateTypes:     true,
+		GenerateClient:    true,
+		GenerateChiServer: true,
+		EmbedSpec:         true,
+		SkipInternal:      true,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "ListPets(")
+	assert.NotContains(t, code, "DebugState")
+	assert.NotContains(t, code, "XDebug")
+	assert.NotContains(t, code, "OwnerNotes")
+	assert.NotContains(t, code, "Audit")
+	assert.NotContains(t, code, "type State ")
+	assert.Equal(t, []string{"name"}, swagger.Components.Schemas["Pet"].Value.Required)
+	assert.NotContains(t, swagger.Paths, "/debug")
+
+	// Internal schemas can't be used by elements which aren't internal.
+	swagger, err = openapi3.NewLoader().LoadFromData([]byte(internalTestSpec))
+	require.NoError(t, err)
+	swagger.Paths["/debug"].Get.Extensions = map[string]interface{}{}
+	swagger.Components.Schemas["State"].Value.Extensions = map[string]interface{}{extPropInternal: json.RawMessage("true")}
+	_, err = Generate(swagger, "internal", Options{GenerateTypes: true, SkipInternal: true})
+	assert.EqualError(t, err, "error removing internal elements: schema State is marked x-internal, but is still used by elements which aren't")
+}
@@ -0,0 +1,81 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// FuzzTargetDefinition describes the fuzz target of an operation, which
+// mutates the parts of a request made of the examples of the spec.
+type FuzzTargetDefinition struct {
+	OperationID string
+	Method      string
+	Path        string         // The path of the operation, such as /pets/{id}
+	PathParams  []FuzzArgument // In the order of the operation
+	Query       string         // The seed of the raw query
+	Headers     []FuzzArgument // The header parameters, by canonical names
+	HasCookie   bool           // Whether the operation has cookie parameters, which the Cookie header carries
+	Cookie      string         // The seed of the Cookie header
+	ContentType string         // The content type of the body, if there's one
+	Body        string         // The seed of the body
+}
+
+// FuzzArgument is a part of a request which a fuzz target mutates, with the
+// value it starts from.
+type FuzzArgument struct {
+	Name string
+	Seed string
+}
+
+// DescribeFuzzTargets describes a fuzz target for every operation, seeded
+// with the request the contract tests send it.
+func DescribeFuzzTargets(ops []OperationDefinition) ([]FuzzTargetDefinition, error) {
+	targets := make([]FuzzTargetDefinition, 0, len(ops))
+	for _, op := range ops {
+		r, err := describeExampleRequest(op)
+		if err != nil {
+			return nil, fmt.Errorf("error describing the fuzz target of operation %s: %w", op.OperationId, err)
+		}
+		target := FuzzTargetDefinition{
+			OperationID: op.OperationId,
+			Method:      op.Method,
+			Path:        op.Path,
+			Query:       r.query,
+			HasCookie:   len(op.CookieParams) > 0,
+			Cookie:      r.cookie,
+			ContentType: r.contentType,
+			Body:        r.body,
+		}
+		for _, param := range op.PathParams {
+			target.PathParams = append(target.PathParams, FuzzArgument{Name: param.ParamName, Seed: r.pathParams[param.ParamName]})
+		}
+		for _, header := range r.headers {
+			target.Headers = append(target.Headers, FuzzArgument{Name: header.Name, Seed: header.Value})
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// GenerateFuzzTests generates a fuzz target for every operation, which sends
+// its handler mutated requests.
+func GenerateFuzzTests(t *template.Template, ops []OperationDefinition) (string, error) {
+	targets, err := DescribeFuzzTargets(ops)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"fuzz-tests.tmpl"}, t, targets)
+}
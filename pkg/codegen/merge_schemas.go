@@ -10,15 +10,21 @@ import (
 // MergeSchemas merges all the fields in the schemas supplied into one giant schema.
 // The idea is that we merge all fields together into one schema.
 func MergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+	return mergeSchemasWith(allOf, path, false)
+}
+
+// mergeSchemasWith merges the schemas like MergeSchemas, with strings of
+// format binary being files when binaryAsFile is set.
+func mergeSchemasWith(allOf []*openapi3.SchemaRef, path []string, binaryAsFile bool) (Schema, error) {
 	// If someone asked for the old way, for backward compatibility, return the
 	// old style result.
 	if options.OldMergeSchemas {
 		return mergeSchemas_V1(allOf, path)
 	}
-	return mergeSchemas(allOf, path)
+	return mergeSchemas(allOf, path, binaryAsFile)
 }
 
-func mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
+func mergeSchemas(allOf []*openapi3.SchemaRef, path []string, binaryAsFile bool) (Schema, error) {
 	var schema openapi3.Schema
 	for _, schemaRef := range allOf {
 		var err error
@@ -28,7 +34,7 @@ func mergeSchemas(allOf []*openapi3.SchemaRef, path []string) (Schema, error) {
 		}
 	}
 
-	return GenerateGoSchema(openapi3.NewSchemaRef("", &schema), path)
+	return generateSchema(openapi3.NewSchemaRef("", &schema), path, binaryAsFile)
 }
 
 func mergeAllOf(allOf []*openapi3.SchemaRef) (openapi3.Schema, error) {
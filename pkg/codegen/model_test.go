@@ -0,0 +1,197 @@
+package codegen
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTemplateDataModel pins the fields and methods of the data model of the
+// templates, which user templates and plugins rely on. New ones can be added,
+// but these can't be removed or changed within a major version.
+func TestTemplateDataModel(t *testing.T) {
+	model := []struct {
+		value   interface{}
+		fields  map[string]string
+		methods map[string]string
+	}{
+		{
+			value: OperationDefinition{},
+			fields: map[string]string{
+				"OperationId":         "string",
+				"SpecOperationId":     "string",
+				"PathParams":          "[]codegen.ParameterDefinition",
+				"HeaderParams":        "[]codegen.ParameterDefinition",
+				"QueryParams":         "[]codegen.ParameterDefinition",
+				"CookieParams":        "[]codegen.ParameterDefinition",
+				"TypeDefinitions":     "[]codegen.TypeDefinition",
+				"SecurityDefinitions": "[]codegen.SecurityDefinition",
+				"BodyRequired":        "bool",
+				"Bodies":              "[]codegen.RequestBodyDefinition",
+				"MultipartBody":       "*codegen.RequestBodyDefinition",
+				"Summary":             "string",
+				"Method":              "string",
+				"Path":                "string",
+				"Links":               "[]codegen.LinkDefinition",
+				"DownloadHeaders":     "[]codegen.ParameterDefinition",
+				"IdempotencyKey":      "string",
+				"Webhook":             "string",
+				"Callback":            "string",
+				"CallbackURL":         "string",
+				"Extensions":          "map[string]interface {}",
+				"Spec":                "*openapi3.Operation",
+			},
+			methods: map[string]string{
+				"AllParams":                  "func(*codegen.OperationDefinition) []codegen.ParameterDefinition",
+				"BinaryBodyContentType":      "func(*codegen.OperationDefinition) string",
+				"GetResponseTypeDefinitions": "func(*codegen.OperationDefinition) ([]codegen.ResponseTypeDefinition, error)",
+				"HasBinaryResponse":          "func(*codegen.OperationDefinition) bool",
+				"HasBody":                    "func(*codegen.OperationDefinition) bool",
+				"HasConditionalResponse":     "func(*codegen.OperationDefinition) bool",
+				"HasDeepObjectQueryParams":   "func(*codegen.OperationDefinition) bool",
+				"HasEventStreamResponse":     "func(*codegen.OperationDefinition) bool",
+				"HasMultipartBody":           "func(*codegen.OperationDefinition) bool",
+				"HasNegotiatedBody":          "func(*codegen.OperationDefinition) bool",
+				"HasRawQueryParams":          "func(*codegen.OperationDefinition) bool",
+				"IsListOperation":            "func(*codegen.OperationDefinition) bool",
+				"Params":                     "func(*codegen.OperationDefinition) []codegen.ParameterDefinition",
+				"RequiresParamObject":        "func(*codegen.OperationDefinition) bool",
+				"SummaryAsComment":           "func(*codegen.OperationDefinition) string",
+			},
+		},
+		{
+			value: TypeDefinition{},
+			fields: map[string]string{
+				"TypeName": "string",
+				"JsonName": "string",
+				"Schema":   "codegen.Schema",
+			},
+			methods: map[string]string{
+				"CanAlias": "func(*codegen.TypeDefinition) bool",
+			},
+		},
+		{
+			value: Schema{},
+			fields: map[string]string{
+				"GoType":                   "string",
+				"RefType":                  "string",
+				"ArrayType":                "*codegen.Schema",
+				"EnumValues":               "map[string]string",
+				"EnumAliases":              "map[string]string",
+				"Properties":               "[]codegen.Property",
+				"HasAdditionalProperties":  "bool",
+				"AdditionalPropertiesType": "*codegen.Schema",
+				"AdditionalTypes":          "[]codegen.TypeDefinition",
+				"SkipOptionalPointer":      "bool",
+				"ProtoMessage":             "bool",
+				"AliasOnly":                "bool",
+				"Description":              "string",
+				"Extensions":               "map[string]interface {}",
+				"OAPISchema":               "*openapi3.Schema",
+			},
+			methods: map[string]string{
+				"AddProperty":           "func(*codegen.Schema, codegen.Property) error",
+				"GetAdditionalTypeDefs": "func(*codegen.Schema) []codegen.TypeDefinition",
+				"IsRef":                 "func(*codegen.Schema) bool",
+				"TypeDecl":              "func(*codegen.Schema) string",
+			},
+		},
+		{
+			value: ParameterDefinition{},
+			fields: map[string]string{
+				"ParamName":  "string",
+				"In":         "string",
+				"Required":   "bool",
+				"Spec":       "*openapi3.Parameter",
+				"Schema":     "codegen.Schema",
+				"Extensions": "map[string]interface {}",
+			},
+			methods: map[string]string{
+				"AllowReserved":    "func(*codegen.ParameterDefinition) bool",
+				"Explode":          "func(*codegen.ParameterDefinition) bool",
+				"GoName":           "func(*codegen.ParameterDefinition) string",
+				"GoVariableName":   "func(*codegen.ParameterDefinition) string",
+				"IndirectOptional": "func(*codegen.ParameterDefinition) bool",
+				"IsDeepObject":     "func(*codegen.ParameterDefinition) bool",
+				"IsEncoded":        "func(*codegen.ParameterDefinition) bool",
+				"IsJson":           "func(*codegen.ParameterDefinition) bool",
+				"IsPassThrough":    "func(*codegen.ParameterDefinition) bool",
+				"IsStyled":         "func(*codegen.ParameterDefinition) bool",
+				"IsText":           "func(*codegen.ParameterDefinition) bool",
+				"IsXml":            "func(*codegen.ParameterDefinition) bool",
+				"JsonTag":          "func(*codegen.ParameterDefinition) string",
+				"Marshaler":        "func(*codegen.ParameterDefinition) string",
+				"Style":            "func(*codegen.ParameterDefinition) string",
+				"TypeDef":          "func(*codegen.ParameterDefinition) string",
+			},
+		},
+		{
+			value: RequestBodyDefinition{},
+			fields: map[string]string{
+				"Required":    "bool",
+				"Schema":      "codegen.Schema",
+				"NameTag":     "string",
+				"ContentType": "string",
+				"Default":     "bool",
+				"Encoding":    "map[string]*openapi3.Encoding",
+				"XMLName":     "string",
+			},
+			methods: map[string]string{
+				"CustomType":         "func(*codegen.RequestBodyDefinition) bool",
+				"FormEncodings":      "func(*codegen.RequestBodyDefinition) string",
+				"Marshaler":          "func(*codegen.RequestBodyDefinition) string",
+				"MultipartEncodings": "func(*codegen.RequestBodyDefinition) string",
+				"ProtoMessageType":   "func(*codegen.RequestBodyDefinition) string",
+				"Suffix":             "func(*codegen.RequestBodyDefinition) string",
+				"TypeDef":            "func(*codegen.RequestBodyDefinition, string) *codegen.TypeDefinition",
+			},
+		},
+		{
+			value: Property{},
+			fields: map[string]string{
+				"Description":    "string",
+				"JsonFieldName":  "string",
+				"Schema":         "codegen.Schema",
+				"Required":       "bool",
+				"Nullable":       "bool",
+				"ReadOnly":       "bool",
+				"WriteOnly":      "bool",
+				"ExtensionProps": "*openapi3.ExtensionProps",
+			},
+			methods: map[string]string{
+				"GoFieldName": "func(*codegen.Property) string",
+				"GoTypeDef":   "func(*codegen.Property) string",
+			},
+		},
+		{
+			value: ResponseTypeDefinition{},
+			fields: map[string]string{
+				"TypeDefinition":  "codegen.TypeDefinition",
+				"ContentTypeName": "string",
+				"ResponseName":    "string",
+				"Extensions":      "map[string]interface {}",
+			},
+			methods: map[string]string{
+				"CanAlias": "func(*codegen.ResponseTypeDefinition) bool",
+			},
+		},
+	}
+	for _, m := range model {
+		typ := reflect.TypeOf(m.value)
+		for name, fieldType := range m.fields {
+			field, found := typ.FieldByName(name)
+			if assert.True(t, found, "%s.%s was removed", typ.Name(), name) {
+				assert.Equal(t, fieldType, field.Type.String(), "%s.%s changed", typ.Name(), name)
+			}
+		}
+		// Pointers have the methods of values too.
+		ptr := reflect.PtrTo(typ)
+		for name, methodType := range m.methods {
+			method, found := ptr.MethodByName(name)
+			if assert.True(t, found, "%s.%s was removed", typ.Name(), name) {
+				assert.Equal(t, methodType, method.Type.String(), "%s.%s changed", typ.Name(), name)
+			}
+		}
+	}
+}
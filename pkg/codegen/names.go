@@ -0,0 +1,180 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// The strategies of the NameCollisions option, which resolve collisions of the
+// Go names of schemas, or of operations, once their names are normalized.
+const (
+	// NameCollisionsError fails generation, listing all the collisions.
+	NameCollisionsError = "error"
+	// NameCollisionsNumericSuffix numbers the names after the first one, as
+	// Pet and Pet2.
+	NameCollisionsNumericSuffix = "numeric-suffix"
+	// NameCollisionsTagPrefix prefixes the names of operations after the first
+	// one with their first tag, as GetPet and StoreGetPet, and numbers them
+	// when this isn't enough, as for schemas, which have no tags.
+	NameCollisionsTagPrefix = "tag-prefix"
+)
+
+// The schemes of the EnumNaming option, and of the x-enum-naming extension of
+// enum schemas, which name the constants of enums.
+const (
+	// EnumNamingTypePrefix prefixes the names of the values with the one of
+	// their type, as PetKindCat, which is the default.
+	EnumNamingTypePrefix = "type-prefix"
+	// EnumNamingShort names the constants after their values only, as Cat,
+	// which must then be unique in the package.
+	EnumNamingShort = "short"
+	// EnumNamingScreamingSnake adds SCREAMING_SNAKE_CASE aliases of the
+	// constants named with the type prefix, as PET_KIND_CAT.
+	EnumNamingScreamingSnake = "screaming-snake"
+)
+
+// enumNaming returns the scheme of the constants of an enum schema, which its
+// extension selects rather than the options.
+func enumNaming(schema *openapi3.Schema) (string, error) {
+	naming := options.EnumNaming
+	if extension, ok := schema.Extensions[extPropEnumNaming]; ok {
+		var err error
+		if naming, err = extString(extension); err != nil {
+			return "", err
+		}
+	}
+	return naming, checkEnumNaming(naming)
+}
+
+// checkEnumNaming checks a scheme of the constants of enums.
+func checkEnumNaming(naming string) error {
+	switch naming {
+	case "", EnumNamingTypePrefix, EnumNamingShort, EnumNamingScreamingSnake:
+		return nil
+	}
+	return fmt.Errorf("unknown enum naming scheme %q", naming)
+}
+
+// Rename is a Go name given to a schema or an operation instead of the one its
+// name normalizes to, as another one already has it.
+type Rename struct {
+	Kind      string // schema or operation
+	Name      string // The name in the spec
+	GoName    string // The Go name given to it
+	Collision string // The Go name it collided on
+}
+
+func (r Rename) String() string {
+	return fmt.Sprintf("%s %q is named %s, as %s is taken", r.Kind, r.Name, r.GoName, r.Collision)
+}
+
+// schemaTypeNames are the Go names given to the component schemas whose names
+// collide, and renames all the renames of the spec, which the ReportRename
+// option is called with.
+var (
+	schemaTypeNames map[string]string
+	renames         []Rename
+)
+
+// resolveSchemaNames gives Go names to the component schemas whose names
+// collide once normalized, as the NameCollisions option says.
+func resolveSchemaNames(swagger *openapi3.T) error {
+	schemaTypeNames = map[string]string{}
+	names := newNameResolver("schema")
+	for _, name := range SortedSchemaKeys(swagger.Components.Schemas) {
+		if schemaExcluded(name, options.ExcludeSchemas) {
+			continue
+		}
+		goName := SchemaNameToTypeName(name)
+		if unique := names.unique(name, goName, nil); unique != goName {
+			schemaTypeNames[name] = unique
+		}
+	}
+	return names.err()
+}
+
+// schemaTypeName returns the Go name of the type of a component schema.
+func schemaTypeName(name string) string {
+	if typeName, renamed := schemaTypeNames[name]; renamed {
+		return typeName
+	}
+	return SchemaNameToTypeName(name)
+}
+
+// nameResolver gives the schemas or the operations of a spec Go names which
+// don't collide.
+type nameResolver struct {
+	kind       string
+	names      map[string]string // The names in the spec of the Go names given so far
+	collisions []string
+}
+
+func newNameResolver(kind string) *nameResolver {
+	return &nameResolver{kind: kind, names: map[string]string{}}
+}
+
+// unique returns the Go name of what's named name in the spec, and has the
+// given tags, which is goName unless it's already given and a strategy of the
+// NameCollisions option resolves this.
+func (r *nameResolver) unique(name, goName string, tags []string) string {
+	other, taken := r.names[goName]
+	if !taken {
+		r.names[goName] = name
+		return goName
+	}
+
+	unique := goName
+	switch options.NameCollisions {
+	case "":
+		return goName
+	case NameCollisionsError:
+		r.collisions = append(r.collisions, fmt.Sprintf("%s %q and %q are both named %s", r.kind, other, name, goName))
+		return goName
+	case NameCollisionsTagPrefix:
+		if len(tags) > 0 {
+			unique = SchemaNameToTypeName(tags[0]) + goName
+		}
+	}
+	base := unique
+	for n := 2; ; n++ {
+		if _, taken := r.names[unique]; !taken {
+			break
+		}
+		unique = fmt.Sprintf("%s%d", base, n)
+	}
+	r.names[unique] = name
+	renames = append(renames, Rename{Kind: r.kind, Name: name, GoName: unique, Collision: goName})
+	return unique
+}
+
+// err returns the collisions found with the error strategy.
+func (r *nameResolver) err() error {
+	if len(r.collisions) == 0 {
+		return nil
+	}
+	return fmt.Errorf("name collisions:\n\t%s", strings.Join(r.collisions, "\n\t"))
+}
+
+// checkNameCollisions checks the NameCollisions option.
+func checkNameCollisions(strategy string) error {
+	switch strategy {
+	case "", NameCollisionsError, NameCollisionsNumericSuffix, NameCollisionsTagPrefix:
+		return nil
+	}
+	return fmt.Errorf("unknown name collision strategy %q", strategy)
+}
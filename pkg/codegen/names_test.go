@@ -0,0 +1,115 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nameCollisionsSpec = `
+openapi: 3.0.1
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets/{id}:
+    get:
+      operationId: getPet
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        200:
+          description: The pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/pet'
+  /store/pets/{id}:
+    get:
+      operationId: get_pet
+      tags: [store]
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        200:
+          description: The pet
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+    pet:
+      type: object
+      properties:
+        kind:
+          type: string
+          enum: [cat, dog]
+`
+
+func TestNameCollisions(t *testing.T) {
+	generate := func(strategy string) (string, []Rename, error) {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(nameCollisionsSpec))
+		require.NoError(t, err)
+		var renames []Rename
+		code, err := Generate(swagger, "pets", Options{
+			GenerateTypes:  true,
+			GenerateClient: true,
+			NameCollisions: strategy,
+			ReportRename:   func(r Rename) { renames = append(renames, r) },
+		})
+		return code, renames, err
+	}
+
+	_, _, err := generate(NameCollisionsError)
+	assert.EqualError(t, err, "name collisions:\n\tschema \"Pet\" and \"pet\" are both named Pet")
+
+	code, renames, err := generate(NameCollisionsNumericSuffix)
+	require.NoError(t, err)
+	assert.Contains(t, code, "type Pet2 struct {")
+	assert.Contains(t, code, "type Pet2Kind string")
+	assert.Contains(t, code, "JSON200      *Pet2\n")
+	assert.Contains(t, code, "GetPet2(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)")
+	assert.Equal(t, []Rename{
+		{Kind: "schema", Name: "pet", GoName: "Pet2", Collision: "Pet"},
+		{Kind: "operation", Name: "get_pet", GoName: "GetPet2", Collision: "GetPet"},
+	}, renames)
+	assert.Equal(t, `operation "get_pet" is named GetPet2, as GetPet is taken`, renames[1].String())
+
+	code, renames, err = generate(NameCollisionsTagPrefix)
+	require.NoError(t, err)
+	assert.Contains(t, code, "type Pet2 struct {")
+	assert.Contains(t, code, "StoreGetPet(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)")
+	assert.Len(t, renames, 2)
+
+	_, _, err = generate("random")
+	assert.EqualError(t, err, `unknown name collision strategy "random"`)
+}
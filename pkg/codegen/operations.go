@@ -17,6 +17,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"go/token"
 	"strings"
 	"text/template"
 	"unicode"
@@ -24,12 +25,16 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
+// ParameterDefinition describes a parameter of an operation, which the
+// templates bind in servers and send in clients as its location and style say.
 type ParameterDefinition struct {
 	ParamName string // The original json parameter name, eg param_name
 	In        string // Where the parameter is defined - path, header, cookie, query
 	Required  bool   // Is this a required parameter?
 	Spec      *openapi3.Parameter
 	Schema    Schema
+
+	Extensions map[string]interface{} // The x- extensions of the parameter, by name, with their decoded values
 }
 
 // This function is here as an adapter after a large refactoring so that I don't
@@ -54,26 +59,55 @@ func (pd *ParameterDefinition) JsonTag() string {
 func (pd *ParameterDefinition) IsJson() bool {
 	p := pd.Spec
 	if len(p.Content) == 1 {
-		_, found := p.Content["application/json"]
-		return found
+		return jsonContentType(p.Content) != ""
 	}
 	return false
 }
 
+// Returns whether the content of the parameter is XML, which is marshaled
+// with encoding/xml.
+func (pd *ParameterDefinition) IsXml() bool {
+	contentType, mt := paramContent(pd.Spec)
+	return mt != nil && mt.Schema != nil && isMediaTypeXML(contentType)
+}
+
+// Returns whether the content of the parameter is marshaled as a whole, with
+// the package Marshaler returns, which is JSON or XML.
+func (pd *ParameterDefinition) IsEncoded() bool {
+	return pd.IsJson() || pd.IsXml()
+}
+
+// Returns the name of the package used to marshal the content of the
+// parameter, such as json.
+func (pd *ParameterDefinition) Marshaler() string {
+	if pd.IsXml() {
+		return "xml"
+	}
+	return "json"
+}
+
+// Returns whether the content of the parameter is plain text of a primitive
+// schema, which is styled as the schema of a parameter is. Plain strings are
+// passed through as they are.
+func (pd *ParameterDefinition) IsText() bool {
+	contentType, mt := paramContent(pd.Spec)
+	return mt != nil && isParamContentText(contentType, mt) && pd.Schema.TypeDecl() != "string"
+}
+
 func (pd *ParameterDefinition) IsPassThrough() bool {
 	p := pd.Spec
 	if len(p.Content) > 1 {
 		return true
 	}
 	if len(p.Content) == 1 {
-		return !pd.IsJson()
+		return !pd.IsEncoded() && !pd.IsText()
 	}
 	return false
 }
 
 func (pd *ParameterDefinition) IsStyled() bool {
 	p := pd.Spec
-	return p.Schema != nil
+	return p.Schema != nil || pd.IsText()
 }
 
 func (pd *ParameterDefinition) Style() string {
@@ -92,6 +126,19 @@ func (pd *ParameterDefinition) Style() string {
 	return style
 }
 
+// Returns whether the parameter is a query parameter serialized as a
+// deepObject, such as filter[name][eq]=x.
+func (pd *ParameterDefinition) IsDeepObject() bool {
+	return pd.IsStyled() && pd.Spec.In == "query" && pd.Style() == "deepObject"
+}
+
+// Returns whether the parameter is a styled query parameter whose values are
+// sent without escaping reserved characters, such as '/', as its
+// allowReserved setting asks.
+func (pd *ParameterDefinition) AllowReserved() bool {
+	return pd.IsStyled() && pd.Spec.In == "query" && pd.Spec.AllowReserved && !pd.IsDeepObject()
+}
+
 func (pd *ParameterDefinition) Explode() bool {
 	if pd.Spec.Explode == nil {
 		in := pd.Spec.In
@@ -118,7 +165,17 @@ func (pd ParameterDefinition) GoVariableName() string {
 	return name
 }
 
+// GoName returns the name of the parameter's field in the parameters struct,
+// which the x-go-name extension of the parameter can give, for names such as
+// page[size]. DescribeParameters rejects invalid x-go-name values.
 func (pd ParameterDefinition) GoName() string {
+	if pd.Spec != nil {
+		if extension, ok := pd.Spec.Extensions[extGoFieldName]; ok {
+			if name, err := extParseGoFieldName(extension); err == nil {
+				return name
+			}
+		}
+	}
 	return SchemaNameToTypeName(pd.ParamName)
 }
 
@@ -151,12 +208,23 @@ func DescribeParameters(params openapi3.Parameters, path []string) ([]ParameterD
 				param.Name, err)
 		}
 
+		if extension, ok := param.Extensions[extGoFieldName]; ok {
+			name, err := extParseGoFieldName(extension)
+			if err == nil && !token.IsIdentifier(name) {
+				err = fmt.Errorf("%q is not a Go identifier", name)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %q in param (%s): %w", extGoFieldName, param.Name, err)
+			}
+		}
+
 		pd := ParameterDefinition{
-			ParamName: param.Name,
-			In:        param.In,
-			Required:  param.Required,
-			Spec:      param,
-			Schema:    goType,
+			ParamName:  param.Name,
+			In:         param.In,
+			Required:   param.Required,
+			Spec:       param,
+			Schema:     goType,
+			Extensions: extensionValues(param.ExtensionProps),
 		}
 
 		// If this is a reference to a predefined type, simply use the reference
@@ -193,22 +261,142 @@ func DescribeSecurityDefinition(securityRequirements openapi3.SecurityRequiremen
 	return outDefs
 }
 
-// This structure describes an Operation
+// SecuritySchemeDefinition describes a scheme from components/securitySchemes,
+// for which the client gets a ready-made provider, and servers a verifier of
+// OpenID Connect tokens.
+type SecuritySchemeDefinition struct {
+	Name         string   // The name of the scheme in the spec
+	Type         string   // apiKey, http, oauth2 or openIdConnect
+	In           string   // Where an API key is sent - header, query or cookie
+	ParamName    string   // The name of the header, query parameter or cookie of an API key
+	HTTPScheme   string   // The scheme of http authentication, lowercased, eg bearer
+	TokenURL     string   // The token URL of the OAuth2 client credentials flow
+	Scopes       []string // The scopes of the OAuth2 client credentials flow, sorted
+	DiscoveryURL string   // The openIdConnectUrl of an OpenID Connect scheme
+}
+
+// TypeName returns the name used for the Go identifiers generated for the scheme.
+func (s SecuritySchemeDefinition) TypeName() string {
+	return SchemaNameToTypeName(s.Name)
+}
+
+// IsClientCredentials returns whether the scheme is an OAuth2 client
+// credentials flow.
+func (s SecuritySchemeDefinition) IsClientCredentials() bool {
+	return s.Type == "oauth2" && s.TokenURL != ""
+}
+
+// IsAPIKey returns whether the scheme is an API key.
+func (s SecuritySchemeDefinition) IsAPIKey() bool {
+	return s.Type == "apiKey"
+}
+
+// IsBearerToken returns whether the scheme is http bearer authentication.
+func (s SecuritySchemeDefinition) IsBearerToken() bool {
+	return s.Type == "http" && s.HTTPScheme == "bearer"
+}
+
+// IsOpenIDConnect returns whether the scheme is OpenID Connect, whose bearer
+// tokens servers verify with the keys of the issuer it discovers.
+func (s SecuritySchemeDefinition) IsOpenIDConnect() bool {
+	return s.Type == "openIdConnect" && s.DiscoveryURL != ""
+}
+
+// IsBasicAuth returns whether the scheme is http basic authentication.
+func (s SecuritySchemeDefinition) IsBasicAuth() bool {
+	return s.Type == "http" && s.HTTPScheme == "basic"
+}
+
+// DescribeSecuritySchemes returns the security schemes of the spec for which
+// a provider, or a verifier of OpenID Connect tokens, can be generated, sorted
+// by name.
+func DescribeSecuritySchemes(swagger *openapi3.T) []SecuritySchemeDefinition {
+	var schemes []SecuritySchemeDefinition
+	for _, name := range SortedSecuritySchemeKeys(swagger.Components.SecuritySchemes) {
+		ref := swagger.Components.SecuritySchemes[name]
+		if ref == nil || ref.Value == nil {
+			continue
+		}
+		scheme := ref.Value
+		sd := SecuritySchemeDefinition{
+			Name: name,
+			Type: scheme.Type,
+		}
+		switch scheme.Type {
+		case "apiKey":
+			if scheme.In != "header" && scheme.In != "query" && scheme.In != "cookie" {
+				continue
+			}
+			sd.In = scheme.In
+			sd.ParamName = scheme.Name
+		case "http":
+			sd.HTTPScheme = strings.ToLower(scheme.Scheme)
+		case "oauth2":
+			if scheme.Flows == nil || scheme.Flows.ClientCredentials == nil {
+				continue
+			}
+			sd.TokenURL = scheme.Flows.ClientCredentials.TokenURL
+			sd.Scopes = SortedStringKeys(scheme.Flows.ClientCredentials.Scopes)
+		case "openIdConnect":
+			sd.DiscoveryURL = scheme.OpenIdConnectUrl
+		}
+		if sd.IsAPIKey() || sd.IsBearerToken() || sd.IsBasicAuth() || sd.IsClientCredentials() || sd.IsOpenIDConnect() {
+			schemes = append(schemes, sd)
+		}
+	}
+	return schemes
+}
+
+// This structure describes an Operation. The operation templates, such as those
+// of clients and servers, are executed with the operations of the spec.
 type OperationDefinition struct {
-	OperationId string // The operation_id description from Swagger, used to generate function names
-
-	PathParams          []ParameterDefinition // Parameters in the path, eg, /path/:param
-	HeaderParams        []ParameterDefinition // Parameters in HTTP headers
-	QueryParams         []ParameterDefinition // Parameters in the query, /path?param
-	CookieParams        []ParameterDefinition // Parameters in cookies
-	TypeDefinitions     []TypeDefinition      // These are all the types we need to define for this operation
-	SecurityDefinitions []SecurityDefinition  // These are the security providers
-	BodyRequired        bool
+	OperationId     string // The operation_id description from Swagger, used to generate function names
+	SpecOperationId string // The operationId of the spec, as written, or the default one generated when it has none
+
+	PathParams          []ParameterDefinition   // Parameters in the path, eg, /path/:param
+	HeaderParams        []ParameterDefinition   // Parameters in HTTP headers
+	QueryParams         []ParameterDefinition   // Parameters in the query, /path?param
+	CookieParams        []ParameterDefinition   // Parameters in cookies
+	TypeDefinitions     []TypeDefinition        // These are all the types we need to define for this operation
+	SecurityDefinitions []SecurityDefinition    // These are the security providers
+	BodyRequired        bool                    // Whether the request body is required
 	Bodies              []RequestBodyDefinition // The list of bodies for which to generate handlers.
+	MultipartBody       *RequestBodyDefinition  // The typed multipart/form-data body, if any
 	Summary             string                  // Summary string from Swagger, used to generate a comment
 	Method              string                  // GET, POST, DELETE, etc.
 	Path                string                  // The Swagger path for the operation, like /resource/{id}
-	Spec                *openapi3.Operation
+	Links               []LinkDefinition        // Links from the responses to other operations
+	DownloadHeaders     []ParameterDefinition   // Headers of the successful application/octet-stream responses
+	IdempotencyKey      string                  // The header a generated idempotency key is sent in, if any
+	Servers             []ServerDefinition      // The servers of the operation, or of its path, which override the spec's, named after the operation
+	Webhook             string                  // The name of the webhook, for webhook operations, which have no path
+	Callback            string                  // The name of the callback, for callback operations, which have no path
+	CallbackURL         string                  // The runtime expression of the URL of a callback
+	Extensions          map[string]interface{}  // The x- extensions of the operation, by name, with their decoded values
+	Spec                *openapi3.Operation     // The operation of the spec
+}
+
+// LinkDefinition describes a link from one of an operation's responses to
+// another operation, for which we generate a method on the response type
+// which calls the target operation.
+type LinkDefinition struct {
+	Name         string               // The name of the link in the spec
+	ResponseName string               // The response declaring the link, such as 200
+	Target       *OperationDefinition // The operation which the link refers to
+	PathParams   []LinkParameter      // Expressions for all the target's path parameters
+	ObjectParams []LinkParameter      // Expressions for the target's other parameters
+}
+
+// MethodName returns the name of the method which follows the link.
+func (l LinkDefinition) MethodName() string {
+	return "Follow" + SchemaNameToTypeName(l.Name)
+}
+
+// LinkParameter maps a link's runtime expression, such as $response.body#/id,
+// onto a parameter of the target operation.
+type LinkParameter struct {
+	ParameterDefinition
+	Expression string
 }
 
 // Returns the list of all parameters except Path parameters. Path parameters
@@ -234,6 +422,38 @@ func (o *OperationDefinition) RequiresParamObject() bool {
 	return len(o.Params()) > 0
 }
 
+// Returns whether any query parameter is serialized as a deepObject. The
+// client adds those to the query string as they are, keeping their brackets,
+// rather than encoding them with the other parameters.
+func (o *OperationDefinition) HasDeepObjectQueryParams() bool {
+	for _, param := range o.QueryParams {
+		if param.IsDeepObject() {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns whether the operation has query parameters which are added to the
+// query already escaped, rather than through url.Values, which are the
+// deepObject parameters and those allowing reserved characters.
+func (o *OperationDefinition) HasRawQueryParams() bool {
+	for _, param := range o.QueryParams {
+		if param.IsDeepObject() || param.AllowReserved() {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns whether the operation is list-style, which is a GET operation taking
+// its parameters in an object, without path parameters or a body. When asked
+// to, we generate a client helper calling those with many parameter sets
+// concurrently.
+func (o *OperationDefinition) IsListOperation() bool {
+	return o.Method == "GET" && len(o.PathParams) == 0 && o.RequiresParamObject() && !o.HasBody()
+}
+
 // This is called by the template engine to determine whether to generate body
 // marshaling code on the client. This is true for all body types, whether or
 // not we generate types for them.
@@ -241,6 +461,154 @@ func (o *OperationDefinition) HasBody() bool {
 	return o.Spec.RequestBody != nil
 }
 
+// Returns whether the request body of the operation has several typed content
+// types, in which case servers get a function decoding it by its Content-Type.
+func (o *OperationDefinition) HasNegotiatedBody() bool {
+	n := len(o.Bodies)
+	if o.MultipartBody != nil {
+		n++
+	}
+	return n > 1
+}
+
+// Returns the media types, or ranges, of the request body of the operation
+// which have no typed body, such as those declared without a schema, whose
+// bodies servers decode as raw bytes.
+func (o *OperationDefinition) RawBodyContentTypes() []string {
+	if o.Spec.RequestBody == nil || o.Spec.RequestBody.Value == nil {
+		return nil
+	}
+	typed := make(map[string]bool)
+	for _, body := range o.Bodies {
+		typed[body.ContentType] = true
+	}
+	if o.MultipartBody != nil {
+		typed["multipart/form-data"] = true
+	}
+	var contentTypes []string
+	for _, contentType := range SortedContentKeys(o.Spec.RequestBody.Value.Content) {
+		if !typed[contentType] {
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+	return contentTypes
+}
+
+// Returns whether the operation accepts a multipart/form-data body, in which
+// case we generate client methods which stream the multipart parts.
+func (o *OperationDefinition) HasMultipartBody() bool {
+	if o.Spec.RequestBody == nil || o.Spec.RequestBody.Value == nil {
+		return false
+	}
+	_, found := o.Spec.RequestBody.Value.Content["multipart/form-data"]
+	return found
+}
+
+// Returns the media type of the operation's binary request body, which is
+// either application/octet-stream or any other media type whose schema is a
+// binary string, such as image/png. Returns an empty string when the
+// operation takes no binary body.
+func (o *OperationDefinition) BinaryBodyContentType() string {
+	if o.Spec.RequestBody == nil || o.Spec.RequestBody.Value == nil {
+		return ""
+	}
+	content := o.Spec.RequestBody.Value.Content
+	if _, found := content["application/octet-stream"]; found {
+		return "application/octet-stream"
+	}
+	for _, contentType := range SortedContentKeys(content) {
+		schema := content[contentType].Schema
+		if strings.HasPrefix(contentType, "multipart/") || schema == nil || schema.Value == nil {
+			continue
+		}
+		if schema.Value.Type == "string" && schema.Value.Format == "binary" {
+			return contentType
+		}
+	}
+	return ""
+}
+
+// Returns whether any of the operation's responses is a text/event-stream, in
+// which case we generate a client method which reads server-sent events. As a
+// request body can't be resent when reconnecting, this is only done for
+// operations without one.
+func (o *OperationDefinition) HasEventStreamResponse() bool {
+	for _, response := range o.Spec.Responses {
+		if response.Value == nil {
+			continue
+		}
+		if _, found := response.Value.Content["text/event-stream"]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns whether the operation has a successful application/octet-stream
+// response, in which case we generate a client method which writes the body
+// to an io.Writer. Like event streams, this is only done for operations
+// without a request body.
+func (o *OperationDefinition) HasBinaryResponse() bool {
+	return len(binaryResponses(o.Spec)) > 0
+}
+
+// binaryResponses returns the successful responses of an operation which have
+// application/octet-stream content, sorted by status code.
+func binaryResponses(op *openapi3.Operation) []*openapi3.Response {
+	var responses []*openapi3.Response
+	for _, code := range SortedResponsesKeys(op.Responses) {
+		response := op.Responses[code].Value
+		if response == nil || !strings.HasPrefix(code, "2") {
+			continue
+		}
+		if _, found := response.Content["application/octet-stream"]; found {
+			responses = append(responses, response)
+		}
+	}
+	return responses
+}
+
+// DescribeDownloadHeaders returns the headers declared by the binary responses
+// of an operation, which are bound into the result of its download method.
+func DescribeDownloadHeaders(op *openapi3.Operation) ([]ParameterDefinition, error) {
+	var params openapi3.Parameters
+	seen := make(map[string]bool)
+	for _, response := range binaryResponses(op) {
+		for _, name := range SortedHeadersKeys(response.Headers) {
+			header := response.Headers[name]
+			if header.Value == nil || seen[strings.ToLower(name)] {
+				continue
+			}
+			seen[strings.ToLower(name)] = true
+			param := header.Value.Parameter
+			param.Name = name
+			param.In = openapi3.ParameterInHeader
+			params = append(params, &openapi3.ParameterRef{Value: &param})
+		}
+	}
+	return DescribeParameters(params, []string{op.OperationID, "DownloadHeader"})
+}
+
+// Returns whether the operation supports conditional requests, which is the
+// case when it declares a 304 response, or responses with an ETag or
+// Last-Modified header. We then generate typed access to those headers.
+func (o *OperationDefinition) HasConditionalResponse() bool {
+	for code, response := range o.Spec.Responses {
+		if code == "304" {
+			return true
+		}
+		if response.Value == nil {
+			continue
+		}
+		for name := range response.Value.Headers {
+			if strings.EqualFold(name, "ETag") || strings.EqualFold(name, "Last-Modified") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // This returns the Operations summary as a multi line comment
 func (o *OperationDefinition) SummaryAsComment() string {
 	if o.Summary == "" {
@@ -269,8 +637,58 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 		// We can only generate a type if we have a value:
 		if responseRef.Value != nil {
 			sortedContentKeys := SortedContentKeys(responseRef.Value.Content)
+			jsonContentTypeName := jsonContentType(responseRef.Value.Content)
 			for _, contentTypeName := range sortedContentKeys {
 				contentType := responseRef.Value.Content[contentTypeName]
+				// The bodies of media ranges are kept as is, as text for
+				// text/*, whatever their schema:
+				if prefix := mediaRangePrefix(contentTypeName); prefix != "" {
+					goType := "[]byte"
+					if contentTypeName == "text/*" {
+						goType = "string"
+					}
+					tds = append(tds, ResponseTypeDefinition{
+						TypeDefinition: TypeDefinition{
+							TypeName: prefix + ToCamelCase(responseName),
+							Schema:   Schema{GoType: goType},
+						},
+						ResponseName:    responseName,
+						ContentTypeName: contentTypeName,
+						Extensions:      extensionValues(responseRef.Value.ExtensionProps),
+					})
+					continue
+				}
+				// Binary bodies are kept as bytes, whatever their schema:
+				if contentTypeName == "application/octet-stream" {
+					tds = append(tds, ResponseTypeDefinition{
+						TypeDefinition: TypeDefinition{
+							TypeName: "OctetStream" + ToCamelCase(responseName),
+							Schema:   Schema{GoType: "[]byte"},
+						},
+						ResponseName:    responseName,
+						ContentTypeName: contentTypeName,
+						Extensions:      extensionValues(responseRef.Value.ExtensionProps),
+					})
+					continue
+				}
+				// Text is kept as a string, unless it's CSV whose rows are
+				// objects:
+				if (contentTypeName == "text/plain" || contentTypeName == "text/csv") && !isCSVRows(contentTypeName, contentType.Schema) {
+					tag := "Text"
+					if contentTypeName == "text/csv" {
+						tag = "CSV"
+					}
+					tds = append(tds, ResponseTypeDefinition{
+						TypeDefinition: TypeDefinition{
+							TypeName: tag + ToCamelCase(responseName),
+							Schema:   Schema{GoType: "string"},
+						},
+						ResponseName:    responseName,
+						ContentTypeName: contentTypeName,
+						Extensions:      extensionValues(responseRef.Value.ExtensionProps),
+					})
+					continue
+				}
 				// We can only generate a type if we have a schema:
 				if contentType.Schema != nil {
 					responseSchema, err := GenerateGoSchema(contentType.Schema, []string{responseName})
@@ -280,14 +698,36 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 
 					var typeName string
 					switch {
-					case StringInArray(contentTypeName, contentTypesJSON):
+					case isMediaTypeJSON(contentTypeName):
+						// Only one JSON media type gets a type, as they'd
+						// share its name.
+						if contentTypeName != jsonContentTypeName {
+							continue
+						}
 						typeName = fmt.Sprintf("JSON%s", ToCamelCase(responseName))
 					// YAML:
-					case StringInArray(contentTypeName, contentTypesYAML):
+					case isMediaTypeYAML(contentTypeName):
 						typeName = fmt.Sprintf("YAML%s", ToCamelCase(responseName))
 					// XML:
-					case StringInArray(contentTypeName, contentTypesXML):
+					case isMediaTypeXML(contentTypeName):
 						typeName = fmt.Sprintf("XML%s", ToCamelCase(responseName))
+					// MessagePack:
+					case isMediaTypeMsgpack(contentTypeName):
+						typeName = fmt.Sprintf("Msgpack%s", ToCamelCase(responseName))
+					// CSV rows:
+					case isCSVRows(contentTypeName, contentType.Schema):
+						typeName = fmt.Sprintf("CSV%s", ToCamelCase(responseName))
+					// Protobuf, which needs the message type to be known:
+					case isMediaTypeProtobuf(contentTypeName):
+						protoType, err := protoMessageType(contentType)
+						if err != nil {
+							return nil, fmt.Errorf("error generating response type for %s.%s: %w", o.OperationId, contentTypeName, err)
+						}
+						if protoType == "" {
+							continue
+						}
+						typeName = fmt.Sprintf("Protobuf%s", ToCamelCase(responseName))
+						responseSchema = Schema{GoType: protoType, ProtoMessage: true}
 					default:
 						continue
 					}
@@ -299,8 +739,9 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 						},
 						ResponseName:    responseName,
 						ContentTypeName: contentTypeName,
+						Extensions:      extensionValues(responseRef.Value.ExtensionProps),
 					}
-					if IsGoTypeReference(contentType.Schema.Ref) {
+					if IsGoTypeReference(contentType.Schema.Ref) && !responseSchema.ProtoMessage {
 						refType, err := RefPathToGoType(contentType.Schema.Ref)
 						if err != nil {
 							return nil, fmt.Errorf("error dereferencing response Ref: %w", err)
@@ -315,7 +756,8 @@ func (o *OperationDefinition) GetResponseTypeDefinitions() ([]ResponseTypeDefini
 	return tds, nil
 }
 
-// This describes a request body
+// This describes a request body, for one of its media types, which clients send
+// and servers decode with the marshaler of the media type.
 type RequestBodyDefinition struct {
 	// Is this body required, or optional?
 	Required bool
@@ -333,6 +775,98 @@ type RequestBodyDefinition struct {
 	// Whether this is the default body type. For an operation named OpFoo, we
 	// will not add suffixes like OpFooJSONBody for this one.
 	Default bool
+
+	// The encoding objects of the properties of form and multipart bodies
+	Encoding map[string]*openapi3.Encoding
+
+	// The name of the root element of XML bodies
+	XMLName string
+}
+
+// Returns the Go expression of the encodings of the properties of a form
+// body, which is a map of runtime.FormEncoding, or nil when there are none.
+func (r RequestBodyDefinition) FormEncodings() string {
+	if len(r.Encoding) == 0 {
+		return "nil"
+	}
+	var parts []string
+	for _, name := range SortedEncodingKeys(r.Encoding) {
+		e := r.Encoding[name]
+		style := e.Style
+		if style == "" {
+			style = "form"
+		}
+		explode := style == "form"
+		if e.Explode != nil {
+			explode = *e.Explode
+		}
+		parts = append(parts, fmt.Sprintf("%q: {ContentType: %q, Style: %q, Explode: %t}", name, e.ContentType, style, explode))
+	}
+	return "map[string]runtime.FormEncoding{" + strings.Join(parts, ", ") + "}"
+}
+
+// Returns the Go expression of the encodings of the properties of a multipart
+// body, which is a map of runtime.MultipartEncoding. The headers of a part are
+// those of its encoding whose schema has a default, which they're sent with,
+// and the others are set with runtime.MultipartBody.SetPartHeader.
+func (r RequestBodyDefinition) MultipartEncodings() string {
+	var parts []string
+	for _, name := range SortedEncodingKeys(r.Encoding) {
+		e := r.Encoding[name]
+		var fields []string
+		if e.ContentType != "" {
+			fields = append(fields, fmt.Sprintf("ContentType: %q", e.ContentType))
+		}
+		var headers []string
+		for _, header := range SortedHeadersKeys(e.Headers) {
+			ref := e.Headers[header]
+			// The Content-Type of a part is given by its content type.
+			if strings.EqualFold(header, "Content-Type") || ref.Value == nil || ref.Value.Schema == nil ||
+				ref.Value.Schema.Value == nil || ref.Value.Schema.Value.Default == nil {
+				continue
+			}
+			headers = append(headers, fmt.Sprintf("%q: %q", header, fmt.Sprint(ref.Value.Schema.Value.Default)))
+		}
+		if len(headers) != 0 {
+			fields = append(fields, "Headers: map[string]string{"+strings.Join(headers, ", ")+"}")
+		}
+		if e.Style != "" {
+			explode := e.Style == "form"
+			if e.Explode != nil {
+				explode = *e.Explode
+			}
+			fields = append(fields, fmt.Sprintf("Style: %q, Explode: %t", e.Style, explode))
+		}
+		parts = append(parts, fmt.Sprintf("%q: {%s}", name, strings.Join(fields, ", ")))
+	}
+	return "map[string]runtime.MultipartEncoding{" + strings.Join(parts, ", ") + "}"
+}
+
+// Returns the name of the package used to marshal the body, such as json.
+func (r RequestBodyDefinition) Marshaler() string {
+	switch {
+	case isMediaTypeXML(r.ContentType):
+		return "xml"
+	case isMediaTypeYAML(r.ContentType):
+		return "yaml"
+	case isMediaTypeMsgpack(r.ContentType):
+		return "msgpack"
+	case isMediaTypeProtobuf(r.ContentType):
+		return "proto"
+	case r.ContentType == "text/csv" && r.Schema.GoType != "string":
+		return "csv"
+	case r.ContentType == "text/plain" || r.ContentType == "text/csv":
+		return "text"
+	case r.ContentType == "application/octet-stream":
+		return "bytes"
+	default:
+		return "json"
+	}
+}
+
+// Returns the message type of a protobuf body, which the body points to.
+func (r RequestBodyDefinition) ProtoMessageType() string {
+	return strings.TrimPrefix(r.Schema.RefType, "*")
 }
 
 // Returns the Go type definition for a request body
@@ -375,10 +909,46 @@ func FilterParameterDefinitionByType(params []ParameterDefinition, in string) []
 
 // OperationDefinitions returns all operations for a swagger definition.
 func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
-	var operations []OperationDefinition
+	operationNames := newNameResolver("operation")
+	operations, err := describeOperations(swagger, swagger.Paths, true, operationNames, generateDefaultOperationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := operationNames.err(); err != nil {
+		return nil, err
+	}
+
+	// Links refer to operations which may appear later in the spec, so they
+	// can only be described once we have all the operations.
+	for i := range operations {
+		operations[i].Links = DescribeLinks(&operations[i], operations)
+	}
+	return operations, nil
+}
 
-	for _, requestPath := range SortedPathsKeys(swagger.Paths) {
-		pathItem := swagger.Paths[requestPath]
+// describeOperations describes the operations of the path items, named by
+// operationNames. Operations without an ID get the one which defaultID
+// returns from their method and the key of their path item, which is their
+// path when keyedByPath, and otherwise the name of a webhook or the URL
+// expression of a callback, which has no path parameters.
+func describeOperations(swagger *openapi3.T, paths openapi3.Paths, keyedByPath bool, operationNames *nameResolver,
+	defaultID func(opName, requestPath string) (string, error)) ([]OperationDefinition, error) {
+	// pendingOperation is an operation which has been named, and is yet to be
+	// described.
+	type pendingOperation struct {
+		requestPath     string
+		opName          string
+		op              *openapi3.Operation
+		specOperationID string
+		globalParams    []ParameterDefinition
+	}
+	var pending []pendingOperation
+
+	// Operations are named first, in order, as their names depend on those of
+	// the operations before them.
+	for _, requestPath := range SortedPathsKeys(paths) {
+		pathItem := paths[requestPath]
 		// These are parameters defined for all methods on a given path. They
 		// are shared by all methods.
 		globalParams, err := DescribeParameters(pathItem.Parameters, nil)
@@ -391,86 +961,232 @@ func OperationDefinitions(swagger *openapi3.T) ([]OperationDefinition, error) {
 		pathOps := pathItem.Operations()
 		for _, opName := range SortedOperationsKeys(pathOps) {
 			op := pathOps[opName]
-			if pathItem.Servers != nil {
+			// The servers of the path item are those of its operations which
+			// don't declare their own.
+			if pathItem.Servers != nil && op.Servers == nil {
 				op.Servers = &pathItem.Servers
 			}
+			name := op.OperationID
+			if name == "" {
+				name = opName + " " + requestPath
+			}
+			specOperationID := op.OperationID
 			// We rely on OperationID to generate function names, it's required
 			if op.OperationID == "" {
-				op.OperationID, err = generateDefaultOperationID(opName, requestPath)
+				op.OperationID, err = defaultID(opName, requestPath)
 				if err != nil {
 					return nil, fmt.Errorf("error generating default OperationID for %s/%s: %s",
 						opName, requestPath, err)
 				}
-				op.OperationID = op.OperationID
+				specOperationID = op.OperationID
 			} else {
-				op.OperationID = ToCamelCase(op.OperationID)
+				op.OperationID = normalizeName(op.OperationID)
 			}
+			op.OperationID = operationNames.unique(name, op.OperationID, op.Tags)
+			pending = append(pending, pendingOperation{
+				requestPath:     requestPath,
+				opName:          opName,
+				op:              op,
+				specOperationID: specOperationID,
+				globalParams:    globalParams,
+			})
+		}
+	}
 
-			// These are parameters defined for the specific path method that
-			// we're iterating over.
-			localParams, err := DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
-			if err != nil {
-				return nil, fmt.Errorf("error describing global parameters for %s/%s: %s",
-					opName, requestPath, err)
-			}
-			// All the parameters required by a handler are the union of the
-			// global parameters and the local parameters.
-			allParams := append(globalParams, localParams...)
-
-			// Order the path parameters to match the order as specified in
-			// the path, not in the swagger spec, and validate that the parameter
-			// names match, as downstream code depends on that.
-			pathParams := FilterParameterDefinitionByType(allParams, "path")
-			pathParams, err = SortParamsByPath(requestPath, pathParams)
+	// The operations are then described concurrently, as they're independent
+	// of each other.
+	operations := make([]OperationDefinition, len(pending))
+	err := forEachParallel(len(pending), func(i int) error {
+		requestPath, opName, op := pending[i].requestPath, pending[i].opName, pending[i].op
+
+		// These are parameters defined for the specific path method that
+		// we're iterating over.
+		localParams, err := DescribeParameters(op.Parameters, []string{op.OperationID + "Params"})
+		if err != nil {
+			return fmt.Errorf("error describing global parameters for %s/%s: %s",
+				opName, requestPath, err)
+		}
+		// All the parameters required by a handler are the union of the
+		// global parameters and the local parameters. The global ones are
+		// shared by the operations of the path, so they're copied.
+		allParams := append(append([]ParameterDefinition{}, pending[i].globalParams...), localParams...)
+
+		// Order the path parameters to match the order as specified in
+		// the path, not in the swagger spec, and validate that the parameter
+		// names match, as downstream code depends on that.
+		pathParams := FilterParameterDefinitionByType(allParams, "path")
+		paramsPath := requestPath
+		if !keyedByPath {
+			paramsPath = ""
+		}
+		pathParams, err = SortParamsByPath(paramsPath, pathParams)
+		if err != nil {
+			return err
+		}
+
+		bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
+		if err != nil {
+			return fmt.Errorf("error generating body definitions: %w", err)
+		}
+
+		multipartBody, multipartTypes, err := GenerateMultipartBodyDefinition(op.OperationID, op.RequestBody)
+		if err != nil {
+			return fmt.Errorf("error generating multipart body definition: %w", err)
+		}
+		typeDefinitions = append(typeDefinitions, multipartTypes...)
+
+		downloadHeaders, err := DescribeDownloadHeaders(op)
+		if err != nil {
+			return fmt.Errorf("error describing response headers: %w", err)
+		}
+
+		var idempotencyKey string
+		if extension, ok := op.Extensions[extPropIdempotencyKey]; ok {
+			idempotencyKey, err = extParseIdempotencyKey(extension)
 			if err != nil {
-				return nil, err
+				return fmt.Errorf("invalid value for %q in operation %s: %w", extPropIdempotencyKey, op.OperationID, err)
 			}
+		}
 
-			bodyDefinitions, typeDefinitions, err := GenerateBodyDefinitions(op.OperationID, op.RequestBody)
+		var servers []ServerDefinition
+		if keyedByPath && op.Servers != nil {
+			servers, err = describeServers(*op.Servers, op.OperationID)
 			if err != nil {
-				return nil, fmt.Errorf("error generating body definitions: %w", err)
+				return fmt.Errorf("error describing the servers of operation %s: %w", op.OperationID, err)
 			}
+		}
 
-			opDef := OperationDefinition{
-				PathParams:   pathParams,
-				HeaderParams: FilterParameterDefinitionByType(allParams, "header"),
-				QueryParams:  FilterParameterDefinitionByType(allParams, "query"),
-				CookieParams: FilterParameterDefinitionByType(allParams, "cookie"),
-				OperationId:  ToCamelCase(op.OperationID),
-				// Replace newlines in summary.
-				Summary:         op.Summary,
-				Method:          opName,
-				Path:            requestPath,
-				Spec:            op,
-				Bodies:          bodyDefinitions,
-				TypeDefinitions: typeDefinitions,
-			}
+		opDef := OperationDefinition{
+			PathParams:   pathParams,
+			HeaderParams: FilterParameterDefinitionByType(allParams, "header"),
+			QueryParams:  FilterParameterDefinitionByType(allParams, "query"),
+			CookieParams: FilterParameterDefinitionByType(allParams, "cookie"),
+			OperationId:  op.OperationID,
+			// The operationId of the spec is replaced with the Go name of
+			// the operation, so it's kept before.
+			SpecOperationId: pending[i].specOperationID,
+			// Replace newlines in summary.
+			Summary:         op.Summary,
+			Method:          opName,
+			Path:            requestPath,
+			Spec:            op,
+			Bodies:          bodyDefinitions,
+			MultipartBody:   multipartBody,
+			TypeDefinitions: typeDefinitions,
+			DownloadHeaders: downloadHeaders,
+			IdempotencyKey:  idempotencyKey,
+			Servers:         servers,
+			Extensions:      extensionValues(op.ExtensionProps),
+		}
 
-			// check for overrides of SecurityDefinitions.
-			// See: "Step 2. Applying security:" from the spec:
-			// https://swagger.io/docs/specification/authentication/
-			if op.Security != nil {
-				opDef.SecurityDefinitions = DescribeSecurityDefinition(*op.Security)
-			} else {
-				// use global securityDefinitions
-				// globalSecurityDefinitions contains the top-level securityDefinitions.
-				// They are the default securityPermissions which are injected into each
-				// path, except for the case where a path explicitly overrides them.
-				opDef.SecurityDefinitions = DescribeSecurityDefinition(swagger.Security)
+		// check for overrides of SecurityDefinitions.
+		// See: "Step 2. Applying security:" from the spec:
+		// https://swagger.io/docs/specification/authentication/
+		if op.Security != nil {
+			opDef.SecurityDefinitions = DescribeSecurityDefinition(*op.Security)
+		} else {
+			// use global securityDefinitions
+			// globalSecurityDefinitions contains the top-level securityDefinitions.
+			// They are the default securityPermissions which are injected into each
+			// path, except for the case where a path explicitly overrides them.
+			opDef.SecurityDefinitions = DescribeSecurityDefinition(swagger.Security)
+		}
 
-			}
+		if op.RequestBody != nil {
+			opDef.BodyRequired = op.RequestBody.Value.Required
+		}
+
+		// Generate all the type definitions needed for this operation
+		opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
+		operations[i] = opDef
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
 
-			if op.RequestBody != nil {
-				opDef.BodyRequired = op.RequestBody.Value.Required
+// DescribeLinks returns the links declared by the responses of an operation.
+// Links to operations which take a request body, or which don't map all of
+// the target's path parameters, aren't supported and are skipped.
+func DescribeLinks(op *OperationDefinition, operations []OperationDefinition) []LinkDefinition {
+	var links []LinkDefinition
+	for _, responseName := range SortedResponsesKeys(op.Spec.Responses) {
+		response := op.Spec.Responses[responseName]
+		if response.Value == nil {
+			continue
+		}
+		for _, linkName := range SortedLinkKeys(response.Value.Links) {
+			linkRef := response.Value.Links[linkName]
+			if linkRef.Value == nil {
+				continue
+			}
+			target := findLinkTarget(linkRef.Value, operations)
+			if target == nil || target.HasBody() {
+				continue
 			}
 
-			// Generate all the type definitions needed for this operation
-			opDef.TypeDefinitions = append(opDef.TypeDefinitions, GenerateTypeDefsForOperation(opDef)...)
+			link := LinkDefinition{
+				Name:         linkName,
+				ResponseName: responseName,
+				Target:       target,
+			}
+			params := linkRef.Value.Parameters
+			for _, param := range target.PathParams {
+				expression, found := linkParameterExpression(params, param)
+				if !found {
+					link.Target = nil
+					break
+				}
+				link.PathParams = append(link.PathParams, LinkParameter{param, expression})
+			}
+			if link.Target == nil {
+				continue
+			}
+			for _, param := range target.Params() {
+				if expression, found := linkParameterExpression(params, param); found {
+					link.ObjectParams = append(link.ObjectParams, LinkParameter{param, expression})
+				}
+			}
+			links = append(links, link)
+		}
+	}
+	return links
+}
 
-			operations = append(operations, opDef)
+// findLinkTarget returns the operation which a link refers to, either by its
+// operationId, or by a local operationRef such as #/paths/~1pets~1{id}/get.
+func findLinkTarget(link *openapi3.Link, operations []OperationDefinition) *OperationDefinition {
+	for i, op := range operations {
+		if link.OperationID != "" {
+			if op.OperationId == normalizeName(link.OperationID) {
+				return &operations[i]
+			}
+			continue
+		}
+		ref := "#/paths/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(op.Path) + "/" + strings.ToLower(op.Method)
+		if link.OperationRef == ref {
+			return &operations[i]
 		}
 	}
-	return operations, nil
+	return nil
+}
+
+// linkParameterExpression returns the expression which a link gives for a
+// parameter, which may be named with its location, such as path.id.
+func linkParameterExpression(params map[string]interface{}, param ParameterDefinition) (string, bool) {
+	value, found := params[param.In+"."+param.ParamName]
+	if !found {
+		value, found = params[param.ParamName]
+	}
+	if !found {
+		return "", false
+	}
+	if expression, ok := value.(string); ok {
+		return expression, true
+	}
+	return fmt.Sprint(value), true
 }
 
 func generateDefaultOperationID(opName string, requestPath string) (string, error) {
@@ -490,7 +1206,40 @@ func generateDefaultOperationID(opName string, requestPath string) (string, erro
 		}
 	}
 
-	return ToCamelCase(operationId), nil
+	return normalizeName(operationId), nil
+}
+
+// protoMessageType returns the Go type given by the x-go-proto-type extension
+// of a protobuf body's schema, or an empty string when there isn't one.
+func protoMessageType(content *openapi3.MediaType) (string, error) {
+	if content.Schema == nil || content.Schema.Value == nil {
+		return "", nil
+	}
+	extension, ok := content.Schema.Value.Extensions[extPropGoProtoType]
+	if !ok {
+		return "", nil
+	}
+	_, typeName, err := extParseGoProtoType(extension)
+	if err != nil {
+		return "", fmt.Errorf("invalid value for %q: %w", extPropGoProtoType, err)
+	}
+	return typeName, nil
+}
+
+// xmlRootName returns the name of the root element of an XML body of the
+// schema, which is the name of its xml object, or the name of the schema it
+// refers to, or else fallback.
+func xmlRootName(schema *openapi3.SchemaRef, fallback string) string {
+	if schema == nil {
+		return fallback
+	}
+	if schema.Value != nil && schema.Value.XML != nil && schema.Value.XML.Name != "" {
+		return schema.Value.XML.Name
+	}
+	if schema.Ref != "" {
+		return schema.Ref[strings.LastIndex(schema.Ref, "/")+1:]
+	}
+	return fallback
 }
 
 // This function turns the Swagger body definitions into a list of our body
@@ -504,18 +1253,89 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 	var bodyDefinitions []RequestBodyDefinition
 	var typeDefinitions []TypeDefinition
 
-	for contentType, content := range body.Content {
+	tagsSeen := make(map[string]bool)
+	jsonContentTypeName := jsonContentType(body.Content)
+	for _, contentType := range SortedContentKeys(body.Content) {
+		content := body.Content[contentType]
 		var tag string
 		var defaultBody bool
 
-		switch contentType {
-		case "application/json":
+		switch {
+		case isMediaTypeJSON(contentType):
+			if contentType != jsonContentTypeName {
+				continue
+			}
 			tag = "JSON"
 			defaultBody = true
+		case isMediaTypeXML(contentType):
+			tag = "XML"
+		case isMediaTypeYAML(contentType):
+			tag = "YAML"
+		case isMediaTypeMsgpack(contentType):
+			tag = "Msgpack"
+		case isMediaTypeProtobuf(contentType):
+			tag = "Protobuf"
+		case contentType == "application/x-www-form-urlencoded":
+			tag = "Formdata"
+		case contentType == "text/plain":
+			tag = "Text"
+		case contentType == "text/csv":
+			tag = "CSV"
+		case contentType == "application/octet-stream":
+			tag = "OctetStream"
 		default:
 			continue
 		}
 
+		// Several media types may map onto the same tag, such as
+		// application/xml and text/xml, we only generate the first.
+		if tagsSeen[tag] {
+			continue
+		}
+		tagsSeen[tag] = true
+
+		// Protobuf bodies use an existing message type, and are passed by
+		// pointer as messages mustn't be copied.
+		if tag == "Protobuf" {
+			protoType, err := protoMessageType(content)
+			if err != nil {
+				return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
+			}
+			if protoType == "" {
+				continue
+			}
+			bodyDefinitions = append(bodyDefinitions, RequestBodyDefinition{
+				Required:    body.Required,
+				Schema:      Schema{RefType: "*" + protoType, ProtoMessage: true},
+				NameTag:     tag,
+				ContentType: contentType,
+			})
+			continue
+		}
+
+		// Text bodies are sent as they are, unless they're CSV whose rows are
+		// objects, which are slices of structs.
+		if (tag == "Text" || tag == "CSV") && !isCSVRows(contentType, content.Schema) {
+			bodyDefinitions = append(bodyDefinitions, RequestBodyDefinition{
+				Required:    body.Required,
+				Schema:      Schema{GoType: "string"},
+				NameTag:     tag,
+				ContentType: contentType,
+			})
+			continue
+		}
+
+		// Binary bodies are bytes, whatever their schema.
+		if tag == "OctetStream" {
+			bodyDefinitions = append(bodyDefinitions, RequestBodyDefinition{
+				Required:    body.Required,
+				Schema:      Schema{GoType: "[]byte"},
+				NameTag:     tag,
+				ContentType: contentType,
+			})
+			continue
+		}
+
 		bodyTypeName := operationID + tag + "Body"
 		bodySchema, err := GenerateGoSchema(content.Schema, []string{bodyTypeName})
 		if err != nil {
@@ -552,11 +1372,83 @@ func GenerateBodyDefinitions(operationID string, bodyOrRef *openapi3.RequestBody
 			ContentType: contentType,
 			Default:     defaultBody,
 		}
+		if tag == "Formdata" {
+			bd.Encoding = content.Encoding
+		}
+		if tag == "XML" {
+			bd.XMLName = xmlRootName(content.Schema, bodyTypeName)
+		}
 		bodyDefinitions = append(bodyDefinitions, bd)
 	}
 	return bodyDefinitions, typeDefinitions, nil
 }
 
+// GenerateMultipartBodyDefinition describes the multipart/form-data body of
+// an operation, when it's an object, as a struct whose binary properties are
+// of type openapi_types.File. Clients build the body from it with
+// runtime.MarshalMultipart, and servers bind it with runtime.BindMultipart, or
+// their WithEncoding variants when its properties have encoding objects. A
+// body referring to a schema with binary properties gets a struct of its own,
+// as the type of the schema has strings rather than files.
+func GenerateMultipartBodyDefinition(operationID string, bodyOrRef *openapi3.RequestBodyRef) (*RequestBodyDefinition, []TypeDefinition, error) {
+	if bodyOrRef == nil {
+		return nil, nil, nil
+	}
+	body := bodyOrRef.Value
+	content, found := body.Content["multipart/form-data"]
+	if !found || content.Schema == nil || content.Schema.Value == nil || len(content.Schema.Value.Properties) == 0 {
+		return nil, nil, nil
+	}
+
+	bodyTypeName := operationID + "MultipartBody"
+	schemaRef := content.Schema
+	if hasBinaryProperties(schemaRef.Value) {
+		schemaRef = openapi3.NewSchemaRef("", schemaRef.Value)
+	}
+	bodySchema, err := generateSchema(schemaRef, []string{bodyTypeName}, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating request body definition: %w", err)
+	}
+
+	var typeDefinitions []TypeDefinition
+	if IsGoTypeReference(schemaRef.Ref) {
+		refType, err := RefPathToGoType(schemaRef.Ref)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error turning reference (%s) into a Go type: %w", schemaRef.Ref, err)
+		}
+		bodySchema.RefType = refType
+	} else {
+		typeDefinitions = append(typeDefinitions, TypeDefinition{
+			TypeName: bodyTypeName,
+			Schema:   bodySchema,
+		})
+		bodySchema.RefType = bodyTypeName
+	}
+
+	return &RequestBodyDefinition{
+		Required:    body.Required,
+		Schema:      bodySchema,
+		NameTag:     "Multipart",
+		ContentType: "multipart/form-data",
+		Encoding:    content.Encoding,
+	}, typeDefinitions, nil
+}
+
+// hasBinaryProperties returns whether some properties of an object schema are
+// strings of format binary, or arrays of them, which are files in multipart
+// bodies.
+func hasBinaryProperties(schema *openapi3.Schema) bool {
+	isBinary := func(ref *openapi3.SchemaRef) bool {
+		return ref != nil && ref.Value != nil && ref.Value.Type == "string" && ref.Value.Format == "binary"
+	}
+	for _, property := range schema.Properties {
+		if isBinary(property) || property.Value != nil && property.Value.Type == "array" && isBinary(property.Value.Items) {
+			return true
+		}
+	}
+	return false
+}
+
 func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 	var typeDefs []TypeDefinition
 	// Start with the params object itself
@@ -572,6 +1464,9 @@ func GenerateTypeDefsForOperation(op OperationDefinition) []TypeDefinition {
 	for _, body := range op.Bodies {
 		typeDefs = append(typeDefs, body.Schema.GetAdditionalTypeDefs()...)
 	}
+	if op.MultipartBody != nil {
+		typeDefs = append(typeDefs, op.MultipartBody.Schema.GetAdditionalTypeDefs()...)
+	}
 	return typeDefs
 }
 
@@ -603,6 +1498,7 @@ func GenerateParamsTypes(op OperationDefinition) []TypeDefinition {
 			Required:       param.Required,
 			Schema:         pSchema,
 			ExtensionProps: &param.Spec.ExtensionProps,
+			isParam:        true,
 		}
 		s.Properties = append(s.Properties, prop)
 	}
@@ -660,19 +1556,49 @@ func GenerateTypesForOperations(t *template.Template, ops []OperationDefinition)
 // GenerateChiServer This function generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateChiServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"chi/chi-interface.tmpl", "chi/chi-middleware.tmpl", "chi/chi-handler.tmpl"}, t, operations)
+	templates := []string{"chi/chi-interface.tmpl", "chi/chi-middleware.tmpl", "chi/chi-handler.tmpl"}
+	if options.LoggingMiddleware {
+		templates = append(templates, "chi/chi-logging.tmpl")
+	}
+	return GenerateTemplates(templates, t, operations)
 }
 
 // GenerateEchoServer This function generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateEchoServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"echo/echo-interface.tmpl", "echo/echo-wrappers.tmpl", "echo/echo-register.tmpl"}, t, operations)
+	templates := []string{"echo/echo-interface.tmpl", "echo/echo-wrappers.tmpl", "echo/echo-register.tmpl"}
+	if options.LoggingMiddleware {
+		templates = append(templates, "echo/echo-logging.tmpl")
+	}
+	return GenerateTemplates(templates, t, operations)
 }
 
 // GenerateGinServer This function generates all the go code for the ServerInterface as well as
 // all the wrapper functions around our handlers.
 func GenerateGinServer(t *template.Template, operations []OperationDefinition) (string, error) {
-	return GenerateTemplates([]string{"gin/gin-interface.tmpl", "gin/gin-wrappers.tmpl", "gin/gin-register.tmpl"}, t, operations)
+	templates := []string{"gin/gin-interface.tmpl", "gin/gin-wrappers.tmpl", "gin/gin-register.tmpl"}
+	if options.LoggingMiddleware {
+		templates = append(templates, "gin/gin-logging.tmpl")
+	}
+	return GenerateTemplates(templates, t, operations)
+}
+
+// GenerateTestClient generates NewTestClient, which serves a ServerInterface
+// with the handlers of the generated server and returns a client of it.
+func GenerateTestClient(t *template.Template) (string, error) {
+	return GenerateTemplates([]string{"test-client.tmpl"}, t, nil)
+}
+
+// GeneratePaths generates the FooPathTemplate constants of the paths of the
+// operations, and the PathFoo functions building them from path parameters.
+func GeneratePaths(t *template.Template, ops []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"paths.tmpl"}, t, ops)
+}
+
+// GenerateRoutes generates Routes, the route table of the operations, and
+// RoutesHandler, which lists it as JSON.
+func GenerateRoutes(t *template.Template, ops []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"routes.tmpl"}, t, ops)
 }
 
 // Uses the template engine to generate the function which registers our wrappers
@@ -687,6 +1613,43 @@ func GenerateClientWithResponses(t *template.Template, ops []OperationDefinition
 	return GenerateTemplates([]string{"client-with-responses.tmpl"}, t, ops)
 }
 
+// GenerateSecurityProviders generates client request editors for the security
+// schemes of the spec.
+func GenerateSecurityProviders(t *template.Template, schemes []SecuritySchemeDefinition) (string, error) {
+	return GenerateTemplates([]string{"security-providers.tmpl"}, t, schemes)
+}
+
+// GenerateOIDCVerifiers generates constructors of verifiers of the bearer
+// tokens of the OpenID Connect security schemes of the spec, for servers.
+func GenerateOIDCVerifiers(t *template.Template, schemes []SecuritySchemeDefinition) (string, error) {
+	var oidcSchemes []SecuritySchemeDefinition
+	for _, scheme := range schemes {
+		if scheme.IsOpenIDConnect() {
+			oidcSchemes = append(oidcSchemes, scheme)
+		}
+	}
+	if len(oidcSchemes) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"oidc-verifiers.tmpl"}, t, oidcSchemes)
+}
+
+// GenerateRequestBodyDecoders generates, for the operations whose request body
+// has several content types, or is a multipart body, functions decoding it by
+// its Content-Type, so that servers don't bind multipart bodies themselves.
+func GenerateRequestBodyDecoders(t *template.Template, ops []OperationDefinition) (string, error) {
+	var negotiated []OperationDefinition
+	for _, op := range ops {
+		if op.HasNegotiatedBody() || op.MultipartBody != nil {
+			negotiated = append(negotiated, op)
+		}
+	}
+	if len(negotiated) == 0 {
+		return "", nil
+	}
+	return GenerateTemplates([]string{"request-body-decoders.tmpl"}, t, negotiated)
+}
+
 // GenerateTemplates used to generate templates
 func GenerateTemplates(templates []string, t *template.Template, ops interface{}) (string, error) {
 	var generatedTemplates []string
@@ -16,6 +16,9 @@ package codegen
 import (
 	"net/http"
 	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGenerateDefaultOperationID(t *testing.T) {
@@ -79,3 +82,11 @@ func TestGenerateDefaultOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestXMLRootName(t *testing.T) {
+	named := &openapi3.SchemaRef{Ref: "#/components/schemas/Pet", Value: &openapi3.Schema{XML: &openapi3.XML{Name: "animal"}}}
+	assert.Equal(t, "animal", xmlRootName(named, "PostPetXMLBody"))
+	referred := &openapi3.SchemaRef{Ref: "#/components/schemas/Pet", Value: &openapi3.Schema{}}
+	assert.Equal(t, "Pet", xmlRootName(referred, "PostPetXMLBody"))
+	assert.Equal(t, "PostPetXMLBody", xmlRootName(&openapi3.SchemaRef{Value: &openapi3.Schema{}}, "PostPetXMLBody"))
+}
@@ -0,0 +1,336 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/pkg/util"
+)
+
+// Package is one of the packages generated by GenerateTagPackages or
+// GenerateDocumentPackages.
+type Package struct {
+	Name     string // The name of the package, which is also the name of its directory
+	Tag      string // The tag of the package's operations, empty for the common package
+	Document string // The location of the spec of the package, for document packages
+	Code     string
+
+	// ImportMapping is the import mapping the package was generated with,
+	// which imports the packages of the specs it refers to, for document
+	// packages.
+	ImportMapping map[string]string
+}
+
+// GenerateTagPackages generates a package for each tag of the spec's
+// operations, along with a common package named packageName, which holds the
+// types of the components and the operations without tags. Operations with
+// several tags go to the package of their first tag, and tag packages are
+// named after their tag, so "Pet Store" becomes petstore.
+//
+// Each package is meant to be written in a directory named after it, under the
+// directory whose import path is importPath, which is how tag packages import
+// the common package.
+func GenerateTagPackages(swagger *openapi3.T, importPath string, packageName string, opts Options) ([]Package, error) {
+	if err := alterSpec(swagger, opts); err != nil {
+		return nil, err
+	}
+	var packages []Package
+	err := generateTagPackages(swagger, importPath, packageName, opts, func(pkg Package) error {
+		packages = append(packages, pkg)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return packages, nil
+}
+
+// generateTagPackages generates the packages of GenerateTagPackages for a spec
+// which alterSpec has altered already, calling emit with each one as soon as
+// it's generated.
+func generateTagPackages(swagger *openapi3.T, importPath string, packageName string, opts Options, emit func(Package) error) error {
+	if opts.ComponentsPackage != "" {
+		return fmt.Errorf("the components are generated in package %s, not in %s", packageName, opts.ComponentsPackage)
+	}
+
+	// The components are generated in the common package, so their tags
+	// depend on the bodies of all the operations.
+	if err := prepareSpec(swagger, opts); err != nil {
+		return err
+	}
+
+	tags := make(map[string]string) // The tag of each package, by name
+	hasUntagged := false
+	for _, op := range swaggerOperations(swagger) {
+		if len(op.Tags) == 0 {
+			hasUntagged = true
+			continue
+		}
+		tag := op.Tags[0]
+		name := goPackageName(tag)
+		if name == "" {
+			return fmt.Errorf("tag %q can't be used as a package name", tag)
+		}
+		if name == packageName {
+			return fmt.Errorf("tag %q has the name of the common package %s", tag, packageName)
+		}
+		if other, found := tags[name]; found && other != tag {
+			return fmt.Errorf("tags %q and %q are both named %s", other, tag, name)
+		}
+		tags[name] = tag
+	}
+
+	commonOpts := opts
+	if !hasUntagged {
+		commonOpts.GenerateClient = false
+		commonOpts.GenerateChiServer = false
+		commonOpts.GenerateEchoServer = false
+		commonOpts.GenerateGinServer = false
+	}
+	code, err := generate(specWithOperations(swagger, ""), packageName, commonOpts)
+	if err != nil {
+		return fmt.Errorf("error generating package %s: %w", packageName, err)
+	}
+	if err := emit(Package{Name: packageName, Code: code}); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// The tag packages refer to the common package, until we're done.
+	defer func() { componentsImport = goImport{} }()
+	tagOpts := opts
+	tagOpts.ComponentsPackage = strings.TrimSuffix(importPath, "/") + "/" + packageName
+	for _, name := range names {
+		code, err := generate(specWithOperations(swagger, tags[name]), name, tagOpts)
+		if err != nil {
+			return fmt.Errorf("error generating package %s for tag %q: %w", name, tags[name], err)
+		}
+		if err := emit(Package{Name: name, Tag: tags[name], Code: code}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateDocumentPackages generates a package named packageName for the spec
+// at specPath, which is a file path or a URL, and a package for each of the
+// other specs its references lead to, recursively, rather than requiring them
+// to be generated beforehand and given in the import mapping. Specs which are
+// in the import mapping are imported as usual.
+//
+// The packages of other specs are named after their path relative to the
+// directory of the spec, so ./common/pets.yaml becomes commonpets, and only
+// have the types of their components, and their spec when it's embedded. Like
+// tag packages, each package is meant to be written in a directory named after
+// it, under the directory whose import path is importPath.
+func GenerateDocumentPackages(specPath string, importPath string, packageName string, opts Options) ([]Package, error) {
+	var packages []Package
+	err := generateDocumentPackages(nil, specPath, importPath, packageName, opts, func(pkg Package) error {
+		packages = append(packages, pkg)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return packages, nil
+}
+
+// generateDocumentPackages generates the packages of GenerateDocumentPackages,
+// calling emit with each one as soon as it's generated. root is the spec at
+// specPath, which alterSpec has altered already, or nil to load it. The other
+// specs are altered as soon as they're loaded.
+func generateDocumentPackages(root *openapi3.T, specPath string, importPath string, packageName string, opts Options, emit func(Package) error) error {
+	if opts.ComponentsPackage != "" || opts.BundleExternalRefs {
+		return fmt.Errorf("document packages can't be generated along with a components package or bundled references")
+	}
+
+	names := map[string]string{specPath: packageName} // The package of each spec, by location
+	taken := map[string]bool{packageName: true}
+	documents := []string{specPath}
+	specs := map[string]*openapi3.T{}
+	mappings := map[string]map[string]string{}
+
+	// The specs of the import mapping are relative to the spec, and are
+	// imported wherever they're referred to, whichever spec refers to them.
+	mapped := map[string]string{}
+	for remote, packagePath := range opts.ImportMapping {
+		mapped[resolveDocument(specPath, remote)] = packagePath
+	}
+
+	// The specs are visited breadth first, in the order of their references.
+	for i := 0; i < len(documents); i++ {
+		document := documents[i]
+		swagger := root
+		if i > 0 || root == nil {
+			var err error
+			swagger, err = util.LoadSwaggerWithOptions(document, opts.LoadOptions)
+			if err != nil {
+				return fmt.Errorf("error loading spec %s: %w", document, err)
+			}
+			if err := alterSpec(swagger, opts); err != nil {
+				return fmt.Errorf("error generating package %s for spec %s: %w", names[document], document, err)
+			}
+		}
+		specs[document] = swagger
+
+		mapping := make(map[string]string)
+		for _, ref := range externalRefs(swagger) {
+			remote := strings.Split(ref, "#")[0]
+			if _, found := mapping[remote]; found {
+				continue
+			}
+			location := resolveDocument(document, remote)
+			if packagePath, found := mapped[location]; found {
+				mapping[remote] = packagePath
+				continue
+			}
+			name, found := names[location]
+			if !found {
+				name = documentPackageName(specPath, location, taken)
+				names[location] = name
+				taken[name] = true
+				documents = append(documents, location)
+			}
+			mapping[remote] = strings.TrimSuffix(importPath, "/") + "/" + name + "#" + name
+		}
+		mappings[document] = mapping
+	}
+
+	for i, document := range documents {
+		docOpts := opts
+		docOpts.ImportMapping = mappings[document]
+		if i > 0 {
+			// Other specs are only generated for the types they define.
+			docOpts.GenerateClient = false
+			docOpts.GenerateChiServer = false
+			docOpts.GenerateEchoServer = false
+			docOpts.GenerateGinServer = false
+			docOpts.GenerateTypes = true
+			docOpts.SkipPrune = true
+			docOpts.IncludeTags = nil
+			docOpts.ExcludeTags = nil
+			docOpts.IncludeOperationIDs = nil
+			docOpts.ExcludeOperationIDs = nil
+		}
+		if err := prepareSpec(specs[document], docOpts); err != nil {
+			return fmt.Errorf("error generating package %s for spec %s: %w", names[document], document, err)
+		}
+		code, err := generate(specs[document], names[document], docOpts)
+		if err != nil {
+			return fmt.Errorf("error generating package %s for spec %s: %w", names[document], document, err)
+		}
+		if err := emit(Package{Name: names[document], Document: document, Code: code, ImportMapping: docOpts.ImportMapping}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveDocument returns the location of a spec referred to as remote by the
+// spec at location document.
+func resolveDocument(document string, remote string) string {
+	remoteURL, err := url.Parse(remote)
+	if err != nil {
+		return remote
+	}
+	if remoteURL.Scheme != "" && remoteURL.Host != "" {
+		return remote
+	}
+	if documentURL, err := url.Parse(document); err == nil && documentURL.Scheme != "" && documentURL.Host != "" {
+		return documentURL.ResolveReference(remoteURL).String()
+	}
+	return filepath.Join(filepath.Dir(document), filepath.FromSlash(remote))
+}
+
+// documentPackageName returns an untaken package name for the spec at location,
+// made of its path relative to the directory of the root spec, without its
+// extension, or of its base name when it's elsewhere. A number is added to
+// names which are taken.
+func documentPackageName(root string, location string, taken map[string]bool) string {
+	name := path.Base(filepath.ToSlash(location))
+	if rel, err := filepath.Rel(filepath.Dir(root), location); err == nil && !strings.HasPrefix(filepath.ToSlash(rel), "../") && !strings.Contains(location, "://") {
+		name = filepath.ToSlash(rel)
+	}
+	name = goPackageName(strings.TrimSuffix(name, path.Ext(name)))
+	if name == "" {
+		name = "external"
+	}
+	unique := name
+	for n := 2; taken[unique]; n++ {
+		unique = fmt.Sprintf("%s%d", name, n)
+	}
+	return unique
+}
+
+// swaggerOperations returns the operations of the spec, sorted by path and
+// method.
+func swaggerOperations(swagger *openapi3.T) []*openapi3.Operation {
+	var ops []*openapi3.Operation
+	for _, requestPath := range SortedPathsKeys(swagger.Paths) {
+		pathItem := swagger.Paths[requestPath]
+		for _, method := range SortedOperationsKeys(pathItem.Operations()) {
+			ops = append(ops, pathItem.Operations()[method])
+		}
+	}
+	return ops
+}
+
+// specWithOperations returns a copy of the spec with only the operations
+// whose first tag is tag, or which have no tags when tag is empty.
+func specWithOperations(swagger *openapi3.T, tag string) *openapi3.T {
+	spec := *swagger
+	spec.Paths = make(openapi3.Paths, len(swagger.Paths))
+	for requestPath, pathItem := range swagger.Paths {
+		item := *pathItem
+		for method, op := range pathItem.Operations() {
+			firstTag := ""
+			if len(op.Tags) > 0 {
+				firstTag = op.Tags[0]
+			}
+			if firstTag != tag {
+				item.SetOperation(method, nil)
+			}
+		}
+		if len(item.Operations()) > 0 {
+			spec.Paths[requestPath] = &item
+		}
+	}
+	return &spec
+}
+
+// goPackageName returns a Go package name made of the lower case letters and
+// digits of s, which is empty when s has none.
+func goPackageName(s string) string {
+	var name strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || (unicode.IsDigit(r) && name.Len() > 0)) {
+			name.WriteRune(r)
+		}
+	}
+	return name.String()
+}
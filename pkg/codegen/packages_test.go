@@ -0,0 +1,117 @@
+package codegen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTagPackages(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Tag Packages Test
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: ListPets
+      tags: [Pet Store, Inventory]
+      responses:
+        200:
+          description: the pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+  /items:
+    get:
+      operationId: ListItems
+      tags: [Inventory]
+      responses:
+        204:
+          description: no items
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+`
+	load := func() *openapi3.T {
+		swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+		require.NoError(t, err)
+		return swagger
+	}
+	opts := Options{GenerateTypes: true, GenerateClient: true}
+
+	packages, err := GenerateTagPackages(load(), "example.com/api", "models", opts)
+	require.NoError(t, err)
+	require.Len(t, packages, 3)
+
+	assert.Equal(t, "models", packages[0].Name)
+	assert.Equal(t, "", packages[0].Tag)
+	assert.Contains(t, packages[0].Code, "type Pet struct")
+	assert.NotContains(t, packages[0].Code, "type Client struct")
+
+	assert.Equal(t, "inventory", packages[1].Name)
+	assert.Equal(t, "Inventory", packages[1].Tag)
+	assert.Contains(t, packages[1].Code, "func (c *Client) ListItems(")
+	assert.NotContains(t, packages[1].Code, "ListPets")
+
+	assert.Equal(t, "petstore", packages[2].Name)
+	assert.Equal(t, "Pet Store", packages[2].Tag)
+	assert.Contains(t, packages[2].Code, `models "example.com/api/models"`)
+	assert.Contains(t, packages[2].Code, "JSON200      *[]models.Pet")
+	assert.NotContains(t, packages[2].Code, "type Pet struct")
+
+	_, err = GenerateTagPackages(load(), "example.com/api", "inventory", opts)
+	assert.EqualError(t, err, `tag "Inventory" has the name of the common package inventory`)
+}
+
+func TestGenerateDocumentPackages(t *testing.T) {
+	packages, err := GenerateDocumentPackages("../../internal/test/externalref/spec.yaml", "example.com/api", "externalref", Options{
+		GenerateTypes: true,
+		SkipPrune:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, packages, 3)
+
+	// The referenced specs are generated in the order they're found in, and
+	// imported where they're referred to, including from each other.
+	assert.Equal(t, "externalref", packages[0].Name)
+	assert.Contains(t, packages[0].Code, `packageaspec "example.com/api/packageaspec"`)
+	assert.Contains(t, packages[0].Code, `packagebspec "example.com/api/packagebspec"`)
+	assert.Contains(t, packages[0].Code, "ObjectA *packageaspec.ObjectA")
+
+	assert.Equal(t, "packageaspec", packages[1].Name)
+	assert.Equal(t, filepath.FromSlash("../../internal/test/externalref/packageA/spec.yaml"), packages[1].Document)
+	assert.Contains(t, packages[1].Code, "package packageaspec")
+	assert.Contains(t, packages[1].Code, "ObjectB *packagebspec.ObjectB")
+
+	assert.Equal(t, "packagebspec", packages[2].Name)
+	assert.Contains(t, packages[2].Code, "type ObjectB struct")
+
+	// Specs in the import mapping are imported as usual.
+	packages, err = GenerateDocumentPackages("../../internal/test/externalref/spec.yaml", "example.com/api", "externalref", Options{
+		GenerateTypes: true,
+		SkipPrune:     true,
+		ImportMapping: map[string]string{"./packageB/spec.yaml": "example.com/models#models"},
+	})
+	require.NoError(t, err)
+	require.Len(t, packages, 2)
+	assert.Contains(t, packages[0].Code, `models "example.com/models"`)
+	assert.Contains(t, packages[0].Code, "ObjectB *models.ObjectB")
+
+	// They're imported by the other specs referring to them too, rather than
+	// generated again.
+	assert.Equal(t, "packageaspec", packages[1].Name)
+	assert.Contains(t, packages[1].Code, `models "example.com/models"`)
+	assert.Contains(t, packages[1].Code, "ObjectB *models.ObjectB")
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachParallel(t *testing.T) {
+	results := make([]int, 100)
+	err := forEachParallel(len(results), func(i int) error {
+		results[i] = i * i
+		if i == 70 || i == 30 {
+			return fmt.Errorf("error %d", i)
+		}
+		return nil
+	})
+	// The error is that of the lowest index, whichever failed first.
+	assert.EqualError(t, err, "error 30")
+	assert.Equal(t, 99*99, results[99])
+
+	assert.NoError(t, forEachParallel(0, func(int) error { return nil }))
+}
+
+// largeSpec returns a spec of n schemas, each with an operation creating it.
+func largeSpec(n int) string {
+	var b strings.Builder
+	b.WriteString("openapi: 3.0.1\ninfo:\n  title: Large\n  version: 1.0.0\npaths:\n")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, `  /things%[1]d/{id}:
+    post:
+      operationId: createThing%[1]d
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+        - name: tags
+          in: query
+          schema:
+            type: array
+            items:
+              type: string
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Thing%[1]d'
+          multipart/form-data:
+            schema:
+              type: object
+              properties:
+                file:
+                  type: string
+                  format: binary
+      responses:
+        '200':
+          description: the thing
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Thing%[1]d'
+`, i)
+	}
+	b.WriteString("components:\n  schemas:\n")
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&b, `    Thing%[1]d:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        created:
+          type: string
+          format: date-time
+        kind:
+          type: string
+          enum: [a, b, c]
+        parts:
+          type: array
+          items:
+            type: object
+            properties:
+              label:
+                type: string
+              size:
+                type: integer
+`, i)
+	}
+	return b.String()
+}
+
+func TestParallelGenerationIsDeterministic(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(largeSpec(50)))
+	require.NoError(t, err)
+	opts := Options{GenerateTypes: true, GenerateClient: true, GenerateChiServer: true}
+
+	parallel, err := Generate(swagger, "api", opts)
+	require.NoError(t, err)
+
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+	swagger, err = openapi3.NewLoader().LoadFromData([]byte(largeSpec(50)))
+	require.NoError(t, err)
+	serial, err := Generate(swagger, "api", opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, serial, parallel)
+	assert.Contains(t, parallel, "type CreateThing49MultipartBody struct {")
+	assert.Regexp(t, `File \*openapi_types\.File`, parallel)
+}
+
+// BenchmarkGenerateLargeSpec compares the generation of a large spec on a
+// single CPU with that on all of them.
+func BenchmarkGenerateLargeSpec(b *testing.B) {
+	spec := []byte(largeSpec(1000))
+	procsList := []int{1}
+	if procs := runtime.GOMAXPROCS(0); procs > 1 {
+		procsList = append(procsList, procs)
+	}
+	for _, procs := range procsList {
+		b.Run(fmt.Sprintf("procs=%d", procs), func(b *testing.B) {
+			defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				swagger, err := openapi3.NewLoader().LoadFromData(spec)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.StartTimer()
+				if _, err := Generate(swagger, "api", Options{GenerateTypes: true, GenerateClient: true, SkipFmt: true}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
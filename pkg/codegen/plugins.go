@@ -0,0 +1,165 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// PluginRequest is the model of the code to generate, which generator plugins
+// read as JSON on their standard input.
+type PluginRequest struct {
+	PackageName string                // The package of the generated code
+	Operations  []OperationDefinition // The operations, after filtering
+	Types       []TypeDefinition      // The types of the components
+	Spec        *openapi3.T
+}
+
+// PluginResponse is what generator plugins write as JSON on their standard
+// output.
+type PluginResponse struct {
+	Files []PluginFile
+}
+
+// PluginFile is a file generated by a plugin.
+type PluginFile struct {
+	Name    string // The path of the file, relative to the directory of the generated code
+	Content string
+}
+
+// GeneratePluginFiles runs the plugins of the options, which are commands
+// given the model of the code to generate as JSON on their standard input, as
+// a PluginRequest, and which answer with the files they generate as JSON on
+// their standard output, as a PluginResponse. Plugins add their own targets to
+// the code generator, such as dependency injection providers, and fail by
+// exiting with a non-zero status.
+func GeneratePluginFiles(swagger *openapi3.T, packageName string, opts Options) ([]PluginFile, error) {
+	if err := alterSpec(swagger, opts); err != nil {
+		return nil, err
+	}
+	return generatePluginFiles(swagger, packageName, opts)
+}
+
+// generatePluginFiles runs the plugins of GeneratePluginFiles for a spec which
+// alterSpec has altered already.
+func generatePluginFiles(swagger *openapi3.T, packageName string, opts Options) ([]PluginFile, error) {
+	defer stopNameCache()
+	if err := prepareSpec(swagger, opts); err != nil {
+		return nil, err
+	}
+
+	if err := initGlobalState(swagger, opts); err != nil {
+		return nil, err
+	}
+
+	ops, err := OperationDefinitions(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating operation definitions: %w", err)
+	}
+	types, err := generateTypesForComponents(nil, swagger, opts.ExcludeSchemas)
+	if err != nil {
+		return nil, err
+	}
+	request, err := json.Marshal(PluginRequest{
+		PackageName: packageName,
+		Operations:  ops,
+		Types:       types,
+		Spec:        swagger,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding plugin request: %w", err)
+	}
+
+	var files []PluginFile
+	for _, plugin := range opts.Plugins {
+		pluginFiles, err := runPlugin(plugin, request)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %q: %w", plugin, err)
+		}
+		files = append(files, pluginFiles...)
+	}
+	return files, nil
+}
+
+// runPlugin runs the command of a plugin with its request, and returns the
+// files of its response.
+func runPlugin(plugin string, request []byte) ([]PluginFile, error) {
+	output, err := runCommand(plugin, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response PluginResponse
+	if err := json.Unmarshal(output, &response); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+	for _, file := range response.Files {
+		// Plugins may only write files next to the generated code.
+		name := path.Clean(strings.ReplaceAll(file.Name, "\\", "/"))
+		if file.Name == "" || path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("invalid file name %q", file.Name)
+		}
+	}
+	return response.Files, nil
+}
+
+// runSpecPlugin runs the command of a spec plugin, which reads the spec as JSON
+// on its standard input, and writes it as it should be generated, as JSON too,
+// on its standard output, such as without its internal operations. The spec is
+// replaced with the one it writes, whose references must be local ones.
+func runSpecPlugin(plugin string, swagger *openapi3.T) error {
+	input, err := json.Marshal(swagger)
+	if err != nil {
+		return fmt.Errorf("error encoding spec: %w", err)
+	}
+	output, err := runCommand(plugin, input)
+	if err != nil {
+		return err
+	}
+	altered, err := openapi3.NewLoader().LoadFromData(output)
+	if err != nil {
+		return fmt.Errorf("error loading spec: %w", err)
+	}
+	*swagger = *altered
+	return nil
+}
+
+// runCommand runs a command line, whose arguments are separated by spaces,
+// with input on its standard input, and returns its standard output. When the
+// command fails, the error holds what it wrote to its standard error.
+func runCommand(command string, input []byte) ([]byte, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
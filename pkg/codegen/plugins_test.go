@@ -0,0 +1,152 @@
+package codegen
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The test binary is its own plugin and post-process command, as
+// TestPluginProcess.
+const testPluginEnv = "OAPI_CODEGEN_TEST_PLUGIN"
+
+func TestGeneratePluginFiles(t *testing.T) {
+	plugin := os.Args[0] + " -test.run=TestPluginProcess"
+
+	os.Setenv(testPluginEnv, "providers")
+	defer os.Unsetenv(testPluginEnv)
+
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+	files, err := GeneratePluginFiles(swagger, "testswagger", Options{
+		GenerateTypes: true,
+		Plugins:       []string{plugin},
+	})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "providers.gen.go", files[0].Name)
+	assert.Equal(t, "package testswagger\n\n// GetCatStatus\n// GetTestByName\n// Types: 6\n", files[0].Content)
+
+	os.Setenv(testPluginEnv, "fail")
+	_, err = GeneratePluginFiles(swagger, "testswagger", Options{Plugins: []string{plugin}})
+	assert.EqualError(t, err, fmt.Sprintf("plugin %q: exit status 1: no providers", plugin))
+
+	os.Setenv(testPluginEnv, "escape")
+	_, err = GeneratePluginFiles(swagger, "testswagger", Options{Plugins: []string{plugin}})
+	assert.EqualError(t, err, fmt.Sprintf(`plugin %q: invalid file name "../providers.gen.go"`, plugin))
+}
+
+func TestPostProcess(t *testing.T) {
+	os.Setenv(testPluginEnv, "post-process")
+	defer os.Unsetenv(testPluginEnv)
+
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateTypes: true,
+		PostProcess:   os.Args[0] + " -test.run=TestPluginProcess",
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(code, "// Post-processed.\n// Package testswagger"), code[:100])
+
+	os.Setenv(testPluginEnv, "fail")
+	_, err = Generate(swagger, "testswagger", Options{
+		GenerateTypes: true,
+		PostProcess:   os.Args[0] + " -test.run=TestPluginProcess",
+	})
+	assert.EqualError(t, err, fmt.Sprintf("error post-processing Go code with %q: exit status 1: no providers", os.Args[0]+" -test.run=TestPluginProcess"))
+}
+
+func TestSpecHook(t *testing.T) {
+	os.Setenv(testPluginEnv, "spec")
+	defer os.Unsetenv(testPluginEnv)
+
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+	code, err := Generate(swagger, "testswagger", Options{
+		GenerateClient: true,
+		SpecHook: func(swagger *openapi3.T) error {
+			delete(swagger.Paths, "/cat")
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, code, "GetCatStatus")
+	assert.Contains(t, code, "GetTestByName")
+
+	// Spec plugins alter the spec before the hook.
+	swagger, err = openapi3.NewLoader().LoadFromData([]byte(testOpenAPIDefinition))
+	require.NoError(t, err)
+	code, err = Generate(swagger, "testswagger", Options{
+		GenerateClient: true,
+		SpecPlugins:    []string{os.Args[0] + " -test.run=TestPluginProcess"},
+		SpecHook: func(swagger *openapi3.T) error {
+			if swagger.Paths["/test/{name}"] != nil {
+				return errors.New("/test/{name} is internal")
+			}
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Contains(t, code, "GetCatStatus")
+	assert.NotContains(t, code, "GetTestByName")
+
+	_, err = Generate(swagger, "testswagger", Options{
+		SpecHook: func(*openapi3.T) error { return errors.New("no cats") },
+	})
+	assert.EqualError(t, err, "error altering spec: no cats")
+}
+
+func TestPluginProcess(t *testing.T) {
+	mode := os.Getenv(testPluginEnv)
+	if mode == "" {
+		return
+	}
+	switch mode {
+	case "fail":
+		fmt.Fprintln(os.Stderr, "no providers")
+		os.Exit(1)
+	case "post-process":
+		fmt.Println("// Post-processed.")
+		_, _ = io.Copy(os.Stdout, os.Stdin)
+		os.Exit(0)
+	case "spec":
+		var swagger openapi3.T
+		if err := json.NewDecoder(os.Stdin).Decode(&swagger); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		delete(swagger.Paths, "/test/{name}")
+		_ = json.NewEncoder(os.Stdout).Encode(&swagger)
+		os.Exit(0)
+	}
+
+	var request PluginRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&request); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var content strings.Builder
+	fmt.Fprintf(&content, "package %s\n\n", request.PackageName)
+	for _, op := range request.Operations {
+		fmt.Fprintf(&content, "// %s\n", op.OperationId)
+	}
+	fmt.Fprintf(&content, "// Types: %d\n", len(request.Types))
+
+	name := "providers.gen.go"
+	if mode == "escape" {
+		name = "../" + name
+	}
+	_ = json.NewEncoder(os.Stdout).Encode(PluginResponse{
+		Files: []PluginFile{{Name: name, Content: content.String()}},
+	})
+	os.Exit(0)
+}
@@ -0,0 +1,288 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// PropertyGeneratorDefinition describes the gopter generator of a type,
+// which generates values of its schema.
+type PropertyGeneratorDefinition struct {
+	TypeName  string
+	Generator string // The Go expression of the generator
+}
+
+// integerGenerators are the gopter generators of ranges of the integer types.
+var integerGenerators = map[string]string{
+	"int":    "gen.IntRange",
+	"int8":   "gen.Int8Range",
+	"int16":  "gen.Int16Range",
+	"int32":  "gen.Int32Range",
+	"int64":  "gen.Int64Range",
+	"uint":   "gen.UIntRange",
+	"uint8":  "gen.UInt8Range",
+	"uint16": "gen.UInt16Range",
+	"uint32": "gen.UInt32Range",
+	"uint64": "gen.UInt64Range",
+}
+
+// DescribePropertyGenerators describes the generators of the given types.
+// Values which can't be generated from the schemas, such as those of unions,
+// of additional properties or of types given by x-go-type, are the examples
+// of the schemas instead.
+func DescribePropertyGenerators(types []TypeDefinition) ([]PropertyGeneratorDefinition, error) {
+	g := propertyGenerators{names: make(map[string]bool)}
+	var unique []TypeDefinition
+	for _, td := range types {
+		if g.names[td.TypeName] || td.Schema.ProtoMessage {
+			continue
+		}
+		g.names[td.TypeName] = true
+		unique = append(unique, td)
+	}
+
+	var generators []PropertyGeneratorDefinition
+	for _, td := range unique {
+		generator, err := g.generator(td.Schema, td.TypeName)
+		if err != nil {
+			return nil, fmt.Errorf("error describing the generator of %s: %w", td.TypeName, err)
+		}
+		generators = append(generators, PropertyGeneratorDefinition{
+			TypeName:  td.TypeName,
+			Generator: generator,
+		})
+	}
+	return generators, nil
+}
+
+// propertyGenerators builds the expressions of generators, which refer to
+// the generators of the types with the given names.
+type propertyGenerators struct {
+	names map[string]bool
+}
+
+// generator returns the expression of a generator of values of the schema,
+// of the Go type goType, which is the type of the schema or a type defined
+// from it.
+func (g propertyGenerators) generator(s Schema, goType string) (string, error) {
+	generator, resultType, err := g.schemaGenerator(s, goType)
+	if err != nil {
+		return "", err
+	}
+	if generator == "" {
+		return g.exampleGenerator(s, goType)
+	}
+	if resultType != goType {
+		generator += fmt.Sprintf(".Map(func(v %s) %s {\nreturn %s(v)\n})", resultType, goType, goType)
+	}
+	return generator, nil
+}
+
+// schemaGenerator returns the expression of a generator of values of the
+// schema, and the type of its values, which is goType for enums and objects
+// and the type of the schema otherwise. It returns nothing when the schema
+// can't be generated.
+func (g propertyGenerators) schemaGenerator(s Schema, goType string) (string, string, error) {
+	o := s.OAPISchema
+	switch {
+	case g.names[s.TypeDecl()]:
+		// Types are generated lazily, as they may refer to themselves.
+		name := s.TypeDecl()
+		return fmt.Sprintf("generators.Lazy(Gen%s)", name), name, nil
+	case s.IsRef():
+		return "", "", nil
+	case o == nil || s.AliasOnly:
+		return "", "", nil
+	case len(o.Enum) > 0:
+		values := make([]string, 0, len(o.Enum))
+		for _, v := range o.Enum {
+			literal, err := json.Marshal(v)
+			if err != nil || v == nil {
+				return "", "", nil
+			}
+			values = append(values, fmt.Sprintf("%s(%s)", goType, literal))
+		}
+		return fmt.Sprintf("gen.OneConstOf(%s)", strings.Join(values, ", ")), goType, nil
+	case s.ArrayType != nil:
+		elem, err := g.generator(*s.ArrayType, s.ArrayType.TypeDecl())
+		if err != nil {
+			return "", "", err
+		}
+		return fmt.Sprintf("generators.Slice(%d, %d, %s, reflect.TypeOf(%s(nil)))",
+			o.MinItems, maxLength(o.MaxItems), elem, s.GoType), s.GoType, nil
+	case len(s.Properties) > 0 && !s.HasAdditionalProperties && strings.HasPrefix(s.GoType, "struct"):
+		var fields []string
+		for _, p := range s.Properties {
+			field, err := g.generator(p.Schema, p.Schema.TypeDecl())
+			if err != nil {
+				return "", "", err
+			}
+			if strings.HasPrefix(p.GoTypeDef(), "*") {
+				field = fmt.Sprintf("generators.Ptr(%s, reflect.TypeOf((*%s)(nil)))", field, p.Schema.TypeDecl())
+			}
+			fields = append(fields, fmt.Sprintf("%q: %s,", p.structFieldName(), field))
+		}
+		return fmt.Sprintf("gen.Struct(reflect.TypeOf(%s{}), map[string]gopter.Gen{\n%s\n})", goType, strings.Join(fields, "\n")), goType, nil
+	}
+
+	generator := primitiveGenerator(s)
+	if generator == "" {
+		return "", "", nil
+	}
+	return generator, s.GoType, nil
+}
+
+// primitiveGenerator returns the expression of a generator of values of a
+// schema of a type other than an object or array, or nothing when it can't
+// be generated.
+func primitiveGenerator(s Schema) string {
+	o := s.OAPISchema
+	if generator, found := integerGenerators[s.GoType]; found && o.MultipleOf == nil {
+		lo, hi := integerBounds(o, strings.HasPrefix(s.GoType, "uint"))
+		return fmt.Sprintf("%s(%d, %d)", generator, lo, hi)
+	}
+	switch s.GoType {
+	case "float32", "float64":
+		if o.MultipleOf != nil {
+			return ""
+		}
+		lo, hi := numberBounds(o, s.GoType == "float32")
+		bits := 64
+		if s.GoType == "float32" {
+			bits = 32
+		}
+		return fmt.Sprintf("gen.Float%dRange(%s, %s)", bits,
+			strconv.FormatFloat(lo, 'g', -1, bits), strconv.FormatFloat(hi, 'g', -1, bits))
+	case "bool":
+		return "gen.Bool()"
+	case "string":
+		if o.Pattern != "" {
+			return fmt.Sprintf("gen.RegexMatch(%q)", o.Pattern)
+		}
+		if _, found := exampleStrings[o.Format]; found {
+			return ""
+		}
+		return fmt.Sprintf("generators.String(%d, %d)", o.MinLength, maxLength(o.MaxLength))
+	case "[]byte":
+		return "generators.Slice(0, -1, gen.UInt8(), reflect.TypeOf([]byte(nil)))"
+	case "openapi_types.Date":
+		return "generators.Date()"
+	case "time.Time":
+		return "generators.DateTime()"
+	case "openapi_types.UUID":
+		return "generators.UUID()"
+	case "openapi_types.Email":
+		return "generators.Email()"
+	}
+	return ""
+}
+
+// exampleGenerator returns the expression of the generator of the example of
+// a schema, for the schemas which can't be generated otherwise.
+func (g propertyGenerators) exampleGenerator(s Schema, goType string) (string, error) {
+	var example interface{}
+	if s.OAPISchema != nil {
+		example = exampleValue(&openapi3.SchemaRef{Value: s.OAPISchema}, 0, true)
+	}
+	data, err := json.Marshal(example)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling example: %w", err)
+	}
+	return fmt.Sprintf("generators.JSON(%q, new(%s))", data, goType), nil
+}
+
+// maxLength returns a maximum length of a schema, or -1 when there's none.
+func maxLength(max *uint64) int64 {
+	if max == nil {
+		return -1
+	}
+	return int64(*max)
+}
+
+// integerBounds returns the range of the integers of a schema, which is
+// 100 wide on the sides the schema doesn't bound.
+func integerBounds(o *openapi3.Schema, unsigned bool) (int64, int64) {
+	lo, hi := int64(-100), int64(100)
+	if unsigned {
+		lo = 0
+	}
+	if o.Min != nil {
+		lo = int64(math.Ceil(*o.Min))
+		if o.ExclusiveMin && float64(lo) == *o.Min {
+			lo++
+		}
+		hi = lo + 100
+	}
+	if o.Max != nil {
+		hi = int64(math.Floor(*o.Max))
+		if o.ExclusiveMax && float64(hi) == *o.Max {
+			hi--
+		}
+		if o.Min == nil {
+			lo = hi - 100
+			if unsigned && lo < 0 {
+				lo = 0
+			}
+		}
+	}
+	return lo, hi
+}
+
+// numberBounds returns the range of the numbers of a schema, which is 100
+// wide on the sides the schema doesn't bound. Exclusive bounds are moved
+// inside by the smallest step.
+func numberBounds(o *openapi3.Schema, single bool) (float64, float64) {
+	next := func(x, toward float64) float64 {
+		if single {
+			return float64(math.Nextafter32(float32(x), float32(toward)))
+		}
+		return math.Nextafter(x, toward)
+	}
+	lo, hi := -100.0, 100.0
+	if o.Min != nil {
+		lo = *o.Min
+		if o.ExclusiveMin {
+			lo = next(lo, math.Inf(1))
+		}
+		hi = lo + 100
+	}
+	if o.Max != nil {
+		hi = *o.Max
+		if o.ExclusiveMax {
+			hi = next(hi, math.Inf(-1))
+		}
+		if o.Min == nil {
+			lo = hi - 100
+		}
+	}
+	return lo, hi
+}
+
+// GeneratePropertyGenerators generates a gopter generator, GenFoo, of every
+// one of the given types.
+func GeneratePropertyGenerators(t *template.Template, types []TypeDefinition) (string, error) {
+	generators, err := DescribePropertyGenerators(types)
+	if err != nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"property-generators.tmpl"}, t, generators)
+}
@@ -0,0 +1,59 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribePropertyGenerators(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Property Generators Test
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Thing:
+      type: object
+      required: [name, size]
+      properties:
+        name:
+          type: string
+          maxLength: 5
+        size:
+          type: integer
+          minimum: 1
+          exclusiveMinimum: true
+        next:
+          $ref: '#/components/schemas/Thing'
+    Color:
+      type: string
+      enum: [red, blue]
+    Any:
+      oneOf:
+        - type: string
+        - type: integer
+      example: 3
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	types, err := GenerateTypesForSchemas(nil, swagger.Components.Schemas, nil)
+	require.NoError(t, err)
+
+	generators, err := DescribePropertyGenerators(types)
+	require.NoError(t, err)
+	byName := make(map[string]string)
+	for _, g := range generators {
+		byName[g.TypeName] = g.Generator
+	}
+
+	assert.Equal(t, `generators.JSON("3", new(Any))`, byName["Any"])
+	assert.Equal(t, `gen.OneConstOf(Color("red"), Color("blue"))`, byName["Color"])
+	assert.Contains(t, byName["Thing"], `"Name": generators.String(0, 5),`)
+	assert.Contains(t, byName["Thing"], `"Size": gen.IntRange(2, 102),`)
+	assert.Contains(t, byName["Thing"], `"Next": generators.Ptr(generators.Lazy(GenThing), reflect.TypeOf((*Thing)(nil))),`)
+}
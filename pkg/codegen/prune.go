@@ -2,6 +2,7 @@ package codegen
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 )
@@ -26,12 +27,16 @@ func walkSwagger(swagger *openapi3.T, doFn func(RefWrapper) (bool, error)) error
 		return nil
 	}
 
-	for _, p := range swagger.Paths {
-		for _, param := range p.Parameters {
-			walkParameterRef(param, doFn)
-		}
-		for _, op := range p.Operations() {
-			walkOperation(op, doFn)
+	// Invalid webhooks are reported when they're described.
+	webhooks, _ := webhookItems(swagger)
+	for _, paths := range []openapi3.Paths{swagger.Paths, webhooks} {
+		for _, p := range paths {
+			for _, param := range p.Parameters {
+				walkParameterRef(param, doFn)
+			}
+			for _, op := range p.Operations() {
+				walkOperation(op, doFn)
+			}
 		}
 	}
 
@@ -472,6 +477,86 @@ func removeOrphanedComponents(swagger *openapi3.T, refs []string) int {
 	return countRemoved
 }
 
+// findReachableComponentRefs returns the references to the components which
+// the operations of the spec use, either directly or through other components.
+// Unlike the references found by findComponentRefs, those of components which
+// are only used by unused components, such as cycles of schemas, aren't
+// included.
+func findReachableComponentRefs(swagger *openapi3.T) []string {
+	refs := []string{}
+	seen := make(map[string]bool)
+	var pending []string
+	collect := func(ref RefWrapper) (bool, error) {
+		if ref.Ref == "" {
+			return true, nil
+		}
+		if !seen[ref.Ref] {
+			seen[ref.Ref] = true
+			refs = append(refs, ref.Ref)
+			pending = append(pending, ref.Ref)
+		}
+		return false, nil
+	}
+
+	// Invalid webhooks are reported when they're described.
+	webhooks, _ := webhookItems(swagger)
+	for _, paths := range []openapi3.Paths{swagger.Paths, webhooks} {
+		for _, p := range paths {
+			for _, param := range p.Parameters {
+				_ = walkParameterRef(param, collect)
+			}
+			for _, op := range p.Operations() {
+				_ = walkOperation(op, collect)
+			}
+		}
+	}
+	for len(pending) > 0 {
+		ref := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		walkComponent(&swagger.Components, ref, collect)
+	}
+	return refs
+}
+
+// walkComponent walks the local component which ref refers to. References to
+// other documents are ignored.
+func walkComponent(components *openapi3.Components, ref string, doFn func(RefWrapper) (bool, error)) {
+	parts := strings.Split(ref, "/")
+	if len(parts) != 4 || parts[0] != "#" || parts[1] != "components" {
+		return
+	}
+	name := parts[3]
+	switch parts[2] {
+	case "schemas":
+		_ = walkSchemaRef(components.Schemas[name], doFn)
+	case "parameters":
+		_ = walkParameterRef(components.Parameters[name], doFn)
+	case "headers":
+		_ = walkHeaderRef(components.Headers[name], doFn)
+	case "requestBodies":
+		_ = walkRequestBodyRef(components.RequestBodies[name], doFn)
+	case "responses":
+		_ = walkResponseRef(components.Responses[name], doFn)
+	case "examples":
+		_ = walkExampleRef(components.Examples[name], doFn)
+	case "links":
+		_ = walkLinkRef(components.Links[name], doFn)
+	case "callbacks":
+		_ = walkCallbackRef(components.Callbacks[name], doFn)
+	}
+}
+
+// pruneComponents prunes the components of the spec as the options say.
+func pruneComponents(swagger *openapi3.T, opts Options) {
+	switch {
+	case opts.SkipPrune:
+	case opts.PruneUnreachable:
+		pruneUnreachableComponents(swagger)
+	default:
+		pruneUnusedComponents(swagger)
+	}
+}
+
 func pruneUnusedComponents(swagger *openapi3.T) {
 	for {
 		refs := findComponentRefs(swagger)
@@ -481,3 +566,11 @@ func pruneUnusedComponents(swagger *openapi3.T) {
 		}
 	}
 }
+
+// pruneUnreachableComponents removes the components which aren't used by the
+// operations of the spec, which are walked first, so only the components they
+// reference, transitively, are kept, unlike the cycles of components which
+// pruneUnusedComponents keeps.
+func pruneUnreachableComponents(swagger *openapi3.T) {
+	removeOrphanedComponents(swagger, findReachableComponentRefs(swagger))
+}
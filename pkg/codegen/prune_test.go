@@ -98,6 +98,66 @@ func TestFilterOnlyDog(t *testing.T) {
 	assert.Len(t, swagger.Components.Schemas, 3)
 }
 
+func TestPruningUnreachableCycles(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Cycles
+  version: 1.0.0
+paths:
+  /trees:
+    get:
+      responses:
+        200:
+          description: a tree
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Tree'
+components:
+  schemas:
+    Tree:
+      type: object
+      properties:
+        children:
+          type: array
+          items:
+            $ref: '#/components/schemas/Tree'
+        leaf:
+          $ref: '#/components/schemas/Leaf'
+    Leaf:
+      type: string
+    Node:
+      type: object
+      properties:
+        next:
+          $ref: '#/components/schemas/Node'
+    Parent:
+      type: object
+      properties:
+        child:
+          $ref: '#/components/schemas/Child'
+    Child:
+      type: object
+      properties:
+        parent:
+          $ref: '#/components/schemas/Parent'
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	assert.NoError(t, err)
+
+	// Cycles of schemas are referenced, so they're kept by default.
+	pruneComponents(swagger, Options{})
+	assert.Len(t, swagger.Components.Schemas, 5)
+
+	// Only the schemas reachable from the operations remain, even though the
+	// others reference each other.
+	pruneComponents(swagger, Options{PruneUnreachable: true})
+	assert.Len(t, swagger.Components.Schemas, 2)
+	assert.Contains(t, swagger.Components.Schemas, "Tree")
+	assert.Contains(t, swagger.Components.Schemas, "Leaf")
+}
+
 func TestPruningUnusedComponents(t *testing.T) {
 	// Get a spec from the test definition in this file:
 	swagger, err := openapi3.NewLoader().LoadFromData([]byte(pruneComprehensiveTestFixture))
@@ -0,0 +1,157 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// SpecRedaction says what's stripped from the spec embedded in the generated
+// code, which GetSwagger returns and servers may publish, while code is still
+// generated for all of it, such as for operations only served in-cluster.
+type SpecRedaction struct {
+	Internal    bool     // Whether to strip the operations and component schemas marked x-internal: true
+	IncludeTags []string // Only keep the operations that have one of these tags. Ignored when empty.
+	ExcludeTags []string // Strip the operations that have one of these tags.
+}
+
+// isZero returns whether the redaction strips nothing.
+func (r SpecRedaction) isZero() bool {
+	return !r.Internal && len(r.IncludeTags) == 0 && len(r.ExcludeTags) == 0
+}
+
+// isInternal returns whether the extensions mark an element as internal.
+func isInternal(extensions map[string]interface{}) (bool, error) {
+	extension, ok := extensions[extPropInternal]
+	if !ok {
+		return false, nil
+	}
+	internal, err := extParseInternal(extension)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %q: %w", extPropInternal, err)
+	}
+	return internal, nil
+}
+
+// redactSpec returns a copy of the spec without what the redaction strips.
+// The paths left without operations are removed, and the components are
+// pruned as the options say. The copy shares what it keeps with the spec,
+// which is left untouched.
+func redactSpec(swagger *openapi3.T, redaction SpecRedaction, opts Options) (*openapi3.T, error) {
+	redacted := *swagger
+	redacted.Paths = make(openapi3.Paths, len(swagger.Paths))
+	for requestPath, pathItem := range swagger.Paths {
+		item := *pathItem
+		redacted.Paths[requestPath] = &item
+	}
+	redacted.Components = copyComponents(swagger.Components)
+
+	if len(redaction.ExcludeTags) > 0 {
+		excludeOperationsWithTags(redacted.Paths, redaction.ExcludeTags)
+	}
+	if len(redaction.IncludeTags) > 0 {
+		includeOperationsWithTags(redacted.Paths, redaction.IncludeTags, false)
+	}
+
+	var internalSchemas []string
+	if redaction.Internal {
+		for requestPath, pathItem := range redacted.Paths {
+			for method, op := range pathItem.Operations() {
+				internal, err := isInternal(op.Extensions)
+				if err != nil {
+					return nil, fmt.Errorf("operation %s %s: %w", method, requestPath, err)
+				}
+				if internal {
+					pathItem.SetOperation(method, nil)
+				}
+			}
+		}
+		for name, schema := range redacted.Components.Schemas {
+			if schema.Value == nil {
+				continue
+			}
+			internal, err := isInternal(schema.Value.Extensions)
+			if err != nil {
+				return nil, fmt.Errorf("schema %s: %w", name, err)
+			}
+			if internal {
+				delete(redacted.Components.Schemas, name)
+				internalSchemas = append(internalSchemas, name)
+			}
+		}
+	}
+
+	for requestPath, pathItem := range redacted.Paths {
+		if len(pathItem.Operations()) == 0 {
+			delete(redacted.Paths, requestPath)
+		}
+	}
+
+	// Internal schemas can't be stripped while the operations left refer to
+	// them, as the embedded spec wouldn't load.
+	if len(internalSchemas) > 0 {
+		refs := findReachableComponentRefs(&redacted)
+		sort.Strings(internalSchemas)
+		for _, name := range internalSchemas {
+			if stringInSlice("#/components/schemas/"+name, refs) {
+				return nil, fmt.Errorf("schema %s is marked %s, but used by operations left in the embedded spec", name, extPropInternal)
+			}
+		}
+	}
+
+	pruneComponents(&redacted, opts)
+	return &redacted, nil
+}
+
+// copyComponents returns a copy of the components, whose maps can be altered
+// without altering theirs.
+func copyComponents(components openapi3.Components) openapi3.Components {
+	copied := components
+	copied.Schemas = make(openapi3.Schemas, len(components.Schemas))
+	for name, schema := range components.Schemas {
+		copied.Schemas[name] = schema
+	}
+	copied.Parameters = make(openapi3.ParametersMap, len(components.Parameters))
+	for name, parameter := range components.Parameters {
+		copied.Parameters[name] = parameter
+	}
+	copied.RequestBodies = make(openapi3.RequestBodies, len(components.RequestBodies))
+	for name, body := range components.RequestBodies {
+		copied.RequestBodies[name] = body
+	}
+	copied.Responses = make(openapi3.Responses, len(components.Responses))
+	for name, response := range components.Responses {
+		copied.Responses[name] = response
+	}
+	copied.Headers = make(openapi3.Headers, len(components.Headers))
+	for name, header := range components.Headers {
+		copied.Headers[name] = header
+	}
+	copied.Examples = make(openapi3.Examples, len(components.Examples))
+	for name, example := range components.Examples {
+		copied.Examples[name] = example
+	}
+	copied.Links = make(openapi3.Links, len(components.Links))
+	for name, link := range components.Links {
+		copied.Links[name] = link
+	}
+	copied.Callbacks = make(openapi3.Callbacks, len(components.Callbacks))
+	for name, callback := range components.Callbacks {
+		copied.Callbacks[name] = callback
+	}
+	return copied
+}
@@ -0,0 +1,82 @@
+package codegen
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const redactSpecTestFixture = `
+openapi: 3.0.1
+info:
+  title: Redaction
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      tags: [pets]
+      responses:
+        200:
+          description: the pets
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    delete:
+      operationId: deletePets
+      tags: [admin]
+      responses:
+        204:
+          description: deleted
+  /debug:
+    get:
+      operationId: debug
+      x-internal: true
+      responses:
+        200:
+          description: the state
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/State'
+components:
+  schemas:
+    Pet:
+      type: object
+    State:
+      type: object
+    Secret:
+      type: object
+      x-internal: true
+`
+
+func TestRedactSpec(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(redactSpecTestFixture))
+	require.NoError(t, err)
+
+	redacted, err := redactSpec(swagger, SpecRedaction{Internal: true, ExcludeTags: []string{"admin"}}, Options{})
+	require.NoError(t, err)
+	assert.Len(t, redacted.Paths, 1)
+	assert.NotNil(t, redacted.Paths["/pets"].Get)
+	assert.Nil(t, redacted.Paths["/pets"].Delete)
+	assert.Len(t, redacted.Components.Schemas, 1)
+	assert.Contains(t, redacted.Components.Schemas, "Pet")
+
+	// The spec code is generated for is left untouched.
+	assert.Len(t, swagger.Paths, 2)
+	assert.NotNil(t, swagger.Paths["/pets"].Delete)
+	assert.Len(t, swagger.Components.Schemas, 3)
+
+	redacted, err = redactSpec(swagger, SpecRedaction{IncludeTags: []string{"pets"}}, Options{SkipPrune: true})
+	require.NoError(t, err)
+	assert.Len(t, redacted.Paths, 1)
+	assert.Len(t, redacted.Components.Schemas, 3)
+
+	swagger.Components.Schemas["Pet"].Value.Extensions[extPropInternal] = json.RawMessage("true")
+	_, err = redactSpec(swagger, SpecRedaction{Internal: true}, Options{})
+	assert.EqualError(t, err, "schema Pet is marked x-internal, but used by operations left in the embedded spec")
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"runtime/metrics"
+	"sync"
+	"time"
+)
+
+// GenerationStats are the statistics of the generation of a package, which
+// Options.ReportGeneration is called with, for the generation report.
+type GenerationStats struct {
+	Package  string        // The name of the package
+	Size     int           // The size of its code, in bytes
+	Duration time.Duration // How long it took to generate and format it
+	PeakHeap uint64        // The largest size of the heap seen while generating it, in bytes
+}
+
+func (s GenerationStats) String() string {
+	return fmt.Sprintf("%s: %d bytes in %s, peak heap %.1f MiB", s.Package, s.Size, s.Duration.Round(time.Millisecond), float64(s.PeakHeap)/(1<<20))
+}
+
+// heapMetric is the size of the heap's objects, which, unlike
+// runtime.ReadMemStats, can be read without stopping the world.
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// heapSampleInterval is how often the size of the heap is sampled.
+const heapSampleInterval = 5 * time.Millisecond
+
+// heapSampler samples the size of the heap in the background, keeping the
+// largest one.
+type heapSampler struct {
+	mu      sync.Mutex
+	peak    uint64
+	once    sync.Once
+	stopped chan struct{}
+	done    chan struct{}
+}
+
+// startHeapSampler starts sampling the size of the heap until stop is called.
+func startHeapSampler() *heapSampler {
+	s := &heapSampler{stopped: make(chan struct{}), done: make(chan struct{})}
+	s.sample()
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(heapSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.sample()
+			case <-s.stopped:
+				return
+			}
+		}
+	}()
+	return s
+}
+
+// sample reads the size of the heap, which can be called at the points where
+// it's likely to peak, between two ticks.
+func (s *heapSampler) sample() {
+	samples := []metrics.Sample{{Name: heapMetric}}
+	metrics.Read(samples)
+	if samples[0].Value.Kind() != metrics.KindUint64 {
+		return
+	}
+	size := samples[0].Value.Uint64()
+	s.mu.Lock()
+	if size > s.peak {
+		s.peak = size
+	}
+	s.mu.Unlock()
+}
+
+// stop stops sampling, if it isn't stopped yet, and returns the peak size of
+// the heap.
+func (s *heapSampler) stop() uint64 {
+	s.once.Do(func() { close(s.stopped) })
+	<-s.done
+	s.sample()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.peak
+}
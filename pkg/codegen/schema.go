@@ -3,19 +3,31 @@ package codegen
 import (
 	"errors"
 	"fmt"
+	"mime"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
 )
 
 // This describes a Schema, a type definition.
+//
+// Schema, TypeDefinition, ResponseTypeDefinition, Property,
+// OperationDefinition, ParameterDefinition and RequestBodyDefinition are the
+// data model of the templates, which user templates and plugins rely on. Their
+// shape is stable: within a major version, fields and methods are only added,
+// never removed or changed.
 type Schema struct {
 	GoType  string // The Go type needed to represent the schema
 	RefType string // If the type has a type name, this is set
 
 	ArrayType *Schema // The schema of array element
 
-	EnumValues map[string]string // Enum values
+	EnumValues  map[string]string // Enum values
+	EnumAliases map[string]string // The names of the enum constants, by the names of their SCREAMING_SNAKE_CASE aliases
 
 	Properties               []Property       // For an object, the fields with names
 	HasAdditionalProperties  bool             // Whether we support additional properties
@@ -24,16 +36,24 @@ type Schema struct {
 
 	SkipOptionalPointer bool // Some types don't need a * in front when they're optional
 
+	ProtoMessage bool // Whether this is a protobuf message type, which must be aliased rather than redefined
+	AliasOnly    bool // Whether the type must be aliased rather than redefined, which would drop its methods, as LayoutDate
+
 	Description string // The description of the element
 
+	Extensions map[string]interface{} // The x- extensions of the schema, by name, with their decoded values
+
 	// The original OpenAPIv3 Schema.
 	OAPISchema *openapi3.Schema
 }
 
+// IsRef returns whether the schema is a reference to a named type.
 func (s Schema) IsRef() bool {
 	return s.RefType != ""
 }
 
+// TypeDecl returns the Go type used to declare values of the schema, which is
+// the name of the type it refers to, if any.
 func (s Schema) TypeDecl() string {
 	if s.IsRef() {
 		return s.RefType
@@ -57,6 +77,8 @@ func (s *Schema) AddProperty(p Property) error {
 	return nil
 }
 
+// GetAdditionalTypeDefs returns the auxiliary types of the schema and of its
+// properties.
 func (s Schema) GetAdditionalTypeDefs() []TypeDefinition {
 	var result []TypeDefinition
 	for _, p := range s.Properties {
@@ -66,6 +88,8 @@ func (s Schema) GetAdditionalTypeDefs() []TypeDefinition {
 	return result
 }
 
+// Property is a property of an object schema, which the templates declare as a
+// field of its struct.
 type Property struct {
 	Description    string
 	JsonFieldName  string
@@ -75,12 +99,44 @@ type Property struct {
 	ReadOnly       bool
 	WriteOnly      bool
 	ExtensionProps *openapi3.ExtensionProps
+
+	// isParam is set for properties of generated parameter structs, which
+	// are never encoded as a body.
+	isParam bool
 }
 
 func (p Property) GoFieldName() string {
 	return SchemaNameToTypeName(p.JsonFieldName)
 }
 
+// sensitive returns whether the x-sensitive extension marks the property as
+// holding a secret, which is masked when its struct is formatted or logged.
+func (p Property) sensitive() (bool, error) {
+	if p.ExtensionProps == nil {
+		return false, nil
+	}
+	extension, ok := p.ExtensionProps.Extensions[extPropSensitive]
+	if !ok {
+		return false, nil
+	}
+	sensitive, err := extParseSensitive(extension)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %q of property %s: %w", extPropSensitive, p.JsonFieldName, err)
+	}
+	return sensitive, nil
+}
+
+// structFieldName returns the name of the field of the property in structs,
+// which the x-go-name extension can give.
+func (p Property) structFieldName() string {
+	if _, ok := p.ExtensionProps.Extensions[extGoFieldName]; ok {
+		if extGoFieldName, err := extParseGoFieldName(p.ExtensionProps.Extensions[extGoFieldName]); err == nil {
+			return extGoFieldName
+		}
+	}
+	return p.GoFieldName()
+}
+
 func (p Property) GoTypeDef() string {
 	typeDef := p.Schema.TypeDecl()
 	if !p.Schema.SkipOptionalPointer &&
@@ -115,6 +171,8 @@ type Constants struct {
 //      properties:
 //      name:
 //        type: string
+//
+// The type templates declare it as "type {{.TypeName}} {{.Schema.TypeDecl}}".
 type TypeDefinition struct {
 	// The name of the type, eg, type <...> Person
 	TypeName string
@@ -128,7 +186,8 @@ type TypeDefinition struct {
 }
 
 // ResponseTypeDefinition is an extension of TypeDefinition, specifically for
-// response unmarshaling in ClientWithResponses.
+// response unmarshaling in ClientWithResponses. The response types have a field
+// of each of them, holding the decoded body of a response and media type.
 type ResponseTypeDefinition struct {
 	TypeDefinition
 	// The content type name where this is used, eg, application/json
@@ -136,8 +195,12 @@ type ResponseTypeDefinition struct {
 
 	// The type name of a response model.
 	ResponseName string
+
+	// The x- extensions of the response, by name, with their decoded values
+	Extensions map[string]interface{}
 }
 
+// CanAlias returns whether the type can be an alias of the type it refers to.
 func (t *TypeDefinition) CanAlias() bool {
 	return t.Schema.IsRef() || /* actual reference */
 		(t.Schema.ArrayType != nil && t.Schema.ArrayType.IsRef()) /* array to ref */
@@ -147,7 +210,56 @@ func PropertiesEqual(a, b Property) bool {
 	return a.JsonFieldName == b.JsonFieldName && a.Schema.TypeDecl() == b.Schema.TypeDecl() && a.Required == b.Required
 }
 
+// excludedSchemaGoType returns the custom Go type of the excluded component
+// schema which sref refers to, if it has one.
+func excludedSchemaGoType(sref *openapi3.SchemaRef) (string, bool, error) {
+	name := strings.TrimPrefix(sref.Ref, "#/components/schemas/")
+	if name == sref.Ref || sref.Value == nil || !schemaExcluded(name, options.ExcludeSchemas) {
+		return "", false, nil
+	}
+	extension, ok := sref.Value.Extensions[extPropGoType]
+	if !ok {
+		return "", false, nil
+	}
+	typeName, err := extTypeName(extension)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid value for %q in excluded schema %s: %w", extPropGoType, name, err)
+	}
+	return typeName, true, nil
+}
+
+// SchemaGenerator generates the Go schema of a schema of the spec, whose path
+// is that of the names of the types and properties it's nested in, as
+// GenerateGoSchema.
+type SchemaGenerator func(sref *openapi3.SchemaRef, path []string) (Schema, error)
+
+// SchemaTransformer wraps the generation of Go schemas, to inspect or alter
+// the Schema which next generates for every schema, such as to follow
+// organization-specific conventions. Nested schemas, such as properties, are
+// generated through the transformers too. As schemas are generated in
+// parallel, transformers may be called concurrently.
+type SchemaTransformer func(next SchemaGenerator) SchemaGenerator
+
+// GenerateGoSchema generates the Go schema of a schema of the spec, through
+// the SchemaTransformers of the options, the first of which is the outermost.
 func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+	return generateSchema(sref, path, false)
+}
+
+// generateSchema generates the Go schema of a schema like GenerateGoSchema,
+// with strings of format binary being files, rather than strings, when
+// binaryAsFile is set, as they are in multipart bodies.
+func generateSchema(sref *openapi3.SchemaRef, path []string, binaryAsFile bool) (Schema, error) {
+	generate := SchemaGenerator(func(sref *openapi3.SchemaRef, path []string) (Schema, error) {
+		return generateGoSchema(sref, path, binaryAsFile)
+	})
+	for i := len(options.SchemaTransformers) - 1; i >= 0; i-- {
+		generate = options.SchemaTransformers[i](generate)
+	}
+	return generate(sref, path)
+}
+
+func generateGoSchema(sref *openapi3.SchemaRef, path []string, binaryAsFile bool) (Schema, error) {
 	// Add a fallback value in case the sref is nil.
 	// i.e. the parent schema defines a type:array, but the array has
 	// no items defined. Therefore we have at least valid Go-Code.
@@ -160,6 +272,16 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// If Ref is set on the SchemaRef, it means that this type is actually a reference to
 	// another type. We're not de-referencing, so simply use the referenced type.
 	if IsGoTypeReference(sref.Ref) {
+		// Excluded schemas aren't generated, so those with a custom Go type
+		// are referred to by it.
+		if goType, ok, err := excludedSchemaGoType(sref); ok || err != nil {
+			return Schema{
+				GoType:      goType,
+				Description: StringToGoComment(schema.Description),
+				Extensions:  extensionValues(schema.ExtensionProps),
+			}, err
+		}
+
 		// Convert the reference path to Go type
 		refType, err := RefPathToGoType(sref.Ref)
 		if err != nil {
@@ -169,11 +291,13 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		return Schema{
 			GoType:      refType,
 			Description: StringToGoComment(schema.Description),
+			Extensions:  extensionValues(schema.ExtensionProps),
 		}, nil
 	}
 
 	outSchema := Schema{
 		Description: StringToGoComment(schema.Description),
+		Extensions:  extensionValues(schema.ExtensionProps),
 		OAPISchema:  schema,
 	}
 
@@ -193,11 +317,12 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 	// so that in a RESTful paradigm, the Create operation can return
 	// (object, id), so that other operations can refer to (id)
 	if schema.AllOf != nil {
-		mergedSchema, err := MergeSchemas(schema.AllOf, path)
+		mergedSchema, err := mergeSchemasWith(schema.AllOf, path, binaryAsFile)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error merging schemas: %w", err)
 		}
 		mergedSchema.OAPISchema = schema
+		mergedSchema.Extensions = extensionValues(schema.ExtensionProps)
 		return mergedSchema, nil
 	}
 
@@ -235,7 +360,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			for _, pName := range SortedSchemaKeys(schema.Properties) {
 				p := schema.Properties[pName]
 				propertyPath := append(path, pName)
-				pSchema, err := GenerateGoSchema(p, propertyPath)
+				pSchema, err := generateSchema(p, propertyPath, binaryAsFile)
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating Go schema for property '%s': %w", pName, err)
 				}
@@ -280,7 +405,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 				GoType: "interface{}",
 			}
 			if schema.AdditionalProperties != nil {
-				additionalSchema, err := GenerateGoSchema(schema.AdditionalProperties, path)
+				additionalSchema, err := generateSchema(schema.AdditionalProperties, path, binaryAsFile)
 				if err != nil {
 					return Schema{}, fmt.Errorf("error generating type for additional properties: %w", err)
 				}
@@ -291,7 +416,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		}
 		return outSchema, nil
 	} else if len(schema.Enum) > 0 {
-		err := resolveType(schema, path, &outSchema)
+		err := resolveType(schema, path, &outSchema, binaryAsFile)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
@@ -300,16 +425,38 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 			enumValues[i] = fmt.Sprintf("%v", enumValue)
 		}
 
+		naming, err := enumNaming(schema)
+		if err != nil {
+			return Schema{}, fmt.Errorf("invalid value for %q: %w", extPropEnumNaming, err)
+		}
+
 		sanitizedValues := SanitizeEnumNames(enumValues)
 		outSchema.EnumValues = make(map[string]string, len(sanitizedValues))
-		var constNamePath []string
-		for k, v := range sanitizedValues {
+		// The constants are named in the order of the values in the spec, so
+		// that names which clash are always numbered the same way.
+		names := SortedStringKeys(sanitizedValues)
+		sort.SliceStable(names, func(i, j int) bool {
+			return indexOf(enumValues, sanitizedValues[names[i]]) < indexOf(enumValues, sanitizedValues[names[j]])
+		})
+		for _, k := range names {
+			v := sanitizedValues[k]
+			valueName := k
 			if v == "" {
-				constNamePath = append(path, "Empty")
-			} else {
-				constNamePath = append(path, k)
+				valueName = "Empty"
+			}
+			constNamePath := append(path, valueName)
+			if naming == EnumNamingShort {
+				constNamePath = []string{valueName}
+			}
+			constName := uniqueEnumName(SchemaNameToTypeName(PathToTypeName(constNamePath)), 0, outSchema.EnumValues)
+			outSchema.EnumValues[constName] = v
+			if naming == EnumNamingScreamingSnake {
+				if outSchema.EnumAliases == nil {
+					outSchema.EnumAliases = map[string]string{}
+				}
+				alias := uniqueEnumName(ToScreamingSnakeCase(constName), 0, outSchema.EnumAliases)
+				outSchema.EnumAliases[alias] = constName
 			}
-			outSchema.EnumValues[SchemaNameToTypeName(PathToTypeName(constNamePath))] = v
 		}
 		if len(path) > 1 { // handle additional type only on non-toplevel types
 			typeName := SchemaNameToTypeName(PathToTypeName(path))
@@ -323,7 +470,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 		}
 		//outSchema.RefType = typeName
 	} else {
-		err := resolveType(schema, path, &outSchema)
+		err := resolveType(schema, path, &outSchema, binaryAsFile)
 		if err != nil {
 			return Schema{}, fmt.Errorf("error resolving primitive type: %w", err)
 		}
@@ -332,7 +479,7 @@ func GenerateGoSchema(sref *openapi3.SchemaRef, path []string) (Schema, error) {
 }
 
 // resolveType resolves primitive  type or array for schema
-func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema) error {
+func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema, binaryAsFile bool) error {
 	f := schema.Format
 	t := schema.Type
 
@@ -340,7 +487,7 @@ func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema) erro
 	case "array":
 		// For arrays, we'll get the type of the Items and throw a
 		// [] in front of it.
-		arrayType, err := GenerateGoSchema(schema.Items, path)
+		arrayType, err := generateSchema(schema.Items, path, binaryAsFile)
 		if err != nil {
 			return fmt.Errorf("error generating type for array: %w", err)
 		}
@@ -370,6 +517,11 @@ func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema) erro
 			outSchema.GoType = "uint8"
 		} else if f == "uint" {
 			outSchema.GoType = "uint"
+		} else if f == "bigint" {
+			// big.Int marshals with pointer receivers, so it's always used
+			// by pointer.
+			outSchema.GoType = "*big.Int"
+			outSchema.SkipOptionalPointer = true
 		} else if f == "" {
 			outSchema.GoType = "int"
 		} else {
@@ -396,15 +548,32 @@ func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema) erro
 			outSchema.GoType = "[]byte"
 		case "email":
 			outSchema.GoType = "openapi_types.Email"
+			if options.SkipEmailValidation {
+				outSchema.GoType = "string"
+			}
 		case "date":
 			outSchema.GoType = "openapi_types.Date"
+			if hasDateLayout() {
+				outSchema.GoType = "LayoutDate"
+				outSchema.AliasOnly = true
+			}
 		case "date-time":
 			outSchema.GoType = "time.Time"
+		case "duration":
+			outSchema.GoType = "openapi_types.Duration"
+			if options.DurationFormat == DurationFormatISO8601 {
+				outSchema.GoType = "openapi_types.ISODuration"
+			}
 		case "json":
 			outSchema.GoType = "json.RawMessage"
 			outSchema.SkipOptionalPointer = true
 		case "uuid":
 			outSchema.GoType = "openapi_types.UUID"
+		case "binary":
+			outSchema.GoType = "string"
+			if binaryAsFile {
+				outSchema.GoType = "openapi_types.File"
+			}
 		default:
 			// All unrecognized formats are simply a regular string.
 			outSchema.GoType = "string"
@@ -415,6 +584,46 @@ func resolveType(schema *openapi3.Schema, path []string, outSchema *Schema) erro
 	return nil
 }
 
+// The syntaxes of the DurationFormat option, in which the values of string
+// schemas of the duration format are written.
+const (
+	// DurationFormatGo writes durations as time.ParseDuration reads them, such
+	// as "1h30m0s", with openapi_types.Duration.
+	DurationFormatGo = "go"
+	// DurationFormatISO8601 writes durations in ISO 8601, such as "PT1H30M",
+	// with openapi_types.ISODuration.
+	DurationFormatISO8601 = "iso8601"
+)
+
+// checkDurationFormat checks the syntax of durations.
+func checkDurationFormat(format string) error {
+	switch format {
+	case "", DurationFormatGo, DurationFormatISO8601:
+		return nil
+	}
+	return fmt.Errorf("unknown duration format %q", format)
+}
+
+// checkDateLayout checks that the layout of dates writes the year, the month
+// and the day, so that dates read back as they were written.
+func checkDateLayout(layout string) error {
+	if layout == "" {
+		return nil
+	}
+	date := time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC)
+	parsed, err := time.Parse(layout, date.Format(layout))
+	if err != nil || !parsed.Equal(date) {
+		return fmt.Errorf("date layout %q doesn't write dates which read back as they were written, as \"20060102\" does", layout)
+	}
+	return nil
+}
+
+// hasDateLayout returns whether dates have a layout of their own, which
+// LayoutDate is generated for.
+func hasDateLayout() bool {
+	return options.DateLayout != "" && options.DateLayout != types.DateFormat
+}
+
 // This describes a Schema, a type definition.
 type SchemaDescriptor struct {
 	Fields                   []FieldDescriptor
@@ -446,14 +655,7 @@ func GenFieldsFromProperties(props []Property) []string {
 			field += fmt.Sprintf("%s\n", StringToGoComment(p.Description))
 		}
 
-		goFieldName := p.GoFieldName()
-		if _, ok := p.ExtensionProps.Extensions[extGoFieldName]; ok {
-			if extGoFieldName, err := extParseGoFieldName(p.ExtensionProps.Extensions[extGoFieldName]); err == nil {
-				goFieldName = extGoFieldName
-			}
-		}
-
-		field += fmt.Sprintf("    %s %s", goFieldName, p.GoTypeDef())
+		field += fmt.Sprintf("    %s %s", p.structFieldName(), p.GoTypeDef())
 
 		// Support x-omitempty
 		omitEmpty := true
@@ -467,9 +669,19 @@ func GenFieldsFromProperties(props []Property) []string {
 
 		if (p.Required && !p.ReadOnly && !p.WriteOnly) || p.Nullable || !omitEmpty {
 			fieldTags["json"] = p.JsonFieldName
+			omitEmpty = false
 		} else {
 			fieldTags["json"] = p.JsonFieldName + ",omitempty"
 		}
+		if generateXMLTags && !p.isParam {
+			fieldTags["xml"] = xmlFieldTag(p, omitEmpty)
+		}
+		if generateYAMLTags && !p.isParam {
+			fieldTags["yaml"] = fieldTags["json"]
+		}
+		if generateMsgpackTags && !p.isParam {
+			fieldTags["msgpack"] = fieldTags["json"]
+		}
 		if extension, ok := p.ExtensionProps.Extensions[extPropExtraTags]; ok {
 			if tags, err := extExtraTags(extension); err == nil {
 				keys := SortedStringKeys(tags)
@@ -490,6 +702,31 @@ func GenFieldsFromProperties(props []Property) []string {
 	return fields
 }
 
+// xmlFieldTag generates the xml tag for a property, honoring the name,
+// attribute and wrapped settings of its xml object in the spec.
+func xmlFieldTag(p Property, omitEmpty bool) string {
+	tag := p.JsonFieldName
+	if p.Schema.OAPISchema != nil && p.Schema.OAPISchema.XML != nil {
+		xmlObj := p.Schema.OAPISchema.XML
+		if xmlObj.Name != "" {
+			tag = xmlObj.Name
+		}
+		if xmlObj.Attribute {
+			tag += ",attr"
+		} else if xmlObj.Wrapped && p.Schema.ArrayType != nil {
+			itemName := tag
+			if items := p.Schema.ArrayType.OAPISchema; items != nil && items.XML != nil && items.XML.Name != "" {
+				itemName = items.XML.Name
+			}
+			tag += ">" + itemName
+		}
+	}
+	if omitEmpty {
+		tag += ",omitempty"
+	}
+	return tag
+}
+
 func GenStructFromSchema(schema Schema) string {
 	// Start out with struct {
 	objectParts := []string{"struct {"}
@@ -521,26 +758,55 @@ func paramToGoType(param *openapi3.Parameter, path []string) (Schema, error) {
 		return GenerateGoSchema(param.Schema, path)
 	}
 
-	// At this point, we have a content type. We know how to deal with
-	// application/json, but if multiple formats are present, we can't do anything,
-	// so we'll return the parameter as a string, not bothering to decode it.
-	if len(param.Content) > 1 {
-		return Schema{
-			GoType:      "string",
-			Description: StringToGoComment(param.Description),
-		}, nil
+	// At this point, we have a content type. We know how to deal with JSON,
+	// XML, and plain text of primitive schemas, but if multiple formats are
+	// present, we can't do anything, so we'll return the parameter as a
+	// string, not bothering to decode it.
+	contentType, mt := paramContent(param)
+	switch {
+	case mt == nil:
+	case isMediaTypeJSON(contentType), isMediaTypeXML(contentType) && mt.Schema != nil:
+		return GenerateGoSchema(mt.Schema, path)
+	case isParamContentText(contentType, mt):
+		return GenerateGoSchema(mt.Schema, path)
 	}
 
-	// Otherwise, look for application/json in there
-	mt, found := param.Content["application/json"]
-	if !found {
-		// If we don't have json, it's a string
-		return Schema{
-			GoType:      "string",
-			Description: StringToGoComment(param.Description),
-		}, nil
+	if options.StrictParamContent {
+		return Schema{}, fmt.Errorf("parameter '%s' has content of media types %s, which can't be decoded",
+			param.Name, strings.Join(SortedContentKeys(param.Content), ", "))
+	}
+	return Schema{
+		GoType:      "string",
+		Description: StringToGoComment(param.Description),
+	}, nil
+}
+
+// paramContent returns the media type of the content of a parameter, when it
+// has a single one.
+func paramContent(param *openapi3.Parameter) (string, *openapi3.MediaType) {
+	if len(param.Content) != 1 {
+		return "", nil
+	}
+	for contentType, mt := range param.Content {
+		return contentType, mt
 	}
+	return "", nil
+}
 
-	// For json, we go through the standard schema mechanism
-	return GenerateGoSchema(mt.Schema, path)
+// isParamContentText returns whether the content of a parameter is plain text
+// of a primitive schema, which is written as it would be with a schema. The
+// media type may have parameters, as in text/plain; charset=utf-8.
+func isParamContentText(contentType string, mt *openapi3.MediaType) bool {
+	if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "text/plain" {
+		return false
+	}
+	if mt.Schema == nil || mt.Schema.Value == nil {
+		return false
+	}
+	switch mt.Schema.Value.Type {
+	case "string", "integer", "number", "boolean":
+		return true
+	default:
+		return false
+	}
 }
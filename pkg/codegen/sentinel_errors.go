@@ -0,0 +1,102 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// SentinelErrorDefinition describes the sentinel error of the package, such
+// as ErrNotFound, generated for a client error status which operations
+// declare.
+type SentinelErrorDefinition struct {
+	Name       string
+	StatusCode int
+	Message    string
+}
+
+// sentinelError describes the sentinel error of a status code, which is
+// named after its status text, or after the code when it has no text.
+func sentinelError(code int) SentinelErrorDefinition {
+	text := http.StatusText(code)
+	if text == "" {
+		return SentinelErrorDefinition{
+			Name:       fmt.Sprintf("ErrStatus%d", code),
+			StatusCode: code,
+			Message:    fmt.Sprintf("status %d", code),
+		}
+	}
+	name := "Err"
+	for _, word := range strings.Fields(text) {
+		word = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+			return -1
+		}, word)
+		name += UppercaseFirstCharacter(word)
+	}
+	return SentinelErrorDefinition{
+		Name:       name,
+		StatusCode: code,
+		Message:    strings.ToLower(text),
+	}
+}
+
+// SentinelErrors returns the sentinel errors of the client error statuses,
+// from 400 to 499, which the operation declares responses for. Ranges of
+// statuses, such as 4XX, have none.
+func (o *OperationDefinition) SentinelErrors() []SentinelErrorDefinition {
+	var sentinels []SentinelErrorDefinition
+	for _, key := range SortedResponsesKeys(o.Spec.Responses) {
+		code, err := strconv.Atoi(key)
+		if err != nil || code < 400 || code > 499 {
+			continue
+		}
+		sentinels = append(sentinels, sentinelError(code))
+	}
+	return sentinels
+}
+
+// DescribeSentinelErrors describes the sentinel errors of all the client
+// error statuses which the operations declare, ordered by status code.
+func DescribeSentinelErrors(ops []OperationDefinition) []SentinelErrorDefinition {
+	byCode := make(map[int]SentinelErrorDefinition)
+	for i := range ops {
+		for _, sentinel := range ops[i].SentinelErrors() {
+			byCode[sentinel.StatusCode] = sentinel
+		}
+	}
+	sentinels := make([]SentinelErrorDefinition, 0, len(byCode))
+	for _, sentinel := range byCode {
+		sentinels = append(sentinels, sentinel)
+	}
+	sort.Slice(sentinels, func(i, j int) bool {
+		return sentinels[i].StatusCode < sentinels[j].StatusCode
+	})
+	return sentinels
+}
+
+// GenerateSentinelErrors generates the sentinel errors of the client error
+// statuses which the operations declare, which the Err methods of the
+// responses of the client wrap.
+func GenerateSentinelErrors(t *template.Template, ops []OperationDefinition) (string, error) {
+	return GenerateTemplates([]string{"sentinel-errors.tmpl"}, t, DescribeSentinelErrors(ops))
+}
@@ -0,0 +1,14 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSentinelError(t *testing.T) {
+	assert.Equal(t, SentinelErrorDefinition{Name: "ErrNotFound", StatusCode: 404, Message: "not found"}, sentinelError(404))
+	assert.Equal(t, "ErrImATeapot", sentinelError(418).Name)
+	assert.Equal(t, "ErrRequestURITooLong", sentinelError(414).Name)
+	assert.Equal(t, SentinelErrorDefinition{Name: "ErrStatus499", StatusCode: 499, Message: "status 499"}, sentinelError(499))
+}
@@ -0,0 +1,190 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ServerDefinition describes an entry of the spec's servers, or of those of
+// an operation, for which the client gets a URL helper and an option
+// selecting it.
+type ServerDefinition struct {
+	Name        string // The Go name of the server, such as ProductionServer
+	URL         string // The URL template, such as https://{region}.example.com
+	Description string
+	Variables   []ServerVariableDefinition // Sorted by name
+	OperationID string                     // The operation whose server it is, if it isn't one of the spec's
+}
+
+// DefaultURL returns the URL of the server with the default values of its
+// variables.
+func (d ServerDefinition) DefaultURL() string {
+	serverURL := d.URL
+	for _, v := range d.Variables {
+		serverURL = strings.ReplaceAll(serverURL, v.Placeholder(), v.Default)
+	}
+	return serverURL
+}
+
+// Host returns the host of the default URL of the server, which is empty
+// when the URL is relative to the spec's.
+func (d ServerDefinition) Host() string {
+	u, err := url.Parse(d.DefaultURL())
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// ServerVariableDefinition describes a variable of a server URL template.
+type ServerVariableDefinition struct {
+	Name        string // The name of the variable in the URL template
+	GoName      string // The name of the field holding the variable
+	TypeName    string // The name of the enum type of the variable, when it has an enum
+	Default     string
+	Description string
+	Enum        []ServerVariableValue // The allowed values, with their constant names
+}
+
+// ServerVariableValue is an allowed value of a server variable.
+type ServerVariableValue struct {
+	ConstName string
+	Value     string
+}
+
+// TypeDecl returns the type of the variable's field.
+func (v ServerVariableDefinition) TypeDecl() string {
+	if len(v.Enum) > 0 {
+		return v.TypeName
+	}
+	return "string"
+}
+
+// Placeholder returns the placeholder of the variable in the URL template.
+func (v ServerVariableDefinition) Placeholder() string {
+	return "{" + v.Name + "}"
+}
+
+// DescribeServers returns the servers of the spec. Servers are named after
+// their x-go-name extension or their description, so "Production server"
+// becomes ProductionServer, and are otherwise numbered.
+func DescribeServers(servers openapi3.Servers) ([]ServerDefinition, error) {
+	return describeServers(servers, "")
+}
+
+// describeServers describes servers, which are those of the operation when
+// operationID is set, and are then prefixed with it.
+func describeServers(servers openapi3.Servers, operationID string) ([]ServerDefinition, error) {
+	var defs []ServerDefinition
+	urls := make(map[string]string) // The URL of each server, by name
+	for i, server := range servers {
+		if server == nil {
+			continue
+		}
+
+		name := server.Description
+		if extension, ok := server.Extensions[extGoFieldName]; ok {
+			var err error
+			if name, err = extParseGoFieldName(extension); err != nil {
+				return nil, fmt.Errorf("invalid value for %q in server %s: %w", extGoFieldName, server.URL, err)
+			}
+		}
+		if name != "" {
+			name = SchemaNameToTypeName(name)
+			if !strings.HasSuffix(name, "Server") {
+				name += "Server"
+			}
+		} else {
+			name = fmt.Sprintf("Server%d", i+1)
+		}
+		name = operationID + name
+		if url, found := urls[name]; found {
+			return nil, fmt.Errorf("servers %s and %s are both named %s, use %q to rename one", url, server.URL, name, extGoFieldName)
+		}
+		urls[name] = server.URL
+
+		def := ServerDefinition{
+			Name:        name,
+			URL:         server.URL,
+			Description: server.Description,
+			OperationID: operationID,
+		}
+		variableNames := make([]string, 0, len(server.Variables))
+		for variableName := range server.Variables {
+			variableNames = append(variableNames, variableName)
+		}
+		sort.Strings(variableNames)
+
+		for _, variableName := range variableNames {
+			variable := server.Variables[variableName]
+			if variable == nil {
+				continue
+			}
+			v := ServerVariableDefinition{
+				Name:        variableName,
+				GoName:      SchemaNameToTypeName(variableName),
+				TypeName:    name + SchemaNameToTypeName(variableName),
+				Default:     variable.Default,
+				Description: variable.Description,
+			}
+			for _, value := range variable.Enum {
+				v.Enum = append(v.Enum, ServerVariableValue{
+					ConstName: v.TypeName + SchemaNameToTypeName(value),
+					Value:     value,
+				})
+			}
+			def.Variables = append(def.Variables, v)
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// operationServerWarnings returns warnings about the operations which the
+// generated servers register along with the others, while their servers are
+// at another host than those of the spec. Relative servers are at the same
+// host.
+func operationServerWarnings(servers []ServerDefinition, ops []OperationDefinition) []string {
+	hosts := make(map[string]bool)
+	for _, server := range servers {
+		hosts[server.Host()] = true
+	}
+	if len(servers) == 0 {
+		// The default server of specs is relative to them.
+		hosts[""] = true
+	}
+	var warnings []string
+	for _, op := range ops {
+		for _, server := range op.Servers {
+			if host := server.Host(); host != "" && !hosts[host] {
+				warnings = append(warnings, fmt.Sprintf("operation %s is served at %s, rather than at the servers of the spec, but its handler is registered along with the others", op.OperationId, server.DefaultURL()))
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+// GenerateServerURLs generates the URL helpers and client options for the
+// servers of the spec.
+func GenerateServerURLs(t *template.Template, servers []ServerDefinition) (string, error) {
+	return GenerateTemplates([]string{"server-urls.tmpl"}, t, servers)
+}
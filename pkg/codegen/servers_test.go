@@ -0,0 +1,72 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeServers(t *testing.T) {
+	spec := `
+openapi: 3.0.1
+info:
+  title: Servers Test
+  version: 1.0.0
+servers:
+  - url: https://{env}.example.com
+    description: Main API
+    variables:
+      env:
+        enum: [prod, staging]
+        default: prod
+  - url: http://localhost:8080
+  - url: https://backup.example.com
+    description: backup server
+paths: {}
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	assert.NoError(t, err)
+
+	servers, err := DescribeServers(swagger.Servers)
+	assert.NoError(t, err)
+	assert.Len(t, servers, 3)
+
+	assert.Equal(t, "MainAPIServer", servers[0].Name)
+	assert.Len(t, servers[0].Variables, 1)
+	assert.Equal(t, "MainAPIServerEnv", servers[0].Variables[0].TypeName)
+	assert.Equal(t, []ServerVariableValue{
+		{ConstName: "MainAPIServerEnvProd", Value: "prod"},
+		{ConstName: "MainAPIServerEnvStaging", Value: "staging"},
+	}, servers[0].Variables[0].Enum)
+
+	assert.Equal(t, "Server2", servers[1].Name)
+	assert.Equal(t, "BackupServer", servers[2].Name)
+
+	swagger.Servers[1].Description = "Backup"
+	_, err = DescribeServers(swagger.Servers)
+	assert.Error(t, err)
+}
+
+func TestOperationServerWarnings(t *testing.T) {
+	servers, err := DescribeServers(openapi3.Servers{{URL: "https://{env}.example.com/v1", Variables: map[string]*openapi3.ServerVariable{
+		"env": {Default: "api"},
+	}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.example.com/v1", servers[0].DefaultURL())
+
+	uploads, err := describeServers(openapi3.Servers{{URL: "https://uploads.example.com"}}, "UploadFile")
+	assert.NoError(t, err)
+	assert.Equal(t, "UploadFileServer1", uploads[0].Name)
+	same, err := describeServers(openapi3.Servers{{URL: "https://api.example.com/v2"}, {URL: "/v3"}}, "GetReport")
+	assert.NoError(t, err)
+
+	ops := []OperationDefinition{
+		{OperationId: "ListPets"},
+		{OperationId: "UploadFile", Servers: uploads},
+		{OperationId: "GetReport", Servers: same},
+	}
+	assert.Equal(t, []string{
+		"operation UploadFile is served at https://uploads.example.com, rather than at the servers of the spec, but its handler is registered along with the others",
+	}, operationServerWarnings(servers, ops))
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+)
+
+// DescribeSignature returns the scheme of the signatures of the requests of
+// the API, which the x-signature extension of the spec describes, or nil when
+// it has none.
+func DescribeSignature(swagger *openapi3.T) (*runtime.SignatureScheme, error) {
+	extension, ok := swagger.Extensions[extPropSignature]
+	if !ok {
+		return nil, nil
+	}
+	scheme, err := extParseSignature(extension)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %q: %w", extPropSignature, err)
+	}
+	return &scheme, nil
+}
+
+// GenerateSignature generates SignatureScheme, the scheme of the signatures
+// of the requests of the API, along with SignRequests, signing those of the
+// client, and VerifySignatures, a middleware verifying those of servers.
+// Nothing is generated when the spec has no x-signature extension.
+func GenerateSignature(t *template.Template, swagger *openapi3.T) (string, error) {
+	scheme, err := DescribeSignature(swagger)
+	if err != nil || scheme == nil {
+		return "", err
+	}
+	return GenerateTemplates([]string{"signature.tmpl"}, t, scheme)
+}
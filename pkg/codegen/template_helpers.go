@@ -20,6 +20,7 @@ import (
 	"strings"
 	"text/template"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 )
 
@@ -33,9 +34,77 @@ var (
 	contentTypesYAML = []string{"application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml"}
 	contentTypesXML  = []string{echo.MIMEApplicationXML, echo.MIMETextXML}
 
+	contentTypesMsgpack  = []string{echo.MIMEApplicationMsgpack, "application/x-msgpack", "application/vnd.msgpack"}
+	contentTypesProtobuf = []string{echo.MIMEApplicationProtobuf, "application/x-protobuf", "application/vnd.google.protobuf"}
+
 	responseTypeSuffix = "Response"
 )
 
+// isMediaTypeJSON returns whether the given media type is JSON, including
+// vendor specific ones such as application/vnd.myco.v2+json.
+func isMediaTypeJSON(mediaType string) bool {
+	return StringInArray(mediaType, contentTypesJSON) || strings.HasSuffix(mediaType, "+json")
+}
+
+// jsonContentType returns the JSON media type of content, preferring
+// application/json when there are several, or "" when there is none.
+func jsonContentType(content openapi3.Content) string {
+	if _, found := content[echo.MIMEApplicationJSON]; found {
+		return echo.MIMEApplicationJSON
+	}
+	for _, contentType := range SortedContentKeys(content) {
+		if isMediaTypeJSON(contentType) {
+			return contentType
+		}
+	}
+	return ""
+}
+
+// isCSVRows returns whether a media type is CSV whose schema is an array of
+// objects, which are its rows. Other text/csv content is a string.
+func isCSVRows(mediaType string, schema *openapi3.SchemaRef) bool {
+	if mediaType != "text/csv" || schema == nil || schema.Value == nil || schema.Value.Type != "array" {
+		return false
+	}
+	items := schema.Value.Items
+	return items != nil && items.Value != nil && (items.Value.Type == "object" || len(items.Value.Properties) > 0)
+}
+
+// mediaRangePrefix returns the prefix of the names of the response fields of
+// a media range, such as AnyText for text/*, or "" when the media type isn't
+// a range.
+func mediaRangePrefix(mediaType string) string {
+	if mediaType == "*/*" {
+		return "Any"
+	}
+	if strings.HasSuffix(mediaType, "/*") {
+		return "Any" + ToCamelCase(strings.TrimSuffix(mediaType, "/*"))
+	}
+	return ""
+}
+
+// isMediaTypeXML returns whether the given media type is XML, including
+// structured syntax suffixes such as application/atom+xml.
+func isMediaTypeXML(mediaType string) bool {
+	return StringInArray(mediaType, contentTypesXML) || strings.HasSuffix(mediaType, "+xml")
+}
+
+// isMediaTypeYAML returns whether the given media type is YAML, including
+// structured syntax suffixes such as application/openapi+yaml.
+func isMediaTypeYAML(mediaType string) bool {
+	return StringInArray(mediaType, contentTypesYAML) || strings.HasSuffix(mediaType, "+yaml")
+}
+
+// isMediaTypeMsgpack returns whether the given media type is MessagePack.
+func isMediaTypeMsgpack(mediaType string) bool {
+	return StringInArray(mediaType, contentTypesMsgpack)
+}
+
+// isMediaTypeProtobuf returns whether the given media type is protobuf.
+func isMediaTypeProtobuf(mediaType string) bool {
+	return StringInArray(mediaType, contentTypesProtobuf)
+}
+
 // This function takes an array of Parameter definition, and generates a valid
 // Go parameter declaration from them, eg:
 // ", foo int, bar string, baz float32". The preceding comma is there to save
@@ -130,7 +199,7 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 		if len(responseRef.Value.Content) == 0 {
 			caseAction := "break // No content-type"
 			caseClauseKey := "case " + getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName) + ":"
-			unhandledCaseClauses[prefixLeastSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
+			unhandledCaseClauses[responseNamePrefix(typeDefinition.ResponseName)+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
 			continue
 		}
 
@@ -148,7 +217,7 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 			switch {
 
 			// JSON:
-			case StringInArray(contentTypeName, contentTypesJSON):
+			case isMediaTypeJSON(contentTypeName):
 				if typeDefinition.ContentTypeName == contentTypeName {
 					caseAction := fmt.Sprintf("var dest %s\n"+
 						"if err := json.Unmarshal(bodyBytes, &dest); err != nil { \n"+
@@ -163,7 +232,7 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 				}
 
 			// YAML:
-			case StringInArray(contentTypeName, contentTypesYAML):
+			case isMediaTypeYAML(contentTypeName):
 				if typeDefinition.ContentTypeName == contentTypeName {
 					caseAction := fmt.Sprintf("var dest %s\n"+
 						"if err := yaml.Unmarshal(bodyBytes, &dest); err != nil { \n"+
@@ -177,7 +246,7 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 				}
 
 			// XML:
-			case StringInArray(contentTypeName, contentTypesXML):
+			case isMediaTypeXML(contentTypeName):
 				if typeDefinition.ContentTypeName == contentTypeName {
 					caseAction := fmt.Sprintf("var dest %s\n"+
 						"if err := xml.Unmarshal(bodyBytes, &dest); err != nil { \n"+
@@ -190,11 +259,83 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 					handledCaseClauses[caseKey] = caseClause
 				}
 
+			// MessagePack:
+			case isMediaTypeMsgpack(contentTypeName):
+				if typeDefinition.ContentTypeName == contentTypeName {
+					caseAction := fmt.Sprintf("var dest %s\n"+
+						"if err := msgpack.Unmarshal(bodyBytes, &dest); err != nil { \n"+
+						" return nil, err \n"+
+						"}\n"+
+						"response.%s = &dest",
+						typeDefinition.Schema.TypeDecl(),
+						typeDefinition.TypeName)
+					caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "msgpack")
+					handledCaseClauses[caseKey] = caseClause
+				}
+
+			// Protobuf:
+			case isMediaTypeProtobuf(contentTypeName):
+				if typeDefinition.ContentTypeName == contentTypeName {
+					caseAction := fmt.Sprintf("var dest %s\n"+
+						"if err := proto.Unmarshal(bodyBytes, &dest); err != nil { \n"+
+						" return nil, err \n"+
+						"}\n"+
+						"response.%s = &dest",
+						typeDefinition.Schema.TypeDecl(),
+						typeDefinition.TypeName)
+					caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, "protobuf")
+					handledCaseClauses[caseKey] = caseClause
+				}
+
+			// Text, and CSV rows:
+			case contentTypeName == "text/plain" || contentTypeName == "text/csv":
+				if typeDefinition.ContentTypeName == contentTypeName {
+					caseAction := fmt.Sprintf("dest := string(bodyBytes)\n"+
+						"response.%s = &dest",
+						typeDefinition.TypeName)
+					if typeDefinition.Schema.TypeDecl() != "string" {
+						caseAction = fmt.Sprintf("var dest %s\n"+
+							"if err := runtime.UnmarshalCSV(bodyBytes, &dest); err != nil { \n"+
+							" return nil, err \n"+
+							"}\n"+
+							"response.%s = &dest",
+							typeDefinition.Schema.TypeDecl(),
+							typeDefinition.TypeName)
+					}
+					caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, contentTypeName)
+					handledCaseClauses[caseKey] = caseClause
+				}
+
+			// Binary bodies, which are kept as they are:
+			case contentTypeName == "application/octet-stream":
+				if typeDefinition.ContentTypeName == contentTypeName {
+					caseAction := fmt.Sprintf("dest := bodyBytes\n"+
+						"response.%s = &dest",
+						typeDefinition.TypeName)
+					caseKey, caseClause := buildUnmarshalCase(typeDefinition, caseAction, contentTypeName)
+					handledCaseClauses[caseKey] = caseClause
+				}
+
+			// Media ranges, whose body is kept as is:
+			case mediaRangePrefix(contentTypeName) != "":
+				if typeDefinition.ContentTypeName == contentTypeName {
+					dest := "bodyBytes"
+					if typeDefinition.Schema.GoType == "string" {
+						dest = "string(bodyBytes)"
+					}
+					caseAction := fmt.Sprintf("dest := %s\n"+
+						"response.%s = &dest",
+						dest,
+						typeDefinition.TypeName)
+					caseKey, caseClause := buildMediaRangeCase(typeDefinition, caseAction)
+					handledCaseClauses[caseKey] = caseClause
+				}
+
 			// Everything else:
 			default:
 				caseAction := fmt.Sprintf("// Content-type (%s) unsupported", contentTypeName)
 				caseClauseKey := "case " + getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName) + ":"
-				unhandledCaseClauses[prefixLeastSpecific+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
+				unhandledCaseClauses[responseNamePrefix(typeDefinition.ResponseName)+caseClauseKey] = fmt.Sprintf("%s\n%s\n", caseClauseKey, caseAction)
 			}
 		}
 	}
@@ -221,14 +362,46 @@ func genResponseUnmarshal(op *OperationDefinition) string {
 	return buffer.String()
 }
 
+// responseNamePrefix returns the prefix sorting the case clauses of a response
+// by the specificity of its status code: exact codes are matched before ranges,
+// such as 4XX, which are matched before the default response.
+func responseNamePrefix(responseName string) string {
+	switch strings.ToUpper(responseName) {
+	case "DEFAULT":
+		return prefixLeastSpecific
+	case "1XX", "2XX", "3XX", "4XX", "5XX":
+		return prefixLessSpecific
+	default:
+		return prefixMostSpecific
+	}
+}
+
 // buildUnmarshalCase builds an unmarshalling case clause for different content-types:
 func buildUnmarshalCase(typeDefinition ResponseTypeDefinition, caseAction string, contentType string) (caseKey string, caseClause string) {
-	caseKey = fmt.Sprintf("%s.%s.%s", prefixLeastSpecific, contentType, typeDefinition.ResponseName)
+	caseKey = fmt.Sprintf("%s.%s.%s.%s", responseNamePrefix(typeDefinition.ResponseName), prefixMostSpecific, contentType, typeDefinition.ResponseName)
 	caseClauseKey := getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName)
 	caseClause = fmt.Sprintf("case strings.Contains(rsp.Header.Get(\"%s\"), \"%s\") && %s:\n%s\n", echo.HeaderContentType, contentType, caseClauseKey, caseAction)
 	return caseKey, caseClause
 }
 
+// buildMediaRangeCase builds a case clause for a media range, which matches the
+// content types of the range, or any for */*. Clauses of a status code are
+// sorted after those of its media types, and ranges of a type are matched
+// before */*.
+func buildMediaRangeCase(typeDefinition ResponseTypeDefinition, caseAction string) (caseKey string, caseClause string) {
+	statusPrefix := responseNamePrefix(typeDefinition.ResponseName)
+	caseClauseKey := getConditionOfResponseName("rsp.StatusCode", typeDefinition.ResponseName)
+	if typeDefinition.ContentTypeName == "*/*" {
+		caseKey = fmt.Sprintf("%s.%s.%s", statusPrefix, prefixLeastSpecific, typeDefinition.ResponseName)
+		caseClause = fmt.Sprintf("case %s:\n%s\n", caseClauseKey, caseAction)
+		return caseKey, caseClause
+	}
+	prefix := strings.TrimSuffix(typeDefinition.ContentTypeName, "*")
+	caseKey = fmt.Sprintf("%s.%s.%s.%s", statusPrefix, prefixLessSpecific, typeDefinition.ResponseName, prefix)
+	caseClause = fmt.Sprintf("case strings.HasPrefix(rsp.Header.Get(\"%s\"), \"%s\") && %s:\n%s\n", echo.HeaderContentType, prefix, caseClauseKey, caseAction)
+	return caseKey, caseClause
+}
+
 // genResponseTypeName creates the name of generated response types (given the operationID):
 func genResponseTypeName(operationID string) string {
 	return fmt.Sprintf("%s%s", UppercaseFirstCharacter(operationID), responseTypeSuffix)
@@ -244,8 +417,8 @@ func getResponseTypeDefinitions(op *OperationDefinition) []ResponseTypeDefinitio
 
 // Return the statusCode comparison clause from the response name.
 func getConditionOfResponseName(statusCodeVar, responseName string) string {
-	switch responseName {
-	case "default":
+	switch strings.ToUpper(responseName) {
+	case "DEFAULT":
 		return "true"
 	case "1XX", "2XX", "3XX", "4XX", "5XX":
 		return fmt.Sprintf("%s / 100 == %s", statusCodeVar, responseName[:1])
@@ -259,6 +432,29 @@ func toStringArray(sarr []string) string {
 	return `[]string{"` + strings.Join(sarr, `","`) + `"}`
 }
 
+// idempotencyKeyHeaders returns the distinct headers in which operations send
+// idempotency keys, sorted.
+func idempotencyKeyHeaders(ops []OperationDefinition) []string {
+	headers := make(map[string]string)
+	for _, op := range ops {
+		if op.IdempotencyKey != "" {
+			headers[op.IdempotencyKey] = op.IdempotencyKey
+		}
+	}
+	return SortedStringKeys(headers)
+}
+
+// hasOperationServers returns whether any of the operations overrides the
+// servers of the spec.
+func hasOperationServers(ops []OperationDefinition) bool {
+	for _, op := range ops {
+		if len(op.Servers) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func stripNewLines(s string) string {
 	r := strings.NewReplacer("\n", "")
 	return r.Replace(s)
@@ -285,5 +481,7 @@ var TemplateFunctions = template.FuncMap{
 	"lower":                      strings.ToLower,
 	"title":                      strings.Title,
 	"stripNewLines":              stripNewLines,
+	"idempotencyKeyHeaders":      idempotencyKeyHeaders,
+	"hasOperationServers":        hasOperationServers,
 	"sanitizeGoIdentity":         SanitizeGoIdentity,
 }
@@ -0,0 +1,81 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// DefaultTransliterations are the ASCII spellings of the Latin letters with
+// diacritics, and of the Cyrillic letters, which names of the spec are
+// transliterated with when the Transliterate option is set. Letters without a
+// transliteration are kept as they are.
+var DefaultTransliterations = map[rune]string{
+	'À': "A", 'Á': "A", 'Â': "A", 'Ã': "A", 'Ä': "A", 'Å': "A", 'Æ': "AE", 'Ç': "C",
+	'È': "E", 'É': "E", 'Ê': "E", 'Ë': "E", 'Ì': "I", 'Í': "I", 'Î': "I", 'Ï': "I",
+	'Ð': "D", 'Ñ': "N", 'Ò': "O", 'Ó': "O", 'Ô': "O", 'Õ': "O", 'Ö': "O", 'Ø': "O",
+	'Ù': "U", 'Ú': "U", 'Û': "U", 'Ü': "U", 'Ý': "Y", 'Þ': "Th", 'ß': "ss", 'à': "a",
+	'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae", 'ç': "c", 'è': "e",
+	'é': "e", 'ê': "e", 'ë': "e", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ð': "d",
+	'ñ': "n", 'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ù': "u",
+	'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'þ': "th", 'ÿ': "y", 'Ā': "A", 'ā': "a",
+	'Ă': "A", 'ă': "a", 'Ą': "A", 'ą': "a", 'Ć': "C", 'ć': "c", 'Ĉ': "C", 'ĉ': "c",
+	'Ċ': "C", 'ċ': "c", 'Č': "C", 'č': "c", 'Ď': "D", 'ď': "d", 'Đ': "D", 'đ': "d",
+	'Ē': "E", 'ē': "e", 'Ĕ': "E", 'ĕ': "e", 'Ė': "E", 'ė': "e", 'Ę': "E", 'ę': "e",
+	'Ě': "E", 'ě': "e", 'Ĝ': "G", 'ĝ': "g", 'Ğ': "G", 'ğ': "g", 'Ġ': "G", 'ġ': "g",
+	'Ģ': "G", 'ģ': "g", 'Ĥ': "H", 'ĥ': "h", 'Ħ': "H", 'ħ': "h", 'Ĩ': "I", 'ĩ': "i",
+	'Ī': "I", 'ī': "i", 'Ĭ': "I", 'ĭ': "i", 'Į': "I", 'į': "i", 'İ': "I", 'ı': "i",
+	'Ĳ': "IJ", 'ĳ': "ij", 'Ĵ': "J", 'ĵ': "j", 'Ķ': "K", 'ķ': "k", 'ĸ': "q", 'Ĺ': "L",
+	'ĺ': "l", 'Ļ': "L", 'ļ': "l", 'Ľ': "L", 'ľ': "l", 'Ŀ': "L", 'ŀ': "l", 'Ł': "L",
+	'ł': "l", 'Ń': "N", 'ń': "n", 'Ņ': "N", 'ņ': "n", 'Ň': "N", 'ň': "n", 'ŉ': "n",
+	'Ŋ': "Ng", 'ŋ': "ng", 'Ō': "O", 'ō': "o", 'Ŏ': "O", 'ŏ': "o", 'Ő': "O", 'ő': "o",
+	'Œ': "OE", 'œ': "oe", 'Ŕ': "R", 'ŕ': "r", 'Ŗ': "R", 'ŗ': "r", 'Ř': "R", 'ř': "r",
+	'Ś': "S", 'ś': "s", 'Ŝ': "S", 'ŝ': "s", 'Ş': "S", 'ş': "s", 'Š': "S", 'š': "s",
+	'Ţ': "T", 'ţ': "t", 'Ť': "T", 'ť': "t", 'Ŧ': "T", 'ŧ': "t", 'Ũ': "U", 'ũ': "u",
+	'Ū': "U", 'ū': "u", 'Ŭ': "U", 'ŭ': "u", 'Ů': "U", 'ů': "u", 'Ű': "U", 'ű': "u",
+	'Ų': "U", 'ų': "u", 'Ŵ': "W", 'ŵ': "w", 'Ŷ': "Y", 'ŷ': "y", 'Ÿ': "Y", 'Ź': "Z",
+	'ź': "z", 'Ż': "Z", 'ż': "z", 'Ž': "Z", 'ž': "z", 'ſ': "s", 'а': "a", 'А': "A",
+	'б': "b", 'Б': "B", 'в': "v", 'В': "V", 'г': "g", 'Г': "G", 'д': "d", 'Д': "D",
+	'е': "e", 'Е': "E", 'ё': "e", 'Ё': "E", 'ж': "zh", 'Ж': "Zh", 'з': "z", 'З': "Z",
+	'и': "i", 'И': "I", 'й': "y", 'Й': "Y", 'к': "k", 'К': "K", 'л': "l", 'Л': "L",
+	'м': "m", 'М': "M", 'н': "n", 'Н': "N", 'о': "o", 'О': "O", 'п': "p", 'П': "P",
+	'р': "r", 'Р': "R", 'с': "s", 'С': "S", 'т': "t", 'Т': "T", 'у': "u", 'У': "U",
+	'ф': "f", 'Ф': "F", 'х': "kh", 'Х': "Kh", 'ц': "ts", 'Ц': "Ts", 'ч': "ch", 'Ч': "Ch",
+	'ш': "sh", 'Ш': "Sh", 'щ': "shch", 'Щ': "Shch", 'ъ': "", 'Ъ': "", 'ы': "y", 'Ы': "Y",
+	'ь': "", 'Ь': "", 'э': "e", 'Э': "E", 'ю': "yu", 'Ю': "Yu", 'я': "ya", 'Я': "Ya",
+	'і': "i", 'І': "I", 'ї': "yi", 'Ї': "Yi", 'є': "ye", 'Є': "Ye", 'ґ': "g", 'Ґ': "G",
+}
+
+// transliterate spells the letters of a name in ASCII, with the
+// transliterations of the options, then the default ones. Letters without a
+// transliteration which have no case, such as CJK ones, would be dropped from
+// identifiers, so they are spelled as words of their code point instead, as in
+// U56FD for 国.
+func transliterate(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if spelling, found := options.Transliterations[r]; found {
+			b.WriteString(spelling)
+		} else if spelling, found := DefaultTransliterations[r]; found {
+			b.WriteString(spelling)
+		} else if unicode.IsLetter(r) && !unicode.IsUpper(r) && !unicode.IsLower(r) {
+			fmt.Fprintf(&b, "U%04X ", r)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
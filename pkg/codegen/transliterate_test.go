@@ -0,0 +1,70 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransliterate(t *testing.T) {
+	defer func() { options = Options{} }()
+
+	options = Options{Transliterate: true}
+	assert.Equal(t, "Pais", SchemaNameToTypeName("país"))
+	assert.Equal(t, "StrasseNr", SchemaNameToTypeName("straße_nr"))
+	assert.Equal(t, "Zhurnal", SchemaNameToTypeName("журнал"))
+	assert.Equal(t, "U56FDU5BB6", SchemaNameToTypeName("国家"))
+	assert.Equal(t, "U56FDU5BB6Id", SchemaNameToTypeName("国家_id"))
+
+	options = Options{Transliterate: true, Transliterations: map[rune]string{'ä': "ae", '国': "guo ", '家': "jia"}}
+	assert.Equal(t, "Baeren", SchemaNameToTypeName("bären"))
+	assert.Equal(t, "GuoJia", SchemaNameToTypeName("国家"))
+
+	spec := `
+openapi: 3.0.1
+info:
+  title: Países
+  version: 1.0.0
+paths:
+  /países:
+    get:
+      operationId: listarPaíses
+      responses:
+        200:
+          description: Los países
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/país'
+components:
+  schemas:
+    país:
+      type: object
+      properties:
+        población:
+          type: integer
+`
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	code, err := Generate(swagger, "paises", Options{GenerateTypes: true, GenerateClient: true, Transliterate: true})
+	require.NoError(t, err)
+	assert.Contains(t, code, "type Pais struct {")
+	assert.Contains(t, code, "Poblacion *int `json:\"población,omitempty\"`")
+	assert.Contains(t, code, "ListarPaises(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)")
+	assert.Contains(t, code, "JSON200      *Pais\n")
+}
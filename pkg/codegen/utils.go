@@ -15,6 +15,7 @@ package codegen
 
 import (
 	"fmt"
+	"go/token"
 	"net/url"
 	"regexp"
 	"sort"
@@ -86,6 +87,74 @@ func ToCamelCase(str string) string {
 	return n
 }
 
+// ToTitleCase is a strict form of ToCamelCase, which also turns the letters
+// following the first one of every word to lower case, so that
+// "HTTP_server-ID" becomes HttpServerId rather than HTTPServerID.
+func ToTitleCase(str string) string {
+	separators := "-#@!$&=.+:;_~ (){}[]"
+	s := strings.Trim(str, " ")
+
+	n := ""
+	capNext := true
+	for _, v := range s {
+		if unicode.IsLetter(v) {
+			if capNext {
+				n += string(unicode.ToUpper(v))
+			} else {
+				n += string(unicode.ToLower(v))
+			}
+		}
+		if unicode.IsDigit(v) {
+			n += string(v)
+		}
+
+		capNext = strings.ContainsRune(separators, v)
+	}
+	return n
+}
+
+// ToScreamingSnakeCase turns a CamelCase name into SCREAMING_SNAKE_CASE, so
+// that PetKindHTTPError becomes PET_KIND_HTTP_ERROR.
+func ToScreamingSnakeCase(str string) string {
+	runes := []rune(str)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			endsAcronym := unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || endsAcronym {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+// NameNormalizer turns the names of a spec, such as those of its schemas, enum
+// values and operations, into Go identifiers.
+type NameNormalizer func(name string) string
+
+// NameNormalizers are the name normalizers which can be selected by name, as
+// the name-normalizer configuration does.
+var NameNormalizers = map[string]NameNormalizer{
+	"pascal-case": ToCamelCase,
+	"title-case":  ToTitleCase,
+}
+
+// normalizeName turns a name of the spec into a Go identifier with the name
+// normalizer of the options, which is ToCamelCase by default, once its letters
+// are transliterated when the options say so.
+func normalizeName(name string) string {
+	if options.Transliterate {
+		name = transliterate(name)
+	}
+	if options.NameNormalizer != nil {
+		return options.NameNormalizer(name)
+	}
+	return ToCamelCase(name)
+}
+
 // This function returns the keys of the given SchemaRef dictionary in sorted
 // order, since Golang scrambles dictionary keys
 func SortedSchemaKeys(dict map[string]*openapi3.SchemaRef) []string {
@@ -183,6 +252,61 @@ func SortedRequestBodyKeys(dict map[string]*openapi3.RequestBodyRef) []string {
 	return keys
 }
 
+func SortedLinkKeys(dict openapi3.Links) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func SortedEncodingKeys(dict map[string]*openapi3.Encoding) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func SortedExampleKeys(dict openapi3.Examples) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func SortedHeadersKeys(dict openapi3.Headers) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func SortedSecuritySchemeKeys(dict openapi3.SecuritySchemes) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func SortedSecurityRequirementKeys(sr openapi3.SecurityRequirement) []string {
 	keys := make([]string, len(sr))
 	i := 0
@@ -194,6 +318,17 @@ func SortedSecurityRequirementKeys(sr openapi3.SecurityRequirement) []string {
 	return keys
 }
 
+func SortedCallbackKeys(dict openapi3.Callbacks) []string {
+	keys := make([]string, len(dict))
+	i := 0
+	for key := range dict {
+		keys[i] = key
+		i++
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // This function checks whether the specified string is present in an array
 // of strings
 func StringInArray(str string, array []string) bool {
@@ -205,6 +340,16 @@ func StringInArray(str string, array []string) bool {
 	return false
 }
 
+// indexOf returns the index of the first occurrence of str in array, or -1
+func indexOf(array []string, str string) int {
+	for i, elt := range array {
+		if elt == str {
+			return i
+		}
+	}
+	return -1
+}
+
 // This function takes a $ref value and converts it to a Go typename.
 // #/components/schemas/Foo -> Foo
 // #/components/parameters/Bar -> Bar
@@ -213,7 +358,7 @@ func StringInArray(str string, array []string) bool {
 // URL components (http://deepmap.com/schemas/document.json#/Foo) are supported if they present in --import-mapping
 // Remote and URL also support standard local paths even though the spec doesn't mention them.
 func RefPathToGoType(refPath string) (string, error) {
-	return refPathToGoType(refPath, true)
+	return nameCache.refType(refPath)
 }
 
 // refPathToGoType returns the Go typename for refPath given its
@@ -228,7 +373,14 @@ func refPathToGoType(refPath string, local bool) (string, error) {
 		} else if depth != 4 && depth != 2 {
 			return "", fmt.Errorf("unexpected reference depth: %d for ref: %s local: %t", depth, refPath, local)
 		}
-		return SchemaNameToTypeName(pathParts[len(pathParts)-1]), nil
+		typeName := SchemaNameToTypeName(pathParts[len(pathParts)-1])
+		if local && pathParts[2] == "schemas" {
+			typeName = schemaTypeName(pathParts[3])
+		}
+		if local && componentsImport.Name != "" {
+			return componentsImport.Name + "." + typeName, nil
+		}
+		return typeName, nil
 	}
 	pathParts := strings.Split(refPath, "#")
 	if len(pathParts) != 2 {
@@ -494,7 +646,7 @@ func SanitizeGoIdentity(str string) string {
 	str = string(sanitized)
 
 	if IsGoKeyword(str) || IsPredeclaredGoIdentifier(str) {
-		str = "_" + str
+		str = escapeReservedWord(str)
 	}
 
 	if !IsValidGoIdentity(str) {
@@ -504,6 +656,50 @@ func SanitizeGoIdentity(str string) string {
 	return str
 }
 
+// The strategies of the ReservedWords option, which escape Go keywords
+// and predeclared identifiers in the names SanitizeGoIdentity returns.
+const (
+	// ReservedWordsUnderscorePrefix prefixes them with an underscore, as
+	// _type, which is the default.
+	ReservedWordsUnderscorePrefix = "underscore-prefix"
+	// ReservedWordsValueSuffix suffixes them with Value, as typeValue.
+	ReservedWordsValueSuffix = "value-suffix"
+	// ReservedWordsPascalCase turns their first letter to upper case, as Type.
+	ReservedWordsPascalCase = "pascal-case"
+)
+
+// escapeReservedWord returns the name which replaces a Go keyword or
+// predeclared identifier, as the options say.
+func escapeReservedWord(word string) string {
+	if replacement, found := options.ReservedWordsMap[word]; found {
+		return replacement
+	}
+	switch options.ReservedWords {
+	case ReservedWordsValueSuffix:
+		return word + "Value"
+	case ReservedWordsPascalCase:
+		return UppercaseFirstCharacter(word)
+	default:
+		return "_" + word
+	}
+}
+
+// checkReservedWords checks the options escaping reserved words.
+func checkReservedWords(opts Options) error {
+	switch opts.ReservedWords {
+	case "", ReservedWordsUnderscorePrefix, ReservedWordsValueSuffix, ReservedWordsPascalCase:
+	default:
+		return fmt.Errorf("unknown reserved word strategy %q", opts.ReservedWords)
+	}
+	for _, word := range SortedStringKeys(opts.ReservedWordsMap) {
+		replacement := opts.ReservedWordsMap[word]
+		if !token.IsIdentifier(replacement) || IsPredeclaredGoIdentifier(replacement) {
+			return fmt.Errorf("the replacement of reserved word %q, %q, can't be used as a Go identifier", word, replacement)
+		}
+	}
+	return nil
+}
+
 // SanitizeEnumNames fixes illegal chars in the enum names
 // and removes duplicates
 func SanitizeEnumNames(enumNames []string) map[string]string {
@@ -522,18 +718,33 @@ func SanitizeEnumNames(enumNames []string) map[string]string {
 
 	for _, n := range deDup {
 		sanitized := SanitizeGoIdentity(SchemaNameToTypeName(n))
-
-		if _, dup := dupCheck[sanitized]; !dup {
-			sanitizedDeDup[sanitized] = n
-		} else {
-			sanitizedDeDup[sanitized+strconv.Itoa(dupCheck[sanitized])] = n
-		}
+		name := uniqueEnumName(sanitized, dupCheck[sanitized], sanitizedDeDup)
+		sanitizedDeDup[name] = n
 		dupCheck[sanitized]++
 	}
 
 	return sanitizedDeDup
 }
 
+// uniqueEnumName returns name, or when it's taken in names, name followed by
+// the first number from n which isn't, so that enum values whose names clash
+// after sanitizing never replace each other.
+func uniqueEnumName(name string, n int, names map[string]string) string {
+	if _, taken := names[name]; !taken && n == 0 {
+		return name
+	}
+	if n == 0 {
+		n = 1
+	}
+	for {
+		suffixed := name + strconv.Itoa(n)
+		if _, taken := names[suffixed]; !taken {
+			return suffixed
+		}
+		n++
+	}
+}
+
 func typeNamePrefix(name string) (prefix string) {
 	for _, r := range name {
 		switch r {
@@ -572,7 +783,11 @@ func typeNamePrefix(name string) (prefix string) {
 // SchemaNameToTypeName converts a Schema name to a valid Go type name. It converts to camel case, and makes sure the name is
 // valid in Go
 func SchemaNameToTypeName(name string) string {
-	return typeNamePrefix(name) + ToCamelCase(name)
+	return nameCache.typeName(name)
+}
+
+func schemaNameToTypeName(name string) string {
+	return typeNamePrefix(name) + normalizeName(name)
 }
 
 // According to the spec, additionalProperties may be true, false, or a
@@ -638,3 +853,28 @@ func EscapePathElements(path string) string {
 	}
 	return strings.Join(elems, "/")
 }
+
+// DefaultUserAgent returns the User-Agent generated clients send by default,
+// made of the spec's title and version, such as Swagger-Petstore/1.0.0.
+// Characters which aren't allowed in a product token are replaced by dashes.
+func DefaultUserAgent(info *openapi3.Info) string {
+	if info == nil || info.Title == "" {
+		return ""
+	}
+	userAgent := userAgentToken(info.Title)
+	if info.Version != "" {
+		userAgent += "/" + userAgentToken(info.Version)
+	}
+	return userAgent
+}
+
+// userAgentToken replaces the characters of s which aren't token characters,
+// as defined by RFC 7230, with dashes.
+func userAgentToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII || unicode.IsSpace(r) || unicode.IsControl(r) || strings.ContainsRune("\"(),/:;<=>?@[\\]{}", r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(s))
+}
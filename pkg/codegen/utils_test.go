@@ -26,6 +26,12 @@ func TestStringOps(t *testing.T) {
 
 	// Make sure numbers don't interact in a funny way.
 	assert.Equal(t, "Number1234", ToCamelCase("number-1234"), "Number Camelcasing not working.")
+
+	// Uppercase runs keep the casing of the spec
+	assert.Equal(t, "HTTPProxy", ToCamelCase("HTTPProxy"))
+	assert.Equal(t, "HttpProxy", ToCamelCase("HttpProxy"))
+	assert.Equal(t, "HttpProxy", ToCamelCase("http_proxy"))
+	assert.Equal(t, "HTTPProxy", ToCamelCase("HTTP-proxy"))
 }
 
 func TestSortedSchemaKeys(t *testing.T) {
@@ -135,7 +141,7 @@ func TestSortedRequestBodyKeys(t *testing.T) {
 
 func TestRefPathToGoType(t *testing.T) {
 	old := importMapping
-	importMapping = constructImportMapping(map[string]string{
+	importMapping, _ = constructImportMapping(map[string]string{
 		"doc.json":                    "externalref0",
 		"http://deepmap.com/doc.json": "externalref1",
 	})
@@ -338,6 +344,34 @@ func TestEscapePathElements(t *testing.T) {
 	assert.Equal(t, "/foo/bar%3Abaz", EscapePathElements(p))
 }
 
+func TestToTitleCase(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]string{
+		"HTTP_server-ID": "HttpServerId",
+		"getPetByID":     "Getpetbyid",
+		"pets.v2":        "PetsV2",
+		" snake_case ":   "SnakeCase",
+		"éCOLE":          "École",
+	} {
+		assert.Equal(t, want, ToTitleCase(in))
+	}
+}
+
+func TestToScreamingSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	for in, want := range map[string]string{
+		"PetKindCat":        "PET_KIND_CAT",
+		"PetKindHTTPError":  "PET_KIND_HTTP_ERROR",
+		"Version2Beta":      "VERSION2_BETA",
+		"N1":                "N1",
+		"Already_Separated": "ALREADY_SEPARATED",
+	} {
+		assert.Equal(t, want, ToScreamingSnakeCase(in))
+	}
+}
+
 func TestSchemaNameToTypeName(t *testing.T) {
 	t.Parallel()
 
@@ -359,3 +393,42 @@ func TestSchemaNameToTypeName(t *testing.T) {
 		assert.Equal(t, want, SchemaNameToTypeName(in))
 	}
 }
+
+func TestDefaultUserAgent(t *testing.T) {
+	assert.Equal(t, "Swagger-Petstore/1.0.0", DefaultUserAgent(&openapi3.Info{Title: "Swagger Petstore", Version: "1.0.0"}))
+	assert.Equal(t, "Pets--v2-/2022-01-01", DefaultUserAgent(&openapi3.Info{Title: " Pets (v2) ", Version: "2022-01-01"}))
+	assert.Equal(t, "Pets", DefaultUserAgent(&openapi3.Info{Title: "Pets"}))
+	assert.Equal(t, "", DefaultUserAgent(&openapi3.Info{Version: "1.0.0"}))
+	assert.Equal(t, "", DefaultUserAgent(nil))
+}
+
+func TestSanitizeEnumNames(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"FooBar":   "foo-bar",
+		"FooBar1":  "foo_bar",
+		"FooBar11": "FooBar1",
+		"Baz":      "baz",
+	}, SanitizeEnumNames([]string{"foo-bar", "foo_bar", "FooBar1", "baz", "foo-bar"}))
+}
+
+func TestReservedWords(t *testing.T) {
+	defer func() { options = Options{} }()
+
+	for strategy, want := range map[string][]string{
+		"":                            {"_type", "_string", "foo_bar"},
+		ReservedWordsUnderscorePrefix: {"_type", "_string", "foo_bar"},
+		ReservedWordsValueSuffix:      {"typeValue", "stringValue", "foo_bar"},
+		ReservedWordsPascalCase:       {"Type", "String", "foo_bar"},
+	} {
+		options = Options{ReservedWords: strategy}
+		assert.Equal(t, want, []string{SanitizeGoIdentity("type"), SanitizeGoIdentity("string"), SanitizeGoIdentity("foo-bar")})
+	}
+
+	options = Options{ReservedWords: ReservedWordsPascalCase, ReservedWordsMap: map[string]string{"type": "Kind"}}
+	assert.Equal(t, "Kind", SanitizeGoIdentity("type"))
+	assert.Equal(t, "Func", SanitizeGoIdentity("func"))
+
+	assert.EqualError(t, checkReservedWords(Options{ReservedWords: "suffix"}), `unknown reserved word strategy "suffix"`)
+	assert.EqualError(t, checkReservedWords(Options{ReservedWordsMap: map[string]string{"type": "int"}}), `the replacement of reserved word "type", "int", can't be used as a Go identifier`)
+	assert.Error(t, checkReservedWords(Options{ReservedWordsMap: map[string]string{"type": ""}}))
+}
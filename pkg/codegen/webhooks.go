@@ -0,0 +1,154 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// The webhooks of OpenAPI 3.1 specs, and their extension in 3.0 ones, which
+// the loader keeps as extensions as it only knows of 3.0.
+const (
+	webhooksKey    = "webhooks"
+	extPropWebhook = "x-webhooks"
+)
+
+// webhookItems returns the path items of the webhooks of the spec, by webhook
+// name, with their references resolved against its components.
+func webhookItems(swagger *openapi3.T) (openapi3.Paths, error) {
+	items := openapi3.Paths{}
+	for _, key := range []string{extPropWebhook, webhooksKey} {
+		raw, ok := swagger.Extensions[key].(json.RawMessage)
+		if !ok {
+			continue
+		}
+		var webhooks openapi3.Paths
+		if err := json.Unmarshal(raw, &webhooks); err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", key, err)
+		}
+		for name, item := range webhooks {
+			if _, found := items[name]; found {
+				return nil, fmt.Errorf("webhook %q is declared in both %s and %s", name, extPropWebhook, webhooksKey)
+			}
+			items[name] = item
+		}
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	doc := &openapi3.T{Components: swagger.Components, Paths: items}
+	if err := openapi3.NewLoader().ResolveRefsIn(doc, nil); err != nil {
+		return nil, fmt.Errorf("error resolving references in webhooks: %w", err)
+	}
+	return items, nil
+}
+
+// WebhookDefinitions returns the operations of the webhooks of a swagger
+// definition, whose names are unique among the given operations of its paths.
+// Webhooks have no path: they're sent to the URLs their consumers subscribe
+// with. Operations without an ID are named after their webhook.
+func WebhookDefinitions(swagger *openapi3.T, operations []OperationDefinition) ([]OperationDefinition, error) {
+	items, err := webhookItems(swagger)
+	if err != nil || items == nil {
+		return nil, err
+	}
+
+	operationNames := newNameResolver("operation")
+	for _, op := range operations {
+		operationNames.unique(op.OperationId, op.OperationId, op.Spec.Tags)
+	}
+	webhooks, err := describeOperations(swagger, items, false, operationNames, func(opName, webhook string) (string, error) {
+		if len(items[webhook].Operations()) > 1 {
+			return generateDefaultOperationID(opName, webhook)
+		}
+		return normalizeName(webhook), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error describing webhooks: %w", err)
+	}
+	if err := operationNames.err(); err != nil {
+		return nil, err
+	}
+
+	for i := range webhooks {
+		webhooks[i].Webhook = webhooks[i].Path
+		webhooks[i].Path = ""
+	}
+	return webhooks, nil
+}
+
+// outboundOperations are the operations of the requests which the API sends
+// rather than serves, of the Webhook or Callback kind, which the templates of
+// their senders and handlers are executed with.
+type outboundOperations struct {
+	Kind       string
+	Operations []OperationDefinition
+}
+
+// GenerateWebhookSender generates a WebhookSender, which producers send the
+// webhooks with, to the URLs of their subscribers, along with the builders of
+// its requests.
+func GenerateWebhookSender(t *template.Template, webhooks []OperationDefinition) (string, error) {
+	return generateOutboundSender(t, outboundOperations{Kind: "Webhook", Operations: webhooks})
+}
+
+// GenerateWebhookHandlers generates the WebhookInterface which consumers
+// implement to handle the webhooks, and the net/http handlers serving it,
+// which verify the signatures of the webhooks.
+func GenerateWebhookHandlers(t *template.Template, webhooks []OperationDefinition) (string, error) {
+	return generateOutboundHandlers(t, outboundOperations{Kind: "Webhook", Operations: webhooks})
+}
+
+// generateOutboundSender generates the sender of the operations, and the
+// builders of their requests.
+func generateOutboundSender(t *template.Template, outbound outboundOperations) (string, error) {
+	sender, err := GenerateTemplates([]string{"outbound-sender.tmpl"}, t, outbound)
+	if err != nil {
+		return "", err
+	}
+	builders, err := GenerateTemplates([]string{"client-request-builders.tmpl"}, t, outbound.Operations)
+	if err != nil {
+		return "", err
+	}
+	return sender + builders, nil
+}
+
+// generateOutboundHandlers generates the interface handling the requests of
+// the operations, its wrapper binding their parameters, and the decoders of
+// their bodies.
+func generateOutboundHandlers(t *template.Template, outbound outboundOperations) (string, error) {
+	handlers, err := GenerateTemplates([]string{"outbound-handlers.tmpl"}, t, outbound)
+	if err != nil {
+		return "", err
+	}
+	var withBody []OperationDefinition
+	for _, op := range outbound.Operations {
+		if len(op.Bodies) > 0 || op.MultipartBody != nil {
+			withBody = append(withBody, op)
+		}
+	}
+	if len(withBody) == 0 {
+		return handlers, nil
+	}
+	decoders, err := GenerateTemplates([]string{"request-body-decoders.tmpl"}, t, withBody)
+	if err != nil {
+		return "", err
+	}
+	return handlers + decoders, nil
+}
@@ -0,0 +1,105 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const webhooksDefinition = `
+openapi: 3.1.0
+info:
+  title: Webhooks
+  version: 1.0.0
+paths:
+  /orders:
+    get:
+      operationId: orderShipped
+      responses:
+        '200':
+          description: Orders
+webhooks:
+  orderShipped:
+    post:
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Shipment'
+      responses:
+        '204':
+          description: Received
+  order-cancelled:
+    post:
+      responses:
+        '204':
+          description: Received
+    put:
+      responses:
+        '204':
+          description: Received
+components:
+  schemas:
+    Shipment:
+      type: object
+      properties:
+        carrier:
+          type: string
+`
+
+func TestWebhookDefinitions(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(webhooksDefinition))
+	require.NoError(t, err)
+
+	ops, err := OperationDefinitions(swagger)
+	require.NoError(t, err)
+	webhooks, err := WebhookDefinitions(swagger, ops)
+	require.NoError(t, err)
+
+	var names []string
+	for _, webhook := range webhooks {
+		names = append(names, webhook.Method+" "+webhook.Webhook+" "+webhook.OperationId)
+		assert.Empty(t, webhook.Path)
+	}
+	assert.Equal(t, []string{
+		"POST order-cancelled PostOrderCancelled",
+		"PUT order-cancelled PutOrderCancelled",
+		"POST orderShipped OrderShipped",
+	}, names)
+
+	_, err = WebhookDefinitions(swagger, nil)
+	require.NoError(t, err)
+	saved := options
+	defer func() { options = saved }()
+	options.NameCollisions = NameCollisionsError
+	_, err = WebhookDefinitions(swagger, ops)
+	assert.Error(t, err)
+}
+
+func TestGenerateWebhooks(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(webhooksDefinition))
+	require.NoError(t, err)
+
+	code, err := Generate(swagger, "webhooks", Options{
+		GenerateTypes:      true,
+		GenerateClient:     true,
+		GenerateEchoServer: true,
+		NameCollisions:     NameCollisionsNumericSuffix,
+	})
+	require.NoError(t, err)
+	// The schema of the webhook isn't pruned.
+	assert.Contains(t, code, "type Shipment struct {")
+	assert.Contains(t, code, "func (s *WebhookSender) SendOrderShipped2(ctx context.Context, url string, body OrderShipped2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {")
+	assert.Contains(t, code, "PutOrderCancelled(w http.ResponseWriter, r *http.Request)\n")
+	// Consumers' handlers are given the decoded body.
+	assert.Contains(t, code, "OrderShipped2(w http.ResponseWriter, r *http.Request, body OrderShipped2JSONRequestBody)\n")
+	assert.Contains(t, code, "func (siw *WebhookInterfaceWrapper) OrderShipped2(w http.ResponseWriter, r *http.Request) {")
+	assert.Contains(t, code, "func NewOrderShipped2WebhookHandler(si WebhookInterface, options WebhookHandlerOptions) http.Handler {")
+
+	// Without a server, consumers' handlers aren't generated.
+	code, err = Generate(swagger, "webhooks", Options{GenerateTypes: true, GenerateClient: true})
+	require.NoError(t, err)
+	assert.NotContains(t, code, "WebhookInterface")
+}
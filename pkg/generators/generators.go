@@ -0,0 +1,135 @@
+// Package generators contains gopter generators of values of schema types,
+// which the property generators of generated code are made of, for property
+// based tests of models and of the code using them.
+package generators
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// String generates alphanumeric strings of at least minLength characters,
+// and of at most maxLength ones, or of at most the size of the generator
+// parameters more when maxLength is negative.
+func String(minLength, maxLength int) gopter.Gen {
+	return func(p *gopter.GenParameters) *gopter.GenResult {
+		n := length(p, minLength, maxLength, p.MaxSize)
+		return gen.SliceOfN(n, gen.AlphaNumChar()).Map(func(r []rune) string {
+			return string(r)
+		})(p)
+	}
+}
+
+// Slice generates slices of type t of elements generated by elem, of at least
+// minItems elements, and of at most maxItems ones, or of at most a tenth of
+// the size of the generator parameters more when maxItems is negative, as
+// the elements may be large themselves.
+func Slice(minItems, maxItems int, elem gopter.Gen, t reflect.Type) gopter.Gen {
+	return func(p *gopter.GenParameters) *gopter.GenResult {
+		n := length(p, minItems, maxItems, p.MaxSize/10)
+		// The elements are generated here rather than by gen.SliceOfN, whose
+		// values must pass the sieve of its first element, which fails the
+		// elements of recursive types with other numbers of items.
+		slice := reflect.MakeSlice(t, 0, n)
+		shrinker := gopter.NoShrinker
+		for i := 0; i < n; i++ {
+			result := elem(p)
+			value, ok := result.Retrieve()
+			if !ok {
+				return gopter.NewEmptyResult(t)
+			}
+			slice = reflect.Append(slice, reflect.ValueOf(value))
+			shrinker = result.Shrinker
+		}
+		return gopter.NewGenResult(slice.Interface(), gen.SliceShrinkerOne(shrinker))
+	}
+}
+
+// Ptr generates pointers of type t to the values generated by elem, or nil
+// pointers, half of the time and always once the size of the generator
+// parameters is down to zero, which ends the values of recursive types.
+func Ptr(elem gopter.Gen, t reflect.Type) gopter.Gen {
+	return func(p *gopter.GenParameters) *gopter.GenResult {
+		if p.MaxSize <= 0 || p.NextBool() {
+			return gopter.NewGenResult(reflect.Zero(t).Interface(), gopter.NoShrinker)
+		}
+		result := elem(p)
+		value, ok := result.Retrieve()
+		if !ok {
+			return gopter.NewGenResult(reflect.Zero(t).Interface(), gopter.NoShrinker)
+		}
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(reflect.ValueOf(value))
+		return gopter.NewGenResult(ptr.Interface(), gopter.NoShrinker)
+	}
+}
+
+// length picks the length of a string or slice, between min and max, with
+// at most maxExtra more than min when max is negative.
+func length(p *gopter.GenParameters, min, max, maxExtra int) int {
+	if max < 0 || max > min+maxExtra {
+		max = min + maxExtra
+	}
+	if max <= min {
+		return min
+	}
+	return min + p.Rng.Intn(max-min+1)
+}
+
+// Lazy calls generator when values are generated, rather than when the
+// generator is built, with half the size, so that generators of recursive
+// types, which refer to themselves, end: their optional values are nil and
+// their arrays are down to their minimum items once the size is zero.
+func Lazy(generator func() gopter.Gen) gopter.Gen {
+	return func(p *gopter.GenParameters) *gopter.GenResult {
+		return generator()(p.WithSize(p.MaxSize / 2))
+	}
+}
+
+// Date generates dates from 1970 to 2070.
+func Date() gopter.Gen {
+	return gen.IntRange(0, 365*100).Map(func(days int) types.Date {
+		return types.Date{Time: time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, days)}
+	})
+}
+
+// DateTime generates times from 1970 to 2070, in UTC, to the second, which
+// survive being marshaled.
+func DateTime() gopter.Gen {
+	return gen.Int64Range(0, 100*365*24*3600).Map(func(seconds int64) time.Time {
+		return time.Unix(seconds, 0).UTC()
+	})
+}
+
+// UUID generates random UUIDs.
+func UUID() gopter.Gen {
+	return gen.SliceOfN(16, gen.UInt8()).Map(func(b []byte) types.UUID {
+		var u types.UUID
+		copy(u[:], b)
+		return u
+	})
+}
+
+// Email generates email addresses at example.com.
+func Email() gopter.Gen {
+	return String(1, 20).Map(func(user string) types.Email {
+		return types.Email(user + "@example.com")
+	})
+}
+
+// JSON generates the value which the JSON example unmarshals into, which v
+// points to, for the values generators can't be built for. It panics when the
+// example doesn't fit the type of v.
+func JSON(example string, v interface{}) gopter.Gen {
+	if err := json.Unmarshal([]byte(example), v); err != nil {
+		panic(fmt.Sprintf("error unmarshaling the example %s: %s", example, err))
+	}
+	return gen.Const(reflect.ValueOf(v).Elem().Interface())
+}
@@ -0,0 +1,234 @@
+// Package oidc contains a verifier of the bearer tokens of openIdConnect
+// security schemes, which discovers the keys of their issuer, and which can
+// be used as the AuthenticationFunc of the request validator middlewares.
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+// ErrMissingScopes is returned for valid tokens which lack required scopes.
+var ErrMissingScopes = errors.New("token is missing required scopes")
+
+// Discovery is the part of the OpenID Connect discovery document of an issuer
+// which tokens are verified with.
+type Discovery struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// Options configures a Verifier.
+type Options struct {
+	// The client fetching the discovery document and keys, a new http.Client
+	// when nil.
+	HTTPClient *http.Client
+	// How long expired tokens are still accepted, for the clock skew between
+	// the issuer and the server.
+	AcceptableSkew time.Duration
+	// The minimum time between fetches of the keys, which are fetched again
+	// when a token is signed by an unknown one, as issuers rotate their keys.
+	// A minute when zero.
+	MinRefreshInterval time.Duration
+}
+
+// Verifier verifies the bearer tokens of an openIdConnect security scheme:
+// their signature by the keys of the issuer, their issuer and audience, their
+// validity period and their scopes.
+type Verifier struct {
+	SchemeName string // The name of the security scheme in the spec
+	Discovery  Discovery
+	Audience   string
+
+	options     Options
+	mu          sync.Mutex
+	keys        jwk.Set
+	lastRefresh time.Time
+}
+
+// NewVerifier fetches the discovery document at the openIdConnectUrl of the
+// security scheme of the given name, and the keys of its issuer, and returns
+// a verifier of the tokens issued for the audience.
+func NewVerifier(ctx context.Context, discoveryURL, schemeName, audience string, options Options) (*Verifier, error) {
+	if options.HTTPClient == nil {
+		options.HTTPClient = &http.Client{}
+	}
+	if options.MinRefreshInterval == 0 {
+		options.MinRefreshInterval = time.Minute
+	}
+
+	discovery, err := Discover(ctx, options.HTTPClient, discoveryURL)
+	if err != nil {
+		return nil, err
+	}
+	v := &Verifier{
+		SchemeName: schemeName,
+		Discovery:  *discovery,
+		Audience:   audience,
+		options:    options,
+	}
+	if _, err := v.refreshKeys(ctx, nil); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Discover fetches the discovery document at url, which must be the one of
+// its issuer.
+func Discover(ctx context.Context, client *http.Client, url string) (*Discovery, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the OpenID Connect discovery document: %w", err)
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching the OpenID Connect discovery document: %s", rsp.Status)
+	}
+
+	var discovery Discovery
+	if err := json.NewDecoder(rsp.Body).Decode(&discovery); err != nil {
+		return nil, fmt.Errorf("error parsing the OpenID Connect discovery document: %w", err)
+	}
+	if discovery.Issuer == "" || discovery.JWKSURI == "" {
+		return nil, errors.New("the OpenID Connect discovery document has no issuer or jwks_uri")
+	}
+	// Discovery documents are published under their issuer, which mustn't be
+	// able to impersonate others.
+	if strings.TrimSuffix(url, "/.well-known/openid-configuration") != strings.TrimSuffix(discovery.Issuer, "/") {
+		return nil, fmt.Errorf("the OpenID Connect discovery document at %s is of another issuer, %s", url, discovery.Issuer)
+	}
+	return &discovery, nil
+}
+
+// refreshKeys fetches the keys of the issuer, unless they were fetched less
+// than the minimum refresh interval ago, or since stale were, and returns
+// them.
+func (v *Verifier) refreshKeys(ctx context.Context, stale jwk.Set) (jwk.Set, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.keys != nil && (v.keys != stale || time.Since(v.lastRefresh) < v.options.MinRefreshInterval) {
+		return v.keys, nil
+	}
+	keys, err := jwk.Fetch(ctx, v.Discovery.JWKSURI, jwk.WithHTTPClient(v.options.HTTPClient))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the keys of %s: %w", v.Discovery.Issuer, err)
+	}
+	v.keys, v.lastRefresh = keys, time.Now()
+	return keys, nil
+}
+
+// Verify verifies a token, which must have all the scopes, and returns it.
+func (v *Verifier) Verify(ctx context.Context, token string, scopes []string) (jwt.Token, error) {
+	v.mu.Lock()
+	keys := v.keys
+	v.mu.Unlock()
+
+	parsed, err := v.parse(token, keys)
+	if err != nil {
+		// The token may be signed by a key the issuer rotated in.
+		refreshed, refreshErr := v.refreshKeys(ctx, keys)
+		if refreshErr != nil || refreshed == keys {
+			return nil, err
+		}
+		if parsed, err = v.parse(token, refreshed); err != nil {
+			return nil, err
+		}
+	}
+
+	granted := make(map[string]bool)
+	for _, scope := range tokenScopes(parsed) {
+		granted[scope] = true
+	}
+	var missing []string
+	for _, scope := range scopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingScopes, strings.Join(missing, ", "))
+	}
+	return parsed, nil
+}
+
+// parse parses a token signed by one of the keys, and validates its claims.
+func (v *Verifier) parse(token string, keys jwk.Set) (jwt.Token, error) {
+	return jwt.Parse([]byte(token),
+		jwt.WithKeySet(keys),
+		jwt.InferAlgorithmFromKey(true),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(v.Discovery.Issuer),
+		jwt.WithAudience(v.Audience),
+		jwt.WithAcceptableSkew(v.options.AcceptableSkew),
+	)
+}
+
+// tokenScopes returns the scopes of a token, which are in its space-separated
+// scope claim, or in its scp claim, as some issuers put them.
+func tokenScopes(token jwt.Token) []string {
+	for _, claim := range []string{"scope", "scp"} {
+		value, found := token.Get(claim)
+		if !found {
+			continue
+		}
+		switch value := value.(type) {
+		case string:
+			return strings.Fields(value)
+		case []interface{}:
+			var scopes []string
+			for _, scope := range value {
+				if s, ok := scope.(string); ok {
+					scopes = append(scopes, s)
+				}
+			}
+			return scopes
+		}
+	}
+	return nil
+}
+
+// AuthenticationFunc returns an openapi3filter.AuthenticationFunc, for the
+// request validator middlewares, which verifies the bearer tokens of the
+// requests of the operations requiring the security scheme, with the scopes
+// they require. Other security schemes fail.
+func (v *Verifier) AuthenticationFunc() openapi3filter.AuthenticationFunc {
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+		if input.SecuritySchemeName != v.SchemeName {
+			return fmt.Errorf("security scheme %s isn't verified by the verifier of %s", input.SecuritySchemeName, v.SchemeName)
+		}
+		token, err := BearerToken(input.RequestValidationInput.Request)
+		if err != nil {
+			return err
+		}
+		_, err = v.Verify(ctx, token, input.Scopes)
+		return err
+	}
+}
+
+// BearerToken returns the token in the "Authorization: Bearer" header of a
+// request.
+func BearerToken(req *http.Request) (string, error) {
+	header := req.Header.Get("Authorization")
+	if header == "" {
+		return "", errors.New("authorization header is missing")
+	}
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", errors.New("authorization header isn't a bearer token")
+	}
+	return strings.TrimSpace(header[len(prefix):]), nil
+}
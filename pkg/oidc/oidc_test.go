@@ -0,0 +1,130 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// issuer is a fake OpenID Connect issuer, serving its discovery document and
+// keys, which signs tokens with its current key.
+type issuer struct {
+	*httptest.Server
+	key  jwk.Key
+	keys jwk.Set
+}
+
+func newIssuer(t *testing.T) *issuer {
+	i := &issuer{keys: jwk.NewSet()}
+	i.rotate(t, "key-1")
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Discovery{Issuer: i.URL, JWKSURI: i.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(i.keys)
+	})
+	i.Server = httptest.NewServer(mux)
+	t.Cleanup(i.Close)
+	return i
+}
+
+// rotate makes a new key the current one.
+func (i *issuer) rotate(t *testing.T, id string) {
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	i.key, err = jwk.New(private)
+	require.NoError(t, err)
+	require.NoError(t, i.key.Set(jwk.KeyIDKey, id))
+	require.NoError(t, i.key.Set(jwk.AlgorithmKey, jwa.ES256))
+	public, err := i.key.PublicKey()
+	require.NoError(t, err)
+	i.keys.Add(public)
+}
+
+func (i *issuer) token(t *testing.T, claims map[string]interface{}) string {
+	token := jwt.New()
+	require.NoError(t, token.Set(jwt.IssuerKey, i.URL))
+	require.NoError(t, token.Set(jwt.AudienceKey, "api"))
+	require.NoError(t, token.Set(jwt.ExpirationKey, time.Now().Add(time.Hour)))
+	for name, value := range claims {
+		require.NoError(t, token.Set(name, value))
+	}
+	signed, err := jwt.Sign(token, jwa.ES256, i.key)
+	require.NoError(t, err)
+	return string(signed)
+}
+
+func TestVerifier(t *testing.T) {
+	iss := newIssuer(t)
+	ctx := context.Background()
+	v, err := NewVerifier(ctx, iss.URL+"/.well-known/openid-configuration", "oidc", "api", Options{MinRefreshInterval: time.Nanosecond})
+	require.NoError(t, err)
+	assert.Equal(t, iss.URL+"/keys", v.Discovery.JWKSURI)
+
+	_, err = v.Verify(ctx, iss.token(t, map[string]interface{}{"scope": "pets:read pets:write"}), []string{"pets:write"})
+	assert.NoError(t, err)
+	_, err = v.Verify(ctx, iss.token(t, map[string]interface{}{"scp": []string{"pets:read"}}), []string{"pets:read"})
+	assert.NoError(t, err)
+	_, err = v.Verify(ctx, iss.token(t, map[string]interface{}{"scope": "pets:read"}), []string{"pets:write"})
+	assert.ErrorIs(t, err, ErrMissingScopes)
+
+	_, err = v.Verify(ctx, iss.token(t, map[string]interface{}{jwt.AudienceKey: "other"}), nil)
+	assert.Error(t, err)
+	_, err = v.Verify(ctx, iss.token(t, map[string]interface{}{jwt.ExpirationKey: time.Now().Add(-time.Hour)}), nil)
+	assert.Error(t, err)
+
+	// Tokens signed by keys the issuer rotated in are verified once its keys
+	// are fetched again.
+	iss.rotate(t, "key-2")
+	_, err = v.Verify(ctx, iss.token(t, nil), nil)
+	assert.NoError(t, err)
+}
+
+func TestDiscoverOtherIssuer(t *testing.T) {
+	iss := newIssuer(t)
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Discovery{Issuer: iss.URL, JWKSURI: iss.URL + "/keys"})
+	}))
+	defer other.Close()
+
+	_, err := Discover(context.Background(), http.DefaultClient, other.URL+"/.well-known/openid-configuration")
+	assert.Error(t, err)
+}
+
+func TestAuthenticationFunc(t *testing.T) {
+	iss := newIssuer(t)
+	v, err := NewVerifier(context.Background(), iss.URL+"/.well-known/openid-configuration", "oidc", "api", Options{})
+	require.NoError(t, err)
+	authenticate := v.AuthenticationFunc()
+
+	input := func(scheme, authorization string) *openapi3filter.AuthenticationInput {
+		req := httptest.NewRequest(http.MethodGet, "/pets", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return &openapi3filter.AuthenticationInput{
+			RequestValidationInput: &openapi3filter.RequestValidationInput{Request: req},
+			SecuritySchemeName:     scheme,
+			Scopes:                 []string{"pets:read"},
+		}
+	}
+	token := iss.token(t, map[string]interface{}{"scope": "pets:read"})
+	assert.NoError(t, authenticate(context.Background(), input("oidc", "Bearer "+token)))
+	assert.Error(t, authenticate(context.Background(), input("oidc", "")))
+	assert.Error(t, authenticate(context.Background(), input("oidc", "Basic abc")))
+	assert.Error(t, authenticate(context.Background(), input("apiKey", "Bearer "+token)))
+}
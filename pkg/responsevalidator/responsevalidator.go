@@ -0,0 +1,113 @@
+// Package responsevalidator contains a response validator, which can be used
+// as a ResponseValidatorFn of a client to make sure that responses received
+// from a server conform to the given OAPI 3.0 specification.
+package responsevalidator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+)
+
+// Options to customize response validation, openapi3filter specified options will be passed through.
+type Options struct {
+	Options openapi3filter.Options
+}
+
+// ResponseValidator checks responses against the operation which produced
+// them in a swagger spec.
+type ResponseValidator struct {
+	router  routers.Router
+	options *Options
+}
+
+// NewResponseValidator creates a ResponseValidator from a swagger object,
+// such as the one returned by GetSwagger in generated code. Routes are
+// matched against the servers listed in the spec, so if the client talks to
+// a server which isn't listed there, set swagger.Servers to nil first.
+func NewResponseValidator(swagger *openapi3.T) (*ResponseValidator, error) {
+	return NewResponseValidatorWithOptions(swagger, nil)
+}
+
+// NewResponseValidatorWithOptions creates a ResponseValidator from a swagger
+// object, with validation options.
+func NewResponseValidatorWithOptions(swagger *openapi3.T, options *Options) (*ResponseValidator, error) {
+	router, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("error creating router from swagger spec: %w", err)
+	}
+	return &ResponseValidator{
+		router:  router,
+		options: options,
+	}, nil
+}
+
+// Validate checks the response to the given request against the spec,
+// returning a descriptive error when it doesn't conform. The response body
+// is read in full, and replaced so that it can still be read by the caller.
+func (v *ResponseValidator) Validate(ctx context.Context, req *http.Request, rsp *http.Response) error {
+	route, pathParams, err := v.router.FindRoute(req)
+	if err != nil {
+		return fmt.Errorf("error finding route for %s %s: %w", req.Method, req.URL.Path, err)
+	}
+
+	body, err := ioutil.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+	rsp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	responseValidationInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+		},
+		Status: rsp.StatusCode,
+		Header: rsp.Header,
+	}
+	responseValidationInput.SetBodyBytes(body)
+
+	if v.options != nil {
+		responseValidationInput.Options = &v.options.Options
+	}
+
+	if err := openapi3filter.ValidateResponse(ctx, responseValidationInput); err != nil {
+		// openapi errors seem to be multi-line with a decent message on the first
+		errorLines := strings.Split(err.Error(), "\n")
+		return &ResponseValidationError{
+			OperationID: route.Operation.OperationID,
+			StatusCode:  rsp.StatusCode,
+			Message:     errorLines[0],
+			Err:         err,
+		}
+	}
+	return nil
+}
+
+// ResponseValidationError is returned when a response doesn't conform to
+// the spec.
+type ResponseValidationError struct {
+	OperationID string
+	StatusCode  int
+	Message     string
+	Err         error
+}
+
+func (e *ResponseValidationError) Error() string {
+	return fmt.Sprintf("response %d for operation %s does not conform to the specification: %s",
+		e.StatusCode, e.OperationID, e.Message)
+}
+
+func (e *ResponseValidationError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,78 @@
+package responsevalidator
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = `openapi: "3.0.0"
+info:
+  version: 1.0.0
+  title: TestServer
+paths:
+  /resource:
+    get:
+      operationId: getResource
+      responses:
+        '200':
+          description: success
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [name]
+                properties:
+                  name:
+                    type: string
+                  id:
+                    type: integer
+`
+
+func doGet(t *testing.T, v *ResponseValidator, body string) (*http.Response, error) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/resource", nil)
+	require.NoError(t, err)
+	rsp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	return rsp, v.Validate(context.Background(), req, rsp)
+}
+
+func TestResponseValidator(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(testSchema))
+	require.NoError(t, err)
+
+	v, err := NewResponseValidator(swagger)
+	require.NoError(t, err)
+
+	// A conforming response passes, and its body can still be read.
+	rsp, err := doGet(t, v, `{"name": "a", "id": 5}`)
+	assert.NoError(t, err)
+	body, err := ioutil.ReadAll(rsp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"name": "a", "id": 5}`, string(body))
+
+	// A missing required property is reported.
+	_, err = doGet(t, v, `{"id": 5}`)
+	require.Error(t, err)
+	var validationErr *ResponseValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "getResource", validationErr.OperationID)
+	assert.Equal(t, http.StatusOK, validationErr.StatusCode)
+
+	// So is a property of the wrong type.
+	_, err = doGet(t, v, `{"name": "a", "id": "five"}`)
+	assert.Error(t, err)
+}
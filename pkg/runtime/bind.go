@@ -21,4 +21,22 @@ package runtime
 // Binder implements Bind("") as a no-op.
 type Binder interface {
 	Bind(src string) error
-}
\ No newline at end of file
+}
+
+// StyledBinder is the interface implemented by types which bind parameters
+// depending on their style, such as types of x-go-type extensions with a
+// syntax of their own. It takes precedence over Binder and
+// encoding.TextUnmarshaler when binding styled and query parameters, except
+// those of the deepObject style. The value is unescaped, and the prefix of the
+// style, such as the dot of the label style, is removed from it.
+type StyledBinder interface {
+	BindStyled(style string, explode bool, paramName string, value string) error
+}
+
+// Unbinder is the counterpart of StyledBinder, implemented by types which
+// turn themselves into the value of a parameter of a given style. The value is
+// then prefixed and escaped as the style and the location of the parameter
+// require.
+type Unbinder interface {
+	Unbind(style string, explode bool, paramName string) (string, error)
+}
@@ -39,8 +39,15 @@ func BindStyledParameter(style string, explode bool, paramName string,
 // This function binds a parameter as described in the Path Parameters
 // section here to a Go object:
 // https://swagger.io/docs/specification/serialization/
+// Errors are of type *InvalidParamFormatError.
 func BindStyledParameterWithLocation(style string, explode bool, paramName string,
 	paramLocation ParamLocation, value string, dest interface{}) error {
+	err := bindStyledParameter(style, explode, paramName, paramLocation, value, dest)
+	return paramError(paramName, paramLocation, err)
+}
+
+func bindStyledParameter(style string, explode bool, paramName string,
+	paramLocation ParamLocation, value string, dest interface{}) error {
 
 	if value == "" {
 		return fmt.Errorf("parameter '%s' is empty, can't bind its value", paramName)
@@ -62,11 +69,29 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 			return fmt.Errorf("error unescaping path parameter '%s': %v", paramName, err)
 		}
 	default:
-		// Headers and cookies aren't escaped.
+		// Headers aren't escaped, and each part of cookies is, so that they're
+		// unescaped once split.
+	}
+
+	// If the destination binds itself depending on the style, it's given the
+	// value without the prefix of the style.
+	if sb, ok := dest.(StyledBinder); ok {
+		switch style {
+		case "label":
+			value = strings.TrimPrefix(value, ".")
+		case "matrix":
+			value = strings.TrimPrefix(value, ";"+paramName+"=")
+		}
+		value = unescapeCookiePart(paramLocation, value)
+		if err := sb.BindStyled(style, explode, paramName, value); err != nil {
+			return fmt.Errorf("error binding parameter '%s': %w", paramName, err)
+		}
+		return nil
 	}
 
 	// If the destination implements encoding.TextUnmarshaler we use it for binding
 	if tu, ok := dest.(encoding.TextUnmarshaler); ok {
+		value = unescapeCookiePart(paramLocation, value)
 		if err := tu.UnmarshalText([]byte(value)); err != nil {
 			return fmt.Errorf("error unmarshaling '%s' text as %T: %s", value, dest, err)
 		}
@@ -87,6 +112,7 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 		if err != nil {
 			return err
 		}
+		unescapeCookieParts(paramLocation, parts)
 
 		return bindSplitPartsToDestinationStruct(paramName, parts, explode, dest)
 	}
@@ -97,14 +123,40 @@ func BindStyledParameterWithLocation(style string, explode bool, paramName strin
 		if err != nil {
 			return fmt.Errorf("error splitting input '%s' into parts: %s", value, err)
 		}
+		unescapeCookieParts(paramLocation, parts)
 
 		return bindSplitPartsToDestinationArray(parts, dest)
 	}
 
 	// Try to bind the remaining types as a base type.
+	value = unescapeCookiePart(paramLocation, value)
 	return BindStringToObject(value, dest)
 }
 
+// unescapeCookieParts unescapes the parts of a cookie parameter, which are
+// percent-encoded by generated clients, in place. Parts of parameters in
+// other locations are left as they are.
+func unescapeCookieParts(paramLocation ParamLocation, parts []string) {
+	for i, part := range parts {
+		parts[i] = unescapeCookiePart(paramLocation, part)
+	}
+}
+
+// unescapeCookiePart unescapes a part of a cookie parameter. Cookies which
+// weren't sent by generated clients may have a % which isn't an escape, in
+// which case the part is taken as it is.
+func unescapeCookiePart(paramLocation ParamLocation, part string) string {
+	if paramLocation != ParamLocationCookie {
+		return part
+	}
+	// Unlike query parameters, '+' stands for itself.
+	unescaped, err := url.PathUnescape(part)
+	if err != nil {
+		return part
+	}
+	return unescaped
+}
+
 // This is a complex set of operations, but each given parameter style can be
 // packed together in multiple ways, using different styles of separators, and
 // different packing strategies based on the explode flag. This function takes
@@ -277,8 +329,17 @@ func bindSplitPartsToDestinationStruct(paramName string, parts []string, explode
 // tell them apart. This code tries to fail, but the moral of the story is that
 // you shouldn't pass objects via form styled query arguments, just use
 // the Content parameter form.
+//
+// Errors are of type *RequiredParamError when a required parameter is
+// missing, and of type *InvalidParamFormatError otherwise.
 func BindQueryParameter(style string, explode bool, required bool, paramName string,
 	queryParams url.Values, dest interface{}) error {
+	err := bindQueryParameter(style, explode, required, paramName, queryParams, dest)
+	return paramError(paramName, ParamLocationQuery, err)
+}
+
+func bindQueryParameter(style string, explode bool, required bool, paramName string,
+	queryParams url.Values, dest interface{}) error {
 
 	// dv = destination value.
 	dv := reflect.Indirect(reflect.ValueOf(dest))
@@ -321,6 +382,28 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 	t := v.Type()
 	k := t.Kind()
 
+	// If the destination binds itself depending on the style, it's given the
+	// single value of the parameter.
+	if sb, ok := output.(StyledBinder); ok && style != "deepObject" {
+		values, found := queryParams[paramName]
+		if !found {
+			if required {
+				return &RequiredParamError{ParamName: paramName, In: ParamLocationQuery}
+			}
+			return nil
+		}
+		if len(values) != 1 {
+			return fmt.Errorf("multiple values for single value parameter '%s'", paramName)
+		}
+		if err := sb.BindStyled(style, explode, paramName, values[0]); err != nil {
+			return fmt.Errorf("error binding parameter '%s': %w", paramName, err)
+		}
+		if !required {
+			dv.Set(reflect.ValueOf(output))
+		}
+		return nil
+	}
+
 	switch style {
 	case "form":
 		var parts []string
@@ -338,13 +421,21 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 				// http library.
 				if !found {
 					if required {
-						return fmt.Errorf("query parameter '%s' is required", paramName)
+						return &RequiredParamError{ParamName: paramName, In: ParamLocationQuery}
 					} else {
 						return nil
 					}
 				}
 				err = bindSplitPartsToDestinationArray(values, output)
 			case reflect.Struct:
+				// Structs which bind themselves, such as times and big
+				// numbers, are primitives, which may be missing.
+				if binder, _, _ := indirect(output); binder != nil && !found {
+					if required {
+						return &RequiredParamError{ParamName: paramName, In: ParamLocationQuery}
+					}
+					return nil
+				}
 				// This case is really annoying, and error prone, but the
 				// form style object binding doesn't tell us which arguments
 				// in the query string correspond to the object's fields. We'll
@@ -355,7 +446,7 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 				// unmarshal.
 				if len(values) == 0 {
 					if required {
-						return fmt.Errorf("query parameter '%s' is required", paramName)
+						return &RequiredParamError{ParamName: paramName, In: ParamLocationQuery}
 					} else {
 						return nil
 					}
@@ -378,7 +469,7 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 			values, found := queryParams[paramName]
 			if !found {
 				if required {
-					return fmt.Errorf("query parameter '%s' is required", paramName)
+					return &RequiredParamError{ParamName: paramName, In: ParamLocationQuery}
 				} else {
 					return nil
 				}
@@ -397,7 +488,7 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 		default:
 			if len(parts) == 0 {
 				if required {
-					return fmt.Errorf("query parameter '%s' is required", paramName)
+					return &RequiredParamError{ParamName: paramName, In: ParamLocationQuery}
 				} else {
 					return nil
 				}
@@ -420,7 +511,36 @@ func BindQueryParameter(style string, explode bool, required bool, paramName str
 		}
 		return UnmarshalDeepObject(dest, paramName, queryParams)
 	case "spaceDelimited", "pipeDelimited":
-		return fmt.Errorf("query arguments of style '%s' aren't yet supported", style)
+		// These styles only apply to arrays, which are the same as exploded
+		// form arrays when they're exploded.
+		if k != reflect.Slice {
+			return fmt.Errorf("style '%s' on parameter '%s' only applies to arrays", style, paramName)
+		}
+		parts, found := queryParams[paramName]
+		if !found {
+			if required {
+				return &RequiredParamError{ParamName: paramName, In: ParamLocationQuery}
+			} else {
+				return nil
+			}
+		}
+		if !explode {
+			if len(parts) != 1 {
+				return fmt.Errorf("parameter '%s' is not exploded, but is specified multiple times", paramName)
+			}
+			separator := " "
+			if style == "pipeDelimited" {
+				separator = "|"
+			}
+			parts = strings.Split(parts[0], separator)
+		}
+		if err := bindSplitPartsToDestinationArray(parts, output); err != nil {
+			return err
+		}
+		if !required {
+			dv.Set(reflect.ValueOf(output))
+		}
+		return nil
 	default:
 		return fmt.Errorf("style '%s' on parameter '%s' is invalid", style, paramName)
 
@@ -497,5 +617,11 @@ func indirect(dest interface{}) (interface{}, reflect.Value, reflect.Type) {
 	if t.ConvertibleTo(reflect.TypeOf(types.Date{})) {
 		return dest, reflect.Value{}, nil
 	}
+	if t.ConvertibleTo(reflect.TypeOf(types.Duration{})) || t.ConvertibleTo(reflect.TypeOf(types.ISODuration{})) {
+		return dest, reflect.Value{}, nil
+	}
+	if reflect.PtrTo(t).Implements(textUnmarshalerType) {
+		return dest, reflect.Value{}, nil
+	}
 	return nil, v, t
 }
@@ -15,7 +15,9 @@ package runtime
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"net/url"
 	"testing"
@@ -335,6 +337,43 @@ func TestBindQueryParameter(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, expected, birthday)
 	})
+
+	t.Run("delimited", func(t *testing.T) {
+		queryParams := url.Values{
+			"space":    {"3 4 5"},
+			"pipe":     {"a|b c"},
+			"exploded": {"3", "4", "5"},
+			"object":   {"role admin"},
+		}
+
+		var space []int
+		err := BindQueryParameter("spaceDelimited", false, true, "space", queryParams, &space)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 4, 5}, space)
+
+		var pipe *[]string
+		err = BindQueryParameter("pipeDelimited", false, false, "pipe", queryParams, &pipe)
+		assert.NoError(t, err)
+		assert.Equal(t, &[]string{"a", "b c"}, pipe)
+
+		var exploded []int
+		err = BindQueryParameter("pipeDelimited", true, true, "exploded", queryParams, &exploded)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 4, 5}, exploded)
+
+		var missing *[]int
+		err = BindQueryParameter("spaceDelimited", false, false, "missing", queryParams, &missing)
+		assert.NoError(t, err)
+		assert.Nil(t, missing)
+		err = BindQueryParameter("spaceDelimited", false, true, "missing", queryParams, &space)
+		assert.Error(t, err)
+
+		var object struct {
+			Role string `json:"role"`
+		}
+		err = BindQueryParameter("spaceDelimited", false, true, "object", queryParams, &object)
+		assert.Error(t, err)
+	})
 }
 
 func TestBindParameterViaAlias(t *testing.T) {
@@ -465,4 +504,171 @@ func TestBindStyledParameterWithLocation(t *testing.T) {
 		"12345678910", &dstBigNumber)
 	assert.NoError(t, err)
 	assert.Equal(t, *expectedBig, dstBigNumber)
+
+	var dstDate types.Date
+	err = BindStyledParameterWithLocation("simple", false, "date", ParamLocationPath, "2020-11-05", &dstDate)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2020, 11, 5, 0, 0, 0, 0, time.UTC), dstDate.Time)
+
+	t.Run("cookie", func(t *testing.T) {
+		value := `a;b "c",d+e 100%`
+		styled, err := StyleParamWithLocation("simple", false, "c", ParamLocationCookie, []string{value, "f"})
+		require.NoError(t, err)
+		assert.Equal(t, "a%3Bb%20%22c%22%2Cd+e%20100%25,f", styled)
+
+		var dst []string
+		err = BindStyledParameterWithLocation("simple", false, "c", ParamLocationCookie, styled, &dst)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{value, "f"}, dst)
+
+		var dstString string
+		err = BindStyledParameterWithLocation("simple", false, "c", ParamLocationCookie, "d+e%20f", &dstString)
+		assert.NoError(t, err)
+		assert.Equal(t, "d+e f", dstString)
+
+		// Values with a % which isn't an escape are taken as they are.
+		err = BindStyledParameterWithLocation("simple", false, "c", ParamLocationCookie, "100%", &dstString)
+		assert.NoError(t, err)
+		assert.Equal(t, "100%", dstString)
+	})
+}
+
+// Coordinates binds itself with a syntax of its own, which depends on whether
+// the parameter is exploded: 1.5:2, or 1.5|2 when exploded.
+type Coordinates struct {
+	Lat, Lon float64
+}
+
+func (c Coordinates) Unbind(style string, explode bool, paramName string) (string, error) {
+	separator := ":"
+	if explode {
+		separator = "|"
+	}
+	return fmt.Sprintf("%g%s%g", c.Lat, separator, c.Lon), nil
+}
+
+func (c *Coordinates) BindStyled(style string, explode bool, paramName string, value string) error {
+	format := "%g:%g"
+	if explode {
+		format = "%g|%g"
+	}
+	_, err := fmt.Sscanf(value, format, &c.Lat, &c.Lon)
+	return err
+}
+
+func TestStyledBinder(t *testing.T) {
+	src := Coordinates{Lat: 1.5, Lon: -2}
+
+	for _, style := range []string{"simple", "label", "matrix"} {
+		for _, explode := range []bool{false, true} {
+			styled, err := StyleParamWithLocation(style, explode, "at", ParamLocationPath, &src)
+			require.NoError(t, err)
+
+			var dst Coordinates
+			err = BindStyledParameterWithLocation(style, explode, "at", ParamLocationPath, styled, &dst)
+			require.NoError(t, err, styled)
+			assert.Equal(t, src, dst, styled)
+		}
+	}
+
+	styled, err := StyleParamWithLocation("label", true, "at", ParamLocationPath, src)
+	require.NoError(t, err)
+	assert.Equal(t, ".1.5%7C-2", styled)
+
+	styled, err = StyleParamWithLocation("form", false, "at", ParamLocationQuery, src)
+	require.NoError(t, err)
+	assert.Equal(t, "at=1.5%3A-2", styled)
+	queryParams, err := url.ParseQuery(styled)
+	require.NoError(t, err)
+
+	var dst Coordinates
+	require.NoError(t, BindQueryParameter("form", false, true, "at", queryParams, &dst))
+	assert.Equal(t, src, dst)
+
+	var optionalDst *Coordinates
+	require.NoError(t, BindQueryParameter("form", false, false, "at", queryParams, &optionalDst))
+	assert.Equal(t, &src, optionalDst)
+
+	optionalDst = nil
+	require.NoError(t, BindQueryParameter("form", false, false, "from", queryParams, &optionalDst))
+	assert.Nil(t, optionalDst)
+	assert.Error(t, BindQueryParameter("form", false, true, "from", queryParams, &dst))
+
+	err = BindStyledParameterWithLocation("simple", false, "at", ParamLocationHeader, "north", &dst)
+	assert.Error(t, err)
+}
+
+func TestBindLargeNumbers(t *testing.T) {
+	id, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	styled, err := StyleParamWithLocation("simple", false, "id", ParamLocationPath, id)
+	require.NoError(t, err)
+	assert.Equal(t, "123456789012345678901234567890", styled)
+	var dstID *big.Int
+	require.NoError(t, BindStyledParameterWithLocation("simple", false, "id", ParamLocationPath, styled, &dstID))
+	assert.Equal(t, id, dstID)
+
+	styled, err = StyleParamWithLocation("form", true, "after", ParamLocationQuery, *id)
+	require.NoError(t, err)
+	assert.Equal(t, "after=123456789012345678901234567890", styled)
+	queryParams, err := url.ParseQuery(styled)
+	require.NoError(t, err)
+
+	var after, before *big.Int
+	require.NoError(t, BindQueryParameter("form", true, false, "after", queryParams, &after))
+	assert.Equal(t, id, after)
+	require.NoError(t, BindQueryParameter("form", true, false, "before", queryParams, &before))
+	assert.Nil(t, before)
+	assert.Error(t, BindQueryParameter("form", true, true, "before", queryParams, &before))
+	require.NoError(t, BindQueryParameter("form", true, true, "after", queryParams, &before))
+	assert.Equal(t, id, before)
+
+	var maxID uint64
+	require.NoError(t, BindStringToObject("18446744073709551615", &maxID))
+	assert.Equal(t, uint64(math.MaxUint64), maxID)
+	styled, err = StyleParamWithLocation("simple", false, "id", ParamLocationPath, maxID)
+	require.NoError(t, err)
+	assert.Equal(t, "18446744073709551615", styled)
+
+	// Values which don't fit their types fail rather than wrap around.
+	var small int8
+	assert.Error(t, BindStringToObject("300", &small))
+	var smallUnsigned uint16
+	assert.Error(t, BindStringToObject("70000", &smallUnsigned))
+	var notBig big.Int
+	assert.Error(t, BindStringToObject("12x", &notBig))
+}
+
+func TestBindParameterErrors(t *testing.T) {
+	var id int
+	err := BindStyledParameterWithLocation("simple", false, "id", ParamLocationPath, "abc", &id)
+	var formatErr *InvalidParamFormatError
+	require.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, "id", formatErr.ParamName)
+	assert.Equal(t, ParamLocationPath, formatErr.In)
+	assert.Equal(t, "path", formatErr.In.String())
+	assert.Equal(t, err.Error(), formatErr.Reason)
+	assert.Error(t, errors.Unwrap(err))
+
+	err = BindStyledParameterWithLocation("simple", false, "id", ParamLocationHeader, "", &id)
+	require.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, ParamLocationHeader, formatErr.In)
+
+	queryParams := url.Values{"limit": {"ten"}, "ids": {"1", "2"}}
+	var limit *int
+	err = BindQueryParameter("form", true, false, "limit", queryParams, &limit)
+	require.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, "limit", formatErr.ParamName)
+	assert.Equal(t, ParamLocationQuery, formatErr.In)
+
+	err = BindQueryParameter("form", true, true, "ids", queryParams, &id)
+	require.True(t, errors.As(err, &formatErr))
+	assert.Equal(t, "multiple values for single value parameter 'ids'", formatErr.Reason)
+
+	err = BindQueryParameter("form", true, true, "missing", queryParams, &id)
+	var requiredErr *RequiredParamError
+	require.True(t, errors.As(err, &requiredErr))
+	assert.EqualError(t, err, "query parameter 'missing' is required")
+	assert.False(t, errors.As(err, &formatErr))
 }
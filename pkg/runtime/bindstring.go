@@ -14,6 +14,7 @@
 package runtime
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"reflect"
@@ -57,16 +58,28 @@ func BindStringToObject(src string, dst interface{}) error {
 		return errors.New("destination is not settable")
 	}
 
+	// Types other than structs which parse themselves from text, such as
+	// emails, which validate themselves, and UUIDs. Structs are handled
+	// below, after the special ones, such as time.Time.
+	if t.Kind() != reflect.Struct {
+		if tu, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			if err := tu.UnmarshalText([]byte(src)); err != nil {
+				return fmt.Errorf("error binding string parameter: %s", err)
+			}
+			return nil
+		}
+	}
+
 	switch t.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		var val int64
-		val, err = strconv.ParseInt(src, 10, 64)
+		val, err = strconv.ParseInt(src, 10, t.Bits())
 		if err == nil {
 			v.SetInt(val)
 		}
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
 		var val uint64
-		val, err = strconv.ParseUint(src, 10, 64)
+		val, err = strconv.ParseUint(src, 10, t.Bits())
 		if err == nil {
 			v.SetUint(val)
 		}
@@ -75,7 +88,7 @@ func BindStringToObject(src string, dst interface{}) error {
 		err = nil
 	case reflect.Float64, reflect.Float32:
 		var val float64
-		val, err = strconv.ParseFloat(src, 64)
+		val, err = strconv.ParseFloat(src, t.Bits())
 		if err == nil {
 			v.SetFloat(val)
 		}
@@ -139,6 +152,31 @@ func BindStringToObject(src string, dst interface{}) error {
 			return nil
 		}
 
+		if t.ConvertibleTo(reflect.TypeOf(types.Duration{})) || t.ConvertibleTo(reflect.TypeOf(types.ISODuration{})) {
+			// Don't fail on empty string.
+			if src == "" {
+				return nil
+			}
+			parsedDuration, err := types.ParseDuration(src)
+			if err != nil {
+				return fmt.Errorf("error parsing '%s' as duration: %s", src, err)
+			}
+			setDuration(v, parsedDuration)
+			return nil
+		}
+
+		// Other types which parse themselves from text, such as big.Int.
+		if tu, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			// Don't fail on empty string.
+			if src == "" {
+				return nil
+			}
+			if err := tu.UnmarshalText([]byte(src)); err != nil {
+				return fmt.Errorf("error parsing '%s' as %s: %s", src, t, err)
+			}
+			return nil
+		}
+
 		// We fall through to the error case below if we haven't handled the
 		// destination type above.
 		fallthrough
@@ -151,3 +189,16 @@ func BindStringToObject(src string, dst interface{}) error {
 	}
 	return nil
 }
+
+// setDuration assigns a duration to a value whose type is, or is defined as,
+// types.Duration or types.ISODuration.
+func setDuration(v reflect.Value, d time.Duration) {
+	value := reflect.ValueOf(types.Duration{Duration: d})
+	if v.Type().ConvertibleTo(reflect.TypeOf(types.ISODuration{})) {
+		value = reflect.ValueOf(types.ISODuration{Duration: types.Duration{Duration: d}})
+	}
+	if v.Type() != value.Type() {
+		v = reflect.Indirect(v.Addr().Convert(reflect.PtrTo(value.Type())))
+	}
+	v.Set(value)
+}
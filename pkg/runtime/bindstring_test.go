@@ -17,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
@@ -162,6 +163,30 @@ func TestBindStringToObject(t *testing.T) {
 	var dstAliasedDate AliasedDate
 	assert.NoError(t, BindStringToObject(dateString, &dstAliasedDate))
 
+	// Checks whether duration binding works directly and through an alias,
+	// in both syntaxes.
+	var dstDuration types.Duration
+	assert.NoError(t, BindStringToObject("PT1M30S", &dstDuration))
+	assert.Equal(t, 90*time.Second, dstDuration.Duration)
+	type AliasedDuration types.Duration
+	var dstAliasedDuration AliasedDuration
+	assert.NoError(t, BindStringToObject("1m30s", &dstAliasedDuration))
+	assert.Equal(t, 90*time.Second, dstAliasedDuration.Duration)
+	type AliasedISODuration types.ISODuration
+	var dstAliasedISODuration AliasedISODuration
+	assert.NoError(t, BindStringToObject("PT2H", &dstAliasedISODuration))
+	assert.Equal(t, 2*time.Hour, dstAliasedISODuration.Duration.Duration)
+	assert.Error(t, BindStringToObject("soon", &dstAliasedDuration))
+
+	// Checks whether emails are validated, and UUIDs parsed.
+	var dstEmail types.Email
+	assert.NoError(t, BindStringToObject("gaben@valvesoftware.com", &dstEmail))
+	assert.Equal(t, types.Email("gaben@valvesoftware.com"), dstEmail)
+	assert.Error(t, BindStringToObject("gaben", &dstEmail))
+	var dstUUID types.UUID
+	assert.NoError(t, BindStringToObject("b6f3e8a2-3c9e-4e5f-9d0a-1c2b3d4e5f60", &dstUUID))
+	assert.Equal(t, uuid.MustParse("b6f3e8a2-3c9e-4e5f-9d0a-1c2b3d4e5f60"), dstUUID)
+
 	// Checks whether a mock binder works and embedded types
 	var mockBinder MockBinder
 	assert.NoError(t, BindStringToObject(dateString, &mockBinder))
@@ -0,0 +1,74 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"sync"
+)
+
+// Bulk calls call for every index from 0 to n-1, with at most concurrency
+// calls running at once, or n when concurrency isn't positive. As with an
+// errgroup, the context passed to the calls is canceled by the first call
+// returning an error, no further calls are made, and that error is returned
+// once the running calls have returned. The error of ctx is returned when it
+// is done before every call was made.
+func Bulk(ctx context.Context, n, concurrency int, call func(ctx context.Context, i int) error) error {
+	if concurrency <= 0 || concurrency > n {
+		concurrency = n
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	indexes := make(chan int)
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := call(ctx, i); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	sent := 0
+feed:
+	for ; sent < n && ctx.Err() == nil; sent++ {
+		select {
+		case indexes <- sent:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if sent < n {
+		return ctx.Err()
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulk(t *testing.T) {
+	t.Run("bounded", func(t *testing.T) {
+		var running, maxRunning int32
+		results := make([]int, 20)
+		err := Bulk(context.Background(), len(results), 3, func(ctx context.Context, i int) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			results[i] = i * i
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, maxRunning, int32(3))
+		for i, result := range results {
+			assert.Equal(t, i*i, result)
+		}
+	})
+
+	t.Run("first error", func(t *testing.T) {
+		var calls int32
+		failure := errors.New("failure")
+		err := Bulk(context.Background(), 100, 1, func(ctx context.Context, i int) error {
+			atomic.AddInt32(&calls, 1)
+			if i == 2 {
+				return failure
+			}
+			return ctx.Err()
+		})
+		assert.Equal(t, failure, err)
+		// The call running when the error occurred may still see the
+		// canceled context, but no more calls are made after it.
+		assert.Less(t, calls, int32(100))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := Bulk(ctx, 5, 2, func(ctx context.Context, i int) error {
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.NoError(t, Bulk(context.Background(), 0, 4, func(ctx context.Context, i int) error {
+			return errors.New("unexpected call")
+		}))
+	})
+}
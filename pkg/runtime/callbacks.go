@@ -0,0 +1,106 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ExpandCallbackURL evaluates the URL of a callback, such as
+// "{$request.body#/callbackUrl}/events" or
+// "https://example.com/notify?id={$request.query.id}", whose runtime
+// expressions refer to the request which registered it, given its body and
+// path parameters, as a server receives them. The values of the expressions
+// are escaped as path segments, or as query values after the "?" of the URL,
+// except at its start, where they give the base URL.
+func ExpandCallbackURL(expression string, req *http.Request, body []byte, pathParams map[string]string) (string, error) {
+	evaluate := func(expression string) (string, error) {
+		value, err := evaluateCallbackExpression(expression, req, body, pathParams)
+		if err != nil {
+			return "", fmt.Errorf("error evaluating the callback URL expression %s: %w", expression, err)
+		}
+		return value, nil
+	}
+	if strings.HasPrefix(expression, "$") {
+		return evaluate(expression)
+	}
+
+	var expanded strings.Builder
+	end := 0
+	for _, match := range embeddedExpression.FindAllStringSubmatchIndex(expression, -1) {
+		value, err := evaluate(expression[match[2]:match[3]])
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case match[0] == 0:
+		case strings.Contains(expression[:match[0]], "?"):
+			value = url.QueryEscape(value)
+		default:
+			value = url.PathEscape(value)
+		}
+		expanded.WriteString(expression[end:match[0]])
+		expanded.WriteString(value)
+		end = match[1]
+	}
+	expanded.WriteString(expression[end:])
+	return expanded.String(), nil
+}
+
+// evaluateCallbackExpression returns the value of a runtime expression
+// referring to the request of an operation.
+func evaluateCallbackExpression(expression string, req *http.Request, body []byte, pathParams map[string]string) (string, error) {
+	var value string
+	switch {
+	case expression == "$url":
+		u := *req.URL
+		if u.Host == "" {
+			u.Host = req.Host
+			u.Scheme = "http"
+			if req.TLS != nil {
+				u.Scheme = "https"
+			}
+		}
+		return u.String(), nil
+	case expression == "$method":
+		return req.Method, nil
+	case strings.HasPrefix(expression, "$request.query."):
+		value = req.URL.Query().Get(strings.TrimPrefix(expression, "$request.query."))
+	case strings.HasPrefix(expression, "$request.header."):
+		value = req.Header.Get(strings.TrimPrefix(expression, "$request.header."))
+	case strings.HasPrefix(expression, "$request.path."):
+		value = pathParams[strings.TrimPrefix(expression, "$request.path.")]
+	case strings.HasPrefix(expression, "$request.body"):
+		raw, err := resolveJSONPointer(body, strings.TrimPrefix(expression, "$request.body"))
+		if err != nil {
+			return "", err
+		}
+		var null interface{}
+		if json.Unmarshal(raw, &null) == nil && null == nil {
+			return "", errors.New("no value")
+		}
+		value = jsonString(raw)
+	default:
+		return "", errors.New("unsupported expression")
+	}
+	if value == "" {
+		return "", errors.New("no value")
+	}
+	return value, nil
+}
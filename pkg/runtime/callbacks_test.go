@@ -0,0 +1,55 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandCallbackURL(t *testing.T) {
+	req := httptest.NewRequest("POST", "/jobs/fast?tenant=acme", nil)
+	req.Header.Set("X-Callback", "https://example.com/cb")
+	req.Header.Set("X-Name", "a/b c&d")
+	body := []byte(`{"callback": {"url": "https://example.com/hooks"}, "id": 42, "missing": null}`)
+	pathParams := map[string]string{"queue": "fast"}
+
+	tests := map[string]string{
+		"{$request.body#/callback/url}":                                             "https://example.com/hooks",
+		"{$request.body#/callback/url}/jobs/{$request.body#/id}":                    "https://example.com/hooks/jobs/42",
+		"$request.header.X-Callback":                                                "https://example.com/cb",
+		"https://example.com/{$request.path.queue}?t={$request.query.tenant}":       "https://example.com/fast?t=acme",
+		"https://example.com/q/{$request.header.X-Name}?n={$request.header.X-Name}": "https://example.com/q/a%2Fb%20c&d?n=a%2Fb+c%26d",
+		"{$url}":    "http://example.com/jobs/fast?tenant=acme",
+		"{$method}": "POST",
+	}
+	for expression, expected := range tests {
+		url, err := ExpandCallbackURL(expression, req, body, pathParams)
+		require.NoError(t, err, expression)
+		assert.Equal(t, expected, url, expression)
+	}
+
+	for _, expression := range []string{
+		"{$request.body#/missing}",
+		"{$request.body#/unknown}",
+		"{$request.query.unknown}",
+		"{$response.body#/id}",
+	} {
+		_, err := ExpandCallbackURL(expression, req, body, pathParams)
+		assert.Error(t, err, expression)
+	}
+}
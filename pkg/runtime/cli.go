@@ -0,0 +1,65 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+)
+
+// BindFlag binds the value of the command-line flag of a parameter, as the
+// commands of the cli target take them, to dest. Values are styled as those of
+// simple parameters, so arrays are given as a,b,c and objects as
+// key,value,key,value.
+func BindFlag(name string, value string, dest interface{}) error {
+	if err := bindStyledParameter("simple", false, name, ParamLocationHeader, value, dest); err != nil {
+		return fmt.Errorf("invalid value of --%s: %w", name, err)
+	}
+	return nil
+}
+
+// UnmarshalFlag unmarshals the value of the command-line flag of a parameter
+// whose content is encoded, such as JSON, to dest, with unmarshal.
+func UnmarshalFlag(name string, value string, unmarshal func([]byte, interface{}) error, dest interface{}) error {
+	if err := unmarshal([]byte(value), dest); err != nil {
+		return fmt.Errorf("invalid value of --%s: %w", name, err)
+	}
+	return nil
+}
+
+// OpenFlagFile opens the file named by a command-line flag, such as the
+// request body of a command of the cli target, which is stdin for "-".
+func OpenFlagFile(name string, stdin io.Reader) (io.ReadCloser, error) {
+	if name == "-" {
+		return ioutil.NopCloser(stdin), nil
+	}
+	return os.Open(name)
+}
+
+// CopyResponse writes the body of a response to w, as the commands of the cli
+// target do, and closes it. Responses with a status of 400 or more are an
+// error, once their body is written.
+func CopyResponse(w io.Writer, rsp *http.Response) error {
+	defer rsp.Body.Close()
+	if _, err := io.Copy(w, rsp.Body); err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+	if rsp.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status %s", rsp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindFlag(t *testing.T) {
+	var tags []string
+	require.NoError(t, BindFlag("tags", "a,b c", &tags))
+	assert.Equal(t, []string{"a", "b c"}, tags)
+
+	var limit int
+	assert.EqualError(t, BindFlag("limit", "many", &limit), "invalid value of --limit: error binding string parameter: strconv.ParseInt: parsing \"many\": invalid syntax")
+
+	var filter struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, UnmarshalFlag("filter", `{"name":"Rex"}`, json.Unmarshal, &filter))
+	assert.Equal(t, "Rex", filter.Name)
+	assert.Error(t, UnmarshalFlag("filter", `{`, json.Unmarshal, &filter))
+}
+
+func TestCopyResponse(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, CopyResponse(&out, &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("ok"))}))
+	assert.Equal(t, "ok", out.String())
+
+	out.Reset()
+	err := CopyResponse(&out, &http.Response{StatusCode: http.StatusNotFound, Status: "404 Not Found", Body: ioutil.NopCloser(strings.NewReader("missing"))})
+	assert.EqualError(t, err, "unexpected status 404 Not Found")
+	assert.Equal(t, "missing", out.String())
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// GzipRequestBody compresses the body of a request with gzip, and sets its
+// Content-Encoding header, when the body is at least minSize bytes long.
+// Bodies of unknown length are streamed, so they are left uncompressed, as
+// are bodies which already have a Content-Encoding.
+func GzipRequestBody(req *http.Request, minSize int64) error {
+	if req.Body == nil || req.Body == http.NoBody || req.ContentLength < minSize || req.ContentLength <= 0 {
+		return nil
+	}
+	if req.Header.Get("Content-Encoding") != "" {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := io.Copy(zw, req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return fmt.Errorf("error compressing request body: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("error compressing request body: %w", err)
+	}
+
+	compressed := buf.Bytes()
+	req.Body = ioutil.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+	req.Header.Set("Content-Encoding", "gzip")
+	return nil
+}
+
+// GunzipResponseBody replaces the body of a gzip encoded response with a
+// reader of its decompressed content. Responses with any other encoding are
+// left unchanged.
+func GunzipResponseBody(rsp *http.Response) error {
+	if !strings.EqualFold(rsp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+	zr, err := gzip.NewReader(rsp.Body)
+	if err != nil {
+		_ = rsp.Body.Close()
+		return fmt.Errorf("error decompressing response body: %w", err)
+	}
+	rsp.Body = &gzipReadCloser{Reader: zr, body: rsp.Body}
+	rsp.Header.Del("Content-Encoding")
+	rsp.Header.Del("Content-Length")
+	rsp.ContentLength = -1
+	rsp.Uncompressed = true
+	return nil
+}
+
+// gzipReadCloser closes the underlying body along with the gzip reader.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (r *gzipReadCloser) Close() error {
+	_ = r.Reader.Close()
+	return r.body.Close()
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGzipRequestBody(t *testing.T) {
+	content := strings.Repeat("compressible ", 100)
+
+	// Small bodies are sent as they are.
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader("small"))
+	require.NoError(t, err)
+	require.NoError(t, GzipRequestBody(req, 100))
+	assert.Empty(t, req.Header.Get("Content-Encoding"))
+	assert.EqualValues(t, 5, req.ContentLength)
+
+	req, err = http.NewRequest(http.MethodPost, "http://example.com", strings.NewReader(content))
+	require.NoError(t, err)
+	require.NoError(t, GzipRequestBody(req, 100))
+	assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+	assert.Less(t, req.ContentLength, int64(len(content)))
+
+	for _, body := range []func() ([]byte, error){
+		func() ([]byte, error) { return ioutil.ReadAll(req.Body) },
+		func() ([]byte, error) {
+			rc, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			return ioutil.ReadAll(rc)
+		},
+	} {
+		compressed, err := body()
+		require.NoError(t, err)
+		zr, err := gzip.NewReader(bytes.NewReader(compressed))
+		require.NoError(t, err)
+		decompressed, err := ioutil.ReadAll(zr)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(decompressed))
+	}
+}
+
+func TestGunzipResponseBody(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, _ = zw.Write([]byte("response content"))
+	require.NoError(t, zw.Close())
+
+	rsp := &http.Response{
+		Header: http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:   ioutil.NopCloser(&buf),
+	}
+	require.NoError(t, GunzipResponseBody(rsp))
+	assert.Empty(t, rsp.Header.Get("Content-Encoding"))
+	assert.True(t, rsp.Uncompressed)
+	body, err := ioutil.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "response content", string(body))
+	assert.NoError(t, rsp.Body.Close())
+
+	// Other encodings are left alone.
+	rsp = &http.Response{
+		Header: http.Header{"Content-Encoding": []string{"br"}},
+		Body:   ioutil.NopCloser(strings.NewReader("brotli")),
+	}
+	require.NoError(t, GunzipResponseBody(rsp))
+	assert.Equal(t, "br", rsp.Header.Get("Content-Encoding"))
+
+	rsp = &http.Response{
+		Header: http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:   ioutil.NopCloser(strings.NewReader("not gzip")),
+	}
+	assert.Error(t, GunzipResponseBody(rsp))
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// DefaultCorrelationIDHeader is the header carrying correlation IDs, unless
+// another one is given to the code generator.
+const DefaultCorrelationIDHeader = "X-Request-ID"
+
+type correlationIDKey struct{}
+
+// ContextWithCorrelationID returns a copy of ctx holding a correlation ID,
+// which generated clients propagate to the servers they call.
+func ContextWithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the correlation ID held by ctx, which is
+// set by the correlation ID middleware of generated servers, or an empty
+// string.
+func CorrelationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// NewCorrelationID returns a new random correlation ID.
+func NewCorrelationID() string {
+	return uuid.New().String()
+}
+
+// RequestCorrelationID returns the correlation ID of a request, given by the
+// header, or a new one when the request has none.
+func RequestCorrelationID(h http.Header, header string) string {
+	if id := h.Get(header); id != "" {
+		return id
+	}
+	return NewCorrelationID()
+}
+
+// ResponseCorrelationID returns the correlation ID of a call, returned by the
+// server in the header, or otherwise the one the request was sent with.
+func ResponseCorrelationID(rsp *http.Response, header string) string {
+	if id := rsp.Header.Get(header); id != "" {
+		return id
+	}
+	if rsp.Request != nil {
+		return rsp.Request.Header.Get(header)
+	}
+	return ""
+}
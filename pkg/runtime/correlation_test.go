@@ -0,0 +1,41 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorrelationID(t *testing.T) {
+	ctx := ContextWithCorrelationID(context.Background(), "abc-123")
+	assert.Equal(t, "abc-123", CorrelationIDFromContext(ctx))
+	assert.Equal(t, "", CorrelationIDFromContext(context.Background()))
+
+	assert.Equal(t, "abc-123", RequestCorrelationID(http.Header{"X-Request-Id": {"abc-123"}}, "X-Request-ID"))
+	generated := RequestCorrelationID(http.Header{}, "X-Request-ID")
+	assert.NotEmpty(t, generated)
+	assert.NotEqual(t, generated, RequestCorrelationID(http.Header{}, "X-Request-ID"))
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	req.Header.Set("X-Correlation-ID", "sent")
+	rsp := &http.Response{Header: http.Header{}, Request: req}
+	assert.Equal(t, "sent", ResponseCorrelationID(rsp, "X-Correlation-ID"))
+	rsp.Header.Set("X-Correlation-ID", "returned")
+	assert.Equal(t, "returned", ResponseCorrelationID(rsp, "X-Correlation-ID"))
+	assert.Equal(t, "", ResponseCorrelationID(&http.Response{Header: http.Header{}}, "X-Correlation-ID"))
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// csvColumn is a field of a row struct, and the column it's written to.
+type csvColumn struct {
+	name  string
+	index []int
+}
+
+// csvColumns returns the columns of a row struct, which are its exported
+// fields, named by their json tag, including those of embedded structs.
+func csvColumns(t reflect.Type, index []int) []csvColumn {
+	var columns []csvColumn
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			columns = append(columns, csvColumns(f.Type, fieldIndex)...)
+			continue
+		}
+		name := getFieldName(f)
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		columns = append(columns, csvColumn{name: name, index: fieldIndex})
+	}
+	return columns
+}
+
+// isJSONCell returns whether values of type t are written to cells as JSON,
+// as arrays and objects have no text form of their own.
+func isJSONCell(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	default:
+		return isObjectType(t)
+	}
+}
+
+// MarshalCSV turns a slice of structs, such as the rows of a generated text/csv
+// body, into CSV, whose header row names the columns by the json tags of the
+// fields. Unset optional fields are empty cells, and arrays and objects are
+// written as JSON.
+func MarshalCSV(v interface{}) ([]byte, error) {
+	rows := reflect.Indirect(reflect.ValueOf(v))
+	if rows.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("CSV bodies must be slices, not %s", rows.Kind())
+	}
+	rowType := rows.Type().Elem()
+	if rowType.Kind() == reflect.Ptr {
+		rowType = rowType.Elem()
+	}
+	if rowType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("CSV rows must be structs, not %s", rowType.Kind())
+	}
+	columns := csvColumns(rowType, nil)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	record := make([]string, len(columns))
+	for i, column := range columns {
+		record[i] = column.name
+	}
+	if err := w.Write(record); err != nil {
+		return nil, err
+	}
+	for i := 0; i < rows.Len(); i++ {
+		row := reflect.Indirect(rows.Index(i))
+		for j, column := range columns {
+			cell, err := marshalCSVCell(row.FieldByIndex(column.index))
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling column '%s' of row %d: %w", column.name, i, err)
+			}
+			record[j] = cell
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func marshalCSVCell(field reflect.Value) (string, error) {
+	switch field.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		if field.IsNil() {
+			return "", nil
+		}
+	}
+	if isJSONCell(field.Type()) {
+		data, err := json.Marshal(field.Interface())
+		return string(data), err
+	}
+	return primitiveToString(reflect.Indirect(field).Interface())
+}
+
+// CSVDecoder decodes the rows of CSV, such as a text/csv request body, one at
+// a time, into structs whose fields are matched to the columns named by the
+// header row by their json tags. Columns without a field are ignored.
+type CSVDecoder struct {
+	r      *csv.Reader
+	header []string
+}
+
+// NewCSVDecoder returns a decoder of the rows of the CSV read from r, whose
+// first row is its header.
+func NewCSVDecoder(r io.Reader) *CSVDecoder {
+	return &CSVDecoder{r: csv.NewReader(r)}
+}
+
+// Decode sets the fields of the struct dst from the next row, and returns
+// io.EOF once there are no more. Empty cells leave their field untouched.
+func (d *CSVDecoder) Decode(dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("CSV rows must be decoded into pointers to structs")
+	}
+	if d.header == nil {
+		header, err := d.r.Read()
+		if err != nil {
+			return err
+		}
+		d.header = header
+	}
+	record, err := d.r.Read()
+	if err != nil {
+		return err
+	}
+
+	row := v.Elem()
+	cells := make(map[string]string, len(record))
+	for i, name := range d.header {
+		cells[name] = record[i]
+	}
+	for _, column := range csvColumns(row.Type(), nil) {
+		cell := cells[column.name]
+		if cell == "" {
+			continue
+		}
+		field := row.FieldByIndex(column.index)
+		if isJSONCell(field.Type()) {
+			err = json.Unmarshal([]byte(cell), field.Addr().Interface())
+		} else {
+			err = BindStringToObject(cell, field.Addr().Interface())
+		}
+		if err != nil {
+			return fmt.Errorf("error unmarshaling column '%s': %w", column.name, err)
+		}
+	}
+	return nil
+}
+
+// UnmarshalCSV decodes all the rows of CSV, such as a text/csv response body,
+// into the slice of structs dst points to. It is the reverse of MarshalCSV.
+func UnmarshalCSV(data []byte, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return errors.New("CSV bodies must be unmarshaled into pointers to slices")
+	}
+	rows := v.Elem()
+	rowType := rows.Type().Elem()
+	isPtr := rowType.Kind() == reflect.Ptr
+	if isPtr {
+		rowType = rowType.Elem()
+	}
+
+	d := NewCSVDecoder(bytes.NewReader(data))
+	rows.Set(reflect.MakeSlice(rows.Type(), 0, 0))
+	for {
+		row := reflect.New(rowType)
+		if err := d.Decode(row.Interface()); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("error unmarshaling row %d: %w", rows.Len(), err)
+		}
+		if !isPtr {
+			row = row.Elem()
+		}
+		rows.Set(reflect.Append(rows, row))
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+type csvRow struct {
+	Name   string            `json:"name"`
+	Age    *int              `json:"age,omitempty"`
+	Born   *types.Date       `json:"born,omitempty"`
+	Tags   []string          `json:"tags,omitempty"`
+	Labels map[string]string `json:"-"`
+}
+
+func TestMarshalCSV(t *testing.T) {
+	age := 42
+	rows := []csvRow{
+		{Name: "Alex, Jr.", Age: &age, Born: &types.Date{Time: time.Date(1980, 2, 29, 0, 0, 0, 0, time.UTC)}, Tags: []string{"a", "b"}},
+		{Name: "Sam"},
+	}
+	data, err := MarshalCSV(rows)
+	require.NoError(t, err)
+	assert.Equal(t, "name,age,born,tags\n\"Alex, Jr.\",42,1980-02-29,\"[\"\"a\"\",\"\"b\"\"]\"\nSam,,,\n", string(data))
+
+	var dst []csvRow
+	require.NoError(t, UnmarshalCSV(data, &dst))
+	assert.Equal(t, rows, dst)
+
+	_, err = MarshalCSV(csvRow{})
+	assert.Error(t, err)
+	assert.Error(t, UnmarshalCSV(data, &csvRow{}))
+}
+
+func TestCSVDecoder(t *testing.T) {
+	// Columns are matched by name, and unknown ones are ignored.
+	d := NewCSVDecoder(strings.NewReader("extra,age,name\nx,7,Kim\ny,oops,Lee\n"))
+
+	var row csvRow
+	require.NoError(t, d.Decode(&row))
+	assert.Equal(t, "Kim", row.Name)
+	assert.Equal(t, 7, *row.Age)
+
+	row = csvRow{}
+	assert.Error(t, d.Decode(&row))
+	assert.Equal(t, io.EOF, d.Decode(&row))
+
+	var rows []*csvRow
+	require.NoError(t, UnmarshalCSV([]byte(""), &rows))
+	assert.Empty(t, rows)
+}
@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"bytes"
+	"encoding"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +11,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/types"
@@ -49,13 +52,20 @@ func marshalDeepObject(in interface{}, path []string) ([]string, error) {
 			}
 			result = append(result, fields...)
 		}
+	case nil:
+		// Null values, such as those of empty nullable fields, are left out.
 	default:
 		// Now, for a concrete value, we will turn the path elements
 		// into a deepObject style set of subscripts. [a, b, c] turns into
-		// [a][b][c]
-		prefix := "[" + strings.Join(path, "][") + "]"
+		// [a][b][c]. The subscripts and value are escaped, but the brackets
+		// are kept as they are, as servers expect them.
+		escaped := make([]string, len(path))
+		for i, p := range path {
+			escaped[i] = url.QueryEscape(p)
+		}
+		prefix := "[" + strings.Join(escaped, "][") + "]"
 		result = []string{
-			prefix + fmt.Sprintf("=%v", t),
+			prefix + "=" + url.QueryEscape(fmt.Sprintf("%v", t)),
 		}
 	}
 	return result, nil
@@ -71,8 +81,12 @@ func MarshalDeepObject(i interface{}, paramName string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal input to JSON: %w", err)
 	}
+	// Numbers are decoded as json.Number, so that they're written as they
+	// were marshaled rather than as floats, such as 1e+06.
+	decoder := json.NewDecoder(bytes.NewReader(buf))
+	decoder.UseNumber()
 	var i2 interface{}
-	err = json.Unmarshal(buf, &i2)
+	err = decoder.Decode(&i2)
 	if err != nil {
 		return "", fmt.Errorf("failed to unmarshal JSON: %w", err)
 	}
@@ -83,81 +97,161 @@ func MarshalDeepObject(i interface{}, paramName string) (string, error) {
 
 	// Prefix the param name to each subscripted field.
 	for i := range fields {
-		fields[i] = paramName + fields[i]
+		fields[i] = url.QueryEscape(paramName) + fields[i]
 	}
 	return strings.Join(fields, "&"), nil
 }
 
-type fieldOrValue struct {
-	fields map[string]fieldOrValue
-	value  string
+// deepObjectNode is a node of the tree of the keys of a deepObject parameter,
+// such as filter[count][in][0], which has either a value or children.
+type deepObjectNode struct {
+	value    string
+	hasValue bool
+	children map[string]*deepObjectNode
 }
 
-func (f *fieldOrValue) appendPathValue(path []string, value string) {
-	fieldName := path[0]
-	if len(path) == 1 {
-		f.fields[fieldName] = fieldOrValue{value: value}
-		return
+// add adds the value of a key, given by its subscripts below the node.
+func (n *deepObjectNode) add(key string, path []string, value string) error {
+	for _, p := range path {
+		if n.hasValue {
+			return fmt.Errorf("%s conflicts with a value of a parent key", key)
+		}
+		if n.children == nil {
+			n.children = make(map[string]*deepObjectNode)
+		}
+		child, found := n.children[p]
+		if !found {
+			child = &deepObjectNode{}
+			n.children[p] = child
+		}
+		n = child
 	}
+	if n.hasValue || n.children != nil {
+		return fmt.Errorf("%s conflicts with another key", key)
+	}
+	n.value = value
+	n.hasValue = true
+	return nil
+}
 
-	pv, found := f.fields[fieldName]
-	if !found {
-		pv = fieldOrValue{
-			fields: make(map[string]fieldOrValue),
+// leaf returns the value of the node, which mustn't have children.
+func (n *deepObjectNode) leaf() (string, error) {
+	if !n.hasValue {
+		return "", errors.New("expected a value, got an object")
+	}
+	return n.value, nil
+}
+
+// indexed returns the children of the node, as elements of an array, when
+// their keys are consecutive indices from 0.
+func (n *deepObjectNode) indexed() ([]*deepObjectNode, bool) {
+	elems := make([]*deepObjectNode, len(n.children))
+	for i := range elems {
+		child, found := n.children[strconv.Itoa(i)]
+		if !found {
+			return nil, false
 		}
-		f.fields[fieldName] = pv
+		elems[i] = child
 	}
-	pv.appendPathValue(path[1:], value)
+	return elems, true
 }
 
-func makeFieldOrValue(paths [][]string, values []string) fieldOrValue {
+// sortedKeys returns the keys of the children of the node, so that they're
+// assigned, and errors reported, in the same order every time.
+func (n *deepObjectNode) sortedKeys() []string {
+	keys := make([]string, 0, len(n.children))
+	for k := range n.children {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
 
-	f := fieldOrValue{
-		fields: make(map[string]fieldOrValue),
+// generic returns the value of the node as JSON would be decoded into an
+// interface{}: values are strings, children with consecutive indices are
+// []interface{}, and other children are map[string]interface{}.
+func (n *deepObjectNode) generic() interface{} {
+	if n.hasValue {
+		return n.value
 	}
-	for i := range paths {
-		path := paths[i]
-		value := values[i]
-		f.appendPathValue(path, value)
+	if elems, ok := n.indexed(); ok && len(elems) > 0 {
+		values := make([]interface{}, len(elems))
+		for i, elem := range elems {
+			values[i] = elem.generic()
+		}
+		return values
+	}
+	values := make(map[string]interface{}, len(n.children))
+	for k, child := range n.children {
+		values[k] = child.generic()
+	}
+	return values
+}
+
+// parseDeepObjectKey returns the subscripts of a key of a deepObject
+// parameter, such as [count in 0] for filter[count][in][0], and whether the
+// key belongs to the parameter.
+func parseDeepObjectKey(key string, paramName string) ([]string, bool, error) {
+	if !strings.HasPrefix(key, paramName+"[") {
+		return nil, false, nil
+	}
+	var path []string
+	rest := key[len(paramName):]
+	for rest != "" {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return nil, true, fmt.Errorf("%s has malformed subscripts", key)
+		}
+		subscript := rest[1:end]
+		if subscript == "" || strings.ContainsRune(subscript, '[') {
+			return nil, true, fmt.Errorf("%s has malformed subscripts", key)
+		}
+		path = append(path, subscript)
+		rest = rest[end+1:]
 	}
-	return f
+	return path, true, nil
 }
 
 func UnmarshalDeepObject(dst interface{}, paramName string, params url.Values) error {
 	// Params are all the query args, so we need those that look like
-	// "paramName["...
-	var fieldNames []string
-	var fieldValues []string
-	searchStr := paramName + "["
-	for pName, pValues := range params {
-		if strings.HasPrefix(pName, searchStr) {
-			// trim the parameter name from the full name.
-			pName = pName[len(paramName):]
-			fieldNames = append(fieldNames, pName)
-			if len(pValues) != 1 {
-				return fmt.Errorf("%s has multiple values", pName)
-			}
-			fieldValues = append(fieldValues, pValues[0])
+	// "paramName[", which make up a tree of subscripts.
+	var root deepObjectNode
+	for _, key := range sortedQueryKeys(params) {
+		path, found, err := parseDeepObjectKey(key, paramName)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		values := params[key]
+		if len(values) != 1 {
+			return fmt.Errorf("%s has multiple values", key)
+		}
+		if err := root.add(key, path, values[0]); err != nil {
+			return err
 		}
 	}
 
-	// Now, for each field, reconstruct its subscript path and value
-	paths := make([][]string, len(fieldNames))
-	for i, path := range fieldNames {
-		path = strings.TrimLeft(path, "[")
-		path = strings.TrimRight(path, "]")
-		paths[i] = strings.Split(path, "][")
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("destination of %s must be a non-nil pointer", paramName)
 	}
-
-	fieldPaths := makeFieldOrValue(paths, fieldValues)
-	err := assignPathValues(dst, fieldPaths)
-	if err != nil {
+	if err := deepObjectSetterFor(v.Type().Elem())(v.Elem(), &root); err != nil {
 		return fmt.Errorf("error assigning value to destination: %w", err)
 	}
-
 	return nil
 }
 
+func sortedQueryKeys(params url.Values) []string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // This returns a field name, either using the variable name, or the json
 // annotation if that exists.
 func getFieldName(f reflect.StructField) string {
@@ -174,184 +268,306 @@ func getFieldName(f reflect.StructField) string {
 	return n
 }
 
-// Create a map of field names that we'll see in the deepObject to reflect
-// field indices on the given type.
-func fieldIndicesByJsonTag(i interface{}) (map[string]int, error) {
-	t := reflect.TypeOf(i)
-	if t.Kind() != reflect.Struct {
-		return nil, errors.New("expected a struct as input")
+// deepObjectSetter assigns a node of a deepObject to an addressable value of
+// the type it was made for. Setters are made once for every type, so that
+// the reflection on types isn't repeated for every parameter.
+type deepObjectSetter func(v reflect.Value, n *deepObjectNode) error
+
+// deepObjectSetters caches the setters by their reflect.Type.
+var deepObjectSetters sync.Map
+
+var (
+	binderType          = reflect.TypeOf((*Binder)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType            = reflect.TypeOf(time.Time{})
+	dateType            = reflect.TypeOf(types.Date{})
+	durationType        = reflect.TypeOf(types.Duration{})
+	isoDurationType     = reflect.TypeOf(types.ISODuration{})
+)
+
+// deepObjectSetterFor returns the setter of a type, making it if needed.
+func deepObjectSetterFor(t reflect.Type) deepObjectSetter {
+	if s, found := deepObjectSetters.Load(t); found {
+		return s.(deepObjectSetter)
 	}
 
-	n := t.NumField()
-	fieldMap := make(map[string]int)
-	for i := 0; i < n; i++ {
-		field := t.Field(i)
-		fieldName := getFieldName(field)
-		fieldMap[fieldName] = i
+	// Recursive types, such as a tree of filters, refer to their own setter
+	// while it's being made, so an indirect one which waits for it is stored
+	// first, like encoding/json does for its encoders.
+	var (
+		wg     sync.WaitGroup
+		setter deepObjectSetter
+	)
+	wg.Add(1)
+	s, loaded := deepObjectSetters.LoadOrStore(t, deepObjectSetter(func(v reflect.Value, n *deepObjectNode) error {
+		wg.Wait()
+		return setter(v, n)
+	}))
+	if loaded {
+		return s.(deepObjectSetter)
 	}
-	return fieldMap, nil
+	setter = newDeepObjectSetter(t)
+	wg.Done()
+	deepObjectSetters.Store(t, setter)
+	return setter
 }
 
-func assignPathValues(dst interface{}, pathValues fieldOrValue) error {
-	//t := reflect.TypeOf(dst)
-	v := reflect.ValueOf(dst)
-
-	iv := reflect.Indirect(v)
-	it := iv.Type()
-
-	switch it.Kind() {
-	case reflect.Slice:
-		sliceLength := len(pathValues.fields)
-		dstSlice := reflect.MakeSlice(it, sliceLength, sliceLength)
-		err := assignSlice(dstSlice, pathValues)
-		if err != nil {
-			return fmt.Errorf("error assigning slice: %w", err)
-		}
-		iv.Set(dstSlice)
-		return nil
-	case reflect.Struct:
-		// Some special types we care about are structs. Handle them
-		// here. They may be redefined, so we need to do some hoop
-		// jumping. If the types are aliased, we need to type convert
-		// the pointer, then set the value of the dereference pointer.
-
-		// We check to see if the object implements the Binder interface first.
-		if dst, isBinder := v.Interface().(Binder); isBinder {
-			return dst.Bind(pathValues.value)
-		}
-		// Then check the legacy types
-		if it.ConvertibleTo(reflect.TypeOf(types.Date{})) {
-			var date types.Date
-			var err error
-			date.Time, err = time.Parse(types.DateFormat, pathValues.value)
+func newDeepObjectSetter(t reflect.Type) deepObjectSetter {
+	// Types which bind themselves come first, then the special types, which
+	// may be redefined, so their pointers are converted.
+	if reflect.PtrTo(t).Implements(binderType) {
+		return func(v reflect.Value, n *deepObjectNode) error {
+			value, err := n.leaf()
 			if err != nil {
-				return fmt.Errorf("invalid date format: %w", err)
+				return err
 			}
-			dst := iv
-			if it != reflect.TypeOf(types.Date{}) {
-				// Types are aliased, convert the pointers.
-				ivPtr := iv.Addr()
-				aPtr := ivPtr.Convert(reflect.TypeOf(&types.Date{}))
-				dst = reflect.Indirect(aPtr)
-			}
-			dst.Set(reflect.ValueOf(date))
+			return v.Addr().Interface().(Binder).Bind(value)
 		}
-		if it.ConvertibleTo(reflect.TypeOf(time.Time{})) {
-			var tm time.Time
-			var err error
-			tm, err = time.Parse(time.RFC3339Nano, pathValues.value)
+	}
+	switch {
+	case t.ConvertibleTo(dateType):
+		return convertedSetter(dateType, func(value string) (interface{}, error) {
+			date, err := time.Parse(types.DateFormat, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid date format: %w", err)
+			}
+			return types.Date{Time: date}, nil
+		})
+	case t.ConvertibleTo(timeType):
+		return convertedSetter(timeType, func(value string) (interface{}, error) {
+			tm, err := time.Parse(time.RFC3339Nano, value)
 			if err != nil {
 				// Fall back to parsing it as a date.
-				tm, err = time.Parse(types.DateFormat, pathValues.value)
+				tm, err = time.Parse(types.DateFormat, value)
 				if err != nil {
-					return fmt.Errorf("error parsing tim as RFC3339 or 2006-01-02 time: %s", err)
+					return nil, fmt.Errorf("error parsing time as RFC3339 or 2006-01-02 time: %s", err)
 				}
-				return fmt.Errorf("invalid date format: %w", err)
 			}
-			dst := iv
-			if it != reflect.TypeOf(time.Time{}) {
-				// Types are aliased, convert the pointers.
-				ivPtr := iv.Addr()
-				aPtr := ivPtr.Convert(reflect.TypeOf(&time.Time{}))
-				dst = reflect.Indirect(aPtr)
+			return tm, nil
+		})
+	case t.ConvertibleTo(isoDurationType):
+		return convertedSetter(isoDurationType, func(value string) (interface{}, error) {
+			d, err := types.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid duration format: %w", err)
 			}
-			dst.Set(reflect.ValueOf(tm))
-		}
-		fieldMap, err := fieldIndicesByJsonTag(iv.Interface())
-		if err != nil {
-			return fmt.Errorf("failed enumerating fields: %w", err)
-		}
-		for _, fieldName := range sortedFieldOrValueKeys(pathValues.fields) {
-			fieldValue := pathValues.fields[fieldName]
-			fieldIndex, found := fieldMap[fieldName]
-			if !found {
-				return fmt.Errorf("field [%s] is not present in destination object", fieldName)
+			return types.ISODuration{Duration: types.Duration{Duration: d}}, nil
+		})
+	case t.ConvertibleTo(durationType):
+		return convertedSetter(durationType, func(value string) (interface{}, error) {
+			d, err := types.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid duration format: %w", err)
 			}
-			field := iv.Field(fieldIndex)
-			err = assignPathValues(field.Addr().Interface(), fieldValue)
+			return types.Duration{Duration: d}, nil
+		})
+	case reflect.PtrTo(t).Implements(textUnmarshalerType):
+		return func(v reflect.Value, n *deepObjectNode) error {
+			value, err := n.leaf()
 			if err != nil {
-				return fmt.Errorf("error assigning field [%s]: %w", fieldName, err)
+				return err
 			}
+			return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
 		}
-		return nil
+	}
+
+	switch t.Kind() {
 	case reflect.Ptr:
-		// If we have a pointer after redirecting, it means we're dealing with
-		// an optional field, such as *string, which was passed in as &foo. We
-		// will allocate it if necessary, and call ourselves with a different
-		// interface.
-		dstVal := reflect.New(it.Elem())
-		dstPtr := dstVal.Interface()
-		err := assignPathValues(dstPtr, pathValues)
-		iv.Set(dstVal)
-		return err
+		return newDeepObjectPtrSetter(t)
+	case reflect.Struct:
+		return newDeepObjectStructSetter(t)
+	case reflect.Slice:
+		return newDeepObjectSliceSetter(t)
+	case reflect.Map:
+		return newDeepObjectMapSetter(t)
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return func(v reflect.Value, n *deepObjectNode) error {
+				v.Set(reflect.ValueOf(n.generic()))
+				return nil
+			}
+		}
 	case reflect.Bool:
-		val, err := strconv.ParseBool(pathValues.value)
+		return scalarSetter(func(v reflect.Value, value string) error {
+			val, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("expected a valid bool, got %s", value)
+			}
+			v.SetBool(val)
+			return nil
+		})
+	case reflect.Float32, reflect.Float64:
+		return scalarSetter(func(v reflect.Value, value string) error {
+			val, err := strconv.ParseFloat(value, t.Bits())
+			if err != nil {
+				return fmt.Errorf("expected a valid float, got %s", value)
+			}
+			v.SetFloat(val)
+			return nil
+		})
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return scalarSetter(func(v reflect.Value, value string) error {
+			val, err := strconv.ParseInt(value, 10, t.Bits())
+			if err != nil {
+				return fmt.Errorf("expected a valid int, got %s", value)
+			}
+			v.SetInt(val)
+			return nil
+		})
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return scalarSetter(func(v reflect.Value, value string) error {
+			val, err := strconv.ParseUint(value, 10, t.Bits())
+			if err != nil {
+				return fmt.Errorf("expected a valid unsigned int, got %s", value)
+			}
+			v.SetUint(val)
+			return nil
+		})
+	case reflect.String:
+		return scalarSetter(func(v reflect.Value, value string) error {
+			v.SetString(value)
+			return nil
+		})
+	}
+	return func(v reflect.Value, n *deepObjectNode) error {
+		return errors.New("unhandled type: " + t.String())
+	}
+}
+
+// scalarSetter makes the setter of a type whose nodes have values.
+func scalarSetter(set func(v reflect.Value, value string) error) deepObjectSetter {
+	return func(v reflect.Value, n *deepObjectNode) error {
+		value, err := n.leaf()
 		if err != nil {
-			return fmt.Errorf("expected a valid bool, got %s", pathValues.value)
+			return err
 		}
-		iv.SetBool(val)
-		return nil
-	case reflect.Float32:
-		val, err := strconv.ParseFloat(pathValues.value, 32)
+		return set(v, value)
+	}
+}
+
+// convertedSetter makes the setter of a type which is, or is defined as, one
+// of the special types, whose values parse returns.
+func convertedSetter(special reflect.Type, parse func(value string) (interface{}, error)) deepObjectSetter {
+	return scalarSetter(func(v reflect.Value, value string) error {
+		parsed, err := parse(value)
 		if err != nil {
-			return fmt.Errorf("expected a valid float, got %s", pathValues.value)
+			return err
 		}
-		iv.SetFloat(val)
-		return nil
-	case reflect.Float64:
-		val, err := strconv.ParseFloat(pathValues.value, 64)
-		if err != nil {
-			return fmt.Errorf("expected a valid float, got %s", pathValues.value)
+		if v.Type() != special {
+			// Types are redefined, convert the pointers.
+			v = reflect.Indirect(v.Addr().Convert(reflect.PtrTo(special)))
 		}
-		iv.SetFloat(val)
+		v.Set(reflect.ValueOf(parsed))
 		return nil
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		val, err := strconv.ParseInt(pathValues.value, 10, 64)
-		if err != nil {
-			return fmt.Errorf("expected a valid int, got %s", pathValues.value)
+	})
+}
+
+func newDeepObjectPtrSetter(t reflect.Type) deepObjectSetter {
+	// Optional fields, such as *string, are allocated, and only set when
+	// their values can be assigned.
+	elem := deepObjectSetterFor(t.Elem())
+	return func(v reflect.Value, n *deepObjectNode) error {
+		ptr := reflect.New(t.Elem())
+		if err := elem(ptr.Elem(), n); err != nil {
+			return err
 		}
-		iv.SetInt(val)
-		return nil
-	case reflect.String:
-		iv.SetString(pathValues.value)
+		v.Set(ptr)
 		return nil
-	default:
-		return errors.New("unhandled type: " + it.String())
 	}
 }
 
-func assignSlice(dst reflect.Value, pathValues fieldOrValue) error {
-	// Gather up the values
-	nValues := len(pathValues.fields)
-	values := make([]string, nValues)
-	// We expect to have consecutive array indices in the map
-	for i := 0; i < nValues; i++ {
-		indexStr := strconv.Itoa(i)
-		fv, found := pathValues.fields[indexStr]
-		if !found {
-			return errors.New("array deepObjects must have consecutive indices")
+// deepObjectField is a field of a struct, with the setter of its type.
+type deepObjectField struct {
+	index  []int
+	setter deepObjectSetter
+}
+
+func newDeepObjectStructSetter(t reflect.Type) deepObjectSetter {
+	fields := make(map[string]deepObjectField)
+	addDeepObjectFields(fields, t, nil)
+	return func(v reflect.Value, n *deepObjectNode) error {
+		if n.hasValue {
+			return fmt.Errorf("expected an object, got %s", n.value)
+		}
+		for _, name := range n.sortedKeys() {
+			field, found := fields[name]
+			if !found {
+				return fmt.Errorf("field [%s] is not present in destination object", name)
+			}
+			if err := field.setter(v.FieldByIndex(field.index), n.children[name]); err != nil {
+				return fmt.Errorf("error assigning field [%s]: %w", name, err)
+			}
 		}
-		values[i] = fv.value
+		return nil
 	}
+}
 
-	// This could be cleaner, but we can call into assignPathValues to
-	// avoid recreating this logic.
-	for i := 0; i < nValues; i++ {
-		dstElem := dst.Index(i).Addr()
-		err := assignPathValues(dstElem.Interface(), fieldOrValue{value: values[i]})
-		if err != nil {
-			return fmt.Errorf("error binding array: %w", err)
+// addDeepObjectFields adds the fields of a struct by their JSON names. The
+// fields of embedded structs are promoted, as encoding/json does, unless
+// fields closer to the surface have their names.
+func addDeepObjectFields(fields map[string]deepObjectField, t reflect.Type, index []int) {
+	var embedded []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			embedded = append(embedded, f)
+			continue
+		}
+		name := getFieldName(f)
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		if _, found := fields[name]; !found {
+			fields[name] = deepObjectField{
+				index:  append(append([]int{}, index...), i),
+				setter: deepObjectSetterFor(f.Type),
+			}
 		}
 	}
+	for _, f := range embedded {
+		addDeepObjectFields(fields, f.Type, append(append([]int{}, index...), f.Index...))
+	}
+}
 
-	return nil
+func newDeepObjectSliceSetter(t reflect.Type) deepObjectSetter {
+	elem := deepObjectSetterFor(t.Elem())
+	return func(v reflect.Value, n *deepObjectNode) error {
+		elems, ok := n.indexed()
+		if n.hasValue || !ok {
+			return errors.New("array deepObjects must have consecutive indices")
+		}
+		slice := reflect.MakeSlice(t, len(elems), len(elems))
+		for i, e := range elems {
+			if err := elem(slice.Index(i), e); err != nil {
+				return fmt.Errorf("error binding array element [%d]: %w", i, err)
+			}
+		}
+		v.Set(slice)
+		return nil
+	}
 }
 
-func sortedFieldOrValueKeys(m map[string]fieldOrValue) []string {
-	keys := make([]string, 0, len(m))
-	for k := range m {
-		keys = append(keys, k)
+func newDeepObjectMapSetter(t reflect.Type) deepObjectSetter {
+	if t.Key().Kind() != reflect.String {
+		return func(v reflect.Value, n *deepObjectNode) error {
+			return errors.New("unhandled map key type: " + t.Key().String())
+		}
+	}
+	elem := deepObjectSetterFor(t.Elem())
+	return func(v reflect.Value, n *deepObjectNode) error {
+		if n.hasValue {
+			return fmt.Errorf("expected an object, got %s", n.value)
+		}
+		m := reflect.MakeMapWithSize(t, len(n.children))
+		for _, key := range n.sortedKeys() {
+			value := reflect.New(t.Elem()).Elem()
+			if err := elem(value, n.children[key]); err != nil {
+				return fmt.Errorf("error assigning key [%s]: %w", key, err)
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(t.Key()), value)
+		}
+		v.Set(m)
+		return nil
 	}
-	sort.Strings(keys)
-	return keys
 }
@@ -0,0 +1,72 @@
+//go:build go1.18
+// +build go1.18
+
+package runtime
+
+import (
+	"net/url"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fuzzFilter struct {
+	Name  *string                `json:"name,omitempty"`
+	Count uint16                 `json:"count"`
+	Score float64                `json:"score"`
+	Tags  []string               `json:"tags,omitempty"`
+	Rules []fuzzFilter           `json:"rules,omitempty"`
+	Meta  map[string]interface{} `json:"meta,omitempty"`
+}
+
+// FuzzUnmarshalDeepObject checks that binding any query either fails or
+// gives a value which marshals back to a query binding to the same value.
+func FuzzUnmarshalDeepObject(f *testing.F) {
+	for _, seed := range []string{
+		"f[name]=a&f[count]=3",
+		"f[tags][0]=x&f[tags][1]=y",
+		"f[rules][0][rules][0][score]=1.5&f[rules][1][name]=b",
+		"f[meta][a][0]=1&f[meta][b][c]=d",
+		"f[name]=a&f[name][x]=b",
+		"f[tags][1]=x",
+		"f[[]]=&f]",
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, query string) {
+		params, err := url.ParseQuery(query)
+		if err != nil || !utf8.ValidString(query) || !validQuery(params) {
+			// JSON replaces invalid UTF-8, so that it doesn't round trip.
+			return
+		}
+		var dst fuzzFilter
+		if err := UnmarshalDeepObject(&dst, "f", params); err != nil {
+			return
+		}
+		marshaled, err := MarshalDeepObject(dst, "f")
+		if err != nil {
+			// Such as infinite scores, which JSON can't represent.
+			return
+		}
+		params, err = url.ParseQuery(marshaled)
+		require.NoError(t, err)
+		var roundTripped fuzzFilter
+		require.NoError(t, UnmarshalDeepObject(&roundTripped, "f", params), marshaled)
+		require.Equal(t, dst, roundTripped, marshaled)
+	})
+}
+
+func validQuery(params url.Values) bool {
+	for k, values := range params {
+		if !utf8.ValidString(k) {
+			return false
+		}
+		for _, v := range values {
+			if !utf8.ValidString(v) {
+				return false
+			}
+		}
+	}
+	return true
+}
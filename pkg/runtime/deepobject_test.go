@@ -2,10 +2,10 @@ package runtime
 
 import (
 	"net/url"
-	"strings"
 	"testing"
 	"time"
 
+	"github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -64,16 +64,176 @@ func TestDeepObject(t *testing.T) {
 	require.NoError(t, err)
 	t.Log(marshaled)
 
-	params := make(url.Values)
-	marshaledParts := strings.Split(marshaled, "&")
-	for _, p := range marshaledParts {
-		parts := strings.Split(p, "=")
-		require.Equal(t, 2, len(parts))
-		params.Set(parts[0], parts[1])
-	}
+	params, err := url.ParseQuery(marshaled)
+	require.NoError(t, err)
 
 	var dstObj AllFields
 	err = UnmarshalDeepObject(&dstObj, "p", params)
 	require.NoError(t, err)
 	assert.EqualValues(t, srcObj, dstObj)
 }
+
+func TestMarshalDeepObjectNested(t *testing.T) {
+	type Condition struct {
+		Eq *string  `json:"eq,omitempty"`
+		In []string `json:"in,omitempty"`
+		Gt *int64   `json:"gt,omitempty"`
+		Ne *string  `json:"ne"`
+	}
+	type Filter struct {
+		Name  Condition `json:"name"`
+		Count Condition `json:"count"`
+		Tags  []string  `json:"tags"`
+	}
+	eq := "a&b=c d"
+	gt := int64(1000000)
+	filter := Filter{
+		Name:  Condition{Eq: &eq},
+		Count: Condition{Gt: &gt, In: []string{"1", "2"}},
+		Tags:  []string{"x", "y"},
+	}
+
+	marshaled, err := MarshalDeepObject(filter, "filter")
+	require.NoError(t, err)
+	assert.Equal(t, "filter[count][gt]=1000000&filter[count][in][0]=1&filter[count][in][1]=2&"+
+		"filter[name][eq]=a%26b%3Dc+d&filter[tags][0]=x&filter[tags][1]=y", marshaled)
+
+	params, err := url.ParseQuery(marshaled)
+	require.NoError(t, err)
+	var dst Filter
+	require.NoError(t, UnmarshalDeepObject(&dst, "filter", params))
+	assert.Equal(t, filter, dst)
+}
+
+func TestDeepObjectDuration(t *testing.T) {
+	type Window struct {
+		Length types.Duration    `json:"length"`
+		Grace  types.ISODuration `json:"grace"`
+	}
+
+	src := Window{
+		Length: types.Duration{Duration: time.Hour},
+		Grace:  types.ISODuration{Duration: types.Duration{Duration: 5 * time.Minute}},
+	}
+	marshaled, err := MarshalDeepObject(src, "window")
+	require.NoError(t, err)
+
+	params, err := url.ParseQuery(marshaled)
+	require.NoError(t, err)
+
+	var dst Window
+	err = UnmarshalDeepObject(&dst, "window", params)
+	require.NoError(t, err)
+	assert.EqualValues(t, src, dst)
+}
+
+func TestDeepObjectNested(t *testing.T) {
+	type Range struct {
+		Min *uint8 `json:"min,omitempty"`
+		Max *uint8 `json:"max,omitempty"`
+	}
+	type Base struct {
+		Kind string `json:"kind"`
+	}
+	type Condition struct {
+		Base
+		Field string   `json:"field"`
+		In    []string `json:"in,omitempty"`
+		Range *Range   `json:"range,omitempty"`
+	}
+	type Query struct {
+		And    []Condition            `json:"and"`
+		Groups [][]string             `json:"groups"`
+		ByName map[string]Condition   `json:"by_name"`
+		Extra  map[string]interface{} `json:"extra"`
+		Next   *Query                 `json:"next,omitempty"`
+	}
+
+	low, high := uint8(1), uint8(200)
+	src := Query{
+		And: []Condition{
+			{Base: Base{Kind: "eq"}, Field: "name", In: []string{"a", "b"}},
+			{Base: Base{Kind: "range"}, Field: "age", Range: &Range{Min: &low, Max: &high}},
+		},
+		Groups: [][]string{{"x"}, {"y", "z"}},
+		ByName: map[string]Condition{
+			"color": {Base: Base{Kind: "eq"}, Field: "color", In: []string{"red"}},
+		},
+		Extra: map[string]interface{}{
+			"tags":  []interface{}{"new", "sale"},
+			"owner": map[string]interface{}{"id": "42"},
+		},
+		Next: &Query{
+			And: []Condition{{Base: Base{Kind: "eq"}, Field: "id"}},
+		},
+	}
+
+	marshaled, err := MarshalDeepObject(src, "q")
+	require.NoError(t, err)
+	assert.Contains(t, marshaled, "q[and][1][range][max]=200")
+	assert.Contains(t, marshaled, "q[and][0][kind]=eq")
+
+	params, err := url.ParseQuery(marshaled)
+	require.NoError(t, err)
+	var dst Query
+	require.NoError(t, UnmarshalDeepObject(&dst, "q", params))
+	assert.Equal(t, src, dst)
+}
+
+func TestDeepObjectErrors(t *testing.T) {
+	type Object struct {
+		Small int8     `json:"small"`
+		Names []string `json:"names"`
+		Inner struct {
+			Name string `json:"name"`
+		} `json:"inner"`
+	}
+
+	for query, expected := range map[string]string{
+		"o[small]=300":                  "error assigning value to destination: error assigning field [small]: expected a valid int, got 300",
+		"o[names][1]=a":                 "error assigning value to destination: error assigning field [names]: array deepObjects must have consecutive indices",
+		"o[names]=a":                    "error assigning value to destination: error assigning field [names]: array deepObjects must have consecutive indices",
+		"o[inner]=a":                    "error assigning value to destination: error assigning field [inner]: expected an object, got a",
+		"o[inner][name][first]=a":       "error assigning value to destination: error assigning field [inner]: error assigning field [name]: expected a value, got an object",
+		"o[unknown]=a":                  "error assigning value to destination: field [unknown] is not present in destination object",
+		"o[inner]=a&o[inner][name]=b":   "o[inner][name] conflicts with a value of a parent key",
+		"o[names][0]=a&o[names][0]=b":   "o[names][0] has multiple values",
+		"o[inner[name]=a":               "o[inner[name] has malformed subscripts",
+		"o[inner][]=a":                  "o[inner][] has malformed subscripts",
+		"o[inner]x=a":                   "o[inner]x has malformed subscripts",
+		"o[names][0][1]=a&o[names][0]=": "o[names][0][1] conflicts with a value of a parent key",
+	} {
+		params, err := url.ParseQuery(query)
+		require.NoError(t, err)
+		var dst Object
+		assert.EqualError(t, UnmarshalDeepObject(&dst, "o", params), expected, query)
+	}
+}
+
+func BenchmarkUnmarshalDeepObject(b *testing.B) {
+	oi := int(5)
+	d := MockBinder{Time: time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)}
+	src := AllFields{
+		I:   12,
+		Oi:  &oi,
+		As:  []string{"hello", "world"},
+		O:   InnerObject{Name: "Joe Schmoe", ID: 456},
+		Oo:  &InnerObject{Name: "Marcin Romaszewicz", ID: 123},
+		D:   d,
+		Od:  &d,
+		Oas: &[]string{"foo", "bar"},
+	}
+	marshaled, err := MarshalDeepObject(src, "p")
+	require.NoError(b, err)
+	params, err := url.ParseQuery(marshaled)
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var dst AllFields
+		if err := UnmarshalDeepObject(&dst, "p", params); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
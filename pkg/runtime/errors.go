@@ -0,0 +1,101 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvalidParamFormatError is returned by the functions binding parameters
+// when the value of a parameter can't be bound to its destination. Servers
+// and error handlers can find it with errors.As, to respond with a
+// structured 400 response naming the parameter.
+type InvalidParamFormatError struct {
+	ParamName string        // The name of the parameter
+	In        ParamLocation // Where the parameter is, when it's known
+	Reason    string        // Why the value is invalid, which is the message of the error
+	Err       error         // The error the value caused, if any
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return e.Reason
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+// RequiredParamError is returned by the functions binding parameters when a
+// required parameter is missing.
+type RequiredParamError struct {
+	ParamName string        // The name of the parameter
+	In        ParamLocation // Where the parameter is, when it's known
+}
+
+func (e *RequiredParamError) Error() string {
+	if e.In == ParamLocationUndefined {
+		return fmt.Sprintf("parameter '%s' is required", e.ParamName)
+	}
+	return fmt.Sprintf("%s parameter '%s' is required", e.In, e.ParamName)
+}
+
+// UnsupportedMediaTypeError is returned by the generated functions decoding
+// request bodies when the content type of a request isn't one the operation
+// accepts. Servers can find it with errors.As, to respond with 415
+// Unsupported Media Type.
+type UnsupportedMediaTypeError struct {
+	ContentType string   // The content type of the request
+	Supported   []string // The content types the operation accepts
+}
+
+func (e *UnsupportedMediaTypeError) Error() string {
+	return fmt.Sprintf("unsupported content type '%s', expected one of %s", e.ContentType, strings.Join(e.Supported, ", "))
+}
+
+// StatusError is returned by the Err methods of generated client responses
+// when the server responded with an error status. When the operation declares
+// the status, Err is the sentinel error of the package generated for it, such
+// as ErrNotFound, which callers can check with errors.Is.
+type StatusError struct {
+	StatusCode int    // The status code of the response
+	Body       []byte // The body of the response
+	Err        error  // The sentinel error of the status, if the operation declares it
+}
+
+func (e *StatusError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("unexpected status code %d", e.StatusCode)
+	}
+	return fmt.Sprintf("status code %d: %s", e.StatusCode, e.Err)
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// paramError returns the error of binding a parameter, which is an
+// InvalidParamFormatError unless the parameter is missing.
+func paramError(paramName string, in ParamLocation, err error) error {
+	switch err.(type) {
+	case nil, *RequiredParamError, *InvalidParamFormatError:
+		return err
+	}
+	return &InvalidParamFormatError{
+		ParamName: paramName,
+		In:        in,
+		Reason:    err.Error(),
+		Err:       err,
+	}
+}
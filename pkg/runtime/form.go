@@ -0,0 +1,194 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// FormEncoding is the encoding object of a property of a form body, which
+// overrides how the property is serialized. Properties without one are
+// serialized in the form style, exploded.
+type FormEncoding struct {
+	ContentType string // When it's JSON, such as application/json, the property is sent as JSON
+	Style       string // The style of the property, which defaults to form
+	Explode     bool   // Whether arrays and objects are exploded
+}
+
+// formEncoding returns the encoding of a property, with its style set.
+func formEncoding(encodings map[string]FormEncoding, name string) FormEncoding {
+	e, found := encodings[name]
+	if !found {
+		return FormEncoding{Style: "form", Explode: true}
+	}
+	if e.Style == "" {
+		e.Style = "form"
+	}
+	return e
+}
+
+func isJSONContentType(contentType string) bool {
+	return contentType == "application/json" || strings.HasSuffix(contentType, "+json")
+}
+
+// MarshalForm turns a struct, such as a generated form body, into form
+// values, with a value for every field which is set, named by its json tag.
+// Fields are styled as query parameters are, in the style their encoding
+// gives, which is the exploded form style by default, so that arrays repeat
+// their name and the properties of objects are values of their own.
+func MarshalForm(v interface{}, encodings map[string]FormEncoding) (url.Values, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("form bodies must be structs, not %s", value.Kind())
+	}
+	form := make(url.Values)
+	if err := marshalFormStruct(form, value, encodings); err != nil {
+		return nil, err
+	}
+	return form, nil
+}
+
+func marshalFormStruct(form url.Values, v reflect.Value, encodings map[string]FormEncoding) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			if err := marshalFormStruct(form, v.Field(i), encodings); err != nil {
+				return err
+			}
+			continue
+		}
+		name := getFieldName(f)
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		field := v.Field(i)
+		if field.Kind() == reflect.Ptr || field.Kind() == reflect.Map || field.Kind() == reflect.Interface {
+			if field.IsNil() {
+				continue
+			}
+		}
+		field = reflect.Indirect(field)
+
+		encoding := formEncoding(encodings, name)
+		if isJSONContentType(encoding.ContentType) {
+			data, err := json.Marshal(field.Interface())
+			if err != nil {
+				return fmt.Errorf("error marshaling field '%s': %w", name, err)
+			}
+			form.Add(name, string(data))
+			continue
+		}
+
+		styled, err := StyleParamWithLocation(encoding.Style, encoding.Explode, name, ParamLocationQuery, field.Interface())
+		if err != nil {
+			return fmt.Errorf("error marshaling field '%s': %w", name, err)
+		}
+		if styled == "" {
+			continue
+		}
+		values, err := url.ParseQuery(styled)
+		if err != nil {
+			return fmt.Errorf("error marshaling field '%s': %w", name, err)
+		}
+		for k, vs := range values {
+			form[k] = append(form[k], vs...)
+		}
+	}
+	return nil
+}
+
+// UnmarshalForm sets the fields of the struct dst, such as a generated form
+// body, from form values, such as those of http.Request.PostForm. It is the
+// reverse of MarshalForm, and fields are bound as query parameters are.
+// Fields without values are left untouched.
+func UnmarshalForm(form url.Values, dst interface{}, encodings map[string]FormEncoding) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("form bodies must be unmarshaled into pointers to structs")
+	}
+	return unmarshalFormStruct(form, v.Elem(), encodings)
+}
+
+func unmarshalFormStruct(form url.Values, v reflect.Value, encodings map[string]FormEncoding) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			if err := unmarshalFormStruct(form, v.Field(i), encodings); err != nil {
+				return err
+			}
+			continue
+		}
+		name := getFieldName(f)
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		field := v.Field(i)
+
+		encoding := formEncoding(encodings, name)
+		if isJSONContentType(encoding.ContentType) {
+			value := form.Get(name)
+			if value == "" {
+				continue
+			}
+			if err := json.Unmarshal([]byte(value), field.Addr().Interface()); err != nil {
+				return fmt.Errorf("error unmarshaling field '%s': %w", name, err)
+			}
+			continue
+		}
+
+		// Exploded objects have no value of their own, so optional ones are
+		// only set when the form has values for their properties.
+		if field.Kind() == reflect.Ptr && encoding.Style == "form" && encoding.Explode &&
+			!hasExplodedFormValues(form, field.Type().Elem()) {
+			continue
+		}
+
+		// Fields are bound as optional query parameters, which are pointers
+		// that are only set when the form has a value for them.
+		ptr := field
+		if field.Kind() != reflect.Ptr {
+			ptr = reflect.New(reflect.PtrTo(field.Type())).Elem()
+		}
+		err := BindQueryParameter(encoding.Style, encoding.Explode, false, name, form, ptr.Addr().Interface())
+		if err != nil {
+			return err
+		}
+		if field.Kind() != reflect.Ptr && !ptr.IsNil() {
+			field.Set(ptr.Elem())
+		}
+	}
+	return nil
+}
+
+// hasExplodedFormValues returns whether a form has values for an exploded
+// object of type t, which are values of its properties. Types which aren't
+// objects are assumed to have them.
+func hasExplodedFormValues(form url.Values, t reflect.Type) bool {
+	if t.Kind() != reflect.Struct || !isObjectType(t) {
+		return true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if _, found := form[getFieldName(t.Field(i))]; found {
+			return true
+		}
+	}
+	return false
+}
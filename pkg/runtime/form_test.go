@@ -0,0 +1,95 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+func TestMarshalForm(t *testing.T) {
+	type Address struct {
+		City string `json:"city"`
+		Zip  string `json:"zip"`
+	}
+	type Form struct {
+		Name     string      `json:"name"`
+		Age      *int        `json:"age,omitempty"`
+		Born     *types.Date `json:"born,omitempty"`
+		Tags     []string    `json:"tags"`
+		Flags    []string    `json:"flags"`
+		Scores   []int       `json:"scores"`
+		Address  Address     `json:"address"`
+		Filter   *Address    `json:"filter,omitempty"`
+		Metadata *Address    `json:"metadata,omitempty"`
+	}
+	encodings := map[string]FormEncoding{
+		"flags":    {Style: "form", Explode: false},
+		"scores":   {Style: "pipeDelimited"},
+		"filter":   {Style: "deepObject", Explode: true},
+		"metadata": {ContentType: "application/json"},
+	}
+
+	age := 42
+	src := Form{
+		Name:     "Alex & co",
+		Age:      &age,
+		Born:     &types.Date{Time: time.Date(1980, 2, 29, 0, 0, 0, 0, time.UTC)},
+		Tags:     []string{"a", "b"},
+		Flags:    []string{"x", "y"},
+		Scores:   []int{1, 2},
+		Address:  Address{City: "Paris", Zip: "75001"},
+		Filter:   &Address{City: "Lyon"},
+		Metadata: &Address{Zip: "1000"},
+	}
+	form, err := MarshalForm(src, encodings)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"name":         {"Alex & co"},
+		"age":          {"42"},
+		"born":         {"1980-02-29"},
+		"tags":         {"a", "b"},
+		"flags":        {"x,y"},
+		"scores":       {"1|2"},
+		"city":         {"Paris"},
+		"zip":          {"75001"},
+		"filter[city]": {"Lyon"},
+		"filter[zip]":  {""},
+		"metadata":     {`{"city":"","zip":"1000"}`},
+	}, form)
+
+	// The form survives being sent.
+	form, err = url.ParseQuery(form.Encode())
+	require.NoError(t, err)
+
+	var dst Form
+	require.NoError(t, UnmarshalForm(form, &dst, encodings))
+	assert.Equal(t, src, dst)
+
+	// Fields without values are left untouched.
+	dst = Form{Name: "kept"}
+	require.NoError(t, UnmarshalForm(url.Values{"tags": {"c"}}, &dst, nil))
+	assert.Equal(t, Form{Name: "kept", Tags: []string{"c"}}, dst)
+
+	assert.Error(t, UnmarshalForm(url.Values{"age": {"old"}}, &dst, nil))
+	assert.Error(t, UnmarshalForm(form, dst, nil))
+	_, err = MarshalForm([]string{"a"}, nil)
+	assert.Error(t, err)
+}
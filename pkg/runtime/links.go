@@ -0,0 +1,149 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// embeddedExpression matches runtime expressions embedded in a string, such
+// as the one in "user-{$response.body#/id}".
+var embeddedExpression = regexp.MustCompile(`\{(\$[^}]+)\}`)
+
+// BindLinkParameter evaluates an OpenAPI link runtime expression, such as
+// $response.body#/id, against a response and its body, and binds the result
+// to dest. Values which aren't expressions are bound as they are.
+func BindLinkParameter(expression string, rsp *http.Response, body []byte, dest interface{}) error {
+	if !strings.HasPrefix(expression, "$") {
+		value := expression
+		if embeddedExpression.MatchString(expression) {
+			var err error
+			value, err = expandEmbeddedExpressions(expression, rsp, body)
+			if err != nil {
+				return err
+			}
+		}
+		return BindStringToObject(value, dest)
+	}
+
+	value, err := evaluateLinkExpression(expression, rsp, body)
+	if err != nil {
+		return err
+	}
+	// Values taken from a JSON body are decoded as JSON when possible, so that
+	// objects and arrays can be bound.
+	if raw, ok := value.(json.RawMessage); ok {
+		if err := json.Unmarshal(raw, dest); err == nil {
+			return nil
+		}
+		return BindStringToObject(jsonString(raw), dest)
+	}
+	return BindStringToObject(value.(string), dest)
+}
+
+func expandEmbeddedExpressions(s string, rsp *http.Response, body []byte) (string, error) {
+	var err error
+	expanded := embeddedExpression.ReplaceAllStringFunc(s, func(match string) string {
+		value, evalErr := evaluateLinkExpression(match[1:len(match)-1], rsp, body)
+		if evalErr != nil {
+			err = evalErr
+			return ""
+		}
+		if raw, ok := value.(json.RawMessage); ok {
+			return jsonString(raw)
+		}
+		return value.(string)
+	})
+	return expanded, err
+}
+
+// evaluateLinkExpression returns the value of a runtime expression, which is
+// either a string, or a json.RawMessage when taken from the response body.
+func evaluateLinkExpression(expression string, rsp *http.Response, body []byte) (interface{}, error) {
+	switch {
+	case rsp == nil && !strings.HasPrefix(expression, "$response.body"):
+		return nil, fmt.Errorf("link expression '%s' needs a response", expression)
+	case expression == "$statusCode":
+		return strconv.Itoa(rsp.StatusCode), nil
+	case expression == "$url" && rsp.Request != nil:
+		return rsp.Request.URL.String(), nil
+	case expression == "$method" && rsp.Request != nil:
+		return rsp.Request.Method, nil
+	case strings.HasPrefix(expression, "$response.header."):
+		return rsp.Header.Get(strings.TrimPrefix(expression, "$response.header.")), nil
+	case strings.HasPrefix(expression, "$response.body"):
+		return resolveJSONPointer(body, strings.TrimPrefix(expression, "$response.body"))
+	case strings.HasPrefix(expression, "$request.query.") && rsp.Request != nil:
+		return rsp.Request.URL.Query().Get(strings.TrimPrefix(expression, "$request.query.")), nil
+	case strings.HasPrefix(expression, "$request.header.") && rsp.Request != nil:
+		return rsp.Request.Header.Get(strings.TrimPrefix(expression, "$request.header.")), nil
+	}
+	return nil, fmt.Errorf("unsupported link expression '%s'", expression)
+}
+
+// resolveJSONPointer returns the value in a JSON document at a fragment such
+// as #/items/0/id, as described in RFC 6901.
+func resolveJSONPointer(document []byte, fragment string) (json.RawMessage, error) {
+	if fragment == "" || fragment == "#" {
+		return json.RawMessage(document), nil
+	}
+	if !strings.HasPrefix(fragment, "#/") {
+		return nil, fmt.Errorf("invalid JSON pointer '%s'", fragment)
+	}
+
+	current := json.RawMessage(document)
+	for _, token := range strings.Split(fragment[2:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		trimmed := bytes.TrimSpace(current)
+		if len(trimmed) > 0 && trimmed[0] == '[' {
+			var array []json.RawMessage
+			if err := json.Unmarshal(trimmed, &array); err != nil {
+				return nil, err
+			}
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(array) {
+				return nil, fmt.Errorf("JSON pointer '%s' has invalid index '%s'", fragment, token)
+			}
+			current = array[i]
+			continue
+		}
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &object); err != nil {
+			return nil, fmt.Errorf("JSON pointer '%s' doesn't refer to an object: %w", fragment, err)
+		}
+		value, found := object[token]
+		if !found {
+			return nil, fmt.Errorf("JSON pointer '%s' not found in body", fragment)
+		}
+		current = value
+	}
+	return current, nil
+}
+
+// jsonString returns the text of a JSON value, unquoting strings.
+func jsonString(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(bytes.TrimSpace(raw))
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindLinkParameter(t *testing.T) {
+	reqURL, _ := url.Parse("https://example.com/users?team=blue")
+	rsp := &http.Response{
+		StatusCode: http.StatusCreated,
+		Header:     http.Header{"Location": []string{"/users/5"}},
+		Request: &http.Request{
+			Method: http.MethodPost,
+			URL:    reqURL,
+			Header: http.Header{"X-Tenant": []string{"acme"}},
+		},
+	}
+	body := []byte(`{"id": 5, "name": "Alex", "tags": ["a", "b/c"], "a/b": {"c~d": true}}`)
+
+	var i int
+	assert.NoError(t, BindLinkParameter("$response.body#/id", rsp, body, &i))
+	assert.Equal(t, 5, i)
+
+	var s string
+	assert.NoError(t, BindLinkParameter("$response.body#/id", rsp, body, &s))
+	assert.Equal(t, "5", s)
+	assert.NoError(t, BindLinkParameter("$response.body#/tags/1", rsp, body, &s))
+	assert.Equal(t, "b/c", s)
+	assert.NoError(t, BindLinkParameter("$response.header.Location", rsp, body, &s))
+	assert.Equal(t, "/users/5", s)
+	assert.NoError(t, BindLinkParameter("$request.query.team", rsp, body, &s))
+	assert.Equal(t, "blue", s)
+	assert.NoError(t, BindLinkParameter("$request.header.X-Tenant", rsp, body, &s))
+	assert.Equal(t, "acme", s)
+	assert.NoError(t, BindLinkParameter("$method", rsp, body, &s))
+	assert.Equal(t, "POST", s)
+	assert.NoError(t, BindLinkParameter("user-{$response.body#/name}", rsp, body, &s))
+	assert.Equal(t, "user-Alex", s)
+	assert.NoError(t, BindLinkParameter("constant", rsp, body, &s))
+	assert.Equal(t, "constant", s)
+
+	var b *bool
+	assert.NoError(t, BindLinkParameter("$response.body#/a~1b/c~0d", rsp, body, &b))
+	assert.Equal(t, true, *b)
+
+	var tags []string
+	assert.NoError(t, BindLinkParameter("$response.body#/tags", rsp, body, &tags))
+	assert.Equal(t, []string{"a", "b/c"}, tags)
+
+	assert.NoError(t, BindLinkParameter("$statusCode", rsp, body, &i))
+	assert.Equal(t, 201, i)
+
+	assert.Error(t, BindLinkParameter("$response.body#/missing", rsp, body, &s))
+	assert.Error(t, BindLinkParameter("$response.body#/tags/5", rsp, body, &s))
+	assert.Error(t, BindLinkParameter("$request.path.id", rsp, body, &s))
+	assert.Error(t, BindLinkParameter("$response.body#/name", rsp, body, &i))
+
+	assert.NoError(t, BindLinkParameter("$response.body#/id", nil, body, &i))
+	assert.Equal(t, 5, i)
+	assert.Error(t, BindLinkParameter("$statusCode", nil, body, &i))
+	assert.Error(t, BindLinkParameter("$response.header.Location", nil, body, &s))
+}
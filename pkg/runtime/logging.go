@@ -0,0 +1,236 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Redacted replaces the values of sensitive headers, query parameters and
+// fields.
+const Redacted = "REDACTED"
+
+// sensitiveNames are the parts of header and query parameter names whose
+// values are redacted from operation records.
+var sensitiveNames = []string{
+	"api-key", "api_key", "apikey", "authorization", "cookie", "credential",
+	"password", "secret", "session", "signature", "token",
+}
+
+// OperationLogger receives a record of every call of an operation, made by a
+// generated client or handled by a generated server.
+type OperationLogger interface {
+	LogOperation(ctx context.Context, record OperationRecord)
+}
+
+// OperationLoggerFunc allows using a function as an OperationLogger.
+type OperationLoggerFunc func(ctx context.Context, record OperationRecord)
+
+// LogOperation calls f(ctx, record).
+func (f OperationLoggerFunc) LogOperation(ctx context.Context, record OperationRecord) {
+	f(ctx, record)
+}
+
+// OperationRecord describes a call of an operation. Credentials, cookies,
+// tokens and the like are redacted from the URL and headers.
+type OperationRecord struct {
+	OperationID    string
+	Method         string
+	URL            string
+	Path           string        // The path template of the operation, such as /pets/{id}, when it's known
+	StatusCode     int           // Zero when no response was received
+	Duration       time.Duration // Until the response headers were received, or the handler returned
+	RequestHeader  http.Header
+	ResponseHeader http.Header
+	Err            error
+}
+
+// NewOperationRecord returns the record of a call of an operation, which
+// responded with the given status code and headers.
+func NewOperationRecord(operationID string, req *http.Request, statusCode int, responseHeader http.Header, duration time.Duration, err error) OperationRecord {
+	record := OperationRecord{
+		OperationID:    operationID,
+		Method:         req.Method,
+		StatusCode:     statusCode,
+		Duration:       duration,
+		RequestHeader:  SanitizeHeader(req.Header),
+		ResponseHeader: SanitizeHeader(responseHeader),
+		Err:            err,
+	}
+	if req.URL != nil {
+		record.URL = SanitizeURL(req.URL)
+	}
+	return record
+}
+
+// NewRouteRecord returns the record of a call of the operation of a route,
+// which responded with the given status code and headers, and whose Path is
+// that of the route.
+func NewRouteRecord(route Route, req *http.Request, statusCode int, responseHeader http.Header, duration time.Duration, err error) OperationRecord {
+	record := NewOperationRecord(route.OperationID, req, statusCode, responseHeader, duration, err)
+	record.Path = route.Path
+	return record
+}
+
+// NewClientOperationRecord returns the record of a call of an operation made
+// by a client, which received rsp unless it failed with err. The request of
+// the response is recorded when there is one, as it's the one which was last
+// sent.
+func NewClientOperationRecord(operationID string, req *http.Request, rsp *http.Response, duration time.Duration, err error) OperationRecord {
+	if rsp == nil {
+		return NewOperationRecord(operationID, req, 0, nil, duration, err)
+	}
+	if rsp.Request != nil {
+		req = rsp.Request
+	}
+	return NewOperationRecord(operationID, req, rsp.StatusCode, rsp.Header, duration, err)
+}
+
+// SanitizeHeader returns a copy of h, in which the values of headers which
+// may hold credentials are redacted.
+func SanitizeHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	sanitized := make(http.Header, len(h))
+	for name, values := range h {
+		if isSensitiveName(name) {
+			values = []string{Redacted}
+		} else {
+			values = append([]string(nil), values...)
+		}
+		sanitized[name] = values
+	}
+	return sanitized
+}
+
+// SanitizeURL returns u as a string, with its password and the values of
+// query parameters which may hold credentials redacted.
+func SanitizeURL(u *url.URL) string {
+	sanitized := *u
+	if sanitized.RawQuery != "" {
+		query := sanitized.Query()
+		for name := range query {
+			if isSensitiveName(name) {
+				query[name] = []string{Redacted}
+			}
+		}
+		sanitized.RawQuery = query.Encode()
+	}
+	return sanitized.Redacted()
+}
+
+func isSensitiveName(name string) bool {
+	name = strings.ToLower(name)
+	for _, sensitive := range sensitiveNames {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// LogHandlerFunc returns a handler for an operation, which logs every call of
+// handler with logger. The handler is returned as it is when logger is nil.
+func LogHandlerFunc(logger OperationLogger, operationID string, handler http.HandlerFunc) http.HandlerFunc {
+	if logger == nil {
+		return handler
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w}
+		handler(recorder, r)
+		statusCode := recorder.statusCode
+		if statusCode == 0 {
+			statusCode = http.StatusOK
+		}
+		logger.LogOperation(r.Context(), NewOperationRecord(operationID, r, statusCode, w.Header(), time.Since(start), nil))
+	}
+}
+
+// LoggingMiddleware returns a middleware logging every request with logger,
+// once it has been handled, as a call of the operation of the route which
+// route returns for it, which is the zero Route when it matched none.
+func LoggingMiddleware(logger OperationLogger, route func(r *http.Request) Route) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			recorder := &statusRecorder{ResponseWriter: w}
+			next.ServeHTTP(recorder, r)
+			statusCode := recorder.statusCode
+			if statusCode == 0 {
+				statusCode = http.StatusOK
+			}
+			logger.LogOperation(r.Context(), NewRouteRecord(route(r), r, statusCode, w.Header(), time.Since(start), nil))
+		})
+	}
+}
+
+// KeyValueLogger returns an OperationLogger logging the method, path
+// template, operation ID, status code and latency of every call, and its
+// error if any, with a function taking a message and alternating keys and
+// values, such as the Info method of a *slog.Logger or the Infow method of a
+// *zap.SugaredLogger. The URL and headers of calls aren't logged, so that the
+// values logged are bounded.
+func KeyValueLogger(log func(msg string, keysAndValues ...interface{})) OperationLogger {
+	return OperationLoggerFunc(func(ctx context.Context, record OperationRecord) {
+		keysAndValues := []interface{}{
+			"method", record.Method,
+			"path", record.Path,
+			"operation_id", record.OperationID,
+			"status", record.StatusCode,
+			"latency", record.Duration,
+		}
+		if record.Err != nil {
+			keysAndValues = append(keysAndValues, "error", record.Err.Error())
+		}
+		log("operation", keysAndValues...)
+	})
+}
+
+// statusRecorder records the status code of a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	if r.statusCode == 0 {
+		r.statusCode = statusCode
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.statusCode == 0 {
+		r.statusCode = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush allows streaming responses through the recorder.
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the recorded ResponseWriter, for http.ResponseController.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,569 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// MultipartBody builds a multipart/form-data request body. The readers
+// passed to AddFile aren't consumed until the body is read, so large files
+// are streamed to the server rather than being buffered in memory.
+type MultipartBody struct {
+	parts []multipartPart
+}
+
+type multipartPart struct {
+	name        string
+	filename    string
+	contentType string
+	value       string
+	header      textproto.MIMEHeader
+	reader      io.Reader
+	closer      io.Closer
+}
+
+// MultipartEncoding is the encoding object of a property of a multipart body,
+// which overrides how its parts are written and bound.
+type MultipartEncoding struct {
+	ContentType string            // The media types of the parts, separated by commas, such as image/png, image/jpeg
+	Headers     map[string]string // Further headers of the parts
+	Style       string            // When set, the property is styled as a query parameter is
+	Explode     bool              // Whether arrays are exploded, when the property is styled
+}
+
+// contentTypes returns the media types, or ranges, of the parts of a property.
+func (e MultipartEncoding) contentTypes() []string {
+	var contentTypes []string
+	for _, contentType := range strings.Split(e.ContentType, ",") {
+		if contentType = strings.TrimSpace(contentType); contentType != "" {
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+	return contentTypes
+}
+
+// defaultContentType returns the content type of parts which have none of
+// their own, which is the first of the encoding, unless it's a range.
+func (e MultipartEncoding) defaultContentType() string {
+	contentTypes := e.contentTypes()
+	if len(contentTypes) == 0 || strings.HasSuffix(contentTypes[0], "/*") {
+		return ""
+	}
+	return contentTypes[0]
+}
+
+// allows returns whether a part of the given content type may be sent for
+// the property, matching it against the media ranges of the encoding.
+func (e MultipartEncoding) allows(contentType string) bool {
+	contentTypes := e.contentTypes()
+	if len(contentTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return MatchMediaRanges(mediaType, contentTypes)
+}
+
+// MatchMediaRanges returns whether a media type, without parameters, matches
+// one of the given media types or ranges, such as image/* or */*.
+func MatchMediaRanges(mediaType string, ranges []string) bool {
+	for _, allowed := range ranges {
+		switch {
+		case allowed == "*/*", strings.EqualFold(allowed, mediaType):
+			return true
+		case strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.ToLower(strings.TrimSuffix(allowed, "*"))):
+			return true
+		}
+	}
+	return false
+}
+
+// NewMultipartBody returns an empty multipart body.
+func NewMultipartBody() *MultipartBody {
+	return &MultipartBody{}
+}
+
+// AddField adds a form field with the given value.
+func (b *MultipartBody) AddField(name, value string) *MultipartBody {
+	b.parts = append(b.parts, multipartPart{name: name, value: value})
+	return b
+}
+
+// AddFile adds a file part, whose content will be read from r. When
+// contentType is empty, application/octet-stream is used.
+func (b *MultipartBody) AddFile(name, filename string, r io.Reader, contentType string) *MultipartBody {
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	b.parts = append(b.parts, multipartPart{
+		name:        name,
+		filename:    filename,
+		contentType: contentType,
+		reader:      r,
+	})
+	return b
+}
+
+// SetPartHeader sets a header of the parts of the given name, such as a
+// header of their encoding object which has no default, replacing the value
+// it has. The Content-Disposition and content type of the parts take
+// precedence over the header.
+func (b *MultipartBody) SetPartHeader(name, key, value string) *MultipartBody {
+	for i := range b.parts {
+		p := &b.parts[i]
+		if p.name != name {
+			continue
+		}
+		if p.header == nil {
+			p.header = make(textproto.MIMEHeader)
+		}
+		p.header.Set(key, value)
+	}
+	return b
+}
+
+// Reader returns the content type of the body, including its boundary, and
+// a reader which produces the encoded body as it is consumed. Any error
+// encountered while reading a file part is returned from the reader. The
+// files of the body are closed once it's read, or once the reader is closed.
+func (b *MultipartBody) Reader() (string, io.ReadCloser) {
+	return b.ReaderContext(context.Background())
+}
+
+// ReaderContext is like Reader, but the body is also abandoned when ctx is
+// done, in which case the reader returns the error of ctx, and the files of
+// the body are closed, even when the reader is neither read nor closed.
+func (b *MultipartBody) ReaderContext(ctx context.Context) (string, io.ReadCloser) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		pw.CloseWithError(b.write(mw))
+	}()
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				// Closing the writer unblocks the pending write of the body.
+				pw.CloseWithError(ctx.Err())
+			case <-written:
+			}
+		}()
+	}
+
+	return mw.FormDataContentType(), pr
+}
+
+func (b *MultipartBody) write(mw *multipart.Writer) error {
+	defer b.close()
+	for _, p := range b.parts {
+		if p.reader == nil && p.contentType == "" && len(p.header) == 0 {
+			if err := mw.WriteField(p.name, p.value); err != nil {
+				return fmt.Errorf("error writing field '%s': %w", p.name, err)
+			}
+			continue
+		}
+
+		h := make(textproto.MIMEHeader)
+		for k, v := range p.header {
+			h[k] = v
+		}
+		if p.reader != nil {
+			h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+				escapeQuotes(p.name), escapeQuotes(p.filename)))
+		} else {
+			h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"`, escapeQuotes(p.name)))
+		}
+		if p.contentType != "" {
+			h.Set("Content-Type", p.contentType)
+		}
+		w, err := mw.CreatePart(h)
+		if err != nil {
+			return fmt.Errorf("error creating part '%s': %w", p.name, err)
+		}
+		r := p.reader
+		if r == nil {
+			r = strings.NewReader(p.value)
+		}
+		if _, err := io.Copy(w, r); err != nil {
+			return fmt.Errorf("error writing part '%s': %w", p.name, err)
+		}
+	}
+	return mw.Close()
+}
+
+// close closes the files opened by MarshalMultipart.
+func (b *MultipartBody) close() {
+	for _, p := range b.parts {
+		if p.closer != nil {
+			p.closer.Close()
+		}
+	}
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
+
+var fileType = reflect.TypeOf(types.File{})
+
+// MarshalMultipart builds a multipart body from a struct, such as a generated
+// multipart request body, with a part for every field which is set, named by
+// its json tag. Fields of type types.File are sent as files, arrays as one
+// part for every element, and objects as JSON.
+func MarshalMultipart(body interface{}) (*MultipartBody, error) {
+	return MarshalMultipartWithEncoding(body, nil)
+}
+
+// MarshalMultipartWithEncoding is MarshalMultipart for bodies whose properties
+// have encoding objects. Files without a content type of their own are sent
+// with the first of their encoding, and properties with a JSON content type
+// are sent as JSON, whatever their type. Properties with a style are styled
+// as query parameters are, so that arrays which aren't exploded are sent in
+// a single part.
+func MarshalMultipartWithEncoding(body interface{}, encodings map[string]MultipartEncoding) (*MultipartBody, error) {
+	v := reflect.Indirect(reflect.ValueOf(body))
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("multipart bodies must be structs, not %s", v.Kind())
+	}
+	b := NewMultipartBody()
+	if err := b.addStruct(v, encodings); err != nil {
+		b.close()
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *MultipartBody) addStruct(v reflect.Value, encodings map[string]MultipartEncoding) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			if err := b.addStruct(v.Field(i), encodings); err != nil {
+				return err
+			}
+			continue
+		}
+		name := getFieldName(f)
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		encoding := encodings[name]
+		start := len(b.parts)
+		if err := b.addEncodedValue(name, v.Field(i), encoding); err != nil {
+			return err
+		}
+		for j := start; j < len(b.parts); j++ {
+			p := &b.parts[j]
+			if p.reader == nil {
+				p.contentType = encoding.defaultContentType()
+			}
+			if len(encoding.Headers) != 0 {
+				p.header = make(textproto.MIMEHeader)
+				for key, value := range encoding.Headers {
+					p.header.Set(key, value)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// addEncodedValue adds the parts of a property, as its encoding gives.
+func (b *MultipartBody) addEncodedValue(name string, v reflect.Value, encoding MultipartEncoding) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+	}
+	t := reflect.Indirect(v).Type()
+	isFile := t == fileType || (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem() == fileType
+
+	switch {
+	case isFile:
+	case isJSONContentType(encoding.defaultContentType()):
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Errorf("error marshaling field '%s': %w", name, err)
+		}
+		b.AddField(name, string(data))
+		return nil
+	case encoding.Style != "" && !isObjectType(t):
+		styled, err := StyleParamWithLocation(encoding.Style, encoding.Explode, name, ParamLocationQuery, reflect.Indirect(v).Interface())
+		if err != nil {
+			return fmt.Errorf("error marshaling field '%s': %w", name, err)
+		}
+		values, err := url.ParseQuery(styled)
+		if err != nil {
+			return fmt.Errorf("error marshaling field '%s': %w", name, err)
+		}
+		for _, value := range values[name] {
+			b.AddField(name, value)
+		}
+		return nil
+	}
+	return b.addValue(name, v, encoding.defaultContentType())
+}
+
+func (b *MultipartBody) addValue(name string, v reflect.Value, defaultContentType string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	t := v.Type()
+
+	switch {
+	case t == fileType:
+		file := v.Interface().(types.File)
+		r, err := file.Reader()
+		if err != nil {
+			return fmt.Errorf("error opening file '%s': %w", name, err)
+		}
+		contentType := file.ContentType()
+		if contentType == "" {
+			contentType = defaultContentType
+		}
+		b.AddFile(name, file.Filename(), r, contentType)
+		b.parts[len(b.parts)-1].closer = r
+		return nil
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		b.AddField(name, base64.StdEncoding.EncodeToString(v.Bytes()))
+		return nil
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array && !t.Implements(textMarshalerType):
+		for i := 0; i < v.Len(); i++ {
+			if err := b.addValue(name, v.Index(i), defaultContentType); err != nil {
+				return err
+			}
+		}
+		return nil
+	case isObjectType(t):
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return fmt.Errorf("error marshaling field '%s': %w", name, err)
+		}
+		b.AddField(name, string(data))
+		return nil
+	case t.Implements(textMarshalerType):
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return fmt.Errorf("error marshaling field '%s': %w", name, err)
+		}
+		b.AddField(name, string(text))
+		return nil
+	}
+
+	s, err := primitiveToString(v.Interface())
+	if err != nil {
+		return fmt.Errorf("error marshaling field '%s': %w", name, err)
+	}
+	b.AddField(name, s)
+	return nil
+}
+
+// isObjectType returns whether values of a type are objects, which are
+// sent in multipart bodies as JSON, rather than primitives such as dates.
+func isObjectType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Map, reflect.Interface:
+		return true
+	case reflect.Struct:
+		p := reflect.PtrTo(t)
+		return !p.Implements(binderType) && !p.Implements(textUnmarshalerType) &&
+			!t.ConvertibleTo(timeType) && !t.ConvertibleTo(dateType) &&
+			!t.ConvertibleTo(durationType) && !t.ConvertibleTo(isoDurationType)
+	default:
+		return false
+	}
+}
+
+// BindMultipart sets the fields of the struct dst, such as a generated
+// multipart request body, from the parts of a multipart form, matching them
+// by their json tags. It is the reverse of MarshalMultipart: files are bound
+// to fields of type types.File, and objects are read from JSON. Fields
+// without parts are left untouched.
+func BindMultipart(form *multipart.Form, dst interface{}) error {
+	return BindMultipartWithEncoding(form, dst, nil)
+}
+
+// BindMultipartWithEncoding is BindMultipart for bodies whose properties have
+// encoding objects, and is the reverse of MarshalMultipartWithEncoding. Files
+// whose content type isn't one of those of their encoding are rejected.
+func BindMultipartWithEncoding(form *multipart.Form, dst interface{}, encodings map[string]MultipartEncoding) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return errors.New("multipart bodies must be bound to pointers to structs")
+	}
+	return bindMultipartStruct(form, v.Elem(), encodings)
+}
+
+func bindMultipartStruct(form *multipart.Form, v reflect.Value, encodings map[string]MultipartEncoding) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			if err := bindMultipartStruct(form, v.Field(i), encodings); err != nil {
+				return err
+			}
+			continue
+		}
+		name := getFieldName(f)
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		encoding := encodings[name]
+		for _, header := range form.File[name] {
+			if contentType := header.Header.Get("Content-Type"); !encoding.allows(contentType) {
+				return fmt.Errorf("error binding field '%s': content type '%s' isn't one of '%s'", name, contentType, encoding.ContentType)
+			}
+		}
+		if err := bindEncodedMultipartField(form, name, v.Field(i), encoding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bindEncodedMultipartField binds a field from the parts of a property, as its
+// encoding gives.
+func bindEncodedMultipartField(form *multipart.Form, name string, v reflect.Value, encoding MultipartEncoding) error {
+	t := v.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	values := form.Value[name]
+	switch {
+	case len(values) == 0:
+	case isJSONContentType(encoding.defaultContentType()):
+		if err := json.Unmarshal([]byte(values[0]), v.Addr().Interface()); err != nil {
+			return fmt.Errorf("error binding field '%s': %w", name, err)
+		}
+		return nil
+	case encoding.Style != "" && !isObjectType(t):
+		// Fields are bound as optional query parameters, which are pointers
+		// that are only set when the form has a value for them.
+		ptr := v
+		if v.Kind() != reflect.Ptr {
+			ptr = reflect.New(reflect.PtrTo(v.Type())).Elem()
+		}
+		err := BindQueryParameter(encoding.Style, encoding.Explode, false, name, url.Values(form.Value), ptr.Addr().Interface())
+		if err != nil {
+			return err
+		}
+		if v.Kind() != reflect.Ptr && !ptr.IsNil() {
+			v.Set(ptr.Elem())
+		}
+		return nil
+	}
+	return bindMultipartField(form, name, v)
+}
+
+func bindMultipartField(form *multipart.Form, name string, v reflect.Value) error {
+	t := v.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	isSlice := t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8
+	elemType := t
+	if isSlice {
+		elemType = t.Elem()
+	}
+
+	var n int
+	var bind func(i int, dst reflect.Value) error
+	if elemType == fileType || elemType.Kind() == reflect.Ptr && elemType.Elem() == fileType {
+		headers := form.File[name]
+		n = len(headers)
+		bind = func(i int, dst reflect.Value) error {
+			dst.Addr().Interface().(*types.File).InitFromMultipart(headers[i])
+			return nil
+		}
+	} else {
+		values := form.Value[name]
+		n = len(values)
+		bind = func(i int, dst reflect.Value) error {
+			return bindMultipartValue(values[i], dst)
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+	if !isSlice {
+		n = 1
+	}
+
+	target := v
+	if v.Kind() == reflect.Ptr {
+		target = reflect.New(t).Elem()
+	}
+	if isSlice {
+		target.Set(reflect.MakeSlice(t, n, n))
+	}
+	for i := 0; i < n; i++ {
+		dst := target
+		if isSlice {
+			dst = target.Index(i)
+		}
+		if dst.Kind() == reflect.Ptr {
+			dst.Set(reflect.New(dst.Type().Elem()))
+			dst = dst.Elem()
+		}
+		if err := bind(i, dst); err != nil {
+			return fmt.Errorf("error binding field '%s': %w", name, err)
+		}
+	}
+	if v.Kind() == reflect.Ptr {
+		v.Set(target.Addr())
+	}
+	return nil
+}
+
+func bindMultipartValue(s string, dst reflect.Value) error {
+	switch {
+	case isObjectType(dst.Type()):
+		return json.Unmarshal([]byte(s), dst.Addr().Interface())
+	case dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8:
+		data, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		dst.SetBytes(data)
+		return nil
+	default:
+		return BindStringToObject(s, dst.Addr().Interface())
+	}
+}
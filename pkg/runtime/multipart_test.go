@@ -0,0 +1,314 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestMultipartBody(t *testing.T) {
+	contentType, body := NewMultipartBody().
+		AddField("name", "value").
+		AddFile("file", "test.txt", strings.NewReader("file content"), "text/plain").
+		AddFile("data", `my "data".bin`, strings.NewReader("binary"), "").
+		Reader()
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+
+	mr := multipart.NewReader(body, params["boundary"])
+
+	part, err := mr.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "name", part.FormName())
+	content, _ := ioutil.ReadAll(part)
+	assert.Equal(t, "value", string(content))
+
+	part, err = mr.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "file", part.FormName())
+	assert.Equal(t, "test.txt", part.FileName())
+	assert.Equal(t, "text/plain", part.Header.Get("Content-Type"))
+	content, _ = ioutil.ReadAll(part)
+	assert.Equal(t, "file content", string(content))
+
+	part, err = mr.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, `my "data".bin`, part.FileName())
+	assert.Equal(t, "application/octet-stream", part.Header.Get("Content-Type"))
+
+	_, err = mr.NextPart()
+	assert.Error(t, err)
+
+	// Errors reading a file are surfaced by the body reader.
+	_, body = NewMultipartBody().AddFile("file", "f", failingReader{}, "").Reader()
+	_, err = ioutil.ReadAll(body)
+	assert.EqualError(t, err, "error writing part 'file': read failed")
+}
+
+// closeRecorder is a file content which records that it was closed.
+type closeRecorder struct {
+	*strings.Reader
+	closed chan struct{}
+}
+
+func (r closeRecorder) Close() error {
+	close(r.closed)
+	return nil
+}
+
+func TestMultipartBodyClose(t *testing.T) {
+	var file types.File
+	content := closeRecorder{Reader: strings.NewReader("content"), closed: make(chan struct{})}
+	file.InitFromReader(content, "f.txt", "text/plain")
+	body, err := MarshalMultipart(struct {
+		File types.File `json:"file"`
+	}{file})
+	require.NoError(t, err)
+
+	// The files of a body which isn't read are closed with its reader.
+	_, r := body.Reader()
+	require.NoError(t, r.Close())
+	select {
+	case <-content.closed:
+	case <-time.After(time.Second):
+		t.Fatal("the file of the body wasn't closed")
+	}
+}
+
+func TestMultipartBodyReaderContext(t *testing.T) {
+	var file types.File
+	content := closeRecorder{Reader: strings.NewReader("content"), closed: make(chan struct{})}
+	file.InitFromReader(content, "f.txt", "text/plain")
+	body, err := MarshalMultipart(struct {
+		File types.File `json:"file"`
+	}{file})
+	require.NoError(t, err)
+
+	// The files of a body which is abandoned are closed once its context is
+	// done, and its reader returns the error of the context.
+	ctx, cancel := context.WithCancel(context.Background())
+	_, r := body.ReaderContext(ctx)
+	cancel()
+	select {
+	case <-content.closed:
+	case <-time.After(time.Second):
+		t.Fatal("the file of the body wasn't closed")
+	}
+	_, err = ioutil.ReadAll(r)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestMarshalMultipart(t *testing.T) {
+	type Meta struct {
+		Tags []string `json:"tags"`
+	}
+	type Upload struct {
+		Name        string       `json:"name"`
+		Size        *int         `json:"size,omitempty"`
+		Count       *big.Int     `json:"count,omitempty"`
+		Created     *types.Date  `json:"created,omitempty"`
+		Email       types.Email  `json:"email"`
+		Labels      []string     `json:"labels"`
+		Meta        Meta         `json:"meta"`
+		Avatar      types.File   `json:"avatar"`
+		Attachments []types.File `json:"attachments"`
+		Thumbnail   *types.File  `json:"thumbnail,omitempty"`
+		Raw         []byte       `json:"raw"`
+	}
+
+	var avatar, first, second types.File
+	avatar.InitFromBytes([]byte("avatar"), "avatar.png", "image/png")
+	first.InitFromReader(strings.NewReader("first"), "first.txt", "text/plain")
+	second.InitFromBytes([]byte("second"), "second.bin", "")
+
+	body, err := MarshalMultipart(Upload{
+		Name:        "upload",
+		Count:       new(big.Int).Lsh(big.NewInt(1), 70),
+		Created:     &types.Date{Time: time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)},
+		Email:       "a@example.com",
+		Labels:      []string{"a", "b"},
+		Meta:        Meta{Tags: []string{"x"}},
+		Avatar:      avatar,
+		Attachments: []types.File{first, second},
+		Raw:         []byte("raw"),
+	})
+	require.NoError(t, err)
+
+	contentType, r := body.Reader()
+	_, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	form, err := multipart.NewReader(r, params["boundary"]).ReadForm(1 << 20)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"name":    {"upload"},
+		"count":   {"1180591620717411303424"},
+		"created": {"2021-12-31"},
+		"email":   {"a@example.com"},
+		"labels":  {"a", "b"},
+		"meta":    {`{"tags":["x"]}`},
+		"raw":     {"cmF3"},
+	}, form.Value)
+	require.Len(t, form.File["avatar"], 1)
+	assert.Equal(t, "avatar.png", form.File["avatar"][0].Filename)
+	assert.Equal(t, "image/png", form.File["avatar"][0].Header.Get("Content-Type"))
+	require.Len(t, form.File["attachments"], 2)
+	assert.Empty(t, form.File["thumbnail"])
+
+	var bound Upload
+	require.NoError(t, BindMultipart(form, &bound))
+	assert.Equal(t, "upload", bound.Name)
+	assert.Nil(t, bound.Size)
+	assert.Equal(t, "1180591620717411303424", bound.Count.String())
+	assert.Equal(t, "2021-12-31", bound.Created.String())
+	assert.Equal(t, types.Email("a@example.com"), bound.Email)
+	assert.Equal(t, []string{"a", "b"}, bound.Labels)
+	assert.Equal(t, Meta{Tags: []string{"x"}}, bound.Meta)
+	assert.Equal(t, []byte("raw"), bound.Raw)
+	assert.Nil(t, bound.Thumbnail)
+
+	assert.Equal(t, "avatar.png", bound.Avatar.Filename())
+	assert.Equal(t, "image/png", bound.Avatar.ContentType())
+	assert.EqualValues(t, 6, bound.Avatar.FileSize())
+	content, err := bound.Avatar.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "avatar", string(content))
+
+	require.Len(t, bound.Attachments, 2)
+	assert.Equal(t, "first.txt", bound.Attachments[0].Filename())
+	assert.Equal(t, "application/octet-stream", bound.Attachments[1].ContentType())
+	content, err = bound.Attachments[1].Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(content))
+
+	form.Value["email"] = []string{"not an email"}
+	assert.Error(t, BindMultipart(form, &bound))
+	assert.Error(t, BindMultipart(form, bound))
+
+	_, err = MarshalMultipart("upload")
+	assert.Error(t, err)
+}
+
+func TestMultipartBodySetPartHeader(t *testing.T) {
+	var file types.File
+	file.InitFromBytes([]byte("image"), "image.png", "image/png")
+	body, err := MarshalMultipartWithEncoding(struct {
+		Image types.File `json:"image"`
+		Note  string     `json:"note"`
+	}{file, "note"}, map[string]MultipartEncoding{
+		"image": {Headers: map[string]string{"X-Rate-Limit": "10"}},
+	})
+	require.NoError(t, err)
+	body.SetPartHeader("image", "X-Rate-Limit", "5").SetPartHeader("note", "X-Author", "Alex")
+
+	contentType, r := body.Reader()
+	_, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	mr := multipart.NewReader(r, params["boundary"])
+
+	part, err := mr.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "image", part.FormName())
+	assert.Equal(t, "5", part.Header.Get("X-Rate-Limit"))
+	assert.Equal(t, "image/png", part.Header.Get("Content-Type"))
+
+	part, err = mr.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "note", part.FormName())
+	assert.Equal(t, "Alex", part.Header.Get("X-Author"))
+	content, _ := ioutil.ReadAll(part)
+	assert.Equal(t, "note", string(content))
+}
+
+func TestMultipartEncoding(t *testing.T) {
+	type Meta struct {
+		Tags []string `json:"tags"`
+	}
+	type Upload struct {
+		Ids    []int        `json:"ids"`
+		Scores []int        `json:"scores"`
+		Meta   *Meta        `json:"meta,omitempty"`
+		Images []types.File `json:"images"`
+		Note   string       `json:"note"`
+	}
+	encodings := map[string]MultipartEncoding{
+		"ids":    {Style: "form", Explode: false},
+		"scores": {ContentType: "application/json"},
+		"images": {ContentType: "image/png, image/*", Headers: map[string]string{"X-Rate-Limit": "10"}},
+		"note":   {ContentType: "text/markdown"},
+	}
+
+	var first, second types.File
+	first.InitFromBytes([]byte("first"), "first.png", "")
+	second.InitFromBytes([]byte("second"), "second.gif", "image/gif")
+	body, err := MarshalMultipartWithEncoding(Upload{
+		Ids:    []int{1, 2},
+		Scores: []int{3, 4},
+		Images: []types.File{first, second},
+		Note:   "# note",
+	}, encodings)
+	require.NoError(t, err)
+
+	contentType, r := body.Reader()
+	_, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+	form, err := multipart.NewReader(r, params["boundary"]).ReadForm(1 << 20)
+	require.NoError(t, err)
+
+	// Arrays which aren't exploded, and JSON ones, are sent in a single part.
+	assert.Equal(t, map[string][]string{
+		"ids":    {"1,2"},
+		"scores": {"[3,4]"},
+		"note":   {"# note"},
+	}, form.Value)
+	require.Len(t, form.File["images"], 2)
+	assert.Equal(t, "image/png", form.File["images"][0].Header.Get("Content-Type"))
+	assert.Equal(t, "image/gif", form.File["images"][1].Header.Get("Content-Type"))
+	assert.Equal(t, "10", form.File["images"][1].Header.Get("X-Rate-Limit"))
+
+	var bound Upload
+	require.NoError(t, BindMultipartWithEncoding(form, &bound, encodings))
+	assert.Equal(t, []int{1, 2}, bound.Ids)
+	assert.Equal(t, []int{3, 4}, bound.Scores)
+	assert.Nil(t, bound.Meta)
+	assert.Equal(t, "# note", bound.Note)
+	require.Len(t, bound.Images, 2)
+	assert.Equal(t, "10", bound.Images[0].Header().Get("X-Rate-Limit"))
+
+	// Files of other content types than those of their encoding are rejected.
+	encodings["images"] = MultipartEncoding{ContentType: "image/png"}
+	assert.EqualError(t, BindMultipartWithEncoding(form, &bound, encodings),
+		"error binding field 'images': content type 'image/gif' isn't one of 'image/png'")
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+type operationIDKey struct{}
+
+// ContextWithOperationID returns a copy of ctx holding the ID of the operation
+// which a request calls, as generated clients set in the context of their
+// requests.
+func ContextWithOperationID(ctx context.Context, operationID string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, operationID)
+}
+
+// OperationIDFromContext returns the operation ID held by ctx, or an empty
+// string.
+func OperationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(operationIDKey{}).(string)
+	return id
+}
+
+// RecorderMode is whether a Recorder records responses or replays them.
+type RecorderMode int
+
+const (
+	// RecorderReplay replays the recorded responses, and fails the requests
+	// which have none.
+	RecorderReplay RecorderMode = iota
+	// RecorderRecord sends the requests and records their responses,
+	// replacing those recorded before.
+	RecorderRecord
+)
+
+// RequestDoer performs HTTP requests, as the standard http.Client and the
+// doers of generated clients do.
+type RequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Recorder performs requests by replaying the responses recorded in files of
+// a directory, which it records when sending requests for real, so that the
+// code calling a service can be tested without reaching it. Recordings are
+// keyed by the operation ID of the request, set by generated clients, and by
+// a hash of its method, path, query and body, so that different parameters
+// get different responses. The server, the headers and the values of query
+// parameters which may hold credentials are left out of the key. Recordings
+// are sanitized as operation records are, without these values.
+type Recorder struct {
+	Mode RecorderMode
+	Dir  string
+	Doer RequestDoer // Sends the requests of recorded responses, a new http.Client when nil
+}
+
+// NewRecorder returns a Recorder of the responses of the doer, which may be
+// nil in RecorderReplay mode, in the directory.
+func NewRecorder(mode RecorderMode, dir string, doer RequestDoer) *Recorder {
+	return &Recorder{Mode: mode, Dir: dir, Doer: doer}
+}
+
+// recording is the file of a recorded response.
+type recording struct {
+	OperationID string      `json:"operationId,omitempty"`
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	StatusCode  int         `json:"statusCode"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        string      `json:"body,omitempty"`
+	BodyBase64  string      `json:"bodyBase64,omitempty"` // The body, when it isn't text
+}
+
+// Do replays the recorded response of the request, or sends it and records
+// its response, following the mode.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	operationID := OperationIDFromContext(req.Context())
+	path := filepath.Join(r.Dir, recordingName(operationID, req, body))
+
+	if r.Mode == RecorderReplay {
+		return replay(req, path)
+	}
+
+	doer := r.Doer
+	if doer == nil {
+		doer = &http.Client{}
+	}
+	rsp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	rspBody, err := ioutil.ReadAll(rsp.Body)
+	_ = rsp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading the response to record: %w", err)
+	}
+	rsp.Body = ioutil.NopCloser(bytes.NewReader(rspBody))
+
+	rec := recording{
+		OperationID: operationID,
+		Method:      req.Method,
+		URL:         SanitizeURL(req.URL),
+		StatusCode:  rsp.StatusCode,
+		Header:      SanitizeHeader(rsp.Header),
+	}
+	if utf8.Valid(rspBody) {
+		rec.Body = string(rspBody)
+	} else {
+		rec.BodyBase64 = base64.StdEncoding.EncodeToString(rspBody)
+	}
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(r.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating the directory of recordings: %w", err)
+	}
+	if err := ioutil.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return nil, fmt.Errorf("error recording the response: %w", err)
+	}
+	return rsp, nil
+}
+
+// replay returns the response recorded in the file at path.
+func replay(req *http.Request, path string) (*http.Response, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no recorded response of %s %s: %s doesn't exist", req.Method, SanitizeURL(req.URL), path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading the recorded response: %w", err)
+	}
+	var rec recording
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("error parsing the recorded response %s: %w", path, err)
+	}
+	body := []byte(rec.Body)
+	if rec.BodyBase64 != "" {
+		if body, err = base64.StdEncoding.DecodeString(rec.BodyBase64); err != nil {
+			return nil, fmt.Errorf("error decoding the body of the recorded response %s: %w", path, err)
+		}
+	}
+	header := rec.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", rec.StatusCode, http.StatusText(rec.StatusCode)),
+		StatusCode:    rec.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// readRequestBody reads the body of a request, which it replaces so that the
+// request can still be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading the request body: %w", err)
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// recordingName returns the name of the file of the recorded response of a
+// request, made of its operation ID and of the hash of its method, path,
+// sanitized query and body.
+func recordingName(operationID string, req *http.Request, body []byte) string {
+	u := *req.URL
+	u.Scheme, u.User, u.Host = "", nil, ""
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n", req.Method, SanitizeURL(&u))
+	h.Write(body)
+	if operationID == "" {
+		operationID = "request"
+	}
+	return operationID + "-" + hex.EncodeToString(h.Sum(nil))[:16] + ".json"
+}
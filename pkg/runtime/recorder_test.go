@@ -0,0 +1,88 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Set-Cookie", "session=secret")
+		if r.URL.Query().Get("format") == "binary" {
+			_, _ = w.Write([]byte{0xff, 0xfe})
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(r.Method + " " + r.URL.Path + " " + string(body)))
+	}))
+	dir := t.TempDir()
+
+	newRequest := func(target, body string) *http.Request {
+		ctx := ContextWithOperationID(context.Background(), "AddPet")
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, strings.NewReader(body))
+		require.NoError(t, err)
+		return req
+	}
+
+	recorder := NewRecorder(RecorderRecord, dir, nil)
+	rsp, err := recorder.Do(newRequest(server.URL+"/pets?token=secret", "rex"))
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "POST /pets rex", string(body))
+	_, err = recorder.Do(newRequest(server.URL+"/pets?format=binary", ""))
+	require.NoError(t, err)
+	server.Close()
+
+	files, err := filepath.Glob(filepath.Join(dir, "AddPet-*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "secret")
+	}
+
+	// Replays ignore the server and the values of credentials.
+	replayer := NewRecorder(RecorderReplay, dir, nil)
+	rsp, err = replayer.Do(newRequest("https://example.com/pets?token=other", "rex"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rsp.StatusCode)
+	assert.Equal(t, "text/plain", rsp.Header.Get("Content-Type"))
+	assert.Equal(t, "REDACTED", rsp.Header.Get("Set-Cookie"))
+	body, err = ioutil.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "POST /pets rex", string(body))
+
+	rsp, err = replayer.Do(newRequest("https://example.com/pets?format=binary", ""))
+	require.NoError(t, err)
+	body, err = ioutil.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xff, 0xfe}, body)
+
+	_, err = replayer.Do(newRequest("https://example.com/pets", "tom"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// JSONRoundTrip unmarshals data into v, which points to a value of a
+// generated type, and marshals it back. It fails when data has fields which
+// the type doesn't know, or when the JSON marshaled back differs from data,
+// as values were lost or changed by the type.
+func JSONRoundTrip(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("error unmarshaling %s: %w", data, err)
+	}
+	marshaled, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("error marshaling %s back: %w", data, err)
+	}
+
+	var want, got interface{}
+	if err := json.Unmarshal(data, &want); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(marshaled, &got); err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(want, got) {
+		return fmt.Errorf("%s was marshaled back as %s", data, marshaled)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	type pet struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags,omitempty"`
+		Age  *int     `json:"age,omitempty"`
+	}
+
+	assert.NoError(t, JSONRoundTrip([]byte(`{"name":"Rex","tags":["dog"],"age":3}`), new(pet)))
+	assert.NoError(t, JSONRoundTrip([]byte(`[{"name":"Rex"}]`), new([]pet)))
+
+	err := JSONRoundTrip([]byte(`{"name":"Rex","color":"brown"}`), new(pet))
+	assert.EqualError(t, err, `error unmarshaling {"name":"Rex","color":"brown"}: json: unknown field "color"`)
+	err = JSONRoundTrip([]byte(`{"name":"Rex","tags":[]}`), new(pet))
+	assert.EqualError(t, err, `{"name":"Rex","tags":[]} was marshaled back as {"name":"Rex"}`)
+	assert.Error(t, JSONRoundTrip([]byte(`{"name":"Rex","age":3.5}`), new(pet)))
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// pathParam matches the parameters of path templates, as the generator does.
+var pathParam = regexp.MustCompile(`{[.;?]?([^{}*]+)\*?}`)
+
+// Route describes an operation a generated server serves, as listed by its
+// route table.
+type Route struct {
+	OperationID string `json:"operationId"` // The operationId of the operation in the spec, such as listPets
+	Method      string `json:"method"`
+	Path        string `json:"path"` // The path template of the spec, such as /pets/{id}
+	Deprecated  bool   `json:"deprecated,omitempty"`
+}
+
+// RoutesHandler returns a handler listing the routes as a JSON array, for
+// gateways and service catalogs discovering what a service serves.
+func RoutesHandler(routes []Route) http.Handler {
+	if routes == nil {
+		routes = []Route{}
+	}
+	// Routes only hold strings and booleans, which always marshal.
+	body, _ := json.Marshal(routes)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	})
+}
+
+// IndexRoutes returns the routes by method and router path, such as
+// "GET /pets/:id", to find the route a router matched a request with. The
+// path parameters of the routes are written as paramFormat writes their
+// names, such as "{%s}" for chi, or ":%s" for echo and gin.
+func IndexRoutes(routes []Route, paramFormat string) map[string]Route {
+	index := make(map[string]Route, len(routes))
+	for _, route := range routes {
+		path := pathParam.ReplaceAllStringFunc(route.Path, func(param string) string {
+			return fmt.Sprintf(paramFormat, pathParam.FindStringSubmatch(param)[1])
+		})
+		index[route.Method+" "+path] = route
+	}
+	return index
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutesHandler(t *testing.T) {
+	h := RoutesHandler([]Route{
+		{OperationID: "listPets", Method: http.MethodGet, Path: "/pets"},
+		{OperationID: "getPet", Method: http.MethodGet, Path: "/pets/{id}", Deprecated: true},
+	})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/routes", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `[
+		{"operationId": "listPets", "method": "GET", "path": "/pets"},
+		{"operationId": "getPet", "method": "GET", "path": "/pets/{id}", "deprecated": true}
+	]`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/routes", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	RoutesHandler(nil).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/routes", nil))
+	assert.Equal(t, "[]", rec.Body.String())
+}
+
+func TestIndexRoutes(t *testing.T) {
+	routes := []Route{
+		{OperationID: "listPets", Method: http.MethodGet, Path: "/pets"},
+		{OperationID: "getPet", Method: http.MethodGet, Path: "/pets/{id}"},
+		{OperationID: "getPhoto", Method: http.MethodGet, Path: "/pets/{id}/photos/{.name*}"},
+	}
+	assert.Equal(t, map[string]Route{
+		"GET /pets":                  routes[0],
+		"GET /pets/:id":              routes[1],
+		"GET /pets/:id/photos/:name": routes[2],
+	}, IndexRoutes(routes, ":%s"))
+	assert.Equal(t, routes[2], IndexRoutes(routes, "{%s}")["GET /pets/{id}/photos/{name}"])
+}
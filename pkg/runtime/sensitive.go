@@ -0,0 +1,65 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// SensitiveString formats the struct v as the %+v verb of fmt does, with the
+// values of the named fields replaced by Redacted unless they're zero. It's
+// what the String methods of generated types with x-sensitive properties
+// return, keeping secrets out of logs.
+func SensitiveString(v interface{}, sensitive ...string) string {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return fmt.Sprint(value)
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := 0; i < value.NumField(); i++ {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		name := value.Type().Field(i).Name
+		b.WriteString(name)
+		b.WriteByte(':')
+		field := value.Field(i)
+		if isSensitiveField(name, sensitive) && !field.IsZero() {
+			b.WriteString(Redacted)
+		} else {
+			// Optional fields are printed as their values rather than their
+			// addresses.
+			if field.Kind() == reflect.Ptr && !field.IsNil() {
+				field = field.Elem()
+			}
+			// Fields are printed with their own String methods, so that
+			// those of nested types mask their sensitive fields too.
+			fmt.Fprintf(&b, "%+v", field)
+		}
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+func isSensitiveField(name string, sensitive []string) bool {
+	for _, s := range sensitive {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
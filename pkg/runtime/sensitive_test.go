@@ -0,0 +1,52 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type credentials struct {
+	User     string
+	Password *string
+}
+
+func (c credentials) String() string {
+	return SensitiveString(c, "Password")
+}
+
+type account struct {
+	ID          int
+	Name        *string
+	Credentials credentials
+	Backup      *credentials
+}
+
+func TestSensitiveString(t *testing.T) {
+	password := "hunter2"
+	c := credentials{User: "alex", Password: &password}
+	assert.Equal(t, "{User:alex Password:REDACTED}", c.String())
+	assert.Equal(t, "{User:alex Password:REDACTED}", fmt.Sprint(c))
+	assert.Equal(t, "{User:alex Password:<nil>}", credentials{User: "alex"}.String())
+
+	// Nested types mask their own sensitive fields.
+	name := "Alex"
+	a := account{ID: 1, Name: &name, Credentials: c, Backup: &c}
+	assert.NotContains(t, fmt.Sprintf("%+v", a), "hunter2")
+	assert.Equal(t, "{ID:1 Name:Alex Credentials:{User:alex Password:REDACTED} Backup:{User:alex Password:REDACTED}}", SensitiveString(a))
+	assert.Equal(t, "{ID:1 Name:<nil> Credentials:{User: Password:<nil>} Backup:<nil>}", SensitiveString(account{ID: 1}))
+}
@@ -0,0 +1,169 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+)
+
+// ServerPolicy chooses the order in which the servers of a ServerPool are
+// tried.
+type ServerPolicy int
+
+const (
+	// ServerFailover sends every request to the first server, moving on to
+	// the next one while servers are unavailable.
+	ServerFailover ServerPolicy = iota
+	// ServerRoundRobin sends each request to the next server in turn, moving
+	// on to the following ones while servers are unavailable.
+	ServerRoundRobin
+)
+
+// ServerPool sends requests to one of several servers serving the same API.
+// A server is considered unavailable when the request fails, or when it
+// responds with 502 Bad Gateway, 503 Service Unavailable or 504 Gateway
+// Timeout.
+type ServerPool struct {
+	servers []string
+	policy  ServerPolicy
+	next    uint32
+}
+
+// NewServerPool returns a pool of the given servers, which need at least one.
+func NewServerPool(policy ServerPolicy, servers ...string) (*ServerPool, error) {
+	if len(servers) == 0 {
+		return nil, errors.New("a server pool needs at least one server")
+	}
+	normalized := make([]string, len(servers))
+	for i, server := range servers {
+		if _, err := url.Parse(server); err != nil {
+			return nil, fmt.Errorf("invalid server URL %q: %w", server, err)
+		}
+		// Servers are joined with the request path, as in the generated
+		// clients.
+		if !strings.HasSuffix(server, "/") {
+			server += "/"
+		}
+		normalized[i] = server
+	}
+	return &ServerPool{servers: normalized, policy: policy}, nil
+}
+
+// Servers returns the servers of the pool, each ending with a slash.
+func (p *ServerPool) Servers() []string {
+	return p.servers
+}
+
+// Do sends a request built for the first server of the pool with send,
+// sending it to the other servers when needed. Requests with a body can only
+// be sent again when they have a GetBody function, and requests built for
+// another server are sent as they are.
+func (p *ServerPool) Do(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	path := req.URL.String()
+	if !strings.HasPrefix(path, p.servers[0]) {
+		return send(req)
+	}
+	path = strings.TrimPrefix(path, p.servers[0])
+
+	start := 0
+	if p.policy == ServerRoundRobin {
+		start = int((atomic.AddUint32(&p.next, 1) - 1) % uint32(len(p.servers)))
+	}
+
+	var lastErr error
+	for i := 0; i < len(p.servers); i++ {
+		last := i == len(p.servers)-1
+		attempt, err := p.request(req, p.servers[(start+i)%len(p.servers)]+path, i > 0)
+		if err != nil {
+			if lastErr != nil {
+				return nil, lastErr
+			}
+			return nil, err
+		}
+
+		rsp, err := send(attempt)
+		if err != nil {
+			if req.Context().Err() != nil {
+				return nil, err
+			}
+			lastErr = err
+			continue
+		}
+		if !last && isUnavailable(rsp.StatusCode) {
+			_, _ = io.Copy(ioutil.Discard, rsp.Body)
+			_ = rsp.Body.Close()
+			lastErr = fmt.Errorf("server %s responded with status code %d", attempt.URL.Host, rsp.StatusCode)
+			continue
+		}
+		return rsp, nil
+	}
+	return nil, lastErr
+}
+
+// request returns a copy of req sent to rawURL, with a fresh body when the
+// request has been sent before.
+func (p *ServerPool) request(req *http.Request, rawURL string, resend bool) (*http.Request, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	attempt := req.Clone(req.Context())
+	attempt.URL = u
+	attempt.Host = u.Host
+	if resend && req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("the request body can't be sent again to another server")
+		}
+		if attempt.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return attempt, nil
+}
+
+func isUnavailable(statusCode int) bool {
+	return statusCode == http.StatusBadGateway ||
+		statusCode == http.StatusServiceUnavailable ||
+		statusCode == http.StatusGatewayTimeout
+}
+
+// ResolveServerURL returns the URL of a server, such as one which an
+// operation overrides the servers of its API with, resolved against the
+// server of the client when it's relative, and ending with a slash, as the
+// generated clients join it with the request path.
+func ResolveServerURL(base, server string) (string, error) {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return "", fmt.Errorf("invalid server URL %q: %w", server, err)
+	}
+	if !serverURL.IsAbs() {
+		baseURL, err := url.Parse(base)
+		if err != nil {
+			return "", fmt.Errorf("invalid server URL %q: %w", base, err)
+		}
+		serverURL = baseURL.ResolveReference(serverURL)
+	}
+	resolved := serverURL.String()
+	if !strings.HasSuffix(resolved, "/") {
+		resolved += "/"
+	}
+	return resolved, nil
+}
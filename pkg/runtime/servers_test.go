@@ -0,0 +1,119 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServers responds to requests according to the status of their host, and
+// records the hosts and bodies received.
+type fakeServers struct {
+	status map[string]int // A status of 0 fails the request
+	hosts  []string
+	bodies []string
+}
+
+func (f *fakeServers) send(req *http.Request) (*http.Response, error) {
+	f.hosts = append(f.hosts, req.Host)
+	if req.Body != nil {
+		body, _ := ioutil.ReadAll(req.Body)
+		f.bodies = append(f.bodies, string(body))
+	}
+	status := f.status[req.Host]
+	if status == 0 {
+		return nil, errors.New("connection refused")
+	}
+	return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestServerPoolFailover(t *testing.T) {
+	pool, err := NewServerPool(ServerFailover, "https://a.example.com/v1", "https://b.example.com/v1", "https://c.example.com/v1")
+	require.NoError(t, err)
+	servers := &fakeServers{status: map[string]int{"a.example.com": 0, "b.example.com": 503, "c.example.com": 200}}
+
+	req, err := http.NewRequest(http.MethodPost, "https://a.example.com/v1/pets?limit=1", strings.NewReader("body"))
+	require.NoError(t, err)
+	rsp, err := pool.Do(req, servers.send)
+	require.NoError(t, err)
+	assert.Equal(t, 200, rsp.StatusCode)
+	assert.Equal(t, []string{"a.example.com", "b.example.com", "c.example.com"}, servers.hosts)
+	assert.Equal(t, []string{"body", "body", "body"}, servers.bodies)
+
+	// The last server's response is returned, whatever its status.
+	servers = &fakeServers{status: map[string]int{"c.example.com": 503}}
+	req, err = http.NewRequest(http.MethodGet, "https://a.example.com/v1/pets", nil)
+	require.NoError(t, err)
+	rsp, err = pool.Do(req, servers.send)
+	require.NoError(t, err)
+	assert.Equal(t, 503, rsp.StatusCode)
+
+	servers = &fakeServers{}
+	_, err = pool.Do(req, servers.send)
+	assert.EqualError(t, err, "connection refused")
+	assert.Len(t, servers.hosts, 3)
+}
+
+func TestServerPoolRoundRobin(t *testing.T) {
+	pool, err := NewServerPool(ServerRoundRobin, "https://a.example.com/", "https://b.example.com/")
+	require.NoError(t, err)
+	servers := &fakeServers{status: map[string]int{"a.example.com": 200, "b.example.com": 200}}
+
+	for i := 0; i < 4; i++ {
+		req, err := http.NewRequest(http.MethodGet, "https://a.example.com/pets", nil)
+		require.NoError(t, err)
+		_, err = pool.Do(req, servers.send)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"a.example.com", "b.example.com", "a.example.com", "b.example.com"}, servers.hosts)
+}
+
+func TestServerPoolUnreplayableBody(t *testing.T) {
+	pool, err := NewServerPool(ServerFailover, "https://a.example.com/", "https://b.example.com/")
+	require.NoError(t, err)
+	servers := &fakeServers{status: map[string]int{"b.example.com": 200}}
+
+	req, err := http.NewRequest(http.MethodPost, "https://a.example.com/pets", ioutil.NopCloser(strings.NewReader("body")))
+	require.NoError(t, err)
+	_, err = pool.Do(req, servers.send)
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, []string{"a.example.com"}, servers.hosts)
+
+	_, err = NewServerPool(ServerFailover)
+	assert.Error(t, err)
+}
+
+func TestResolveServerURL(t *testing.T) {
+	tests := []struct {
+		base, server, want string
+	}{
+		{"https://api.example.com/v1/", "https://uploads.example.com", "https://uploads.example.com/"},
+		{"https://api.example.com/v1/", "/v2", "https://api.example.com/v2/"},
+		{"https://api.example.com/v1/", "files/", "https://api.example.com/v1/files/"},
+	}
+	for _, tt := range tests {
+		got, err := ResolveServerURL(tt.base, tt.server)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+	_, err := ResolveServerURL("https://api.example.com/", "http://[::1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,260 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// The algorithms of signature schemes.
+const (
+	SignatureHMACSHA1   = "hmac-sha1"
+	SignatureHMACSHA256 = "hmac-sha256"
+	SignatureHMACSHA512 = "hmac-sha512"
+)
+
+// The encodings of signatures.
+const (
+	SignatureEncodingHex    = "hex"
+	SignatureEncodingBase64 = "base64"
+)
+
+// The components of requests which signature schemes sign, besides their
+// headers, which are "header:" followed by the name of the header.
+const (
+	SignatureComponentMethod = "method"
+	SignatureComponentPath   = "path"
+	SignatureComponentQuery  = "query"
+	SignatureComponentBody   = "body"
+
+	signatureComponentHeaderPrefix = "header:"
+)
+
+// DefaultMaxSignedBodySize is the size in bytes of the largest body which
+// servers read to verify its signature, unless MaxBodySize says otherwise.
+const DefaultMaxSignedBodySize = 10 << 20
+
+// errRequestBodyTooLarge is returned for bodies larger than servers read.
+var errRequestBodyTooLarge = errors.New("the request body is too large")
+
+// SignatureScheme describes how requests are signed with an HMAC of some of
+// their components, which are joined with newlines, such as the scheme which
+// the x-signature extension of a spec describes. Clients sign requests with
+// its Signer, and servers verify them with its Middleware, or its Verifier.
+type SignatureScheme struct {
+	Header     string   // The header carrying the signature
+	Algorithm  string   // SignatureHMACSHA1, SignatureHMACSHA256 or SignatureHMACSHA512
+	Components []string // The signed components of requests, in order, only their body when empty
+	Prefix     string   // Prefixes the signature in the header, such as "sha256="
+	Encoding   string   // How the signature is encoded: SignatureEncodingHex, the default, or SignatureEncodingBase64
+
+	MaxBodySize int64 // The size in bytes of the largest body Middleware reads, DefaultMaxSignedBodySize when 0
+}
+
+// Validate returns an error when the scheme isn't valid.
+func (s SignatureScheme) Validate() error {
+	if s.Header == "" {
+		return errors.New("the signature header is missing")
+	}
+	if _, err := s.hash(); err != nil {
+		return err
+	}
+	if s.MaxBodySize < 0 {
+		return errors.New("the maximum body size is negative")
+	}
+	switch s.Encoding {
+	case "", SignatureEncodingHex, SignatureEncodingBase64:
+	default:
+		return fmt.Errorf("unsupported signature encoding '%s'", s.Encoding)
+	}
+	for _, component := range s.Components {
+		switch component {
+		case SignatureComponentMethod, SignatureComponentPath, SignatureComponentQuery, SignatureComponentBody:
+		default:
+			if !strings.HasPrefix(component, signatureComponentHeaderPrefix) || component == signatureComponentHeaderPrefix {
+				return fmt.Errorf("unsupported signature component '%s'", component)
+			}
+		}
+	}
+	return nil
+}
+
+// Signer returns a request editor, such as the RequestSigner of a generated
+// client, which sets the signature header of requests with the secret.
+func (s SignatureScheme) Signer(secret []byte) func(ctx context.Context, req *http.Request) error {
+	return func(ctx context.Context, req *http.Request) error {
+		body, err := readRequestBody(req)
+		if err != nil {
+			return err
+		}
+		signature, err := s.sign(secret, req, body)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(s.Header, s.Prefix+s.encode(signature))
+		return nil
+	}
+}
+
+// Verifier returns a verifier of the signatures which Signer sets with the
+// secret, for the Verify option of generated webhook and callback handlers.
+func (s SignatureScheme) Verifier(secret []byte) func(r *http.Request, body []byte) error {
+	return func(r *http.Request, body []byte) error {
+		value := r.Header.Get(s.Header)
+		if value == "" {
+			return fmt.Errorf("missing %s header", s.Header)
+		}
+		if !strings.HasPrefix(value, s.Prefix) {
+			return fmt.Errorf("malformed %s header", s.Header)
+		}
+		signature, err := s.decode(strings.TrimPrefix(value, s.Prefix))
+		if err != nil {
+			return fmt.Errorf("malformed %s header: %w", s.Header, err)
+		}
+		expected, err := s.sign(secret, r, body)
+		if err != nil {
+			return err
+		}
+		if !hmac.Equal(signature, expected) {
+			return errors.New("signature mismatch")
+		}
+		return nil
+	}
+}
+
+// Middleware returns a middleware verifying the signatures of requests with
+// the secret before they're handled. Requests which fail it get a 401
+// Unauthorized response, and those whose body is larger than MaxBodySize a 413
+// Request Entity Too Large one.
+func (s SignatureScheme) Middleware(secret []byte) func(http.Handler) http.Handler {
+	verify := s.Verifier(secret)
+	limit := s.MaxBodySize
+	if limit == 0 {
+		limit = DefaultMaxSignedBodySize
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := readLimitedRequestBody(r, limit)
+			if err == errRequestBodyTooLarge {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if err := verify(r, body); err != nil {
+				http.Error(w, fmt.Sprintf("invalid signature: %s", err), http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// readLimitedRequestBody is readRequestBody for bodies of at most limit bytes,
+// returning errRequestBodyTooLarge for larger ones, which are left unread.
+func readLimitedRequestBody(req *http.Request, limit int64) ([]byte, error) {
+	if req.ContentLength > limit {
+		return nil, errRequestBodyTooLarge
+	}
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(req.Body, limit+1))
+	if err != nil {
+		_ = req.Body.Close()
+		return nil, fmt.Errorf("error reading the request body: %w", err)
+	}
+	if int64(len(body)) > limit {
+		return nil, errRequestBodyTooLarge
+	}
+	_ = req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// sign returns the HMAC of the components of a request with the secret.
+func (s SignatureScheme) sign(secret []byte, req *http.Request, body []byte) ([]byte, error) {
+	newHash, err := s.hash()
+	if err != nil {
+		return nil, err
+	}
+	components := s.Components
+	if len(components) == 0 {
+		components = []string{SignatureComponentBody}
+	}
+	var message bytes.Buffer
+	for i, component := range components {
+		if i > 0 {
+			message.WriteByte('\n')
+		}
+		switch component {
+		case SignatureComponentMethod:
+			message.WriteString(req.Method)
+		case SignatureComponentPath:
+			message.WriteString(req.URL.EscapedPath())
+		case SignatureComponentQuery:
+			message.WriteString(req.URL.RawQuery)
+		case SignatureComponentBody:
+			message.Write(body)
+		default:
+			message.WriteString(req.Header.Get(strings.TrimPrefix(component, signatureComponentHeaderPrefix)))
+		}
+	}
+	mac := hmac.New(newHash, secret)
+	mac.Write(message.Bytes())
+	return mac.Sum(nil), nil
+}
+
+// hash returns the hash function of the algorithm of the scheme.
+func (s SignatureScheme) hash() (func() hash.Hash, error) {
+	switch s.Algorithm {
+	case SignatureHMACSHA1:
+		return sha1.New, nil
+	case SignatureHMACSHA256:
+		return sha256.New, nil
+	case SignatureHMACSHA512:
+		return sha512.New, nil
+	}
+	return nil, fmt.Errorf("unsupported signature algorithm '%s'", s.Algorithm)
+}
+
+func (s SignatureScheme) encode(signature []byte) string {
+	if s.Encoding == SignatureEncodingBase64 {
+		return base64.StdEncoding.EncodeToString(signature)
+	}
+	return hex.EncodeToString(signature)
+}
+
+func (s SignatureScheme) decode(signature string) ([]byte, error) {
+	if s.Encoding == SignatureEncodingBase64 {
+		return base64.StdEncoding.DecodeString(signature)
+	}
+	return hex.DecodeString(signature)
+}
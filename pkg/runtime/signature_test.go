@@ -0,0 +1,105 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureScheme(t *testing.T) {
+	scheme := SignatureScheme{
+		Header:     "X-Signature",
+		Algorithm:  SignatureHMACSHA512,
+		Components: []string{SignatureComponentMethod, SignatureComponentPath, "header:Date", SignatureComponentBody},
+		Prefix:     "v1=",
+		Encoding:   SignatureEncodingBase64,
+	}
+	require.NoError(t, scheme.Validate())
+	secret := []byte("secret")
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "https://example.com/pets?limit=1", strings.NewReader(`{"id":1}`))
+		req.Header.Set("Date", "Mon, 02 Jan 2006 15:04:05 GMT")
+		return req
+	}
+	req := newRequest()
+	require.NoError(t, scheme.Signer(secret)(context.Background(), req))
+	signature := req.Header.Get("X-Signature")
+	assert.True(t, strings.HasPrefix(signature, "v1="), signature)
+	// The body is still sent.
+	body, err := ioutil.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(body))
+
+	verify := scheme.Verifier(secret)
+	assert.NoError(t, verify(req, body))
+	assert.EqualError(t, verify(req, []byte(`{"id":2}`)), "signature mismatch")
+	req.Header.Set("Date", "Tue, 03 Jan 2006 15:04:05 GMT")
+	assert.EqualError(t, verify(req, body), "signature mismatch")
+	req.Header.Set("X-Signature", "v2=")
+	assert.EqualError(t, verify(req, body), "malformed X-Signature header")
+	req.Header.Del("X-Signature")
+	assert.EqualError(t, verify(req, body), "missing X-Signature header")
+
+	handler := scheme.Middleware(secret)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	req = newRequest()
+	require.NoError(t, scheme.Signer(secret)(context.Background(), req))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"id":1}`, rec.Body.String())
+
+	req = newRequest()
+	require.NoError(t, scheme.Signer([]byte("other"))(context.Background(), req))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Bodies larger than MaxBodySize aren't read.
+	scheme.MaxBodySize = 4
+	req = newRequest()
+	require.NoError(t, scheme.Signer(secret)(context.Background(), req))
+	req.ContentLength = -1
+	rec = httptest.NewRecorder()
+	scheme.Middleware(secret)(handler).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestSignatureSchemeDefaults(t *testing.T) {
+	// Only the body is signed by default, in hex, as SignHMACSHA256 does.
+	scheme := SignatureScheme{Header: "X-Signature", Algorithm: SignatureHMACSHA256, Prefix: "sha256="}
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/hooks", strings.NewReader(`{"id":1}`))
+	require.NoError(t, scheme.Signer([]byte("secret"))(context.Background(), req))
+	body, err := ioutil.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.NoError(t, VerifyHMACSHA256([]byte("secret"), "X-Signature")(req, body))
+}
+
+func TestSignatureSchemeValidate(t *testing.T) {
+	assert.EqualError(t, SignatureScheme{Algorithm: SignatureHMACSHA256}.Validate(), "the signature header is missing")
+	assert.EqualError(t, SignatureScheme{Header: "X-Signature", Algorithm: "md5"}.Validate(), "unsupported signature algorithm 'md5'")
+	assert.EqualError(t, SignatureScheme{Header: "X-Signature", Algorithm: SignatureHMACSHA1, Encoding: "base32"}.Validate(), "unsupported signature encoding 'base32'")
+	assert.EqualError(t, SignatureScheme{Header: "X-Signature", Algorithm: SignatureHMACSHA1, Components: []string{"header:"}}.Validate(), "unsupported signature component 'header:'")
+}
@@ -0,0 +1,225 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultEventStreamRetry is how long an EventStream waits before reconnecting,
+// until the server sends a retry field.
+const DefaultEventStreamRetry = 3 * time.Second
+
+// Event is a single server-sent event.
+type Event struct {
+	ID    string // The id field, or the last id received on the stream
+	Event string // The event type, "message" when not given
+	Data  string // The data fields, joined with newlines
+}
+
+// UnmarshalData decodes the data of the event as JSON into v.
+func (e Event) UnmarshalData(v interface{}) error {
+	return json.Unmarshal([]byte(e.Data), v)
+}
+
+// EventStreamConnectFn opens an event stream. When reconnecting, lastEventID
+// is the id of the last event received, which should be sent in the
+// Last-Event-ID header.
+type EventStreamConnectFn func(ctx context.Context, lastEventID string) (*http.Response, error)
+
+// EventStream reads server-sent events from a text/event-stream response.
+// When the connection is lost, it reconnects after the retry delay sent by the
+// server, until its context is cancelled or the server responds with 204 No
+// Content. Events are read with Next:
+//
+//	for stream.Next() {
+//	    event := stream.Event()
+//	    ...
+//	}
+//	if err := stream.Err(); err != nil {
+//	    ...
+//	}
+//
+// To stop a stream which is blocked in Next, cancel its context.
+type EventStream struct {
+	ctx         context.Context
+	connect     EventStreamConnectFn
+	body        io.ReadCloser
+	reader      *bufio.Reader
+	lastEventID string
+	retry       time.Duration
+	event       Event
+	done        bool
+	err         error
+}
+
+// NewEventStream connects to an event stream, returning an error if the first
+// connection fails.
+func NewEventStream(ctx context.Context, connect EventStreamConnectFn) (*EventStream, error) {
+	s := &EventStream{
+		ctx:     ctx,
+		connect: connect,
+		retry:   DefaultEventStreamRetry,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Next reads the next event, reconnecting if needed, and returns false when
+// the stream has ended.
+func (s *EventStream) Next() bool {
+	for !s.done {
+		if s.body == nil {
+			select {
+			case <-s.ctx.Done():
+				s.finish(s.ctx.Err())
+				return false
+			case <-time.After(s.retry):
+			}
+			// Connection errors are retried, as the server may be
+			// temporarily unavailable, unless open has ended the stream.
+			_ = s.open()
+			continue
+		}
+
+		event, err := s.readEvent()
+		if err == nil {
+			s.event = event
+			return true
+		}
+		_ = s.body.Close()
+		s.body = nil
+		if s.ctx.Err() != nil {
+			s.finish(s.ctx.Err())
+		}
+	}
+	return false
+}
+
+// Event returns the event read by the last call to Next.
+func (s *EventStream) Event() Event {
+	return s.event
+}
+
+// LastEventID returns the id of the last event received.
+func (s *EventStream) LastEventID() string {
+	return s.lastEventID
+}
+
+// Err returns the error which ended the stream, if any.
+func (s *EventStream) Err() error {
+	return s.err
+}
+
+// Close closes the underlying response body, after which Next returns false.
+func (s *EventStream) Close() error {
+	s.done = true
+	if s.body != nil {
+		err := s.body.Close()
+		s.body = nil
+		return err
+	}
+	return nil
+}
+
+func (s *EventStream) finish(err error) {
+	s.done = true
+	s.err = err
+}
+
+func (s *EventStream) open() error {
+	rsp, err := s.connect(s.ctx, s.lastEventID)
+	if err != nil {
+		return err
+	}
+	switch rsp.StatusCode {
+	case http.StatusOK:
+		s.body = rsp.Body
+		s.reader = bufio.NewReader(rsp.Body)
+		return nil
+	case http.StatusNoContent:
+		// The server is telling us to stop reconnecting.
+		_ = rsp.Body.Close()
+		s.done = true
+		return nil
+	default:
+		_, _ = io.Copy(ioutil.Discard, rsp.Body)
+		_ = rsp.Body.Close()
+		s.finish(fmt.Errorf("unexpected status code %d from event stream", rsp.StatusCode))
+		return s.err
+	}
+}
+
+// readEvent reads lines up to the next blank line, as described in
+// https://html.spec.whatwg.org/multipage/server-sent-events.html
+func (s *EventStream) readEvent() (Event, error) {
+	var data []string
+	var eventType string
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			// An incomplete event at the end of the stream is discarded.
+			return Event{}, err
+		}
+		line = strings.TrimRight(line, "\r\n")
+
+		if line == "" {
+			if data == nil {
+				eventType = ""
+				continue
+			}
+			if eventType == "" {
+				eventType = "message"
+			}
+			return Event{
+				ID:    s.lastEventID,
+				Event: eventType,
+				Data:  strings.Join(data, "\n"),
+			}, nil
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.Index(line, ":"); i != -1 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "event":
+			eventType = value
+		case "data":
+			data = append(data, value)
+		case "id":
+			if !strings.Contains(value, "\x00") {
+				s.lastEventID = value
+			}
+		case "retry":
+			if ms, err := strconv.ParseUint(value, 10, 63); err == nil {
+				s.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
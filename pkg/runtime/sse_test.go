@@ -0,0 +1,103 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventStream(t *testing.T) {
+	var lastEventIDs []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastEventIDs = append(lastEventIDs, r.Header.Get("Last-Event-ID"))
+		switch len(lastEventIDs) {
+		case 1:
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, ": a comment\n")
+			fmt.Fprint(w, "retry: 1\n")
+			fmt.Fprint(w, "id: 1\ndata: {\"value\": 1}\n\n")
+			fmt.Fprint(w, "event: update\r\nid: 2\r\ndata: first\r\ndata: second\r\n\r\n")
+			fmt.Fprint(w, "data: incomplete\n")
+		case 2:
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "data:no space\n\n")
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	connect := func(ctx context.Context, lastEventID string) (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			return nil, err
+		}
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		return http.DefaultClient.Do(req)
+	}
+
+	stream, err := NewEventStream(context.Background(), connect)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	var events []Event
+	for stream.Next() {
+		events = append(events, stream.Event())
+	}
+	require.NoError(t, stream.Err())
+
+	assert.Equal(t, []Event{
+		{ID: "1", Event: "message", Data: `{"value": 1}`},
+		{ID: "2", Event: "update", Data: "first\nsecond"},
+		{ID: "2", Event: "message", Data: "no space"},
+	}, events)
+	assert.Equal(t, []string{"", "2", "2"}, lastEventIDs)
+
+	var data struct {
+		Value int `json:"value"`
+	}
+	require.NoError(t, events[0].UnmarshalData(&data))
+	assert.Equal(t, 1, data.Value)
+}
+
+func TestEventStreamErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	_, err := NewEventStream(context.Background(), func(ctx context.Context, lastEventID string) (*http.Response, error) {
+		return http.Get(server.URL)
+	})
+	assert.EqualError(t, err, "unexpected status code 500 from event stream")
+
+	// Cancelling the context ends the stream.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := NewEventStream(ctx, func(ctx context.Context, lastEventID string) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+	require.NoError(t, err)
+	cancel()
+	assert.False(t, stream.Next())
+	assert.Equal(t, context.Canceled, stream.Err())
+}
@@ -14,6 +14,7 @@
 package runtime
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"net/url"
@@ -37,6 +38,23 @@ const (
 	ParamLocationCookie
 )
 
+// String returns the name of the location as the in field of a parameter
+// names it, such as "query", or an empty string when it's undefined.
+func (l ParamLocation) String() string {
+	switch l {
+	case ParamLocationQuery:
+		return "query"
+	case ParamLocationPath:
+		return "path"
+	case ParamLocationHeader:
+		return "header"
+	case ParamLocationCookie:
+		return "cookie"
+	default:
+		return ""
+	}
+}
+
 // This function is used by older generated code, and must remain compatible
 // with that code. It is not to be used in new templates. Please see the
 // function below, which can specialize its output based on the location of
@@ -62,6 +80,14 @@ func StyleParamWithLocation(style string, explode bool, paramName string, paramL
 		t = v.Type()
 	}
 
+	// Types which turn themselves into parameters take precedence.
+	if u, ok := value.(Unbinder); ok {
+		return styleUnbinder(style, explode, paramName, paramLocation, u)
+	}
+	if u, ok := v.Interface().(Unbinder); ok {
+		return styleUnbinder(style, explode, paramName, paramLocation, u)
+	}
+
 	switch t.Kind() {
 	case reflect.Slice:
 		n := v.Len()
@@ -158,8 +184,8 @@ func sortedKeys(strMap map[string]string) []string {
 	return keys
 }
 
-// This is a special case. The struct may be a date or time, in
-// which case, marshal it in correct format.
+// This is a special case. The struct may be a date, time or duration, or
+// another struct written as text, in which case, marshal it in correct format.
 func marshalDateTimeValue(value interface{}) (string, bool) {
 	v := reflect.Indirect(reflect.ValueOf(value))
 	t := v.Type()
@@ -176,6 +202,25 @@ func marshalDateTimeValue(value interface{}) (string, bool) {
 		return dateVal.Format(types.DateFormat), true
 	}
 
+	if t.ConvertibleTo(reflect.TypeOf(types.ISODuration{})) {
+		d := v.Convert(reflect.TypeOf(types.ISODuration{}))
+		return d.Interface().(types.ISODuration).String(), true
+	}
+
+	if t.ConvertibleTo(reflect.TypeOf(types.Duration{})) {
+		d := v.Convert(reflect.TypeOf(types.Duration{}))
+		return d.Interface().(types.Duration).String(), true
+	}
+
+	// Other structs which write themselves as text, such as big.Int.
+	if t.Kind() == reflect.Struct && reflect.PtrTo(t).Implements(textMarshalerType) {
+		ptr := reflect.New(t)
+		ptr.Elem().Set(v)
+		if text, err := ptr.Interface().(encoding.TextMarshaler).MarshalText(); err == nil {
+			return string(text), true
+		}
+	}
+
 	return "", false
 }
 
@@ -343,6 +388,22 @@ func stylePrimitive(style string, explode bool, paramName string, paramLocation
 	return prefix + escapeParameterString(strVal, paramLocation), nil
 }
 
+// styleUnbinder styles the value of a parameter which an Unbinder gives, as
+// that of a primitive.
+func styleUnbinder(style string, explode bool, paramName string, paramLocation ParamLocation, u Unbinder) (string, error) {
+	strVal, err := u.Unbind(style, explode, paramName)
+	if err != nil {
+		return "", fmt.Errorf("error unbinding parameter '%s': %w", paramName, err)
+	}
+	switch style {
+	case "spaceDelimited", "pipeDelimited":
+		// These are form parameters whose arrays are delimited differently,
+		// which is up to the Unbinder.
+		style = "form"
+	}
+	return stylePrimitive(style, explode, paramName, paramLocation, strVal)
+}
+
 // Converts a primitive value to a string. We need to do this based on the
 // Kind of an interface, not the Type to work with aliased types.
 func primitiveToString(value interface{}) (string, error) {
@@ -382,6 +443,29 @@ func primitiveToString(value interface{}) (string, error) {
 	return output, nil
 }
 
+// reservedUnescaper undoes the escaping of the reserved characters which
+// query parameters allowing them are sent with. The delimiters of queries,
+// '#', and '+', which is read as a space, stay escaped, as does ';', which
+// net/url no longer accepts as a delimiter.
+var reservedUnescaper = strings.NewReplacer(
+	"%3A", ":", "%2F", "/", "%3F", "?", "%5B", "[", "%5D", "]", "%40", "@",
+	"%21", "!", "%24", "$", "%27", "'", "%28", "(", "%29", ")", "%2A", "*",
+	"%2C", ",",
+)
+
+// StyleParamAllowReserved styles a query parameter whose allowReserved
+// setting is true, which is like StyleParamWithLocation, except that reserved
+// characters, such as '/', aren't escaped. The result is added to the raw
+// query, since url.Values would escape them again. Servers read such
+// parameters as any other, as url.ParseQuery accepts those characters.
+func StyleParamAllowReserved(style string, explode bool, paramName string, value interface{}) (string, error) {
+	styled, err := StyleParamWithLocation(style, explode, paramName, ParamLocationQuery, value)
+	if err != nil {
+		return "", err
+	}
+	return reservedUnescaper.Replace(styled), nil
+}
+
 // This function escapes a parameter value bas on the location of that parameter.
 // Query params and path params need different kinds of escaping, while header
 // and cookie params seem not to need escaping.
@@ -391,7 +475,25 @@ func escapeParameterString(value string, paramLocation ParamLocation) string {
 		return url.QueryEscape(value)
 	case ParamLocationPath:
 		return url.PathEscape(value)
+	case ParamLocationCookie:
+		return escapeCookieValue(value)
 	default:
 		return value
 	}
 }
+
+// escapeCookieValue percent-encodes the bytes which aren't allowed in cookie
+// values by RFC 6265, which net/http would otherwise drop, along with the
+// percent sign itself.
+func escapeCookieValue(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c <= ' ' || c >= 0x7f || c == '"' || c == ',' || c == ';' || c == '\\' || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -14,6 +14,7 @@
 package runtime
 
 import (
+	"net/url"
 	"testing"
 	"time"
 
@@ -564,4 +565,32 @@ func TestStyleParam(t *testing.T) {
 	result, err = StyleParamWithLocation("simple", false, "id", ParamLocationQuery, object3)
 	assert.NoError(t, err)
 	assert.EqualValues(t, "date_field,1996-03-19,time_field,1996-03-19T00%3A00%3A00Z", result)
+
+	// Test handling of durations, directly and through aliases
+	type AliasedDuration types.ISODuration
+	result, err = StyleParamWithLocation("form", true, "timeout", ParamLocationQuery, types.Duration{Duration: 90 * time.Second})
+	assert.NoError(t, err)
+	assert.EqualValues(t, "timeout=1m30s", result)
+
+	result, err = StyleParamWithLocation("simple", false, "timeout", ParamLocationPath, &AliasedDuration{Duration: types.Duration{Duration: 90 * time.Second}})
+	assert.NoError(t, err)
+	assert.EqualValues(t, "PT1M30S", result)
+}
+
+func TestStyleParamAllowReserved(t *testing.T) {
+	result, err := StyleParamAllowReserved("form", true, "path", "/a/b:c?d=e&f#g+h;i")
+	assert.NoError(t, err)
+	assert.Equal(t, "path=/a/b:c?d%3De%26f%23g%2Bh%3Bi", result)
+
+	query, err := url.ParseQuery(result)
+	assert.NoError(t, err)
+	assert.Equal(t, "/a/b:c?d=e&f#g+h;i", query.Get("path"))
+
+	result, err = StyleParamAllowReserved("form", false, "tags", []string{"a/b", "[c]", "100%"})
+	assert.NoError(t, err)
+	assert.Equal(t, "tags=a/b,[c],100%25", result)
+
+	var tags []string
+	assert.NoError(t, BindQueryParameter("form", false, true, "tags", url.Values{"tags": {"a/b,[c],100%"}}, &tags))
+	assert.Equal(t, []string{"a/b", "[c]", "100%"}, tags)
 }
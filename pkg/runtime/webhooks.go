@@ -0,0 +1,74 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// hmacSHA256Scheme returns the signature scheme of SignHMACSHA256 and
+// VerifyHMACSHA256, which signs the body only.
+func hmacSHA256Scheme(header string) SignatureScheme {
+	return SignatureScheme{Header: header, Algorithm: SignatureHMACSHA256, Prefix: "sha256="}
+}
+
+// SignHMACSHA256 returns a request editor, such as the RequestSigner of a
+// generated webhook sender, which sets the header to the HMAC-SHA256 of the
+// request body with the secret, in hex and prefixed with "sha256=", as many
+// webhook producers sign their requests.
+func SignHMACSHA256(secret []byte, header string) func(ctx context.Context, req *http.Request) error {
+	return hmacSHA256Scheme(header).Signer(secret)
+}
+
+// VerifyHMACSHA256 returns a verifier of the signatures which SignHMACSHA256
+// sets, for the Verify option of generated webhook handlers.
+func VerifyHMACSHA256(secret []byte, header string) func(r *http.Request, body []byte) error {
+	return hmacSHA256Scheme(header).Verifier(secret)
+}
+
+// VerifiedHandlerFunc returns a handler of the requests of a webhook or
+// callback, which are of the method, calling handler once verify, when set,
+// accepts their signature, given their body. Requests of other methods get a
+// 405 Method Not Allowed response, those which verify fails get a 401
+// Unauthorized one, those whose body is larger than DefaultMaxSignedBodySize
+// get a 413 Request Entity Too Large one, and errors reading their body are
+// given to errorHandler.
+func VerifiedHandlerFunc(method string, verify func(r *http.Request, body []byte) error,
+	errorHandler func(w http.ResponseWriter, r *http.Request, err error), handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		if verify != nil {
+			body, err := readLimitedRequestBody(r, DefaultMaxSignedBodySize)
+			if err == errRequestBodyTooLarge {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			if err != nil {
+				errorHandler(w, r, err)
+				return
+			}
+			if err := verify(r, body); err != nil {
+				http.Error(w, fmt.Sprintf("invalid signature: %s", err), http.StatusUnauthorized)
+				return
+			}
+		}
+		handler(w, r)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHMACSHA256(t *testing.T) {
+	secret := []byte("secret")
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/hooks", strings.NewReader(`{"id":1}`))
+	require.NoError(t, err)
+	require.NoError(t, SignHMACSHA256(secret, "X-Signature")(context.Background(), req))
+
+	signature := req.Header.Get("X-Signature")
+	assert.True(t, strings.HasPrefix(signature, "sha256="), signature)
+	// The body is still sent.
+	body, err := ioutil.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(body))
+
+	verify := VerifyHMACSHA256(secret, "X-Signature")
+	assert.NoError(t, verify(req, body))
+	assert.EqualError(t, verify(req, []byte(`{"id":2}`)), "signature mismatch")
+	assert.Error(t, VerifyHMACSHA256([]byte("other"), "X-Signature")(req, body))
+
+	req.Header.Set("X-Signature", "md5=00")
+	assert.EqualError(t, verify(req, body), "malformed X-Signature header")
+	req.Header.Del("X-Signature")
+	assert.EqualError(t, verify(req, body), "missing X-Signature header")
+}
@@ -0,0 +1,30 @@
+// Copyright 2022 DeepMap, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalXML(t *testing.T) {
+	type PostPetXMLRequestBody struct {
+		Name string `xml:"name"`
+	}
+	buf, err := MarshalXML(PostPetXMLRequestBody{Name: "Rex"}, "Pet")
+	require.NoError(t, err)
+	assert.Equal(t, "<Pet><name>Rex</name></Pet>", string(buf))
+}
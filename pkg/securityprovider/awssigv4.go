@@ -0,0 +1,233 @@
+package securityprovider
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// ErrSecurityProviderAWSSigV4InvalidConfig indicates missing credentials,
+	// region or service.
+	ErrSecurityProviderAWSSigV4InvalidConfig = SecurityProviderError("AWS signature v4 requires an access key, secret key, region and service")
+
+	awsSigV4Algorithm  = "AWS4-HMAC-SHA256"
+	awsSigV4TimeFormat = "20060102T150405Z"
+	awsSigV4DateFormat = "20060102"
+)
+
+// AWSCredentials are the credentials requests are signed with.
+type AWSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // Only needed for temporary credentials
+}
+
+// NewSecurityProviderAWSSigV4 provides a SecurityProvider, which signs
+// requests with AWS Signature Version 4, as required by API Gateway and other
+// AWS style services.
+func NewSecurityProviderAWSSigV4(credentials AWSCredentials, region, service string) (*SecurityProviderAWSSigV4, error) {
+	if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" || region == "" || service == "" {
+		return nil, ErrSecurityProviderAWSSigV4InvalidConfig
+	}
+	return &SecurityProviderAWSSigV4{
+		credentials: credentials,
+		region:      region,
+		service:     service,
+		now:         time.Now,
+	}, nil
+}
+
+// SecurityProviderAWSSigV4 signs requests with AWS Signature Version 4. The
+// signature covers the body, so it must be the last change made to a request.
+type SecurityProviderAWSSigV4 struct {
+	credentials AWSCredentials
+	region      string
+	service     string
+	now         func() time.Time
+}
+
+// Intercept will sign the request, attaching the X-Amz-Date and Authorization
+// headers, and the X-Amz-Security-Token header when using a session token.
+func (s *SecurityProviderAWSSigV4) Intercept(ctx context.Context, req *http.Request) error {
+	payloadHash, err := hashRequestBody(req)
+	if err != nil {
+		return fmt.Errorf("error signing request: %w", err)
+	}
+
+	now := s.now().UTC()
+	req.Header.Set("X-Amz-Date", now.Format(awsSigV4TimeFormat))
+	if s.credentials.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.credentials.SessionToken)
+	}
+
+	signedHeaders, canonicalHeaders := awsCanonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s.canonicalURI(req),
+		awsCanonicalQuery(req),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(awsSigV4DateFormat), s.region, s.service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		awsSigV4Algorithm,
+		now.Format(awsSigV4TimeFormat),
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.credentials.SecretAccessKey), now.Format(awsSigV4DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s.service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		awsSigV4Algorithm, s.credentials.AccessKeyID, scope, signedHeaders, signature))
+	return nil
+}
+
+// canonicalURI returns the escaped path of the request, which is escaped once
+// more for every service but S3.
+func (s *SecurityProviderAWSSigV4) canonicalURI(req *http.Request) string {
+	path := req.URL.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+	if s.service == "s3" {
+		return path
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = awsURIEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// hashRequestBody returns the hex encoded SHA-256 hash of the request body,
+// replacing the body when it can't be read again with GetBody.
+func hashRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return hashHex(nil), nil
+	}
+
+	var body io.ReadCloser
+	if req.GetBody != nil {
+		var err error
+		if body, err = req.GetBody(); err != nil {
+			return "", err
+		}
+	} else {
+		buf, err := ioutil.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(buf))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(buf)), nil
+		}
+		req.ContentLength = int64(len(buf))
+		body = ioutil.NopCloser(bytes.NewReader(buf))
+	}
+	defer body.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, body); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// awsCanonicalHeaders returns the names of the signed headers, and their
+// canonical form. The host, the content type and all X-Amz headers are signed.
+func awsCanonicalHeaders(req *http.Request) (string, string) {
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name != "content-type" && !strings.HasPrefix(name, "x-amz-") {
+			continue
+		}
+		trimmed := make([]string, len(values))
+		for i, v := range values {
+			trimmed[i] = strings.Join(strings.Fields(v), " ")
+		}
+		headers[name] = strings.Join(trimmed, ",")
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonical strings.Builder
+	for _, name := range names {
+		canonical.WriteString(name + ":" + headers[name] + "\n")
+	}
+	return strings.Join(names, ";"), canonical.String()
+}
+
+// awsCanonicalQuery returns the query parameters sorted by name and value.
+func awsCanonicalQuery(req *http.Request) string {
+	var params [][2]string
+	for name, values := range req.URL.Query() {
+		for _, v := range values {
+			params = append(params, [2]string{awsURIEncode(name), awsURIEncode(v)})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i][0] != params[j][0] {
+			return params[i][0] < params[j][0]
+		}
+		return params[i][1] < params[j][1]
+	})
+
+	encoded := make([]string, len(params))
+	for i, p := range params {
+		encoded[i] = p[0] + "=" + p[1]
+	}
+	return strings.Join(encoded, "&")
+}
+
+// awsURIEncode escapes every byte but the unreserved characters of RFC 3986.
+func awsURIEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,76 @@
+package securityprovider
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The expected signatures come from the examples in the AWS Signature
+// Version 4 documentation and test suite.
+func TestSecurityProviderAWSSigV4(t *testing.T) {
+	credentials := AWSCredentials{
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}
+	signingTime := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	t.Run("get-vanilla", func(t *testing.T) {
+		signer, err := NewSecurityProviderAWSSigV4(credentials, "us-east-1", "service")
+		require.NoError(t, err)
+		signer.now = func() time.Time { return signingTime }
+
+		req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+		require.NoError(t, err)
+		require.NoError(t, signer.Intercept(context.Background(), req))
+
+		assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+		assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+			"SignedHeaders=host;x-amz-date, "+
+			"Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+			req.Header.Get("Authorization"))
+	})
+
+	t.Run("iam", func(t *testing.T) {
+		signer, err := NewSecurityProviderAWSSigV4(credentials, "us-east-1", "iam")
+		require.NoError(t, err)
+		signer.now = func() time.Time { return signingTime }
+
+		req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Version=2010-05-08&Action=ListUsers", nil)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+		require.NoError(t, signer.Intercept(context.Background(), req))
+
+		assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+			"SignedHeaders=content-type;host;x-amz-date, "+
+			"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7",
+			req.Header.Get("Authorization"))
+	})
+
+	t.Run("body and session token", func(t *testing.T) {
+		credentials := credentials
+		credentials.SessionToken = "token"
+		signer, err := NewSecurityProviderAWSSigV4(credentials, "eu-west-1", "execute-api")
+		require.NoError(t, err)
+
+		req, err := http.NewRequest(http.MethodPost, "https://example.amazonaws.com/pets", ioutil.NopCloser(strings.NewReader(`{"name":"Rex"}`)))
+		require.NoError(t, err)
+		require.NoError(t, signer.Intercept(context.Background(), req))
+
+		assert.Equal(t, "token", req.Header.Get("X-Amz-Security-Token"))
+		assert.Contains(t, req.Header.Get("Authorization"), "SignedHeaders=host;x-amz-date;x-amz-security-token,")
+		// The body has been read to be hashed, so it must have been replaced.
+		body, err := ioutil.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"name":"Rex"}`, string(body))
+	})
+
+	_, err := NewSecurityProviderAWSSigV4(credentials, "", "execute-api")
+	assert.Equal(t, ErrSecurityProviderAWSSigV4InvalidConfig, err)
+}
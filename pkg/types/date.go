@@ -29,6 +29,23 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalText writes the date of a parameter, rather than the time of the
+// embedded time.Time.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the date of a parameter, rather than the time of the
+// embedded time.Time.
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := time.Parse(DateFormat, string(text))
+	if err != nil {
+		return err
+	}
+	d.Time = parsed
+	return nil
+}
+
 func (d Date) String() string {
 	return d.Time.Format(DateFormat)
 }
@@ -52,3 +52,13 @@ func TestDate_Stringer(t *testing.T) {
 		assert.Equal(t, "2019-04-01", fmt.Sprintf("%v", d))
 	})
 }
+
+func TestDate_Text(t *testing.T) {
+	var d Date
+	assert.NoError(t, d.UnmarshalText([]byte("2019-04-01")))
+	assert.Equal(t, time.Date(2019, 4, 1, 0, 0, 0, 0, time.UTC), d.Time)
+	text, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2019-04-01", string(text))
+	assert.Error(t, d.UnmarshalText([]byte("2019-04-01T00:00:00Z")))
+}
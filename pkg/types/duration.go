@@ -0,0 +1,214 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration written in the syntax of time.ParseDuration,
+// such as "1h30m0s". It is read from that syntax or from ISO 8601.
+type Duration struct {
+	time.Duration
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = parsed
+	return nil
+}
+
+// Bind parses a duration of a parameter.
+func (d *Duration) Bind(src string) error {
+	if src == "" {
+		return nil
+	}
+	parsed, err := ParseDuration(src)
+	if err != nil {
+		return err
+	}
+	d.Duration = parsed
+	return nil
+}
+
+// ISODuration is a time.Duration written in ISO 8601, such as "PT1H30M". It is
+// read from ISO 8601 or from the syntax of time.ParseDuration.
+type ISODuration struct {
+	Duration
+}
+
+func (d ISODuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *ISODuration) UnmarshalJSON(data []byte) error {
+	return d.Duration.UnmarshalJSON(data)
+}
+
+// Bind parses a duration of a parameter.
+func (d *ISODuration) Bind(src string) error {
+	return d.Duration.Bind(src)
+}
+
+func (d ISODuration) String() string {
+	return FormatISODuration(d.Duration.Duration)
+}
+
+// ParseDuration parses a duration in ISO 8601, such as "PT1H30M", or in the
+// syntax of time.ParseDuration, such as "1h30m".
+func ParseDuration(s string) (time.Duration, error) {
+	if strings.HasPrefix(strings.TrimLeft(s, "+-"), "P") {
+		return ParseISODuration(s)
+	}
+	return time.ParseDuration(s)
+}
+
+// isoDurationUnits are the lengths of the designators of ISO 8601 durations,
+// in the date part and in the time part. Years and months have no fixed
+// length, so they are left out.
+var isoDurationUnits = [2]map[byte]time.Duration{
+	{'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour},
+	{'H': time.Hour, 'M': time.Minute, 'S': time.Second},
+}
+
+// ParseISODuration parses a duration in ISO 8601, such as "PT1H30M" or
+// "-P1DT0.5S". Days are 24 hours long, and years and months are not
+// supported.
+func ParseISODuration(s string) (time.Duration, error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("invalid ISO 8601 duration %q: %s", s, reason)
+	}
+
+	rest := s
+	negative := false
+	if rest != "" && (rest[0] == '-' || rest[0] == '+') {
+		negative = rest[0] == '-'
+		rest = rest[1:]
+	}
+	if !strings.HasPrefix(rest, "P") {
+		return 0, invalid("missing P designator")
+	}
+	rest = rest[1:]
+
+	var d time.Duration
+	part := 0
+	components := 0
+	last := time.Duration(0)
+	for rest != "" {
+		if rest[0] == 'T' {
+			if part == 1 {
+				return 0, invalid("more than one T designator")
+			}
+			part = 1
+			rest = rest[1:]
+			if rest == "" {
+				return 0, invalid("no component after T designator")
+			}
+			continue
+		}
+		i := 0
+		for i < len(rest) && (rest[i] >= '0' && rest[i] <= '9' || rest[i] == '.' || rest[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return 0, invalid("components are a number and a designator")
+		}
+		unit, ok := isoDurationUnits[part][rest[i]]
+		if !ok {
+			if part == 0 && (rest[i] == 'Y' || rest[i] == 'M') {
+				return 0, invalid("years and months have no fixed length")
+			}
+			return 0, invalid(fmt.Sprintf("unexpected designator %q", rest[i]))
+		}
+		if last != 0 && unit >= last {
+			return 0, invalid("components are out of order")
+		}
+		value, err := parseISODurationNumber(rest[:i], unit)
+		if err != nil {
+			return 0, invalid(err.Error())
+		}
+		if d > time.Duration(1<<63-1)-value {
+			return 0, invalid("overflow")
+		}
+		d += value
+		last = unit
+		components++
+		rest = rest[i+1:]
+	}
+	if components == 0 {
+		return 0, invalid("no components")
+	}
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
+
+// parseISODurationNumber parses the number of a component of an ISO 8601
+// duration, which may have a fraction, in a given unit.
+func parseISODurationNumber(s string, unit time.Duration) (time.Duration, error) {
+	whole, fraction := s, ""
+	if i := strings.IndexAny(s, ".,"); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+	if whole == "" || strings.ContainsAny(fraction, ".,") {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || n > int64(1<<63-1)/int64(unit) {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	d := time.Duration(n) * unit
+	if fraction != "" {
+		f, err := strconv.ParseFloat("0."+fraction, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q", s)
+		}
+		d += time.Duration(f * float64(unit))
+	}
+	return d, nil
+}
+
+// FormatISODuration writes a duration in ISO 8601 with hours, minutes and
+// seconds, such as "PT1H30M".
+func FormatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var b strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		b.WriteByte('-')
+		u = uint64(-d)
+	}
+	b.WriteString("PT")
+	if h := u / uint64(time.Hour); h > 0 {
+		b.WriteString(strconv.FormatUint(h, 10) + "H")
+		u -= h * uint64(time.Hour)
+	}
+	if m := u / uint64(time.Minute); m > 0 {
+		b.WriteString(strconv.FormatUint(m, 10) + "M")
+		u -= m * uint64(time.Minute)
+	}
+	if u > 0 {
+		b.WriteString(strconv.FormatUint(u/uint64(time.Second), 10))
+		if ns := u % uint64(time.Second); ns > 0 {
+			b.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", ns), "0"))
+		}
+		b.WriteByte('S')
+	}
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuration_MarshalJSON(t *testing.T) {
+	b := struct {
+		Timeout    Duration    `json:"timeout"`
+		ISOTimeout ISODuration `json:"iso_timeout"`
+	}{
+		Timeout:    Duration{90 * time.Minute},
+		ISOTimeout: ISODuration{Duration{90 * time.Minute}},
+	}
+	jsonBytes, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"timeout":"1h30m0s","iso_timeout":"PT1H30M"}`, string(jsonBytes))
+}
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	b := struct {
+		Timeout    Duration    `json:"timeout"`
+		ISOTimeout ISODuration `json:"iso_timeout"`
+	}{}
+	err := json.Unmarshal([]byte(`{"timeout":"PT1M","iso_timeout":"1m30s"}`), &b)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, b.Timeout.Duration)
+	assert.Equal(t, 90*time.Second, b.ISOTimeout.Duration.Duration)
+
+	err = json.Unmarshal([]byte(`{"timeout":"soon"}`), &b)
+	assert.Error(t, err)
+}
+
+func TestParseISODuration(t *testing.T) {
+	valid := map[string]time.Duration{
+		"PT0S":        0,
+		"PT1H30M":     90 * time.Minute,
+		"PT1.5S":      1500 * time.Millisecond,
+		"PT0,25S":     250 * time.Millisecond,
+		"P1D":         24 * time.Hour,
+		"P1W":         7 * 24 * time.Hour,
+		"P1DT12H":     36 * time.Hour,
+		"-PT10M":      -10 * time.Minute,
+		"+PT10M":      10 * time.Minute,
+		"PT0.000001S": time.Microsecond,
+	}
+	for s, expected := range valid {
+		d, err := ParseISODuration(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, expected, d, s)
+	}
+
+	for _, s := range []string{"", "P", "PT", "1H", "P1Y", "P2M", "PT1S1H", "PT1H1H", "P1DT", "PTT1H", "PT1.2.3S", "PT.5S", "PT1X"} {
+		_, err := ParseISODuration(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestFormatISODuration(t *testing.T) {
+	assert.Equal(t, "PT0S", FormatISODuration(0))
+	assert.Equal(t, "PT1H30M", FormatISODuration(90*time.Minute))
+	assert.Equal(t, "PT36H", FormatISODuration(36*time.Hour))
+	assert.Equal(t, "PT1M0.5S", FormatISODuration(time.Minute+500*time.Millisecond))
+	assert.Equal(t, "-PT0.000000001S", FormatISODuration(-time.Nanosecond))
+
+	for _, d := range []time.Duration{time.Hour + time.Nanosecond, -42 * time.Second, 1<<63 - 1} {
+		parsed, err := ParseISODuration(FormatISODuration(d))
+		require.NoError(t, err)
+		assert.Equal(t, d, parsed)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	d, err := ParseDuration("1h2m")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour+2*time.Minute, d)
+
+	d, err = ParseDuration("-PT1H2M")
+	assert.NoError(t, err)
+	assert.Equal(t, -time.Hour-2*time.Minute, d)
+}
@@ -5,11 +5,24 @@ import (
 	"errors"
 )
 
+// ErrInvalidEmail is returned for values of Email which aren't email
+// addresses.
+var ErrInvalidEmail = errors.New("email: failed to pass regex validation")
+
 type Email string
 
-func (e Email) MarshalJSON() ([]byte, error) {
+// Validate checks that the email is an address as RFC 5322 describes them,
+// without comments and folding.
+func (e Email) Validate() error {
 	if !emailRegex.MatchString(string(e)) {
-		return nil, errors.New("email: failed to pass regex validation")
+		return ErrInvalidEmail
+	}
+	return nil
+}
+
+func (e Email) MarshalJSON() ([]byte, error) {
+	if err := e.Validate(); err != nil {
+		return nil, err
 	}
 	return json.Marshal(string(e))
 }
@@ -19,9 +32,14 @@ func (e *Email) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
 	}
-	if !emailRegex.MatchString(s) {
-		return errors.New("email: failed to pass regex validation")
+	return e.UnmarshalText([]byte(s))
+}
+
+// UnmarshalText validates the email of a parameter.
+func (e *Email) UnmarshalText(text []byte) error {
+	if err := Email(text).Validate(); err != nil {
+		return err
 	}
-	*e = Email(s)
+	*e = Email(text)
 	return nil
 }
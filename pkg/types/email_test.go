@@ -29,3 +29,15 @@ func TestEmail_UnmarshalJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, testEmail, b.EmailField)
 }
+
+func TestEmail_Validate(t *testing.T) {
+	var e Email
+	assert.NoError(t, e.UnmarshalText([]byte("gaben@valvesoftware.com")))
+	assert.Equal(t, Email("gaben@valvesoftware.com"), e)
+
+	for _, invalid := range []string{"", "gaben", "gaben@", "@valvesoftware.com", "gaben@valve software.com"} {
+		assert.ErrorIs(t, e.UnmarshalText([]byte(invalid)), ErrInvalidEmail, invalid)
+		assert.ErrorIs(t, json.Unmarshal([]byte(`"`+invalid+`"`), &e), ErrInvalidEmail, invalid)
+	}
+	assert.Equal(t, Email("gaben@valvesoftware.com"), e)
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+)
+
+// File is the value of a property of format binary in a multipart body. It
+// is a file received in a multipart form, or one to be sent, whose content is
+// in memory or is read from a reader.
+type File struct {
+	multipart   *multipart.FileHeader
+	data        []byte
+	reader      io.Reader
+	filename    string
+	contentType string
+}
+
+// InitFromMultipart sets the file to one received in a multipart form.
+func (file *File) InitFromMultipart(header *multipart.FileHeader) {
+	*file = File{
+		multipart:   header,
+		filename:    header.Filename,
+		contentType: header.Header.Get("Content-Type"),
+	}
+}
+
+// InitFromBytes sets the file to content held in memory.
+func (file *File) InitFromBytes(data []byte, filename, contentType string) {
+	*file = File{data: data, filename: filename, contentType: contentType}
+}
+
+// InitFromReader sets the file to content read from r. The reader is
+// consumed when the file is read, so the file may only be read once.
+func (file *File) InitFromReader(r io.Reader, filename, contentType string) {
+	*file = File{reader: r, filename: filename, contentType: contentType}
+}
+
+// Filename returns the name of the file, which may be empty.
+func (file File) Filename() string {
+	return file.filename
+}
+
+// ContentType returns the media type of the file, which may be empty.
+func (file File) ContentType() string {
+	return file.contentType
+}
+
+// Header returns the headers of the part a file was received in, such as
+// those given by the encoding object of its property, or nil when it wasn't
+// received in a multipart form.
+func (file File) Header() textproto.MIMEHeader {
+	if file.multipart == nil {
+		return nil
+	}
+	return file.multipart.Header
+}
+
+// FileSize returns the size of the file, or -1 when it's read from a reader.
+func (file File) FileSize() int64 {
+	switch {
+	case file.multipart != nil:
+		return file.multipart.Size
+	case file.reader != nil:
+		return -1
+	default:
+		return int64(len(file.data))
+	}
+}
+
+// Reader returns a reader of the content of the file, which must be closed.
+func (file File) Reader() (io.ReadCloser, error) {
+	switch {
+	case file.multipart != nil:
+		return file.multipart.Open()
+	case file.reader != nil:
+		if rc, ok := file.reader.(io.ReadCloser); ok {
+			return rc, nil
+		}
+		return io.NopCloser(file.reader), nil
+	default:
+		return io.NopCloser(bytes.NewReader(file.data)), nil
+	}
+}
+
+// Bytes reads the whole content of the file.
+func (file File) Bytes() ([]byte, error) {
+	if file.multipart == nil && file.reader == nil {
+		return file.data, nil
+	}
+	r, err := file.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// MarshalJSON writes the content of the file in base64, as encoding/json
+// writes byte slices.
+func (file File) MarshalJSON() ([]byte, error) {
+	data, err := file.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(data)
+}
+
+func (file *File) UnmarshalJSON(data []byte) error {
+	var content []byte
+	if err := json.Unmarshal(data, &content); err != nil {
+		return err
+	}
+	file.InitFromBytes(content, "", "")
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_Bytes(t *testing.T) {
+	var file File
+	file.InitFromBytes([]byte("content"), "a.txt", "text/plain")
+	assert.Equal(t, "a.txt", file.Filename())
+	assert.Equal(t, "text/plain", file.ContentType())
+	assert.EqualValues(t, 7, file.FileSize())
+	data, err := file.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(data))
+
+	file.InitFromReader(strings.NewReader("streamed"), "b.txt", "")
+	assert.EqualValues(t, -1, file.FileSize())
+	data, err = file.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "streamed", string(data))
+}
+
+func TestFile_JSON(t *testing.T) {
+	var file File
+	file.InitFromBytes([]byte("content"), "a.txt", "text/plain")
+	data, err := json.Marshal(file)
+	require.NoError(t, err)
+	assert.Equal(t, `"Y29udGVudA=="`, string(data))
+
+	var parsed File
+	require.NoError(t, json.Unmarshal(data, &parsed))
+	content, err := parsed.Bytes()
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	assert.Error(t, json.Unmarshal([]byte(`"not base64!"`), &parsed))
+}
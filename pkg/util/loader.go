@@ -1,20 +1,190 @@
 package util
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
+// LoadOptions configure how specs at URLs are fetched, for registries and
+// portals which require authentication or their own certificates.
+type LoadOptions struct {
+	Headers            map[string]string // Headers sent with the requests for specs
+	BearerToken        string            // When set, sent as the Authorization header
+	BasicAuth          string            // When set, the user and password sent as the Authorization header, as user:password
+	CACertFile         string            // A PEM file of certificates trusted along with those of the system
+	ClientCertFile     string            // With ClientKeyFile, the PEM certificate presented to servers asking for one
+	ClientKeyFile      string            // The PEM key of ClientCertFile
+	InsecureSkipVerify bool              // Whether certificates of servers are trusted without being verified
+	ReportWarning      func(string)      // When set, called with what the conversion of Swagger 2.0 specs loses
+	Stdin              []byte            // The content of the spec at the path "-", such as one read from the standard input
+}
+
 func LoadSwagger(filePath string) (swagger *openapi3.T, err error) {
+	return LoadSwaggerWithOptions(filePath, LoadOptions{})
+}
+
+// LoadSwaggerWithOptions loads the spec at filePath, which is a file path or
+// a URL. When it's a URL, the headers and credentials of the options are sent
+// with the requests for the spec, and for the specs it refers to at the same
+// scheme and host, but not to other hosts, including those requests are
+// redirected to. When it's "-", the spec is the Stdin of the options. Swagger
+// 2.0 specs are converted to OpenAPI 3.
+func LoadSwaggerWithOptions(filePath string, opts LoadOptions) (swagger *openapi3.T, err error) {
+	if filePath == "-" {
+		return LoadSwaggerFromDataWithOptions(opts.Stdin, opts)
+	}
 
 	loader := openapi3.NewLoader()
 	loader.IsExternalRefsAllowed = true
 
 	u, err := url.Parse(filePath)
 	if err == nil && u.Scheme != "" && u.Host != "" {
+		client, err := opts.httpClient(u)
+		if err != nil {
+			return nil, err
+		}
+		loader.ReadFromURIFunc = openapi3.URIMapCache(openapi3.ReadFromURIs(readFromHTTP(client, opts, u), openapi3.ReadFromFile))
+		data, err := loader.ReadFromURIFunc(loader, u)
+		if err != nil {
+			return nil, err
+		}
+		if isSwagger2(data) {
+			return loadSwagger2(data, opts)
+		}
 		return loader.LoadFromURI(u)
 	} else {
+		data, err := ioutil.ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if isSwagger2(data) {
+			return loadSwagger2(data, opts)
+		}
 		return loader.LoadFromFile(filePath)
 	}
 }
+
+// LoadSwaggerFromData loads a spec from its content, such as one read from
+// the standard input, whose references to other files are relative to the
+// working directory.
+func LoadSwaggerFromData(data []byte) (*openapi3.T, error) {
+	return LoadSwaggerFromDataWithOptions(data, LoadOptions{})
+}
+
+// LoadSwaggerFromDataWithOptions loads a spec from its content like
+// LoadSwaggerFromData, reporting what the conversion of a Swagger 2.0 spec
+// loses to the ReportWarning of the options.
+func LoadSwaggerFromDataWithOptions(data []byte, opts LoadOptions) (*openapi3.T, error) {
+	if isSwagger2(data) {
+		return loadSwagger2(data, opts)
+	}
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	return loader.LoadFromDataWithPath(data, &url.URL{Path: "-"})
+}
+
+// httpClient returns the client fetching the spec at spec, and those it refers
+// to, with the TLS configuration of the options. The headers and credentials
+// of the options aren't sent on when a request is redirected to another host.
+func (opts LoadOptions) httpClient(spec *url.URL) (*http.Client, error) {
+	client := &http.Client{CheckRedirect: opts.checkRedirect(spec)}
+	if opts.CACertFile == "" && opts.ClientCertFile == "" && !opts.InsecureSkipVerify {
+		return client, nil
+	}
+
+	config := &tls.Config{InsecureSkipVerify: opts.InsecureSkipVerify}
+	if opts.CACertFile != "" {
+		pem, err := ioutil.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA certificates: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", opts.CACertFile)
+		}
+		config.RootCAs = pool
+	}
+	if opts.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = config
+	client.Transport = transport
+	return client, nil
+}
+
+// checkRedirect returns the CheckRedirect of the client fetching the spec at
+// spec, which removes the headers and credentials of the options from requests
+// redirected away from its scheme and host. Like the default, it stops after 10
+// redirects.
+func (opts LoadOptions) checkRedirect(spec *url.URL) func(*http.Request, []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		if req.URL.Scheme != spec.Scheme || req.URL.Host != spec.Host {
+			for name := range opts.Headers {
+				req.Header.Del(name)
+			}
+			if opts.BearerToken != "" || opts.BasicAuth != "" {
+				req.Header.Del("Authorization")
+			}
+		}
+		return nil
+	}
+}
+
+// readFromHTTP reads specs at URLs with client, like openapi3.ReadFromHTTP,
+// sending the headers and credentials of the options to the scheme and host of
+// the spec.
+func readFromHTTP(client *http.Client, opts LoadOptions, spec *url.URL) openapi3.ReadFromURIFunc {
+	return func(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+		if location.Scheme == "" || location.Host == "" {
+			return nil, openapi3.ErrURINotSupported
+		}
+		req, err := http.NewRequest("GET", location.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if location.Scheme == spec.Scheme && location.Host == spec.Host {
+			for name, value := range opts.Headers {
+				req.Header.Set(name, value)
+			}
+			if opts.BearerToken != "" {
+				req.Header.Set("Authorization", "Bearer "+opts.BearerToken)
+			}
+			if opts.BasicAuth != "" {
+				user, password := opts.BasicAuth, ""
+				if i := strings.Index(user, ":"); i >= 0 {
+					user, password = user[:i], user[i+1:]
+				}
+				req.SetBasicAuth(user, password)
+			}
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode > 399 {
+			return nil, fmt.Errorf("error loading %q: request returned status code %d", location.String(), resp.StatusCode)
+		}
+		return ioutil.ReadAll(resp.Body)
+	}
+}
@@ -0,0 +1,156 @@
+package util
+
+import (
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSwaggerWithOptions(t *testing.T) {
+	// Another host serves the errors, and must not be given the credentials.
+	errors := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" || r.Header.Get("X-Tenant") != "" {
+			http.Error(w, "leaked credentials", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `
+components:
+  schemas:
+    Error:
+      type: string
+`)
+	}))
+	defer errors.Close()
+
+	registry := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Tenant") != "acme" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/specs/api.yaml":
+			fmt.Fprintf(w, `
+openapi: 3.0.1
+info:
+  title: Registry
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pet:
+      $ref: ./pets.yaml#/components/schemas/Pet
+    Error:
+      $ref: %s/errors.yaml#/components/schemas/Error
+`, errors.URL)
+		case "/specs/pets.yaml":
+			fmt.Fprint(w, `
+components:
+  schemas:
+    Pet:
+      type: object
+`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer registry.Close()
+
+	dir, err := ioutil.TempDir("", "loader")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	caCert := filepath.Join(dir, "ca.pem")
+	err = ioutil.WriteFile(caCert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: registry.Certificate().Raw}), 0644)
+	require.NoError(t, err)
+
+	specURL := registry.URL + "/specs/api.yaml"
+	opts := LoadOptions{
+		Headers:     map[string]string{"X-Tenant": "acme"},
+		BearerToken: "secret",
+		CACertFile:  caCert,
+	}
+	swagger, err := LoadSwaggerWithOptions(specURL, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "object", swagger.Components.Schemas["Pet"].Value.Type)
+	assert.Equal(t, "string", swagger.Components.Schemas["Error"].Value.Type)
+
+	// The certificate of the registry isn't trusted by the system.
+	_, err = LoadSwagger(specURL)
+	assert.Error(t, err)
+
+	opts = LoadOptions{InsecureSkipVerify: true}
+	_, err = LoadSwaggerWithOptions(specURL, opts)
+	assert.EqualError(t, err, fmt.Sprintf("error loading %q: request returned status code 401", specURL))
+}
+
+func TestLoadSwaggerWithOptionsRedirect(t *testing.T) {
+	// The registry redirects to a mirror on another host, which must not be
+	// given the credentials.
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" || r.Header.Get("X-Tenant") != "" {
+			http.Error(w, "leaked credentials", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `
+openapi: 3.0.1
+info:
+  title: Mirror
+  version: 1.0.0
+paths: {}
+`)
+	}))
+	defer mirror.Close()
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Tenant") != "acme" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		http.Redirect(w, r, mirror.URL+r.URL.Path, http.StatusFound)
+	}))
+	defer registry.Close()
+
+	opts := LoadOptions{
+		Headers:     map[string]string{"X-Tenant": "acme"},
+		BearerToken: "secret",
+	}
+	swagger, err := LoadSwaggerWithOptions(registry.URL+"/specs/api.yaml", opts)
+	require.NoError(t, err)
+	assert.Equal(t, "Mirror", swagger.Info.Title)
+}
+
+func TestLoadSwaggerFromData(t *testing.T) {
+	// References are relative to the working directory.
+	swagger, err := LoadSwaggerFromData([]byte(`
+openapi: 3.0.1
+info:
+  title: Piped
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Object:
+      $ref: ../../internal/test/externalref/packageB/spec.yaml#/components/schemas/ObjectB
+`))
+	require.NoError(t, err)
+	assert.Contains(t, swagger.Components.Schemas["Object"].Value.Properties, "name")
+}
+
+func TestLoadSwaggerWithOptionsStdin(t *testing.T) {
+	swagger, err := LoadSwaggerWithOptions("-", LoadOptions{Stdin: []byte(`
+openapi: 3.0.1
+info:
+  title: Piped
+  version: 1.0.0
+paths: {}
+`)})
+	require.NoError(t, err)
+	assert.Equal(t, "Piped", swagger.Info.Title)
+}
@@ -0,0 +1,192 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	ghodss "github.com/ghodss/yaml"
+	"gopkg.in/yaml.v2"
+)
+
+// isSwagger2 reports whether data is a Swagger 2.0 document rather than an
+// OpenAPI 3 one.
+func isSwagger2(data []byte) bool {
+	var version struct {
+		Swagger string `yaml:"swagger"`
+	}
+	return yaml.Unmarshal(data, &version) == nil && strings.HasPrefix(version.Swagger, "2.")
+}
+
+// loadSwagger2 converts the Swagger 2.0 document data to OpenAPI 3, reporting
+// what the conversion loses to the ReportWarning of the options. The
+// conversion only resolves the references within the document, so those to
+// other documents are rejected.
+func loadSwagger2(data []byte, opts LoadOptions) (*openapi3.T, error) {
+	var doc2 openapi2.T
+	if err := ghodss.Unmarshal(data, &doc2); err != nil {
+		return nil, fmt.Errorf("error parsing Swagger 2.0 spec: %w", err)
+	}
+	var raw interface{}
+	if err := ghodss.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("error parsing Swagger 2.0 spec: %w", err)
+	}
+	if ref := externalRef(raw); ref != "" {
+		return nil, fmt.Errorf("the Swagger 2.0 spec references %s in another document, which can't be converted; bundle it into a single document first", ref)
+	}
+	// The conversion drops the fields it doesn't know from doc2, so the losses
+	// are found first.
+	losses := swagger2Losses(&doc2)
+	swagger, err := openapi2conv.ToV3(&doc2)
+	if err != nil {
+		return nil, fmt.Errorf("error converting Swagger 2.0 spec: %w", err)
+	}
+	if opts.ReportWarning != nil {
+		for _, loss := range losses {
+			opts.ReportWarning(loss)
+		}
+	}
+	return swagger, nil
+}
+
+// swagger2Losses returns what the conversion of doc to OpenAPI 3 loses, in the
+// order of the paths and methods of its operations.
+func swagger2Losses(doc *openapi2.T) []string {
+	var losses []string
+	lose := func(format string, args ...interface{}) {
+		losses = append(losses, "Swagger 2.0 conversion: "+fmt.Sprintf(format, args...))
+	}
+
+	for _, field := range unknownFields(doc.Extensions) {
+		lose("%s is not converted", field)
+	}
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		pathItem := doc.Paths[path]
+		for _, field := range unknownFields(pathItem.Extensions) {
+			lose("%s of path %s is not converted", field, path)
+		}
+		for _, parameter := range pathItem.Parameters {
+			if loss := parameterLoss(parameter); loss != "" {
+				lose("%s of path %s is not converted", loss, path)
+			}
+		}
+
+		operations := pathItem.Operations()
+		methods := make([]string, 0, len(operations))
+		for method := range operations {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			op := operations[method]
+			for _, field := range unknownFields(op.Extensions) {
+				lose("%s of %s %s is not converted", field, method, path)
+			}
+			if len(op.Schemes) > 0 {
+				lose("schemes of %s %s are not converted", method, path)
+			}
+			for _, parameter := range op.Parameters {
+				if loss := parameterLoss(parameter); loss != "" {
+					lose("%s of %s %s is not converted", loss, method, path)
+				}
+			}
+
+			produces := op.Produces
+			if len(produces) == 0 {
+				produces = doc.Produces
+			}
+			for _, mediaType := range produces {
+				if !strings.Contains(mediaType, "json") {
+					lose("responses of %s %s are converted as application/json, not %s", method, path, mediaType)
+				}
+			}
+
+			codes := make([]string, 0, len(op.Responses))
+			for code := range op.Responses {
+				codes = append(codes, code)
+			}
+			sort.Strings(codes)
+			for _, code := range codes {
+				if len(op.Responses[code].Examples) > 0 {
+					lose("examples of response %s of %s %s are not converted", code, method, path)
+				}
+			}
+		}
+	}
+	return losses
+}
+
+// parameterLoss returns what the conversion of parameter loses, or "" when
+// nothing is lost. The collection formats of arrays become the default styles
+// of their locations, which are the same as multi in queries and forms, and
+// csv elsewhere.
+func parameterLoss(parameter *openapi2.Parameter) string {
+	if parameter.Ref != "" || parameter.Type != "array" {
+		return ""
+	}
+	format := parameter.CollectionFormat
+	if format == "" {
+		format = "csv"
+	}
+	switch parameter.In {
+	case "query", "formData":
+		if format == "multi" {
+			return ""
+		}
+	default:
+		if format == "csv" {
+			return ""
+		}
+	}
+	return fmt.Sprintf("collectionFormat %s of parameter %s", format, parameter.Name)
+}
+
+// unknownFields returns the sorted fields of extensions which aren't
+// extensions, which the conversion drops, such as deprecated.
+func unknownFields(extensions map[string]interface{}) []string {
+	var fields []string
+	for field := range extensions {
+		if !strings.HasPrefix(field, "x-") {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// externalRef returns the first $ref of value, in the order of its keys, which
+// refers to another document, or "" when it has none.
+func externalRef(value interface{}) string {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := value["$ref"].(string); ok && !strings.HasPrefix(ref, "#") {
+			return ref
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if ref := externalRef(value[key]); ref != "" {
+				return ref
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			if ref := externalRef(item); ref != "" {
+				return ref
+			}
+		}
+	}
+	return ""
+}
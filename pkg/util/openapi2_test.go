@@ -0,0 +1,114 @@
+package util
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const swagger2Spec = `
+swagger: "2.0"
+info:
+  title: Pets
+  version: 1.0.0
+host: api.example.com
+basePath: /v1
+produces:
+  - application/json
+  - application/xml
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      deprecated: true
+      schemes:
+        - http
+      parameters:
+        - name: tags
+          in: query
+          type: array
+          items:
+            type: string
+        - name: ids
+          in: header
+          type: array
+          items:
+            type: integer
+          collectionFormat: pipes
+      responses:
+        200:
+          description: The pets
+          schema:
+            type: array
+            items:
+              $ref: "#/definitions/Pet"
+          examples:
+            application/json: []
+definitions:
+  Pet:
+    type: object
+    properties:
+      name:
+        type: string
+`
+
+func TestLoadSwagger2(t *testing.T) {
+	var warnings []string
+	opts := LoadOptions{ReportWarning: func(warning string) {
+		warnings = append(warnings, warning)
+	}}
+
+	swagger, err := LoadSwaggerFromDataWithOptions([]byte(swagger2Spec), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "3.0.3", swagger.OpenAPI)
+	require.Len(t, swagger.Servers, 1)
+	assert.Equal(t, "https://api.example.com/v1", swagger.Servers[0].URL)
+	op := swagger.Paths["/pets"].Get
+	require.NotNil(t, op)
+	assert.Equal(t, "listPets", op.OperationID)
+	assert.Equal(t, "#/components/schemas/Pet", op.Responses["200"].Value.Content["application/json"].Schema.Value.Items.Ref)
+	assert.Contains(t, swagger.Components.Schemas, "Pet")
+
+	assert.Equal(t, []string{
+		"Swagger 2.0 conversion: deprecated of GET /pets is not converted",
+		"Swagger 2.0 conversion: schemes of GET /pets are not converted",
+		"Swagger 2.0 conversion: collectionFormat csv of parameter tags of GET /pets is not converted",
+		"Swagger 2.0 conversion: collectionFormat pipes of parameter ids of GET /pets is not converted",
+		"Swagger 2.0 conversion: responses of GET /pets are converted as application/json, not application/xml",
+		"Swagger 2.0 conversion: examples of response 200 of GET /pets are not converted",
+	}, warnings)
+
+	// Files are converted in the same way, and OpenAPI 3 specs are loaded as
+	// they are.
+	path := filepath.Join(t.TempDir(), "swagger.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(swagger2Spec), 0644))
+	swagger, err = LoadSwaggerWithOptions(path, LoadOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "3.0.3", swagger.OpenAPI)
+
+	swagger, err = LoadSwaggerFromDataWithOptions([]byte("openapi: 3.0.0\ninfo: {title: Pets, version: 1.0.0}\npaths: {}\n"), opts)
+	require.NoError(t, err)
+	assert.Equal(t, "3.0.0", swagger.OpenAPI)
+}
+
+func TestLoadSwagger2ExternalRef(t *testing.T) {
+	spec := `
+swagger: "2.0"
+info:
+  title: Pets
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      responses:
+        200:
+          description: The pets
+          schema:
+            $ref: "pets.yaml#/definitions/Pets"
+`
+	_, err := LoadSwaggerFromDataWithOptions([]byte(spec), LoadOptions{})
+	assert.EqualError(t, err, "the Swagger 2.0 spec references pets.yaml#/definitions/Pets in another document, which can't be converted; bundle it into a single document first")
+}